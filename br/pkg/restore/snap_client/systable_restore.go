@@ -110,6 +110,8 @@ var unRecoverableTable = map[string]map[string]struct{}{
 
 		// TiDB internal timers.
 		"tidb_timers": {},
+		// TiDB internal event information, the events are stored as timers.
+		"tidb_event_history": {},
 
 		// gc info don't need to recover.
 		"gc_delete_range":       {},
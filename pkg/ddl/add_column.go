@@ -16,6 +16,7 @@ package ddl
 
 import (
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 	"time"
@@ -553,6 +554,10 @@ func columnDefToCol(ctx *metabuild.Context, offset int, colDef *ast.ColumnDef, o
 				}
 			case ast.ColumnOptionFulltext:
 				ctx.AppendWarning(dbterror.ErrTableCantHandleFt.FastGenByArgs())
+			case ast.ColumnOptionSRID:
+				if err = setColumnSRID(col, v); err != nil {
+					return nil, nil, errors.Trace(err)
+				}
 			case ast.ColumnOptionCheck:
 				if !vardef.EnableCheckConstraint.Load() {
 					ctx.AppendWarning(errCheckConstraintIsOff)
@@ -923,6 +928,23 @@ func setColumnComment(ctx expression.BuildContext, col *table.Column, option *as
 	return errors.Trace(err)
 }
 
+// setColumnSRID sets the SRID of the geometry column.
+func setColumnSRID(col *table.Column, option *ast.ColumnOption) error {
+	if col.GetType() != mysql.TypeGeometry {
+		return dbterror.ErrWrongUsage.GenWithStackByArgs("SRID", "non-geometry column")
+	}
+	v, ok := option.Expr.(ast.ValueExpr).GetValue().(uint64)
+	if !ok || v > math.MaxUint32 {
+		return types.ErrOverflow.GenWithStackByArgs("SRID", col.Name.O)
+	}
+	srid := uint32(v)
+	if err := types.CheckSRID(srid); err != nil {
+		return errors.Trace(err)
+	}
+	col.SRID = &srid
+	return nil
+}
+
 func processAndCheckDefaultValueAndColumn(ctx expression.BuildContext, col *table.Column,
 	outPriKeyConstraint *ast.Constraint, hasDefaultValue, setOnUpdateNow, hasNullFlag bool) error {
 	processDefaultValue(col, hasDefaultValue, setOnUpdateNow)
@@ -1194,6 +1216,10 @@ func checkColumnDefaultValue(ctx exprctx.BuildContext, col *table.Column, value
 		// Note that expression default is still supported.
 		return hasDefaultValue, value, errors.Errorf("VECTOR column '%-.192s' can't have a literal default. Use expression default instead: ((VEC_FROM_TEXT('...')))", col.Name.O)
 	}
+	if value != nil && col.GetType() == mysql.TypeGeometry {
+		// In any SQL mode we don't allow GEOMETRY column to have a literal default value.
+		return hasDefaultValue, value, dbterror.ErrBlobCantHaveDefault.GenWithStackByArgs(col.Name.O)
+	}
 	if value != nil && (col.GetType() == mysql.TypeJSON ||
 		col.GetType() == mysql.TypeTinyBlob || col.GetType() == mysql.TypeMediumBlob ||
 		col.GetType() == mysql.TypeLongBlob || col.GetType() == mysql.TypeBlob) {
@@ -64,6 +64,7 @@ const (
 	typeCleanUpIndexWorker
 	typeAddIndexMergeTmpWorker
 	typeReorgPartitionWorker
+	typeChangePrimaryKeyWorker
 
 	typeCount
 )
@@ -85,6 +86,8 @@ func (bT backfillerType) String() string {
 		return "merge temporary index"
 	case typeReorgPartitionWorker:
 		return "reorganize partition"
+	case typeChangePrimaryKeyWorker:
+		return "change primary key"
 	default:
 		return "unknown"
 	}
@@ -95,6 +98,7 @@ func (bT backfillerType) String() string {
 // 2: modify-column-type
 // 3: clean-up global index
 // 4: reorganize partition
+// 5: change primary key
 //
 // They all have a write reorganization state to back fill data into the rows existed.
 // Backfilling is time consuming, to accelerate this process, TiDB has built some sub
@@ -15,6 +15,7 @@
 package ddl
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 
@@ -26,6 +27,7 @@ import (
 	"github.com/pingcap/tidb/pkg/disttask/framework/taskexecutor/execute"
 	"github.com/pingcap/tidb/pkg/lightning/backend/external"
 	"github.com/pingcap/tidb/pkg/lightning/common"
+	"github.com/pingcap/tidb/pkg/meta"
 	"github.com/pingcap/tidb/pkg/meta/model"
 	"github.com/pingcap/tidb/pkg/table"
 	"go.uber.org/zap"
@@ -37,7 +39,8 @@ type BackfillTaskMeta struct {
 	// EleIDs stands for the index/column IDs to backfill with distributed framework.
 	EleIDs []int64 `json:"ele_ids"`
 	// EleTypeKey is the type of the element to backfill with distributed framework.
-	// For now, only index type is supported.
+	// The index type backfills indexes, and the column type copies the rows of a
+	// primary key change, see doReorgWorkForChangePrimaryKeyRows.
 	EleTypeKey []byte `json:"ele_type_key"`
 
 	CloudStorageURI string `json:"cloud_storage_uri"`
@@ -143,7 +146,31 @@ func (s *backfillDistExecutor) newBackfillStepExecutor(
 	if err != nil {
 		return nil, err
 	}
+	if pkc := tblIface.Meta().PrimaryKeyChange; pkc != nil {
+		// The indexes may belong to the table with the new primary key.
+		if reorgTblInfo := pkc.ReorgTableInfo(tblIface.Meta()); reorgTblInfo != tblIface.Meta() {
+			tblIface, err = getTable(ddlObj.ddlCtx.getAutoIDRequirement(), jobMeta.SchemaID, reorgTblInfo)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
 	tbl := tblIface.(table.PhysicalTable)
+	if bytes.Equal(s.taskMeta.EleTypeKey, meta.ColumnElementKey) {
+		// Copying the rows only has the read index step with local sort.
+		if stage != proto.BackfillStepReadIndex {
+			return nil, errors.Errorf("unknown step %d for copying rows of job %d", stage, jobMeta.ID)
+		}
+		jc := ddlObj.jobContext(jobMeta.ID, jobMeta.ReorgMeta)
+		ddlObj.setDDLLabelForTopSQL(jobMeta.ID, jobMeta.Query)
+		ddlObj.setDDLSourceForDiagnosis(jobMeta.ID, jobMeta.Type)
+		exec, err := newReadIndexExecutor(ddlObj, jobMeta, nil, tbl, jc, "", s.taskMeta.EstimateRowSize)
+		if err != nil {
+			return nil, err
+		}
+		exec.copyRows = true
+		return exec, nil
+	}
 	eleIDs := s.taskMeta.EleIDs
 	indexInfos := make([]*model.IndexInfo, 0, len(eleIDs))
 	for _, eid := range eleIDs {
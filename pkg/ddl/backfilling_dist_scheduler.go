@@ -227,7 +227,9 @@ func getTblInfo(ctx context.Context, d *ddl, job *model.Job) (tblInfo *model.Tab
 	if err != nil {
 		return nil, err
 	}
-
+	if pkc := tblInfo.PrimaryKeyChange; pkc != nil {
+		return pkc.ReorgTableInfo(tblInfo), nil
+	}
 	return tblInfo, nil
 }
 
@@ -46,6 +46,7 @@ import (
 	"github.com/pingcap/tidb/pkg/table"
 	"github.com/pingcap/tidb/pkg/table/tables"
 	"github.com/pingcap/tidb/pkg/tablecodec"
+	"github.com/pingcap/tidb/pkg/types"
 	"github.com/pingcap/tidb/pkg/util/chunk"
 	"github.com/pingcap/tidb/pkg/util/dbterror"
 	"github.com/pingcap/tidb/pkg/util/intest"
@@ -68,6 +69,10 @@ var (
 	_ operator.Operator                     = (*IndexIngestOperator)(nil)
 	_ operator.WithSink[IndexWriteResult]   = (*IndexIngestOperator)(nil)
 
+	_ operator.WithSource[IndexRecordChunk] = (*RecordIngestOperator)(nil)
+	_ operator.Operator                     = (*RecordIngestOperator)(nil)
+	_ operator.WithSink[IndexWriteResult]   = (*RecordIngestOperator)(nil)
+
 	_ operator.WithSource[IndexWriteResult] = (*indexWriteResultSink)(nil)
 	_ operator.Operator                     = (*indexWriteResultSink)(nil)
 )
@@ -278,6 +283,53 @@ func NewWriteIndexToExternalStoragePipeline(
 	), nil
 }
 
+// NewChangePrimaryKeyIngestPipeline creates a pipeline for copying the rows to the table
+// with the new primary key in ingest mode, see doReorgWorkForChangePrimaryKeyRows.
+func NewChangePrimaryKeyIngestPipeline(
+	ctx *OperatorCtx,
+	store kv.Storage,
+	sessPool opSessPool,
+	backendCtx ingest.BackendCtx,
+	engine ingest.Engine,
+	jobID int64,
+	tbl table.PhysicalTable,
+	startKey, endKey kv.Key,
+	reorgMeta *model.DDLReorgMeta,
+	avgRowSize int,
+	concurrency int,
+	rowCntListener RowCountListener,
+) (*operator.AsyncPipeline, error) {
+	reqSrc := getDDLRequestSource(model.ActionChangePrimaryKey)
+	copCtx, err := NewReorgCopContext(store, reorgMeta, tbl.Meta(),
+		[]*model.IndexInfo{buildCopyRowsIndexInfo(tbl.Meta())}, reqSrc)
+	if err != nil {
+		return nil, err
+	}
+	srcChkPool := createChunkPool(copCtx, reorgMeta)
+	readerCnt, writerCnt := expectedIngestWorkerCnt(concurrency, avgRowSize)
+
+	srcOp := NewTableScanTaskSource(ctx, store, tbl, startKey, endKey, backendCtx)
+	scanOp := NewTableScanOperator(ctx, sessPool, copCtx, srcChkPool, readerCnt,
+		reorgMeta.GetBatchSize(), reorgMeta, backendCtx)
+	ingestOp := NewRecordIngestOperator(ctx, copCtx, sessPool,
+		tbl, engine, srcChkPool, writerCnt, reorgMeta)
+	sinkOp := newIndexWriteResultSink(ctx, backendCtx, tbl, nil, rowCntListener)
+
+	operator.Compose(srcOp, scanOp)
+	operator.Compose(scanOp, ingestOp)
+	operator.Compose(ingestOp, sinkOp)
+
+	logutil.Logger(ctx).Info("build change primary key local storage operators",
+		zap.Int64("jobID", jobID),
+		zap.Int("avgRowSize", avgRowSize),
+		zap.Int("reader", readerCnt),
+		zap.Int("writer", writerCnt))
+
+	return operator.NewAsyncPipeline(
+		srcOp, scanOp, ingestOp, sinkOp,
+	), nil
+}
+
 func createChunkPool(copCtx copr.CopContext, reorgMeta *model.DDLReorgMeta) *sync.Pool {
 	return &sync.Pool{
 		New: func() any {
@@ -867,6 +919,111 @@ func (w *indexIngestWorker) WriteChunk(rs *IndexRecordChunk) (count int, nextKey
 	return cnt, nextKey, nil
 }
 
+// RecordIngestOperator writes the rows to the ingest engine as the records of the table
+// with the new primary key.
+type RecordIngestOperator struct {
+	*operator.AsyncOperator[IndexRecordChunk, IndexWriteResult]
+}
+
+// NewRecordIngestOperator creates a new RecordIngestOperator.
+func NewRecordIngestOperator(
+	ctx *OperatorCtx,
+	copCtx copr.CopContext,
+	sessPool opSessPool,
+	tbl table.PhysicalTable,
+	engine ingest.Engine,
+	srcChunkPool *sync.Pool,
+	concurrency int,
+	reorgMeta *model.DDLReorgMeta,
+) *RecordIngestOperator {
+	writerCfg := getLocalWriterConfig(1, concurrency)
+
+	var writerIDAlloc atomic.Int32
+	pool := workerpool.NewWorkerPool(
+		"recordIngestOperator",
+		util.DDL,
+		concurrency,
+		func() workerpool.Worker[IndexRecordChunk, IndexWriteResult] {
+			writer, err := engine.CreateWriter(int(writerIDAlloc.Add(1)), writerCfg)
+			if err != nil {
+				logutil.Logger(ctx).Error("create record ingest worker failed", zap.Error(err))
+				ctx.onError(err)
+				return nil
+			}
+			return &recordIngestWorker{
+				indexIngestWorker: indexIngestWorker{
+					ctx:          ctx,
+					tbl:          tbl,
+					copCtx:       copCtx,
+					sessPool:     sessPool,
+					writers:      []ingest.Writer{writer},
+					srcChunkPool: srcChunkPool,
+					reorgMeta:    reorgMeta,
+				},
+			}
+		})
+	return &RecordIngestOperator{
+		AsyncOperator: operator.NewAsyncOperator[IndexRecordChunk, IndexWriteResult](ctx, pool),
+	}
+}
+
+// recordIngestWorker shares the session and the writer management with indexIngestWorker.
+type recordIngestWorker struct {
+	indexIngestWorker
+	rowBuf []types.Datum
+}
+
+func (w *recordIngestWorker) HandleTask(ck IndexRecordChunk, send func(IndexWriteResult)) {
+	defer func() {
+		if ck.Chunk != nil {
+			w.srcChunkPool.Put(ck.Chunk)
+		}
+	}()
+	w.initSessCtx()
+	count, err := w.writeRecords(ck.Chunk)
+	if err != nil {
+		w.ctx.onError(err)
+		return
+	}
+	if count == 0 {
+		logutil.Logger(w.ctx).Info("finish a record ingest task", zap.Int("id", ck.ID))
+		return
+	}
+	send(IndexWriteResult{ID: ck.ID, Added: count})
+}
+
+func (w *recordIngestWorker) writeRecords(chk *chunk.Chunk) (int, error) {
+	if w.se == nil {
+		// The error is reported by initSessCtx.
+		return 0, nil
+	}
+	failpoint.InjectCall("writeRecordsExec")
+	c := w.copCtx.GetBase()
+	ectx := c.ExprCtx.GetEvalCtx()
+	offsets := w.copCtx.IndexColumnOutputOffsets(copyRowsEngineID)
+	if len(w.rowBuf) < len(offsets) {
+		w.rowBuf = make([]types.Datum, len(offsets))
+	}
+	writer := w.writers[0]
+	unlock := writer.LockForWrite()
+	defer unlock()
+	tblCtx := w.se.GetTableCtx()
+	count := 0
+	iter := chunk.NewIterator4Chunk(chk)
+	for row := iter.Begin(); row != iter.End(); row = iter.Next() {
+		rowData := ExtractDatumByOffsets(ectx, row, offsets, c.ExprColumnInfos, w.rowBuf)
+		key, val, h, err := tables.EncodeRecordForPrimaryKeyChange(tblCtx, w.tbl, rowData[:len(offsets)])
+		if err != nil {
+			return 0, errors.Trace(err)
+		}
+		if err = writer.WriteRow(w.ctx, key, val, h); err != nil {
+			return 0, errors.Trace(err)
+		}
+		count++
+	}
+	return count, nil
+}
+
 type indexWriteResultSink struct {
 	ctx        *OperatorCtx
 	backendCtx ingest.BackendCtx
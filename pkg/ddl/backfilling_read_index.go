@@ -51,6 +51,9 @@ type readIndexStepExecutor struct {
 	indexes []*model.IndexInfo
 	ptbl    table.PhysicalTable
 	jc      *ReorgContext
+	// copyRows is true if the step copies the rows of a primary key change instead of
+	// backfilling indexes, it only supports local sort.
+	copyRows bool
 
 	avgRowSize      int
 	cloudStorageURI string
@@ -337,6 +340,9 @@ func (r *readIndexStepExecutor) buildLocalStorePipeline(
 		return nil, err
 	}
 	d := r.d
+	if r.copyRows {
+		return r.buildCopyRowsPipeline(opCtx, backendCtx, tbl, start, end, concurrency)
+	}
 	indexIDs := make([]int64, 0, len(r.indexes))
 	uniques := make([]bool, 0, len(r.indexes))
 	var idxNames strings.Builder
@@ -375,6 +381,41 @@ func (r *readIndexStepExecutor) buildLocalStorePipeline(
 	)
 }
 
+func (r *readIndexStepExecutor) buildCopyRowsPipeline(
+	opCtx *OperatorCtx,
+	backendCtx ingest.BackendCtx,
+	tbl table.PhysicalTable,
+	start, end kv.Key,
+	concurrency int,
+) (*operator.AsyncPipeline, error) {
+	engines, err := backendCtx.Register([]int64{copyRowsEngineID}, []bool{false}, r.ptbl)
+	if err != nil {
+		tidblogutil.Logger(opCtx).Error("cannot register new engine",
+			zap.Error(err),
+			zap.Int64("job ID", r.job.ID))
+		return nil, err
+	}
+	rowCntListener := &distTaskRowCntListener{
+		totalRowCount: r.curRowCount,
+		counter:       metrics.GetBackfillTotalByLabel(metrics.LblChangePKRate, r.job.SchemaName, tbl.Meta().Name.O, ""),
+	}
+	return NewChangePrimaryKeyIngestPipeline(
+		opCtx,
+		r.d.store,
+		r.d.sessPool,
+		backendCtx,
+		engines[0],
+		r.job.ID,
+		tbl,
+		start,
+		end,
+		r.job.ReorgMeta,
+		r.avgRowSize,
+		concurrency,
+		rowCntListener,
+	)
+}
+
 func (r *readIndexStepExecutor) buildExternalStorePipeline(
 	opCtx *OperatorCtx,
 	subtaskID int64,
@@ -314,6 +314,13 @@ func (b *txnBackfillExecutor) adjustWorkerSize() error {
 			}
 			runner = newBackfillWorker(b.ctx, partWorker)
 			worker = partWorker
+		case typeChangePrimaryKeyWorker:
+			pkWorker, err := newChangePrimaryKeyWorker(i, b.tbl, b.decodeColMap, reorgInfo, jc)
+			if err != nil {
+				return err
+			}
+			runner = newBackfillWorker(b.ctx, pkWorker)
+			worker = pkWorker
 		default:
 			return errors.New("unknown backfill type")
 		}
@@ -924,13 +924,13 @@ func setTemporaryType(tbInfo *model.TableInfo, s *ast.CreateTableStmt) error {
 	case ast.TemporaryGlobal:
 		tbInfo.TempTableType = model.TempTableGlobal
 		// "create global temporary table ... on commit preserve rows"
-		if !s.OnCommitDelete {
-			return errors.Trace(dbterror.ErrUnsupportedOnCommitPreserve)
-		}
+		tbInfo.OnCommitPreserveRows = !s.OnCommitDelete
 	case ast.TemporaryLocal:
 		tbInfo.TempTableType = model.TempTableLocal
+		tbInfo.OnCommitPreserveRows = false
 	default:
 		tbInfo.TempTableType = model.TempTableNone
+		tbInfo.OnCommitPreserveRows = false
 	}
 	return nil
 }
@@ -1609,3 +1609,18 @@ func BuildViewInfo(s *ast.CreateViewStmt) (*model.ViewInfo, error) {
 	return &model.ViewInfo{Definer: s.Definer, Algorithm: s.Algorithm,
 		Security: s.Security, SelectStmt: sb.String(), CheckOption: s.CheckOption, Cols: nil}, nil
 }
+
+// BuildMaterializedViewInfo builds a MaterializedViewInfo from CreateMaterializedViewStmt.
+func BuildMaterializedViewInfo(s *ast.CreateMaterializedViewStmt) (*model.MaterializedViewInfo, error) {
+	// The query is restored in the same way as the one of a view, see BuildViewInfo.
+	restoreFlag := format.RestoreStringSingleQuotes | format.RestoreKeyWordUppercase | format.RestoreNameBackQuotes
+	var sb strings.Builder
+	if err := s.Select.Restore(format.NewRestoreCtx(restoreFlag, &sb)); err != nil {
+		return nil, err
+	}
+	refreshMethod := s.RefreshMethod
+	if refreshMethod == ast.RefreshMethodDefault {
+		refreshMethod = ast.RefreshMethodComplete
+	}
+	return &model.MaterializedViewInfo{SelectStmt: sb.String(), RefreshMethod: refreshMethod}, nil
+}
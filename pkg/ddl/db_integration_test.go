@@ -2439,8 +2439,11 @@ func TestCreateTemporaryTable(t *testing.T) {
 	tk.MustGetErrCode("create table t(id int) on commit delete rows", errno.ErrParse)
 	tk.MustGetErrCode("create table t(id int) on commit preserve rows", errno.ErrParse)
 
-	// Not support yet.
-	tk.MustGetErrCode("create global temporary table t (id int) on commit preserve rows", errno.ErrUnsupportedDDLOperation)
+	tk.MustExec("create global temporary table t (id int) on commit preserve rows")
+	tk.MustQuery("show create table t").Check(testkit.Rows("t CREATE GLOBAL TEMPORARY TABLE `t` (\n" +
+		"  `id` int DEFAULT NULL\n" +
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin ON COMMIT PRESERVE ROWS"))
+	tk.MustExec("drop table t")
 
 	// Engine type can be anyone, see https://github.com/pingcap/tidb/issues/28541.
 	tk.MustExec("drop table if exists tengine")
@@ -342,6 +342,19 @@ func insertJobIntoDeleteRangeTable(ctx context.Context, wrapper DelRangeExecWrap
 			}
 		}
 		return errors.Trace(doBatchDeleteTablesRange(ctx, wrapper, job.ID, args.OldPhysicalTblIDs, ea, "reorganize partition: physical table ID(s)"))
+	case model.ActionChangePrimaryKey:
+		// Delete the table with the replaced handle layout, as well as the indexes
+		// only needed during the reorganization.
+		args, err := model.GetFinishedChangePrimaryKeyArgs(job)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		for _, idx := range args.DroppedIndexes {
+			if err := doBatchDeleteIndiceRange(ctx, wrapper, job.ID, idx.TableID, []int64{idx.IndexID}, ea, "change primary key: index ID(s)"); err != nil {
+				return errors.Trace(err)
+			}
+		}
+		return errors.Trace(doBatchDeleteTablesRange(ctx, wrapper, job.ID, []int64{args.DroppedTableID}, ea, "change primary key: table ID"))
 	case model.ActionTruncateTablePartition:
 		args, err := model.GetTruncateTableArgs(job)
 		if err != nil {
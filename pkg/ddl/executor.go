@@ -23,6 +23,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"slices"
 	"strings"
 	"sync/atomic"
 	"time"
@@ -109,10 +110,12 @@ type Executor interface {
 	DropSchema(ctx sessionctx.Context, stmt *ast.DropDatabaseStmt) error
 	CreateTable(ctx sessionctx.Context, stmt *ast.CreateTableStmt) error
 	CreateView(ctx sessionctx.Context, stmt *ast.CreateViewStmt) error
+	CreateMaterializedView(ctx sessionctx.Context, stmt *ast.CreateMaterializedViewStmt) error
 	DropTable(ctx sessionctx.Context, stmt *ast.DropTableStmt) (err error)
 	RecoverTable(ctx sessionctx.Context, recoverTableInfo *model.RecoverTableInfo) (err error)
 	RecoverSchema(ctx sessionctx.Context, recoverSchemaInfo *model.RecoverSchemaInfo) error
 	DropView(ctx sessionctx.Context, stmt *ast.DropTableStmt) (err error)
+	DropMaterializedView(ctx sessionctx.Context, stmt *ast.DropTableStmt) (err error)
 	CreateIndex(ctx sessionctx.Context, stmt *ast.CreateIndexStmt) error
 	DropIndex(ctx sessionctx.Context, stmt *ast.DropIndexStmt) error
 	AlterTable(ctx context.Context, sctx sessionctx.Context, stmt *ast.AlterTableStmt) error
@@ -133,6 +136,12 @@ type Executor interface {
 	AddResourceGroup(ctx sessionctx.Context, stmt *ast.CreateResourceGroupStmt) error
 	AlterResourceGroup(ctx sessionctx.Context, stmt *ast.AlterResourceGroupStmt) error
 	DropResourceGroup(ctx sessionctx.Context, stmt *ast.DropResourceGroupStmt) error
+	CreateMaskingPolicy(ctx sessionctx.Context, stmt *ast.CreateMaskingPolicyStmt) error
+	DropMaskingPolicy(ctx sessionctx.Context, stmt *ast.DropMaskingPolicyStmt) error
+	CreateRowPolicy(ctx sessionctx.Context, stmt *ast.CreateRowPolicyStmt) error
+	DropRowPolicy(ctx sessionctx.Context, stmt *ast.DropRowPolicyStmt) error
+	CreateTrigger(ctx sessionctx.Context, stmt *ast.CreateTriggerStmt) error
+	DropTrigger(ctx sessionctx.Context, stmt *ast.DropTriggerStmt) error
 	FlashbackCluster(ctx sessionctx.Context, flashbackTS uint64) error
 	// RefreshMeta can only be called by BR during the log restore phase.
 	RefreshMeta(ctx sessionctx.Context, args *model.RefreshMetaArgs) error
@@ -1528,6 +1537,37 @@ func (e *executor) CreateView(ctx sessionctx.Context, s *ast.CreateViewStmt) (er
 	return e.CreateTableWithInfo(ctx, s.ViewName.Schema, tbInfo, nil, WithOnExist(onExist))
 }
 
+// CreateMaterializedView creates the table which stores the result of the materialized view.
+// The columns of the table are filled by the planner, the table is populated by the caller.
+func (e *executor) CreateMaterializedView(ctx sessionctx.Context, s *ast.CreateMaterializedViewStmt) (err error) {
+	mvInfo, err := BuildMaterializedViewInfo(s)
+	if err != nil {
+		return err
+	}
+	is := e.infoCache.GetLatest()
+	schema, ok := is.SchemaByName(s.ViewName.Schema)
+	if !ok {
+		return infoschema.ErrDatabaseNotExists.GenWithStackByArgs(s.ViewName.Schema)
+	}
+
+	stmt := &ast.CreateTableStmt{Table: s.ViewName, IfNotExists: s.IfNotExists, Cols: s.Columns}
+	metaBuildCtx := NewMetaBuildContextWithSctx(ctx)
+	tbInfo, err := BuildTableInfoWithStmt(metaBuildCtx, stmt, schema.Charset, schema.Collate, schema.PlacementPolicyRef)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err = checkTableInfoValidWithStmt(metaBuildCtx, tbInfo, stmt); err != nil {
+		return err
+	}
+	tbInfo.MaterializedView = mvInfo
+
+	onExist := OnExistError
+	if s.IfNotExists {
+		onExist = OnExistIgnore
+	}
+	return e.CreateTableWithInfo(ctx, schema.Name, tbInfo, nil, WithOnExist(onExist))
+}
+
 func checkCharsetAndCollation(cs string, co string) error {
 	if !charset.ValidCharsetAndCollation(cs, co) {
 		return dbterror.ErrUnknownCharacterSet.GenWithStackByArgs(cs)
@@ -1702,6 +1742,35 @@ func isMultiSchemaChanges(specs []*ast.AlterTableSpec) bool {
 	return false
 }
 
+// getReplacedPrimaryKey returns the new primary key if the specs are
+// `DROP PRIMARY KEY, ADD PRIMARY KEY ...` and the clustered index is involved.
+func getReplacedPrimaryKey(tblInfo *model.TableInfo, specs []*ast.AlterTableSpec) *ast.Constraint {
+	if len(specs) != 2 || specs[0].Tp != ast.AlterTableDropPrimaryKey || specs[0].IfExists ||
+		specs[1].Tp != ast.AlterTableAddConstraint || specs[1].Constraint.Tp != ast.ConstraintPrimaryKey {
+		return nil
+	}
+	constr := specs[1].Constraint
+	if tblInfo.HasClusteredIndex() || (constr.Option != nil && constr.Option.PrimaryKeyTp == ast.PrimaryKeyTypeClustered) {
+		return constr
+	}
+	return nil
+}
+
+// isIndexSpec checks whether the spec only changes a secondary index.
+func isIndexSpec(spec *ast.AlterTableSpec) bool {
+	switch spec.Tp {
+	case ast.AlterTableDropIndex, ast.AlterTableRenameIndex, ast.AlterTableIndexInvisible:
+		return true
+	case ast.AlterTableAddConstraint:
+		switch spec.Constraint.Tp {
+		case ast.ConstraintKey, ast.ConstraintIndex, ast.ConstraintUniq, ast.ConstraintUniqKey,
+			ast.ConstraintUniqIndex, ast.ConstraintFulltext:
+			return true
+		}
+	}
+	return false
+}
+
 func (e *executor) AlterTable(ctx context.Context, sctx sessionctx.Context, stmt *ast.AlterTableStmt) (err error) {
 	ident := ast.Ident{Schema: stmt.Table.Schema, Name: stmt.Table.Name}
 	validSpecs, err := ResolveAlterTableSpec(sctx, stmt.Specs)
@@ -1717,6 +1786,18 @@ func (e *executor) AlterTable(ctx context.Context, sctx sessionctx.Context, stmt
 	if tb.Meta().IsView() || tb.Meta().IsSequence() {
 		return dbterror.ErrWrongObject.GenWithStackByArgs(ident.Schema, ident.Name, "BASE TABLE")
 	}
+	if tb.Meta().TempTableType == model.TempTableGlobal && tb.Meta().OnCommitPreserveRows {
+		// The rows kept in sessions can't be changed by the DDL.
+		return dbterror.ErrUnsupportedPreservedTempTableDDL.GenWithStackByArgs("ALTER TABLE")
+	}
+	if tb.Meta().IsMaterializedView() {
+		// The columns of a materialized view are decided by its query, only the indexes can be changed.
+		for _, spec := range validSpecs {
+			if !isIndexSpec(spec) {
+				return dbterror.ErrGeneralUnsupportedDDL.GenWithStackByArgs("ALTER TABLE on materialized view except changing indexes")
+			}
+		}
+	}
 	if tb.Meta().TableCacheStatusType != model.TableCacheStatusDisable {
 		if len(validSpecs) != 1 {
 			return dbterror.ErrOptOnCacheTable.GenWithStackByArgs("Alter Table")
@@ -1737,6 +1818,11 @@ func (e *executor) AlterTable(ctx context.Context, sctx sessionctx.Context, stmt
 		}
 	}
 
+	if constr := getReplacedPrimaryKey(tb.Meta(), validSpecs); constr != nil {
+		// The handle layout is changed, it's done by a single job instead of a multi-schema change.
+		return e.createPrimaryKey(sctx, ident, ast.NewCIStr(constr.Name), constr.Keys, constr.Option, true)
+	}
+
 	if len(validSpecs) > 1 {
 		// after MultiSchemaInfo is set, DoDDLJob will collect all jobs into
 		// MultiSchemaInfo and skip running them. Then we will run them in
@@ -1762,7 +1848,7 @@ func (e *executor) AlterTable(ctx context.Context, sctx sessionctx.Context, stmt
 		case ast.AlterTableCheckPartitions:
 			err = errors.Trace(dbterror.ErrUnsupportedCheckPartition)
 		case ast.AlterTableRebuildPartition:
-			err = errors.Trace(dbterror.ErrUnsupportedRebuildPartition)
+			err = e.RebuildPartitions(sctx, ident, spec)
 		case ast.AlterTableOptimizePartition:
 			err = errors.Trace(dbterror.ErrUnsupportedOptimizePartition)
 		case ast.AlterTableRemovePartitioning:
@@ -2500,10 +2586,21 @@ func (e *executor) ReorganizePartitions(ctx sessionctx.Context, ident ast.Ident,
 	if err != nil {
 		return errors.Trace(err)
 	}
-	if err = checkReorgPartitionDefs(ctx, model.ActionReorganizePartition, meta, partInfo, firstPartIdx, lastPartIdx, idMap); err != nil {
+	err = e.doReorganizePartitions(ctx, schema, meta, partNames, partInfo, firstPartIdx, lastPartIdx, idMap)
+	failpoint.InjectCall("afterReorganizePartition")
+	if err == nil {
+		ctx.GetSessionVars().StmtCtx.AppendWarning(errors.NewNoStackError("The statistics of related partitions will be outdated after reorganizing partitions. Please use 'ANALYZE TABLE' statement if you want to update it now"))
+	}
+	return errors.Trace(err)
+}
+
+// doReorganizePartitions submits the job to reorganize the partitions in partNames into the partitions in partInfo.
+func (e *executor) doReorganizePartitions(ctx sessionctx.Context, schema *model.DBInfo, meta *model.TableInfo, partNames []string,
+	partInfo *model.PartitionInfo, firstPartIdx, lastPartIdx int, idMap map[int]struct{}) error {
+	if err := checkReorgPartitionDefs(ctx, model.ActionReorganizePartition, meta, partInfo, firstPartIdx, lastPartIdx, idMap); err != nil {
 		return errors.Trace(err)
 	}
-	if err = handlePartitionPlacement(ctx, partInfo); err != nil {
+	if err := handlePartitionPlacement(ctx, partInfo); err != nil {
 		return errors.Trace(err)
 	}
 
@@ -2512,13 +2609,13 @@ func (e *executor) ReorganizePartitions(ctx sessionctx.Context, ident ast.Ident,
 		SchemaID:       schema.ID,
 		TableID:        meta.ID,
 		SchemaName:     schema.Name.L,
-		TableName:      t.Meta().Name.L,
+		TableName:      meta.Name.L,
 		Type:           model.ActionReorganizePartition,
 		BinlogInfo:     &model.HistoryInfo{},
 		CDCWriteSource: ctx.GetSessionVars().CDCWriteSource,
 		SQLMode:        ctx.GetSessionVars().SQLMode,
 	}
-	err = initJobReorgMetaFromVariables(job, ctx)
+	err := initJobReorgMetaFromVariables(job, ctx)
 	if err != nil {
 		return errors.Trace(err)
 	}
@@ -2528,12 +2625,98 @@ func (e *executor) ReorganizePartitions(ctx sessionctx.Context, ident ast.Ident,
 	}
 
 	// No preSplitAndScatter here, it will be done by the worker in onReorganizePartition instead.
-	err = e.doDDLJob2(ctx, job, args)
-	failpoint.InjectCall("afterReorganizePartition")
-	if err == nil {
-		ctx.GetSessionVars().StmtCtx.AppendWarning(errors.NewNoStackError("The statistics of related partitions will be outdated after reorganizing partitions. Please use 'ANALYZE TABLE' statement if you want to update it now"))
+	return e.doDDLJob2(ctx, job, args)
+}
+
+// RebuildPartitions rebuilds the partitions by reorganizing them into the partitions with the same definitions,
+// which rewrites all the rows and indexes of the partitions.
+func (e *executor) RebuildPartitions(ctx sessionctx.Context, ident ast.Ident, spec *ast.AlterTableSpec) error {
+	schema, t, err := e.getSchemaAndTableByIdent(ident)
+	if err != nil {
+		return errors.Trace(infoschema.ErrTableNotExists.FastGenByArgs(ident.Schema, ident.Name))
+	}
+
+	meta := t.Meta()
+	pi := meta.GetPartitionInfo()
+	if pi == nil {
+		return dbterror.ErrPartitionMgmtOnNonpartitioned
+	}
+	groups, err := getRebuiltPartitionGroups(spec, pi, meta.Name.O)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for _, group := range groups {
+		partNames := make([]string, 0, len(group))
+		partInfo := &model.PartitionInfo{
+			Type:        pi.Type,
+			Expr:        pi.Expr,
+			Columns:     pi.Columns,
+			Enable:      pi.Enable,
+			Num:         uint64(len(group)),
+			Definitions: make([]model.PartitionDefinition, 0, len(group)),
+		}
+		idMap := make(map[int]struct{}, len(group))
+		for _, idx := range group {
+			partNames = append(partNames, pi.Definitions[idx].Name.L)
+			// The partition ID will be reassigned when the job is submitted.
+			def := pi.Definitions[idx].Clone()
+			def.ID = 0
+			partInfo.Definitions = append(partInfo.Definitions, def)
+			idMap[idx] = struct{}{}
+		}
+		err = e.doReorganizePartitions(ctx, schema, meta, partNames, partInfo, group[0], group[len(group)-1], idMap)
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
+	ctx.GetSessionVars().StmtCtx.AppendWarning(errors.NewNoStackError("The statistics of related partitions will be outdated after rebuilding partitions. Please use 'ANALYZE TABLE' statement if you want to update it now"))
+	return nil
+}
+
+// getRebuiltPartitionGroups returns the ordered offsets of the partitions to rebuild, grouped by the reorganize jobs.
+// The non-adjacent RANGE partitions are rebuilt by separate jobs, and the HASH/KEY partitions must be rebuilt all together.
+func getRebuiltPartitionGroups(spec *ast.AlterTableSpec, pi *model.PartitionInfo, tblName string) ([][]int, error) {
+	var offsets []int
+	if spec.OnAllPartitions {
+		offsets = make([]int, 0, len(pi.Definitions))
+		for i := range pi.Definitions {
+			offsets = append(offsets, i)
+		}
+	} else {
+		for _, name := range spec.PartitionNames {
+			idx := pi.FindPartitionDefinitionByName(name.L)
+			if idx < 0 {
+				return nil, errors.Trace(table.ErrUnknownPartition.GenWithStackByArgs(name.O, tblName))
+			}
+			// MySQL allows duplicate partition names in rebuild partition.
+			if !slices.Contains(offsets, idx) {
+				offsets = append(offsets, idx)
+			}
+		}
+		slices.Sort(offsets)
+	}
+
+	switch pi.Type {
+	case ast.PartitionTypeRange:
+		var groups [][]int
+		for i, idx := range offsets {
+			if i == 0 || idx != offsets[i-1]+1 {
+				groups = append(groups, nil)
+			}
+			groups[len(groups)-1] = append(groups[len(groups)-1], idx)
+		}
+		return groups, nil
+	case ast.PartitionTypeList:
+		return [][]int{offsets}, nil
+	case ast.PartitionTypeHash, ast.PartitionTypeKey:
+		if len(offsets) != len(pi.Definitions) {
+			return nil, errors.Trace(dbterror.ErrGeneralUnsupportedDDL.GenWithStackByArgs(
+				"REBUILD PARTITION of HASH/KEY; must rebuild all partitions"))
+		}
+		return [][]int{offsets}, nil
+	default:
+		return nil, errors.Trace(dbterror.ErrUnsupportedRebuildPartition)
 	}
-	return errors.Trace(err)
 }
 
 // RemovePartitioning removes partitioning from a table.
@@ -3120,6 +3303,10 @@ func (e *executor) ExchangeTablePartition(ctx sessionctx.Context, ident ast.Iden
 		},
 		SQLMode: ctx.GetSessionVars().SQLMode,
 	}
+	if spec.WithValidation {
+		// The validation evaluates the partitioning expression with the sql_mode and time zone of the session.
+		job.ReorgMeta = NewDDLReorgMeta(ctx)
+	}
 	args := &model.ExchangeTablePartitionArgs{
 		PartitionID:    defID,
 		PTSchemaID:     ptSchema.ID,
@@ -3534,6 +3721,328 @@ func (e *executor) AlterTableComment(ctx sessionctx.Context, ident ast.Ident, sp
 	return errors.Trace(err)
 }
 
+// CreateMaskingPolicy creates a masking policy on a column of the table.
+func (e *executor) CreateMaskingPolicy(ctx sessionctx.Context, stmt *ast.CreateMaskingPolicyStmt) error {
+	ident := ast.Ident{Schema: stmt.Table.Schema, Name: stmt.Table.Name}
+	schema, t, err := e.getSchemaAndTableByIdent(ident)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	tblInfo := t.Meta()
+	if tblInfo.IsView() || tblInfo.IsSequence() {
+		return dbterror.ErrWrongObject.GenWithStackByArgs(schema.Name, tblInfo.Name, "BASE TABLE")
+	}
+	col := model.FindColumnInfo(tblInfo.Columns, stmt.Column.L)
+	if col == nil {
+		return infoschema.ErrColumnNotExists.GenWithStackByArgs(stmt.Column, tblInfo.Name)
+	}
+	if existing := findMaskingPolicy(tblInfo, stmt.PolicyName); existing != nil || col.MaskingPolicy != nil {
+		if existing == nil {
+			// A column can only have one masking policy.
+			existing = col
+		}
+		err = dbterror.ErrMaskingPolicyExists.GenWithStackByArgs(existing.MaskingPolicy.Name, tblInfo.Name)
+		if stmt.IfNotExists {
+			ctx.GetSessionVars().StmtCtx.AppendNote(err)
+			return nil
+		}
+		return err
+	}
+	if stmt.Option.Tp == ast.MaskingDateTrunc {
+		if !types.IsTypeTime(col.GetType()) {
+			return dbterror.ErrGeneralUnsupportedDDL.GenWithStackByArgs(fmt.Sprintf("DATE_TRUNC masking policy on non-date column '%s'", col.Name))
+		}
+		switch stmt.Option.Unit {
+		case ast.TimeUnitYear, ast.TimeUnitMonth, ast.TimeUnitDay:
+		default:
+			return dbterror.ErrGeneralUnsupportedDDL.GenWithStackByArgs(fmt.Sprintf("DATE_TRUNC masking policy with unit %s", stmt.Option.Unit))
+		}
+	}
+
+	job := &model.Job{
+		Version:        model.GetJobVerInUse(),
+		SchemaID:       schema.ID,
+		TableID:        tblInfo.ID,
+		SchemaName:     schema.Name.L,
+		TableName:      tblInfo.Name.L,
+		Type:           model.ActionCreateMaskingPolicy,
+		BinlogInfo:     &model.HistoryInfo{},
+		CDCWriteSource: ctx.GetSessionVars().CDCWriteSource,
+		SQLMode:        ctx.GetSessionVars().SQLMode,
+	}
+	args := &model.MaskingPolicyArgs{
+		ColumnName: col.Name,
+		Policy: &model.MaskingPolicyInfo{
+			Name:       stmt.PolicyName,
+			Type:       stmt.Option.Tp,
+			KeepPrefix: stmt.Option.KeepPrefix,
+			KeepSuffix: stmt.Option.KeepSuffix,
+			Unit:       stmt.Option.Unit,
+		},
+	}
+	err = e.doDDLJob2(ctx, job, args)
+	if dbterror.ErrMaskingPolicyExists.Equal(err) && stmt.IfNotExists {
+		ctx.GetSessionVars().StmtCtx.AppendNote(err)
+		return nil
+	}
+	return errors.Trace(err)
+}
+
+// DropMaskingPolicy drops a masking policy of the table.
+func (e *executor) DropMaskingPolicy(ctx sessionctx.Context, stmt *ast.DropMaskingPolicyStmt) error {
+	ident := ast.Ident{Schema: stmt.Table.Schema, Name: stmt.Table.Name}
+	schema, t, err := e.getSchemaAndTableByIdent(ident)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	tblInfo := t.Meta()
+	if findMaskingPolicy(tblInfo, stmt.PolicyName) == nil {
+		err = dbterror.ErrMaskingPolicyNotExists.GenWithStackByArgs(stmt.PolicyName, tblInfo.Name)
+		if stmt.IfExists {
+			ctx.GetSessionVars().StmtCtx.AppendNote(err)
+			return nil
+		}
+		return err
+	}
+
+	job := &model.Job{
+		Version:        model.GetJobVerInUse(),
+		SchemaID:       schema.ID,
+		TableID:        tblInfo.ID,
+		SchemaName:     schema.Name.L,
+		TableName:      tblInfo.Name.L,
+		Type:           model.ActionDropMaskingPolicy,
+		BinlogInfo:     &model.HistoryInfo{},
+		CDCWriteSource: ctx.GetSessionVars().CDCWriteSource,
+		SQLMode:        ctx.GetSessionVars().SQLMode,
+	}
+	args := &model.MaskingPolicyArgs{
+		Policy: &model.MaskingPolicyInfo{Name: stmt.PolicyName},
+	}
+	err = e.doDDLJob2(ctx, job, args)
+	if dbterror.ErrMaskingPolicyNotExists.Equal(err) && stmt.IfExists {
+		ctx.GetSessionVars().StmtCtx.AppendNote(err)
+		return nil
+	}
+	return errors.Trace(err)
+}
+
+// CreateRowPolicy creates a row-level security policy on the table.
+func (e *executor) CreateRowPolicy(ctx sessionctx.Context, stmt *ast.CreateRowPolicyStmt) error {
+	ident := ast.Ident{Schema: stmt.Table.Schema, Name: stmt.Table.Name}
+	schema, t, err := e.getSchemaAndTableByIdent(ident)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	tblInfo := t.Meta()
+	if tblInfo.IsView() || tblInfo.IsSequence() {
+		return dbterror.ErrWrongObject.GenWithStackByArgs(schema.Name, tblInfo.Name, "BASE TABLE")
+	}
+	if tblInfo.FindRowPolicy(stmt.PolicyName.L) != nil {
+		err = dbterror.ErrRowPolicyExists.GenWithStackByArgs(stmt.PolicyName, tblInfo.Name)
+		if stmt.IfNotExists {
+			ctx.GetSessionVars().StmtCtx.AppendNote(err)
+			return nil
+		}
+		return err
+	}
+	policy := &model.RowPolicyInfo{
+		Name:        stmt.PolicyName,
+		Restrictive: stmt.Restrictive,
+		Command:     stmt.Command,
+		Roles:       stmt.Roles,
+	}
+	if policy.UsingExpr, err = restoreRowPolicyExpr(tblInfo, stmt.Using); err != nil {
+		return err
+	}
+	if policy.CheckExpr, err = restoreRowPolicyExpr(tblInfo, stmt.WithCheck); err != nil {
+		return err
+	}
+
+	job := &model.Job{
+		Version:        model.GetJobVerInUse(),
+		SchemaID:       schema.ID,
+		TableID:        tblInfo.ID,
+		SchemaName:     schema.Name.L,
+		TableName:      tblInfo.Name.L,
+		Type:           model.ActionCreateRowPolicy,
+		BinlogInfo:     &model.HistoryInfo{},
+		CDCWriteSource: ctx.GetSessionVars().CDCWriteSource,
+		SQLMode:        ctx.GetSessionVars().SQLMode,
+	}
+	args := &model.RowPolicyArgs{Policy: policy}
+	err = e.doDDLJob2(ctx, job, args)
+	if dbterror.ErrRowPolicyExists.Equal(err) && stmt.IfNotExists {
+		ctx.GetSessionVars().StmtCtx.AppendNote(err)
+		return nil
+	}
+	return errors.Trace(err)
+}
+
+// DropRowPolicy drops a row-level security policy of the table.
+func (e *executor) DropRowPolicy(ctx sessionctx.Context, stmt *ast.DropRowPolicyStmt) error {
+	ident := ast.Ident{Schema: stmt.Table.Schema, Name: stmt.Table.Name}
+	schema, t, err := e.getSchemaAndTableByIdent(ident)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	tblInfo := t.Meta()
+	if tblInfo.FindRowPolicy(stmt.PolicyName.L) == nil {
+		err = dbterror.ErrRowPolicyNotExists.GenWithStackByArgs(stmt.PolicyName, tblInfo.Name)
+		if stmt.IfExists {
+			ctx.GetSessionVars().StmtCtx.AppendNote(err)
+			return nil
+		}
+		return err
+	}
+
+	job := &model.Job{
+		Version:        model.GetJobVerInUse(),
+		SchemaID:       schema.ID,
+		TableID:        tblInfo.ID,
+		SchemaName:     schema.Name.L,
+		TableName:      tblInfo.Name.L,
+		Type:           model.ActionDropRowPolicy,
+		BinlogInfo:     &model.HistoryInfo{},
+		CDCWriteSource: ctx.GetSessionVars().CDCWriteSource,
+		SQLMode:        ctx.GetSessionVars().SQLMode,
+	}
+	args := &model.RowPolicyArgs{
+		Policy: &model.RowPolicyInfo{Name: stmt.PolicyName},
+	}
+	err = e.doDDLJob2(ctx, job, args)
+	if dbterror.ErrRowPolicyNotExists.Equal(err) && stmt.IfExists {
+		ctx.GetSessionVars().StmtCtx.AppendNote(err)
+		return nil
+	}
+	return errors.Trace(err)
+}
+
+// CreateTrigger creates a row trigger on the table.
+func (e *executor) CreateTrigger(ctx sessionctx.Context, stmt *ast.CreateTriggerStmt) error {
+	sessVars := ctx.GetSessionVars()
+	ident := ast.Ident{Schema: stmt.Table.Schema, Name: stmt.Table.Name}
+	if stmt.TriggerName.Schema.L != "" && stmt.TriggerName.Schema.L != ident.Schema.L {
+		return dbterror.ErrTrgInWrongSchema.GenWithStackByArgs()
+	}
+	if util.IsMemOrSysDB(ident.Schema.L) {
+		return dbterror.ErrNoTriggersOnSystemSchema.GenWithStackByArgs()
+	}
+	schema, t, err := e.getSchemaAndTableByIdent(ident)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	tblInfo := t.Meta()
+	if tblInfo.IsView() || tblInfo.IsSequence() || tblInfo.TempTableType != model.TempTableNone {
+		return dbterror.ErrTrgOnViewOrTempTable.GenWithStackByArgs(schema.Name.O + "." + tblInfo.Name.O)
+	}
+	name := stmt.TriggerName.Name
+	existing, err := e.findTrigger(schema.Name, name.L)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if existing != nil {
+		err = dbterror.ErrTrgAlreadyExists.GenWithStackByArgs()
+		if stmt.IfNotExists {
+			sessVars.StmtCtx.AppendNote(err)
+			return nil
+		}
+		return err
+	}
+
+	trigger := &model.TriggerInfo{
+		Name:    name,
+		Timing:  stmt.Timing,
+		Event:   stmt.Event,
+		Body:    stmt.Body.Text(),
+		SQLMode: sessVars.SQLMode,
+	}
+	if user := sessVars.User; user != nil {
+		trigger.Definer = fmt.Sprintf("%s@%s", user.AuthUsername, user.AuthHostname)
+	}
+	trigger.CharacterSetClient, trigger.CollationConnection = sessVars.GetCharsetInfo()
+
+	job := &model.Job{
+		Version:        model.GetJobVerInUse(),
+		SchemaID:       schema.ID,
+		TableID:        tblInfo.ID,
+		SchemaName:     schema.Name.L,
+		TableName:      tblInfo.Name.L,
+		Type:           model.ActionCreateTrigger,
+		BinlogInfo:     &model.HistoryInfo{},
+		CDCWriteSource: sessVars.CDCWriteSource,
+		SQLMode:        sessVars.SQLMode,
+	}
+	args := &model.TriggerArgs{Trigger: trigger}
+	err = e.doDDLJob2(ctx, job, args)
+	if dbterror.ErrTrgAlreadyExists.Equal(err) && stmt.IfNotExists {
+		sessVars.StmtCtx.AppendNote(err)
+		return nil
+	}
+	return errors.Trace(err)
+}
+
+// DropTrigger drops a trigger.
+func (e *executor) DropTrigger(ctx sessionctx.Context, stmt *ast.DropTriggerStmt) error {
+	sessVars := ctx.GetSessionVars()
+	schemaName := stmt.TriggerName.Schema
+	if schemaName.L == "" {
+		schemaName = ast.NewCIStr(sessVars.CurrentDB)
+	}
+	schema, ok := e.infoCache.GetLatest().SchemaByName(schemaName)
+	if !ok {
+		return infoschema.ErrDatabaseNotExists.GenWithStackByArgs(schemaName)
+	}
+	name := stmt.TriggerName.Name
+	tblInfo, err := e.findTrigger(schema.Name, name.L)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if tblInfo == nil {
+		err = dbterror.ErrTrgDoesNotExist.GenWithStackByArgs()
+		if stmt.IfExists {
+			sessVars.StmtCtx.AppendNote(err)
+			return nil
+		}
+		return err
+	}
+
+	job := &model.Job{
+		Version:        model.GetJobVerInUse(),
+		SchemaID:       schema.ID,
+		TableID:        tblInfo.ID,
+		SchemaName:     schema.Name.L,
+		TableName:      tblInfo.Name.L,
+		Type:           model.ActionDropTrigger,
+		BinlogInfo:     &model.HistoryInfo{},
+		CDCWriteSource: sessVars.CDCWriteSource,
+		SQLMode:        sessVars.SQLMode,
+	}
+	args := &model.TriggerArgs{
+		Trigger: &model.TriggerInfo{Name: name},
+	}
+	err = e.doDDLJob2(ctx, job, args)
+	if dbterror.ErrTrgDoesNotExist.Equal(err) && stmt.IfExists {
+		sessVars.StmtCtx.AppendNote(err)
+		return nil
+	}
+	return errors.Trace(err)
+}
+
+// findTrigger returns the table which has the trigger, the names of triggers are unique in a schema.
+func (e *executor) findTrigger(schemaName ast.CIStr, name string) (*model.TableInfo, error) {
+	tblInfos, err := e.infoCache.GetLatest().SchemaTableInfos(e.ctx, schemaName)
+	if err != nil {
+		return nil, err
+	}
+	for _, tblInfo := range tblInfos {
+		if tblInfo.FindTrigger(name) != nil {
+			return tblInfo, nil
+		}
+	}
+	return nil, nil
+}
+
 // AlterTableAutoIDCache updates the table comment information.
 func (e *executor) AlterTableAutoIDCache(ctx sessionctx.Context, ident ast.Ident, newCache int64) error {
 	schema, tb, err := e.getSchemaAndTableByIdent(ident)
@@ -3830,10 +4339,6 @@ func (e *executor) AlterTableAddStatistics(ctx sessionctx.Context, ident ast.Ide
 	if !ctx.GetSessionVars().EnableExtendedStats {
 		return errors.New("Extended statistics feature is not generally available now, and tidb_enable_extended_stats is OFF")
 	}
-	// Not support Cardinality and Dependency statistics type for now.
-	if stats.StatsType == ast.StatsTypeCardinality || stats.StatsType == ast.StatsTypeDependency {
-		return errors.New("Cardinality and Dependency statistics types are not supported now")
-	}
 	_, tbl, err := e.getSchemaAndTableByIdent(ident)
 	if err != nil {
 		return err
@@ -3863,10 +4368,9 @@ func (e *executor) AlterTableAddStatistics(ctx sessionctx.Context, ident ast.Ide
 	if len(colIDs) != 2 && (stats.StatsType == ast.StatsTypeCorrelation || stats.StatsType == ast.StatsTypeDependency) {
 		return errors.New("Only support Correlation and Dependency statistics types on 2 columns")
 	}
-	if len(colIDs) < 1 && stats.StatsType == ast.StatsTypeCardinality {
+	if len(colIDs) < 2 && stats.StatsType == ast.StatsTypeCardinality {
 		return errors.New("Only support Cardinality statistics type on at least 2 columns")
 	}
-	// TODO: check whether covering index exists for cardinality / dependency types.
 
 	// Call utilities of statistics.Handle to modify system tables instead of doing DML directly,
 	// because locking in Handle can guarantee the correctness of `version` in system tables.
@@ -4097,9 +4601,10 @@ const (
 	tableObject objectType = iota
 	viewObject
 	sequenceObject
+	materializedViewObject
 )
 
-// dropTableObject provides common logic to DROP TABLE/VIEW/SEQUENCE.
+// dropTableObject provides common logic to DROP TABLE/VIEW/SEQUENCE/MATERIALIZED VIEW.
 func (e *executor) dropTableObject(
 	ctx sessionctx.Context,
 	objects []*ast.TableName,
@@ -4119,7 +4624,7 @@ func (e *executor) dropTableObject(
 		fkCheck      bool
 	)
 	switch tableObjectType {
-	case tableObject:
+	case tableObject, materializedViewObject:
 		dropExistErr = infoschema.ErrTableDropExists
 		jobType = model.ActionDropTable
 		objectIdents = make([]ast.Ident, len(objects))
@@ -4172,6 +4677,9 @@ func (e *executor) dropTableObject(
 				notExistTables = append(notExistTables, fullti.String())
 				continue
 			}
+			if tableInfo.Meta().IsMaterializedView() {
+				return dbterror.ErrWrongObject.GenWithStackByArgs(fullti.Schema, fullti.Name, "BASE TABLE")
+			}
 
 			tempTableType := tableInfo.Meta().TempTableType
 			if config.CheckTableBeforeDrop && tempTableType == model.TempTableNone {
@@ -4194,6 +4702,10 @@ func (e *executor) dropTableObject(
 			if !tableInfo.Meta().IsView() {
 				return dbterror.ErrWrongObject.GenWithStackByArgs(fullti.Schema, fullti.Name, "VIEW")
 			}
+		case materializedViewObject:
+			if !tableInfo.Meta().IsMaterializedView() {
+				return dbterror.ErrWrongObject.GenWithStackByArgs(fullti.Schema, fullti.Name, "MATERIALIZED VIEW")
+			}
 		case sequenceObject:
 			if !tableInfo.Meta().IsSequence() {
 				err = dbterror.ErrWrongObject.GenWithStackByArgs(fullti.Schema, fullti.Name, "SEQUENCE")
@@ -4231,7 +4743,7 @@ func (e *executor) dropTableObject(
 		}
 
 		// unlock table after drop
-		if tableObjectType != tableObject {
+		if tableObjectType != tableObject && tableObjectType != materializedViewObject {
 			continue
 		}
 		if !config.TableLockEnabled() {
@@ -4263,6 +4775,11 @@ func (e *executor) DropView(ctx sessionctx.Context, stmt *ast.DropTableStmt) (er
 	return e.dropTableObject(ctx, stmt.Tables, stmt.IfExists, viewObject)
 }
 
+// DropMaterializedView will proceed even if some materialized view in the list does not exists.
+func (e *executor) DropMaterializedView(ctx sessionctx.Context, stmt *ast.DropTableStmt) (err error) {
+	return e.dropTableObject(ctx, stmt.Tables, stmt.IfExists, materializedViewObject)
+}
+
 func (e *executor) TruncateTable(ctx sessionctx.Context, ti ast.Ident) error {
 	schema, tb, err := e.getSchemaAndTableByIdent(ti)
 	if err != nil {
@@ -4581,10 +5098,13 @@ func checkCreateGlobalIndex(ec errctx.Context, tblInfo *model.TableInfo, indexNa
 
 func (e *executor) CreatePrimaryKey(ctx sessionctx.Context, ti ast.Ident, indexName ast.CIStr,
 	indexPartSpecifications []*ast.IndexPartSpecification, indexOption *ast.IndexOption) error {
-	if indexOption != nil && indexOption.PrimaryKeyTp == ast.PrimaryKeyTypeClustered {
-		return dbterror.ErrUnsupportedModifyPrimaryKey.GenWithStack("Adding clustered primary key is not supported. " +
-			"Please consider adding NONCLUSTERED primary key instead")
-	}
+	return e.createPrimaryKey(ctx, ti, indexName, indexPartSpecifications, indexOption, false)
+}
+
+// createPrimaryKey adds a primary key to the table. If replace is true, the existing
+// primary key is dropped in the same job, see `ALTER TABLE ... DROP PRIMARY KEY, ADD PRIMARY KEY ...`.
+func (e *executor) createPrimaryKey(ctx sessionctx.Context, ti ast.Ident, indexName ast.CIStr,
+	indexPartSpecifications []*ast.IndexPartSpecification, indexOption *ast.IndexOption, replace bool) error {
 	schema, t, err := e.getSchemaAndTableByIdent(ti)
 	if err != nil {
 		return errors.Trace(err)
@@ -4595,11 +5115,15 @@ func (e *executor) CreatePrimaryKey(ctx sessionctx.Context, ti ast.Ident, indexN
 	}
 
 	indexName = ast.NewCIStr(mysql.PrimaryKeyName)
-	if indexInfo := t.Meta().FindIndexByName(indexName.L); indexInfo != nil ||
-		// If the table's PKIsHandle is true, it also means that this table has a primary key.
-		t.Meta().PKIsHandle {
+	indexInfo := t.Meta().FindIndexByName(indexName.L)
+	// If the table's PKIsHandle is true, it also means that this table has a primary key.
+	hasPK := indexInfo != nil || t.Meta().PKIsHandle
+	if hasPK && !replace {
 		return infoschema.ErrMultiplePriKey
 	}
+	if !hasPK && replace {
+		return dbterror.ErrCantDropFieldOrKey.GenWithStackByArgs("PRIMARY")
+	}
 
 	// Primary keys cannot include expression index parts. A primary key requires the generated column to be stored,
 	// but expression index parts are implemented as virtual generated columns, not stored generated columns.
@@ -4641,6 +5165,18 @@ func (e *executor) CreatePrimaryKey(ctx sessionctx.Context, ti ast.Ident, indexN
 		return errors.Trace(err)
 	}
 	sqlMode := ctx.GetSessionVars().SQLMode
+	if replace || (indexOption != nil && indexOption.PrimaryKeyTp == ast.PrimaryKeyTypeClustered) {
+		// The handle layout of the table is changed, all the rows must be rewritten.
+		return e.changePrimaryKey(ctx, schema, t, &model.IndexArg{
+			Unique:                  true,
+			IndexName:               indexName,
+			IndexPartSpecifications: indexPartSpecifications,
+			IndexOption:             indexOption,
+			SQLMode:                 sqlMode,
+			IsPK:                    true,
+			SplitOpt:                splitOpt,
+		})
+	}
 	// global is set to  'false' is just there to be backwards compatible,
 	// to avoid unmarshal issues, it is now part of indexOption.
 	job := &model.Job{
@@ -4899,6 +5435,9 @@ func (e *executor) createIndex(ctx sessionctx.Context, ti ast.Ident, keyType ast
 	if t.Meta().TableCacheStatusType != model.TableCacheStatusDisable {
 		return errors.Trace(dbterror.ErrOptOnCacheTable.GenWithStackByArgs("Create Index"))
 	}
+	if t.Meta().TempTableType == model.TempTableGlobal && t.Meta().OnCommitPreserveRows {
+		return errors.Trace(dbterror.ErrUnsupportedPreservedTempTableDDL.GenWithStackByArgs("CREATE INDEX"))
+	}
 
 	metaBuildCtx := NewMetaBuildContextWithSctx(ctx)
 	indexName, hiddenCols, err := checkIndexNameAndColumns(metaBuildCtx, t, indexName, indexPartSpecifications, model.ColumnarIndexTypeNA, ifNotExists)
@@ -5043,7 +5582,7 @@ func initJobReorgMetaFromVariables(job *model.Job, sctx sessionctx.Context) erro
 	}
 
 	switch job.Type {
-	case model.ActionAddIndex, model.ActionAddPrimaryKey:
+	case model.ActionAddIndex, model.ActionAddPrimaryKey, model.ActionChangePrimaryKey:
 		setReorgParam()
 		err := setDistTaskParam()
 		if err != nil {
@@ -5427,6 +5966,11 @@ func (e *executor) dropIndex(ctx sessionctx.Context, ti ast.Ident, indexName ast
 		return infoschema.ErrTableWithoutPrimaryKey
 	}
 
+	if isPK && t.Meta().HasClusteredIndex() {
+		// The rows are rewritten with _tidb_rowid as the handle.
+		return e.changePrimaryKey(ctx, schema, t, nil)
+	}
+
 	if indexInfo == nil {
 		err = dbterror.ErrCantDropFieldOrKey.GenWithStack("index %s doesn't exist", indexName)
 		if ifExist {
@@ -5470,6 +6014,59 @@ func (e *executor) dropIndex(ctx sessionctx.Context, ti ast.Ident, indexName ast
 	return errors.Trace(err)
 }
 
+// changePrimaryKey submits a job to change the primary key of the table when the
+// handle layout is changed. If pk is nil, the primary key is dropped.
+func (e *executor) changePrimaryKey(ctx sessionctx.Context, schema *model.DBInfo, t table.Table, pk *model.IndexArg) error {
+	if ctx.GetSessionVars().StmtCtx.MultiSchemaInfo != nil {
+		return dbterror.ErrUnsupportedModifyPrimaryKey.GenWithStack("Unsupported change primary key in multi-schema change")
+	}
+	tblInfo := t.Meta()
+	is := e.infoCache.GetLatest()
+	if referredFKs := is.GetTableReferredForeignKeys(schema.Name.L, tblInfo.Name.L); len(referredFKs) > 0 {
+		return dbterror.ErrUnsupportedModifyPrimaryKey.GenWithStack("Unsupported change primary key of table referred by foreign keys")
+	}
+	if pk != nil {
+		lastCol, err := CheckPKOnGeneratedColumn(tblInfo, pk.IndexPartSpecifications)
+		if err != nil {
+			return err
+		}
+		isSingleInt := isSingleIntPK(&ast.Constraint{Keys: pk.IndexPartSpecifications}, lastCol)
+		// Decide the type of the primary key here, so the job doesn't depend on the session variables.
+		opt := &ast.IndexOption{}
+		if pk.IndexOption != nil {
+			*opt = *pk.IndexOption
+		}
+		if ShouldBuildClusteredIndex(ctx.GetSessionVars().EnableClusteredIndex, pk.IndexOption, isSingleInt) {
+			opt.PrimaryKeyTp = ast.PrimaryKeyTypeClustered
+		} else {
+			opt.PrimaryKeyTp = ast.PrimaryKeyTypeNonClustered
+		}
+		pk.IndexOption = opt
+	}
+	args := &model.ChangePrimaryKeyArgs{PrimaryKey: pk}
+	if err := checkChangePrimaryKey(tblInfo, args); err != nil {
+		return err
+	}
+
+	job := &model.Job{
+		Version:        model.GetJobVerInUse(),
+		SchemaID:       schema.ID,
+		TableID:        tblInfo.ID,
+		SchemaName:     schema.Name.L,
+		TableName:      tblInfo.Name.L,
+		Type:           model.ActionChangePrimaryKey,
+		BinlogInfo:     &model.HistoryInfo{},
+		Priority:       ctx.GetSessionVars().DDLReorgPriority,
+		CDCWriteSource: ctx.GetSessionVars().CDCWriteSource,
+		SQLMode:        ctx.GetSessionVars().SQLMode,
+	}
+	if err := initJobReorgMetaFromVariables(job, ctx); err != nil {
+		return err
+	}
+	err := e.doDDLJob2(ctx, job, args)
+	return errors.Trace(err)
+}
+
 // CheckIsDropPrimaryKey checks if we will drop PK, there are many PK implementations so we provide a helper function.
 func CheckIsDropPrimaryKey(indexName ast.CIStr, indexInfo *model.IndexInfo, t table.Table) (bool, error) {
 	var isPK bool
@@ -5483,9 +6080,6 @@ func CheckIsDropPrimaryKey(indexName ast.CIStr, indexInfo *model.IndexInfo, t ta
 		if indexInfo == nil && !t.Meta().PKIsHandle {
 			return isPK, dbterror.ErrCantDropFieldOrKey.GenWithStackByArgs("PRIMARY")
 		}
-		if t.Meta().IsCommonHandle || t.Meta().PKIsHandle {
-			return isPK, dbterror.ErrUnsupportedModifyPrimaryKey.GenWithStack("Unsupported drop primary key when the table is using clustered index")
-		}
 	}
 
 	return isPK, nil
@@ -6924,6 +7518,10 @@ func (e *executor) DoDDLJobWrapper(ctx sessionctx.Context, jobW *JobWrapper) (re
 
 		if historyJob.Error != nil {
 			logutil.DDLLogger().Info("DDL job is failed", zap.Int64("jobID", jobID))
+			// The warning of a failed job gives the details of the error, e.g. the row which fails the validation.
+			if historyJob.Warning != nil {
+				ctx.GetSessionVars().StmtCtx.AppendNote(historyJob.Warning)
+			}
 			return errors.Trace(historyJob.Error)
 		}
 		panic("When the state is JobStateRollbackDone or JobStateCancelled, historyJob.Error should never be nil")
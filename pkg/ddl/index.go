@@ -236,6 +236,14 @@ func checkIndexColumn(col *model.ColumnInfo, indexColumnLen int, suppressTooLong
 		return dbterror.ErrUnsupportedAddColumnarIndex.FastGen("only VECTOR INDEX can be added to vector column")
 	}
 
+	// Spatial index is not supported yet, the geometry column can only be scanned.
+	if col.FieldType.GetType() == mysql.TypeGeometry {
+		if col.Hidden {
+			return dbterror.ErrFunctionalIndexOnJSONOrGeometryFunction
+		}
+		return dbterror.ErrUnsupportedIndexType.FastGen("index on the geometry column '%s' is not supported", col.Name.O)
+	}
+
 	// Length must be specified and non-zero for BLOB and TEXT column indexes.
 	if types.IsTypeBlob(col.FieldType.GetType()) {
 		if indexColumnLen == types.UnspecifiedLength {
@@ -2631,6 +2639,14 @@ func (w *worker) executeDistTask(jobCtx *jobContext, t table.Table, reorgInfo *r
 	if mInfo := reorgInfo.Job.MultiSchemaInfo; mInfo != nil {
 		taskKey = fmt.Sprintf("%s/%d", taskKey, mInfo.Seq)
 	}
+	// Changing primary key backfills the indexes of both the original table and the new table.
+	if reorgInfo.Job.Type == model.ActionChangePrimaryKey {
+		taskKey = fmt.Sprintf("%s/%d", taskKey, reorgInfo.PhysicalTableID)
+		// The rows are copied from the same table as the handle index is backfilled.
+		if bytes.Equal(reorgInfo.currElement.TypeKey, meta.ColumnElementKey) {
+			taskKey += "/rows"
+		}
+	}
 
 	// For resuming add index task.
 	// Need to fetch task by taskKey in tidb_global_task and tidb_global_task_history tables.
@@ -100,7 +100,8 @@ func (b *BackendCtxBuilder) Build(cfg *local.BackendConfig, bd *local.Backend) (
 		return nil, err
 	}
 	intest.Assert(job.Type == model.ActionAddPrimaryKey ||
-		job.Type == model.ActionAddIndex)
+		job.Type == model.ActionAddIndex ||
+		job.Type == model.ActionChangePrimaryKey)
 	intest.Assert(job.ReorgMeta != nil)
 
 	failpoint.Inject("beforeCreateLocalBackend", func() {
@@ -150,7 +151,8 @@ func CreateLocalBackend(ctx context.Context, store kv.Storage, job *model.Job, c
 		return nil, nil, err
 	}
 	intest.Assert(job.Type == model.ActionAddPrimaryKey ||
-		job.Type == model.ActionAddIndex)
+		job.Type == model.ActionAddIndex ||
+		job.Type == model.ActionChangePrimaryKey)
 	intest.Assert(job.ReorgMeta != nil)
 
 	resGroupName := job.ReorgMeta.ResourceGroupName
@@ -193,6 +195,10 @@ func CreateLocalBackend(ctx context.Context, store kv.Storage, job *model.Job, c
 }
 
 func hasUniqueIndex(job *model.Job) (bool, error) {
+	if job.Type == model.ActionChangePrimaryKey {
+		// The handle index and the new primary key are unique.
+		return true, nil
+	}
 	args, err := model.GetModifyIndexArgs(job)
 	if err != nil {
 		return false, errors.Trace(err)
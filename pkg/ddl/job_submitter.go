@@ -439,6 +439,8 @@ func getRequiredGIDCount(jobWs []*JobWrapper) int {
 			count += len(args.PartInfo.Definitions)
 		case model.ActionTruncateTable:
 			count += 1 + len(jobW.JobArgs.(*model.TruncateTableArgs).OldPartitionIDs)
+		case model.ActionChangePrimaryKey:
+			count++
 		}
 	}
 	return count
@@ -505,6 +507,11 @@ func assignGIDsForJobs(jobWs []*JobWrapper, ids []int64) {
 				}
 				args.NewPartitionIDs = partIDs
 			}
+		case model.ActionChangePrimaryKey:
+			if !jobW.IDAllocated {
+				args := jobW.JobArgs.(*model.ChangePrimaryKeyArgs)
+				args.NewTableID = alloc.next()
+			}
 		}
 		jobW.ID = alloc.next()
 	}
@@ -682,6 +689,9 @@ func job2TableIDs(jobW *JobWrapper) string {
 	case model.ActionTruncateTable:
 		newTableID := jobW.JobArgs.(*model.TruncateTableArgs).NewTableID
 		return strconv.FormatInt(jobW.TableID, 10) + "," + strconv.FormatInt(newTableID, 10)
+	case model.ActionChangePrimaryKey:
+		newTableID := jobW.JobArgs.(*model.ChangePrimaryKeyArgs).NewTableID
+		return strconv.FormatInt(jobW.TableID, 10) + "," + strconv.FormatInt(newTableID, 10)
 	default:
 		return strconv.FormatInt(jobW.TableID, 10)
 	}
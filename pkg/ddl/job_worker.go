@@ -355,7 +355,7 @@ func JobNeedGC(job *model.Job) bool {
 			model.ActionDropColumn, model.ActionModifyColumn,
 			model.ActionAddIndex, model.ActionAddPrimaryKey,
 			model.ActionReorganizePartition, model.ActionRemovePartitioning,
-			model.ActionAlterTablePartitioning:
+			model.ActionAlterTablePartitioning, model.ActionChangePrimaryKey:
 			return true
 		case model.ActionDropIndex:
 			args, err := model.GetFinishedModifyIndexArgs(job)
@@ -1049,6 +1049,20 @@ func (w *worker) runOneJobStep(
 		ver, err = w.onAlterCheckConstraint(jobCtx, job)
 	case model.ActionRefreshMeta:
 		ver, err = onRefreshMeta(jobCtx, job)
+	case model.ActionChangePrimaryKey:
+		ver, err = w.onChangePrimaryKey(jobCtx, job)
+	case model.ActionCreateMaskingPolicy:
+		ver, err = onCreateMaskingPolicy(jobCtx, job)
+	case model.ActionDropMaskingPolicy:
+		ver, err = onDropMaskingPolicy(jobCtx, job)
+	case model.ActionCreateRowPolicy:
+		ver, err = onCreateRowPolicy(jobCtx, job)
+	case model.ActionDropRowPolicy:
+		ver, err = onDropRowPolicy(jobCtx, job)
+	case model.ActionCreateTrigger:
+		ver, err = onCreateTrigger(jobCtx, job)
+	case model.ActionDropTrigger:
+		ver, err = onDropTrigger(jobCtx, job)
 	default:
 		// Invalid job, cancel it.
 		job.State = model.JobStateCancelled
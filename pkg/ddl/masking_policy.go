@@ -0,0 +1,91 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl
+
+import (
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/infoschema"
+	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/util/dbterror"
+)
+
+// findMaskingPolicy returns the column which the masking policy is created on,
+// the names of the masking policies are unique in a table.
+func findMaskingPolicy(tblInfo *model.TableInfo, name ast.CIStr) *model.ColumnInfo {
+	for _, col := range tblInfo.Columns {
+		if col.MaskingPolicy != nil && col.MaskingPolicy.Name.L == name.L {
+			return col
+		}
+	}
+	return nil
+}
+
+func onCreateMaskingPolicy(jobCtx *jobContext, job *model.Job) (ver int64, _ error) {
+	args, err := model.GetMaskingPolicyArgs(job)
+	if err != nil {
+		job.State = model.JobStateCancelled
+		return ver, errors.Trace(err)
+	}
+	tblInfo, err := GetTableInfoAndCancelFaultJob(jobCtx.metaMut, job, job.SchemaID)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	col := model.FindColumnInfo(tblInfo.Columns, args.ColumnName.L)
+	if col == nil {
+		job.State = model.JobStateCancelled
+		return ver, infoschema.ErrColumnNotExists.GenWithStackByArgs(args.ColumnName, tblInfo.Name)
+	}
+	if existing := findMaskingPolicy(tblInfo, args.Policy.Name); existing != nil || col.MaskingPolicy != nil {
+		if existing == nil {
+			existing = col
+		}
+		job.State = model.JobStateCancelled
+		return ver, dbterror.ErrMaskingPolicyExists.GenWithStackByArgs(existing.MaskingPolicy.Name, tblInfo.Name)
+	}
+
+	col.MaskingPolicy = args.Policy
+	ver, err = updateVersionAndTableInfo(jobCtx, job, tblInfo, true)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	job.FinishTableJob(model.JobStateDone, model.StatePublic, ver, tblInfo)
+	return ver, nil
+}
+
+func onDropMaskingPolicy(jobCtx *jobContext, job *model.Job) (ver int64, _ error) {
+	args, err := model.GetMaskingPolicyArgs(job)
+	if err != nil {
+		job.State = model.JobStateCancelled
+		return ver, errors.Trace(err)
+	}
+	tblInfo, err := GetTableInfoAndCancelFaultJob(jobCtx.metaMut, job, job.SchemaID)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	col := findMaskingPolicy(tblInfo, args.Policy.Name)
+	if col == nil {
+		job.State = model.JobStateCancelled
+		return ver, dbterror.ErrMaskingPolicyNotExists.GenWithStackByArgs(args.Policy.Name, tblInfo.Name)
+	}
+
+	col.MaskingPolicy = nil
+	ver, err = updateVersionAndTableInfo(jobCtx, job, tblInfo, true)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	job.FinishTableJob(model.JobStateDone, model.StatePublic, ver, tblInfo)
+	return ver, nil
+}
@@ -0,0 +1,135 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl_test
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/pkg/errno"
+	"github.com/pingcap/tidb/pkg/parser/auth"
+	"github.com/pingcap/tidb/pkg/testkit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaskingPolicy(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (id int primary key, name varchar(20), phone varchar(20), email varchar(50), birthday date, salary int)")
+	tk.MustExec("insert into t values (1, 'alice', '13812345678', 'alice@example.com', '1990-05-17', 1000)")
+
+	tk.MustExec("create masking policy p_name on t (name) using redact")
+	tk.MustExec("create masking policy p_phone on t (phone) using partial(3, 4)")
+	tk.MustExec("create masking policy p_email on t (email) using hash")
+	tk.MustExec("create masking policy p_birthday on t (birthday) using date_trunc(year)")
+	tk.MustExec("create masking policy p_salary on t (salary) using null")
+
+	// The names of the policies are unique in a table, and a column has at most one policy.
+	tk.MustGetErrCode("create masking policy p_name on t (id) using redact", errno.ErrMaskingPolicyExists)
+	tk.MustGetErrCode("create masking policy p_other on t (name) using hash", errno.ErrMaskingPolicyExists)
+	tk.MustExec("create masking policy if not exists p_name on t (name) using hash")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Note 8266 Masking policy 'p_name' already exists on table 't'"))
+	tk.MustGetErrCode("create masking policy p_x on t (not_exists) using redact", errno.ErrBadField)
+	tk.MustGetErrCode("create masking policy p_x on t (id) using date_trunc(day)", errno.ErrUnsupportedDDLOperation)
+	tk.MustGetErrCode("drop masking policy p_x on t", errno.ErrMaskingPolicyNotExists)
+	tk.MustExec("drop masking policy if exists p_x on t")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Note 8267 Unknown masking policy 'p_x' on table 't'"))
+
+	tk.MustExec("create user u1, u2")
+	tk.MustExec("grant select on test.t to u1, u2")
+	tk.MustExec("create role r_unmasked")
+	tk.MustExec("grant unmasked on *.* to r_unmasked")
+	tk.MustExec("grant r_unmasked to u2")
+
+	masked := testkit.Rows("1 ***** 138****5678 ff8d9819fc0e12bf0d24892e45987e249a28dce836a85cad60e28eaaa8c6d976 1990-01-01 <nil>")
+	unmasked := testkit.Rows("1 alice 13812345678 alice@example.com 1990-05-17 1000")
+
+	// The users with SUPER see the original values.
+	tk.MustQuery("select * from t").Check(unmasked)
+
+	tk1 := testkit.NewTestKit(t, store)
+	require.NoError(t, tk1.Session().Auth(&auth.UserIdentity{Username: "u1", Hostname: "%"}, nil, nil, nil))
+	tk1.MustExec("use test")
+	tk1.MustQuery("select * from t").Check(masked)
+	tk1.MustQuery("select * from t where id = 1").Check(masked)
+	tk1.MustQuery("select * from (select name, phone from t) x").Check(testkit.Rows("***** 138****5678"))
+	tk1.MustQuery("select concat(name, '!'), max(phone) from t group by name").Check(testkit.Rows("*****! 138****5678"))
+	tk1.MustQuery("select first_value(name) over (), lag(phone, 0) over (order by id) from t").Check(testkit.Rows("***** 138****5678"))
+	tk1.MustQuery("select name, upper(phone), count(*) from t group by name, upper(phone) with rollup").Sort().Check(testkit.Rows(
+		"***** 138****5678 1", "***** <nil> 1", "<nil> <nil> 1"))
+	// The filters are evaluated on the original values.
+	tk1.MustQuery("select id from t where name = 'alice' and salary > 500").Check(testkit.Rows("1"))
+	// Only the users with the ALTER privilege can create or drop masking policies.
+	tk1.MustGetErrCode("drop masking policy p_name on t", errno.ErrTableaccessDenied)
+
+	tk2 := testkit.NewTestKit(t, store)
+	require.NoError(t, tk2.Session().Auth(&auth.UserIdentity{Username: "u2", Hostname: "%"}, nil, nil, nil))
+	tk2.MustExec("use test")
+	tk2.MustQuery("select * from t").Check(masked)
+	tk2.MustExec("set role r_unmasked")
+	tk2.MustQuery("select * from t").Check(unmasked)
+	// The plan built for the user who sees the original values is not cached.
+	tk2.MustExec("prepare stmt from 'select name from t where id = ?'")
+	tk2.MustExec("set @a = 1")
+	tk2.MustQuery("execute stmt using @a").Check(testkit.Rows("alice"))
+	tk2.MustQuery("execute stmt using @a").Check(testkit.Rows("alice"))
+	tk2.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("0"))
+	tk2.MustExec("set role none")
+	tk2.MustQuery("execute stmt using @a").Check(testkit.Rows("*****"))
+
+	tk.MustExec("grant unmasked on *.* to u1")
+	tk1.MustQuery("select * from t").Check(unmasked)
+	tk.MustExec("revoke unmasked on *.* from u1")
+	tk1.MustQuery("select * from t").Check(masked)
+
+	tk.MustExec("drop masking policy p_name on t")
+	tk.MustExec("drop masking policy p_phone on t")
+	tk.MustExec("drop masking policy p_email on t")
+	tk.MustExec("drop masking policy p_birthday on t")
+	tk.MustExec("drop masking policy p_salary on t")
+	tk1.MustQuery("select * from t").Check(unmasked)
+}
+
+func TestMaskingPolicyOfWrittenValues(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (id int primary key, name varchar(20), copy varchar(20))")
+	tk.MustExec("insert into t values (1, 'alice', null)")
+	tk.MustExec("create table s (id int primary key, name varchar(20))")
+	tk.MustExec("insert into s values (1, 'bob'), (2, 'carol')")
+	tk.MustExec("create masking policy p_t on t (name) using redact")
+	tk.MustExec("create masking policy p_s on s (name) using redact")
+	tk.MustExec("create user u1")
+	tk.MustExec("grant select, insert, update on test.t to u1")
+	tk.MustExec("grant select on test.s to u1")
+
+	tk1 := testkit.NewTestKit(t, store)
+	require.NoError(t, tk1.Session().Auth(&auth.UserIdentity{Username: "u1", Hostname: "%"}, nil, nil, nil))
+	tk1.MustExec("use test")
+	// The masked columns can't be copied to the other columns by the written values.
+	tk1.MustExec("update t set copy = concat(name, '!')")
+	tk.MustQuery("select name, copy from t").Check(testkit.Rows("alice *****!"))
+	tk1.MustExec("update t, s set t.copy = s.name where t.id = s.id")
+	tk.MustQuery("select name, copy from t").Check(testkit.Rows("alice ***"))
+	tk1.MustExec("insert into t values (1, 'x', null) on duplicate key update copy = name")
+	tk.MustQuery("select name, copy from t").Check(testkit.Rows("alice *****"))
+	tk1.MustExec("merge into t using s on t.id = s.id when matched then update set copy = s.name when not matched then insert values (s.id, 'dave', s.name)")
+	tk.MustQuery("select id, name, copy from t order by id").Check(testkit.Rows("1 alice ***", "2 dave *****"))
+
+	// The filters are still evaluated on the original values.
+	tk1.MustExec("update t set copy = 'found' where name = 'alice'")
+	tk.MustQuery("select copy from t where id = 1").Check(testkit.Rows("found"))
+}
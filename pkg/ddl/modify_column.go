@@ -1277,6 +1277,10 @@ func ProcessModifyColumnOptions(ctx sessionctx.Context, col *table.Column, optio
 			}
 		case ast.ColumnOptionCollate:
 			col.SetCollate(opt.StrValue)
+		case ast.ColumnOptionSRID:
+			if err = setColumnSRID(col, opt); err != nil {
+				return errors.Trace(err)
+			}
 		case ast.ColumnOptionReference:
 			return errors.Trace(dbterror.ErrUnsupportedModifyColumn.GenWithStackByArgs("can't modify with references"))
 		case ast.ColumnOptionFulltext:
@@ -129,6 +129,31 @@ func (s *SchemaChangeEvent) GetTruncateTableInfo() (
 	return s.inner.TableInfo, s.inner.OldTableInfo
 }
 
+// NewChangePrimaryKeyEvent creates a SchemaChangeEvent whose type is
+// ActionChangePrimaryKey.
+func NewChangePrimaryKeyEvent(
+	newTableInfo *model.TableInfo,
+	oldTableInfo *model.TableInfo,
+) *SchemaChangeEvent {
+	return &SchemaChangeEvent{
+		inner: &jsonSchemaChangeEvent{
+			Tp:           model.ActionChangePrimaryKey,
+			TableInfo:    newTableInfo,
+			OldTableInfo: oldTableInfo,
+		},
+	}
+}
+
+// GetChangePrimaryKeyInfo returns the new and old table info of the
+// SchemaChangeEvent whose type is ActionChangePrimaryKey.
+func (s *SchemaChangeEvent) GetChangePrimaryKeyInfo() (
+	newTableInfo *model.TableInfo,
+	oldTableInfo *model.TableInfo,
+) {
+	intest.Assert(s.inner.Tp == model.ActionChangePrimaryKey)
+	return s.inner.TableInfo, s.inner.OldTableInfo
+}
+
 // NewDropTableEvent creates a SchemaChangeEvent whose type is ActionDropTable.
 func NewDropTableEvent(
 	droppedTableInfo *model.TableInfo,
@@ -62,6 +62,7 @@ import (
 	"github.com/pingcap/tidb/pkg/util/hack"
 	decoder "github.com/pingcap/tidb/pkg/util/rowDecoder"
 	"github.com/pingcap/tidb/pkg/util/slice"
+	"github.com/pingcap/tidb/pkg/util/sqlexec"
 	"github.com/pingcap/tidb/pkg/util/stringutil"
 	"github.com/tikv/client-go/v2/tikv"
 	kvutil "github.com/tikv/client-go/v2/util"
@@ -2857,6 +2858,7 @@ func (w *worker) onExchangeTablePartition(jobCtx *jobContext, job *model.Job) (v
 		err = checkExchangePartitionRecordValidation(
 			jobCtx.stepCtx,
 			w,
+			job,
 			ptbl,
 			ntbl,
 			ptDbInfo.Name.L,
@@ -4188,50 +4190,98 @@ func bundlesForExchangeTablePartition(t *meta.Mutator, pt *model.TableInfo, newP
 	return bundles, nil
 }
 
+// checkExchangePartitionRecordValidation checks that all the rows of the non-partitioned table belong to the partition,
+// and that the rows of both tables satisfy the check constraints of the other table.
+// The checks are done by SQL, so the tables are scanned by the coprocessor in parallel. The first row found which
+// violates the rule is reported by a note of the job, see DoDDLJobWrapper.
 func checkExchangePartitionRecordValidation(
 	ctx context.Context,
 	w *worker,
+	job *model.Job,
 	ptbl, ntbl table.Table,
 	pschemaName, nschemaName, partitionName string,
 ) error {
-	verifyFunc := func(sql string, params ...any) error {
+	ctx = kv.WithInternalSourceType(ctx, kv.InternalTxnDDL)
+	reorgMeta := job.ReorgMeta
+	if reorgMeta == nil {
+		reorgMeta = &model.DDLReorgMeta{SQLMode: job.SQLMode}
+	}
+	queryFunc := func(sql string, params ...any) ([]chunk.Row, []*types.FieldType, error) {
 		sctx, err := w.sessPool.Get()
 		if err != nil {
-			return errors.Trace(err)
+			return nil, nil, errors.Trace(err)
 		}
 		defer w.sessPool.Put(sctx)
 
-		rows, _, err := sctx.GetRestrictedSQLExecutor().ExecRestrictedSQL(
+		// The partitioning expression and the check constraints are evaluated with the sql_mode and
+		// the time zone of the statement, so the statement is executed by the session of the worker.
+		defer restoreSessCtx(sctx)(sctx)
+		if err = initSessCtx(sctx, reorgMeta); err != nil {
+			return nil, nil, errors.Trace(err)
+		}
+		rows, fields, err := sctx.GetRestrictedSQLExecutor().ExecRestrictedSQL(
 			ctx,
-			nil,
+			[]sqlexec.OptionFuncAlias{sqlexec.ExecOptionUseCurSession},
 			sql,
 			params...,
 		)
 		if err != nil {
-			return errors.Trace(err)
+			return nil, nil, errors.Trace(err)
 		}
-		rowCount := len(rows)
-		if rowCount != 0 {
-			return errors.Trace(dbterror.ErrRowDoesNotMatchPartition)
+		fts := make([]*types.FieldType, 0, len(fields))
+		for _, field := range fields {
+			fts = append(fts, &field.Column.FieldType)
 		}
-		// Check warnings!
-		// Is it possible to check how many rows where checked as well?
-		return nil
+		return rows, fts, nil
 	}
-	genConstraintCondition := func(constraints []*table.Constraint) string {
+	type CheckConstraintTable interface {
+		WritableConstraint() []*table.Constraint
+	}
+	// checkConstraints checks the rows of the table against the constraints of the other table.
+	checkConstraints := func(tbl, otherTbl table.Table, from string, params ...any) error {
+		if !vardef.EnableCheckConstraint.Load() {
+			return nil
+		}
+		cc, ok := otherTbl.(CheckConstraintTable)
+		if !ok {
+			return errors.Errorf("exchange partition process assert table partition failed")
+		}
+		constraints := cc.WritableConstraint()
+		if len(constraints) == 0 {
+			return nil
+		}
 		var buf strings.Builder
-		buf.WriteString("not (")
+		buf.WriteString("select ")
+		for i, cons := range constraints {
+			if i != 0 {
+				buf.WriteString(", ")
+			}
+			buf.WriteString(fmt.Sprintf("not (%s)", cons.ExprString))
+		}
+		buf.WriteString(from)
+		buf.WriteString(" where not (")
 		for i, cons := range constraints {
 			if i != 0 {
 				buf.WriteString(" and ")
 			}
 			buf.WriteString(fmt.Sprintf("(%s)", cons.ExprString))
 		}
-		buf.WriteString(")")
-		return buf.String()
-	}
-	type CheckConstraintTable interface {
-		WritableConstraint() []*table.Constraint
+		buf.WriteString(") limit 1")
+		rows, _, err := queryFunc(buf.String(), params...)
+		if err != nil || len(rows) == 0 {
+			return errors.Trace(err)
+		}
+		violated := constraints[0].Name.O
+		for i, cons := range constraints {
+			if !rows[0].IsNull(i) && rows[0].GetInt64(i) != 0 {
+				violated = cons.Name.O
+				break
+			}
+		}
+		job.Warning = toTError(errors.NewNoStackError(fmt.Sprintf(
+			"A row of table `%s` violates check constraint `%s` of table `%s`",
+			tbl.Meta().Name.O, violated, otherTbl.Meta().Name.O)))
+		return errors.Trace(dbterror.ErrRowDoesNotMatchPartition)
 	}
 
 	pt := ptbl.Meta()
@@ -4241,19 +4291,37 @@ func checkExchangePartitionRecordValidation(
 	}
 
 	var buf strings.Builder
-	buf.WriteString("select 1 from %n.%n where ")
-	paramList := []any{nschemaName, ntbl.Meta().Name.L}
+	pi := pt.Partition
+	// The values of the partitioning columns or expression are selected to report the row.
+	buf.WriteString("select ")
+	var paramList []any
+	var keyNames []string
+	if len(pi.Columns) > 0 {
+		for i, col := range pi.Columns {
+			if i != 0 {
+				buf.WriteString(", ")
+			}
+			buf.WriteString("%n")
+			paramList = append(paramList, col.L)
+			keyNames = append(keyNames, "`"+strings.ReplaceAll(col.O, "`", "``")+"`")
+		}
+	} else {
+		buf.WriteString(pi.Expr)
+		keyNames = append(keyNames, pi.Expr)
+	}
+	buf.WriteString(" from %n.%n where ")
+	paramList = append(paramList, nschemaName, ntbl.Meta().Name.L)
 	checkNt := true
 
-	pi := pt.Partition
 	switch pi.Type {
 	case ast.PartitionTypeHash:
 		if pi.Num == 1 {
 			checkNt = false
 		} else {
-			buf.WriteString("mod(")
+			// Like locateHashPartition, the partition is the absolute value of the modulo.
+			buf.WriteString("abs(mod(")
 			buf.WriteString(pi.Expr)
-			buf.WriteString(", %?) != %?")
+			buf.WriteString(", %?)) != %?")
 			paramList = append(paramList, pi.Num, index)
 			if index != 0 {
 				// TODO: if hash result can't be NULL, we can remove the check part.
@@ -4261,7 +4329,7 @@ func checkExchangePartitionRecordValidation(
 				buf.WriteString(" or mod(")
 				buf.WriteString(pi.Expr)
 				buf.WriteString(", %?) is null")
-				paramList = append(paramList, pi.Num, index)
+				paramList = append(paramList, pi.Num)
 			}
 		}
 	case ast.PartitionTypeRange:
@@ -4281,60 +4349,53 @@ func checkExchangePartitionRecordValidation(
 			}
 		}
 	case ast.PartitionTypeList:
+		var conds string
 		if len(pi.Columns) == 0 {
-			conds := buildCheckSQLConditionForListPartition(pi, index)
-			buf.WriteString(conds)
+			conds = buildCheckSQLConditionForListPartition(pi, index)
 		} else {
-			conds := buildCheckSQLConditionForListColumnsPartition(pi, index)
-			buf.WriteString(conds)
+			conds = buildCheckSQLConditionForListColumnsPartition(pi, index)
+		}
+		if len(conds) == 0 {
+			// It's the DEFAULT partition and there are no values of the other partitions.
+			checkNt = false
 		}
+		buf.WriteString(conds)
 	default:
 		return dbterror.ErrUnsupportedPartitionType.GenWithStackByArgs(pt.Name.O)
 	}
 
-	if vardef.EnableCheckConstraint.Load() {
-		pcc, ok := ptbl.(CheckConstraintTable)
-		if !ok {
-			return errors.Errorf("exchange partition process assert table partition failed")
-		}
-		pCons := pcc.WritableConstraint()
-		if len(pCons) > 0 {
-			if !checkNt {
-				checkNt = true
-			} else {
-				buf.WriteString(" or ")
-			}
-			buf.WriteString(genConstraintCondition(pCons))
-		}
-	}
 	// Check non-partition table records.
 	if checkNt {
 		buf.WriteString(" limit 1")
-		err = verifyFunc(buf.String(), paramList...)
+		rows, fts, err := queryFunc(buf.String(), paramList...)
 		if err != nil {
 			return errors.Trace(err)
 		}
-	}
-
-	// Check partition table records.
-	if vardef.EnableCheckConstraint.Load() {
-		ncc, ok := ntbl.(CheckConstraintTable)
-		if !ok {
-			return errors.Errorf("exchange partition process assert table partition failed")
-		}
-		nCons := ncc.WritableConstraint()
-		if len(nCons) > 0 {
-			buf.Reset()
-			buf.WriteString("select 1 from %n.%n partition(%n) where ")
-			buf.WriteString(genConstraintCondition(nCons))
-			buf.WriteString(" limit 1")
-			err = verifyFunc(buf.String(), pschemaName, pt.Name.L, partitionName)
-			if err != nil {
-				return errors.Trace(err)
+		if len(rows) != 0 {
+			vals := make([]string, 0, len(fts))
+			for i, ft := range fts {
+				d := rows[0].GetDatum(i, ft)
+				if d.IsNull() {
+					vals = append(vals, "NULL")
+					continue
+				}
+				val, err := d.ToString()
+				if err != nil {
+					return errors.Trace(err)
+				}
+				vals = append(vals, val)
 			}
+			job.Warning = toTError(errors.NewNoStackError(fmt.Sprintf(
+				"The row of table `%s` with (%s) = (%s) does not match partition `%s`",
+				ntbl.Meta().Name.O, strings.Join(keyNames, ", "), strings.Join(vals, ", "), partitionName)))
+			return errors.Trace(dbterror.ErrRowDoesNotMatchPartition)
 		}
 	}
-	return nil
+	if err = checkConstraints(ntbl, ptbl, " from %n.%n", nschemaName, ntbl.Meta().Name.L); err != nil {
+		return errors.Trace(err)
+	}
+	// Check partition table records.
+	return checkConstraints(ptbl, ntbl, " from %n.%n partition(%n)", pschemaName, pt.Name.L, partitionName)
 }
 
 func checkExchangePartitionPlacementPolicy(t *meta.Mutator, ntPPRef, ptPPRef, partPPRef *model.PolicyRefInfo) error {
@@ -4477,58 +4538,57 @@ func buildCheckSQLConditionForRangeColumnsPartition(pi *model.PartitionInfo, ind
 }
 
 func buildCheckSQLConditionForListPartition(pi *model.PartitionInfo, index int) string {
-	var buf strings.Builder
-	// TODO: Handle DEFAULT partition
-	buf.WriteString("not (")
-	for i, inValue := range pi.Definitions[index].InValues {
-		if i != 0 {
-			buf.WriteString(" OR ")
-		}
-		// AND has higher priority than OR, so no need for parentheses
-		for j, val := range inValue {
-			if j != 0 {
-				// Should never happen, since there should be no multi-columns, only a single expression :)
-				buf.WriteString(" AND ")
-			}
-			// null-safe compare '<=>'
-			buf.WriteString(fmt.Sprintf("(%s) <=> %s", pi.Expr, val))
-		}
-	}
-	buf.WriteString(")")
-	return buf.String()
+	return buildCheckSQLConditionForListInValues(pi, index, []string{"(" + pi.Expr + ")"})
 }
 
 func buildCheckSQLConditionForListColumnsPartition(pi *model.PartitionInfo, index int) string {
-	var buf strings.Builder
-	// TODO: Verify if this is correct!!!
-	// TODO: Handle DEFAULT partition!
-	// TODO: use paramList with column names, instead of quoting.
-	// How to find a match?
-	// (row <=> vals1) OR (row <=> vals2)
-	// How to find a non-matching row:
-	// NOT ( (row <=> vals1) OR (row <=> vals2) ... )
-	buf.WriteString("not (")
 	colNames := make([]string, 0, len(pi.Columns))
 	for i := range pi.Columns {
-		// TODO: Add test for this!
-		// TODO: check if there are no proper quoting function for this?
-		// TODO: Maybe Sprintf("%#q", str) ?
 		n := "`" + strings.ReplaceAll(pi.Columns[i].O, "`", "``") + "`"
 		colNames = append(colNames, n)
 	}
-	for i, colValues := range pi.Definitions[index].InValues {
-		if i != 0 {
-			buf.WriteString(" OR ")
+	return buildCheckSQLConditionForListInValues(pi, index, colNames)
+}
+
+// buildCheckSQLConditionForListInValues builds the condition to find the rows which don't belong to the list partition.
+// A row belongs to a partition if it matches one of its values, i.e.
+// NOT ( (row <=> vals1) OR (row <=> vals2) ... ) finds the non-matching rows.
+// A row belongs to the DEFAULT partition if it doesn't match the values of any other partition, so
+// (row <=> vals of other partitions) OR ... finds the non-matching rows, and an empty string is returned
+// if there are no other values, since all the rows belong to the DEFAULT partition.
+func buildCheckSQLConditionForListInValues(pi *model.PartitionInfo, index int, exprs []string) string {
+	var buf strings.Builder
+	isDefault := index == pi.GetDefaultListPartition()
+	if !isDefault {
+		buf.WriteString("not ")
+	}
+	buf.WriteString("(")
+	hasValues := false
+	for i := range pi.Definitions {
+		if (i == index) == isDefault {
+			continue
 		}
-		// AND has higher priority than OR, so no need for parentheses
-		for j, val := range colValues {
-			if j != 0 {
-				buf.WriteString(" AND ")
+		for _, vals := range pi.Definitions[i].InValues {
+			if len(vals) == 1 && vals[0] == "DEFAULT" {
+				continue
+			}
+			if hasValues {
+				buf.WriteString(" OR ")
+			}
+			hasValues = true
+			// AND has higher priority than OR, so no need for parentheses
+			for j, val := range vals {
+				if j != 0 {
+					buf.WriteString(" AND ")
+				}
+				// null-safe compare '<=>'
+				buf.WriteString(fmt.Sprintf("%s <=> %s", exprs[j], val))
 			}
-			// null-safe compare '<=>'
-			buf.WriteString(fmt.Sprintf("%s <=> %s", colNames[j], val))
 		}
 	}
+	if !hasValues {
+		return ""
+	}
 	buf.WriteString(")")
 	return buf.String()
 }
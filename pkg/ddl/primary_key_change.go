@@ -0,0 +1,904 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl
+
+import (
+	"context"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/ddl/ingest"
+	"github.com/pingcap/tidb/pkg/ddl/logutil"
+	"github.com/pingcap/tidb/pkg/ddl/notifier"
+	sess "github.com/pingcap/tidb/pkg/ddl/session"
+	"github.com/pingcap/tidb/pkg/kv"
+	"github.com/pingcap/tidb/pkg/meta"
+	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/metrics"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/table"
+	"github.com/pingcap/tidb/pkg/table/tables"
+	"github.com/pingcap/tidb/pkg/tablecodec"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util"
+	"github.com/pingcap/tidb/pkg/util/dbterror"
+	decoder "github.com/pingcap/tidb/pkg/util/rowDecoder"
+	kvutil "github.com/tikv/client-go/v2/util"
+	"go.uber.org/zap"
+)
+
+// onChangePrimaryKey changes the primary key of a table when the clustered index
+// is involved, i.e. the handle layout of the rows has to be changed.
+//
+// The rows are copied to a new table with the new handle layout, which is kept in
+// TableInfo.PrimaryKeyChange and double written by DML. The states are:
+//
+//	none -> delete only -> write only -> write reorganization -> delete reorganization -> public
+//
+// The write reorganization backfills the handle index if needed, copies the rows and
+// backfills the secondary indexes of the new table. Then the two tables are swapped
+// in delete reorganization, and the original table is dropped when the job is done.
+func (w *worker) onChangePrimaryKey(jobCtx *jobContext, job *model.Job) (ver int64, err error) {
+	args, err := model.GetChangePrimaryKeyArgs(job)
+	if err != nil {
+		job.State = model.JobStateCancelled
+		return ver, errors.Trace(err)
+	}
+	jobCtx.jobArgs = args
+	if job.IsRollingback() {
+		return onRollbackChangePrimaryKey(jobCtx, job, args)
+	}
+	if job.SchemaState == model.StateDeleteReorganization {
+		return w.finishChangePrimaryKey(jobCtx, job, args)
+	}
+
+	schemaID := job.SchemaID
+	tblInfo, err := GetTableInfoAndCancelFaultJob(jobCtx.metaMut, job, schemaID)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+
+	pkc := tblInfo.PrimaryKeyChange
+	if pkc == nil {
+		// none -> delete only
+		if err = checkChangePrimaryKey(tblInfo, args); err != nil {
+			job.State = model.JobStateCancelled
+			return ver, errors.Trace(err)
+		}
+		_, err = pickBackfillType(job)
+		if err != nil {
+			if !isRetryableJobError(err, job.ErrorCount) {
+				job.State = model.JobStateCancelled
+			}
+			return ver, err
+		}
+		loadCloudStorageURI(w, job)
+		if err = buildPrimaryKeyChange(job, tblInfo, args); err != nil {
+			job.State = model.JobStateCancelled
+			return ver, errors.Trace(err)
+		}
+		err = syncAutoIDsForChangePrimaryKey(jobCtx.metaMut, schemaID, tblInfo.ID, args.NewTableID)
+		if err != nil {
+			return ver, errors.Trace(err)
+		}
+		ver, err = updateVersionAndTableInfoWithCheck(jobCtx, job, tblInfo, true)
+		if err != nil {
+			return ver, errors.Trace(err)
+		}
+		job.SchemaState = model.StateDeleteOnly
+		return ver, nil
+	}
+
+	handleIdx := tblInfo.FindIndexByID(pkc.HandleIndexID)
+	switch pkc.DDLState {
+	case model.StateDeleteOnly:
+		// delete only -> write only
+		if handleIdx != nil {
+			handleIdx.State = model.StateWriteOnly
+		}
+		if err = checkChangePrimaryKeyNotNull(jobCtx, w, job, tblInfo, args); err != nil {
+			return convertChangePrimaryKeyJob2RollbackJob(jobCtx, job, tblInfo, args, err)
+		}
+		pkc.DDLState = model.StateWriteOnly
+		ver, err = updateVersionAndTableInfo(jobCtx, job, tblInfo, true)
+		if err != nil {
+			return ver, errors.Trace(err)
+		}
+		job.SchemaState = model.StateWriteOnly
+	case model.StateWriteOnly:
+		// write only -> reorganization
+		pkc.Stage = model.PrimaryKeyChangeStageCopyRows
+		if handleIdx != nil {
+			handleIdx.State = model.StateWriteReorganization
+			pkc.Stage = model.PrimaryKeyChangeStageHandleIndex
+		}
+		if err = checkChangePrimaryKeyNotNull(jobCtx, w, job, tblInfo, args); err != nil {
+			return convertChangePrimaryKeyJob2RollbackJob(jobCtx, job, tblInfo, args, err)
+		}
+		pkc.DDLState = model.StateWriteReorganization
+		ver, err = updateVersionAndTableInfo(jobCtx, job, tblInfo, true)
+		if err != nil {
+			return ver, errors.Trace(err)
+		}
+		// Initialize SnapshotVer to 0 for later reorganization check.
+		job.SnapshotVer = 0
+		job.SchemaState = model.StateWriteReorganization
+	case model.StateWriteReorganization:
+		return w.doReorgWorkForChangePrimaryKey(jobCtx, job, tblInfo, handleIdx, args)
+	default:
+		err = dbterror.ErrInvalidDDLState.GenWithStackByArgs("primary key change", pkc.DDLState)
+	}
+	return ver, errors.Trace(err)
+}
+
+func checkChangePrimaryKey(tblInfo *model.TableInfo, args *model.ChangePrimaryKeyArgs) error {
+	if tblInfo.TableCacheStatusType != model.TableCacheStatusDisable {
+		return errors.Trace(dbterror.ErrOptOnCacheTable.GenWithStackByArgs("Change Primary Key"))
+	}
+	var reason string
+	switch {
+	case tblInfo.GetPartitionInfo() != nil:
+		reason = "partitioned table"
+	case tblInfo.TempTableType != model.TempTableNone:
+		reason = "temporary table"
+	case tblInfo.TiFlashReplica != nil:
+		reason = "table with TiFlash replica"
+	case tblInfo.PlacementPolicyRef != nil:
+		reason = "table with placement policy"
+	case tblInfo.ContainsAutoRandomBits():
+		reason = "table with auto_random column"
+	case len(tblInfo.ForeignKeys) > 0:
+		reason = "table with foreign keys"
+	case !tblInfo.HasClusteredIndex() && !isClusteredPrimaryKeyArg(args.PrimaryKey):
+		reason = "table without clustered index"
+	case tblInfo.ShardRowIDBits > 0 && isClusteredPrimaryKeyArg(args.PrimaryKey):
+		reason = "table with shard_row_id_bits"
+	}
+	for _, idx := range tblInfo.Indices {
+		if idx.IsColumnarIndex() {
+			reason = "table with columnar index"
+		}
+	}
+	if reason != "" {
+		return dbterror.ErrUnsupportedModifyPrimaryKey.GenWithStack("Unsupported change primary key of %s", reason)
+	}
+	return nil
+}
+
+// isClusteredPrimaryKeyArg checks whether the primary key should be built as a clustered index,
+// the type of the primary key is decided when the job is submitted.
+func isClusteredPrimaryKeyArg(pk *model.IndexArg) bool {
+	return pk != nil && pk.IndexOption != nil && pk.IndexOption.PrimaryKeyTp == ast.PrimaryKeyTypeClustered
+}
+
+// buildPrimaryKeyChange builds the table with the new primary key, and adds the
+// handle index which is needed to locate the rows in the table without a clustered index.
+func buildPrimaryKeyChange(job *model.Job, tblInfo *model.TableInfo, args *model.ChangePrimaryKeyArgs) error {
+	needMerge := job.ReorgMeta.ReorgTp.NeedMergeProcess()
+	newInfo := tblInfo.Clone()
+	newInfo.ID = args.NewTableID
+	newInfo.PKIsHandle = false
+	newInfo.IsCommonHandle = false
+	newInfo.CommonHandleVersion = 0
+	indices := make([]*model.IndexInfo, 0, len(newInfo.Indices)+2)
+	for _, idx := range newInfo.Indices {
+		if idx.Primary {
+			continue
+		}
+		idx.State = model.StateDeleteOnly
+		if needMerge {
+			idx.BackfillState = model.BackfillStateRunning
+		}
+		indices = append(indices, idx)
+	}
+	for _, col := range newInfo.Columns {
+		col.DelFlag(mysql.PriKeyFlag)
+	}
+
+	var pkInfo *model.IndexInfo
+	if pk := args.PrimaryKey; pk != nil {
+		lastCol, err := CheckPKOnGeneratedColumn(newInfo, pk.IndexPartSpecifications)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		pkInfo, err = BuildIndexInfo(nil, newInfo, ast.NewCIStr(mysql.PrimaryKeyName), true, true,
+			model.ColumnarIndexTypeNA, pk.IndexPartSpecifications, pk.IndexOption, model.StateDeleteOnly)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		for _, idxCol := range pkInfo.Columns {
+			newInfo.Columns[idxCol.Offset].AddFlag(mysql.NotNullFlag)
+		}
+		clustered := isClusteredPrimaryKeyArg(pk)
+		switch {
+		case clustered && isSingleIntPK(&ast.Constraint{Keys: pk.IndexPartSpecifications}, lastCol):
+			newInfo.PKIsHandle = true
+			newInfo.Columns[pkInfo.Columns[0].Offset].AddFlag(mysql.PriKeyFlag)
+		case clustered:
+			newInfo.IsCommonHandle = true
+			newInfo.CommonHandleVersion = 1
+			// The rows are written with the clustered index, so it's never backfilled.
+			pkInfo.State = model.StatePublic
+			pkInfo.ID = AllocateIndexID(newInfo)
+			AddIndexColumnFlag(newInfo, pkInfo)
+			indices = append(indices, pkInfo)
+		default:
+			pkInfo.ID = AllocateIndexID(newInfo)
+			if needMerge {
+				pkInfo.BackfillState = model.BackfillStateRunning
+			}
+			indices = append(indices, pkInfo)
+		}
+	}
+
+	pkc := &model.PrimaryKeyChangeInfo{TableInfo: newInfo, DDLState: model.StateDeleteOnly}
+	switch {
+	case tblInfo.HasClusteredIndex() && !newInfo.HasClusteredIndex():
+		// Locate the rows in the new table by the original clustered index. The index
+		// is written along with the rows, so it's never backfilled.
+		var handleIdx *model.IndexInfo
+		if tblInfo.PKIsHandle {
+			pkCol := tblInfo.GetPkColInfo()
+			handleIdx = &model.IndexInfo{
+				Columns: []*model.IndexColumn{{Name: pkCol.Name, Offset: pkCol.Offset, Length: types.UnspecifiedLength}},
+				Tp:      ast.IndexTypeBtree,
+			}
+		} else {
+			handleIdx = tables.FindPrimaryIndex(tblInfo).Clone()
+			handleIdx.Primary = false
+			handleIdx.Invisible = false
+		}
+		handleIdx.Name = ast.NewCIStr(genChangingIndexUniqueName(newInfo, &model.IndexInfo{Name: ast.NewCIStr(mysql.PrimaryKeyName)}))
+		handleIdx.Unique = true
+		handleIdx.ID = AllocateIndexID(newInfo)
+		handleIdx.State = model.StateWriteReorganization
+		handleIdx.BackfillState = model.BackfillStateInapplicable
+		indices = append(indices, handleIdx)
+		pkc.HandleIndexID = handleIdx.ID
+		tblInfo.MaxIndexID = max(tblInfo.MaxIndexID, newInfo.MaxIndexID)
+	case !tblInfo.HasClusteredIndex():
+		// Locate the rows in the original table by a unique index on the new clustered
+		// index after the two tables are swapped, it also guarantees the uniqueness of
+		// the new primary key.
+		tblInfo.MaxIndexID = max(tblInfo.MaxIndexID, newInfo.MaxIndexID)
+		handleIdx, err := BuildIndexInfo(nil, tblInfo, ast.NewCIStr(genChangingIndexUniqueName(tblInfo, pkInfo)), false, true,
+			model.ColumnarIndexTypeNA, args.PrimaryKey.IndexPartSpecifications, nil, model.StateDeleteOnly)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		handleIdx.ID = AllocateIndexID(tblInfo)
+		if needMerge {
+			handleIdx.BackfillState = model.BackfillStateRunning
+		}
+		tblInfo.Indices = append(tblInfo.Indices, handleIdx)
+		pkc.HandleIndexID = handleIdx.ID
+		newInfo.MaxIndexID = tblInfo.MaxIndexID
+	}
+	newInfo.Indices = indices
+	tblInfo.PrimaryKeyChange = pkc
+	if err := checkTooManyIndexes(newInfo.Indices); err != nil {
+		return errors.Trace(err)
+	}
+	logutil.DDLLogger().Info("run change primary key job", zap.Stringer("job", job),
+		zap.Bool("clustered", newInfo.HasClusteredIndex()), zap.Int64("handle index ID", pkc.HandleIndexID))
+	return nil
+}
+
+// getChangePrimaryKeyNullCols returns the columns of the new primary key which may contain null values.
+func getChangePrimaryKeyNullCols(tblInfo *model.TableInfo, pk *model.IndexArg) []*model.ColumnInfo {
+	if pk == nil {
+		return nil
+	}
+	nullCols := make([]*model.ColumnInfo, 0, len(pk.IndexPartSpecifications))
+	for _, spec := range pk.IndexPartSpecifications {
+		col := model.FindColumnInfo(tblInfo.Columns, spec.Column.Name.L)
+		if col == nil {
+			continue
+		}
+		if !mysql.HasNotNullFlag(col.GetFlag()) || mysql.HasPreventNullInsertFlag(col.GetFlag()) {
+			nullCols = append(nullCols, col)
+		}
+	}
+	return nullCols
+}
+
+func checkChangePrimaryKeyNotNull(jobCtx *jobContext, w *worker, job *model.Job,
+	tblInfo *model.TableInfo, args *model.ChangePrimaryKeyArgs) error {
+	nullCols := getChangePrimaryKeyNullCols(tblInfo, args.PrimaryKey)
+	if len(nullCols) == 0 {
+		return nil
+	}
+	dbInfo, err := checkSchemaExistAndCancelNotExistJob(jobCtx.metaMut, job)
+	if err != nil {
+		return err
+	}
+	return modifyColsFromNull2NotNull(
+		jobCtx.stepCtx,
+		w,
+		dbInfo,
+		tblInfo,
+		nullCols,
+		&model.ColumnInfo{Name: ast.NewCIStr("")},
+		false,
+	)
+}
+
+// syncAutoIDsForChangePrimaryKey makes the auto IDs of table toID not less than the ones of table fromID.
+// The allocators are moved between the two tables during the job, see autoid.NewAllocatorsFromTblInfo.
+func syncAutoIDsForChangePrimaryKey(metaMut *meta.Mutator, schemaID, fromID, toID int64) error {
+	from, err := metaMut.GetAutoIDAccessors(schemaID, fromID).Get()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	to, err := metaMut.GetAutoIDAccessors(schemaID, toID).Get()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return metaMut.GetAutoIDAccessors(schemaID, toID).Put(model.AutoIDGroup{
+		RowID:       max(from.RowID, to.RowID),
+		IncrementID: max(from.IncrementID, to.IncrementID),
+		RandomID:    max(from.RandomID, to.RandomID),
+	})
+}
+
+// getChangePrimaryKeyReorgIndexes returns the indexes of the new table that need to be backfilled.
+func getChangePrimaryKeyReorgIndexes(pkc *model.PrimaryKeyChangeInfo) []*model.IndexInfo {
+	idxInfos := make([]*model.IndexInfo, 0, len(pkc.TableInfo.Indices))
+	for _, idx := range pkc.TableInfo.Indices {
+		if idx.State == model.StatePublic || idx.ID == pkc.HandleIndexID {
+			continue
+		}
+		idxInfos = append(idxInfos, idx)
+	}
+	return idxInfos
+}
+
+func (w *worker) doReorgWorkForChangePrimaryKey(jobCtx *jobContext, job *model.Job,
+	tblInfo *model.TableInfo, handleIdx *model.IndexInfo, args *model.ChangePrimaryKeyArgs) (ver int64, err error) {
+	pkc := tblInfo.PrimaryKeyChange
+	tbl, err := getTable(jobCtx.getAutoIDRequirement(), job.SchemaID, tblInfo)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	var done bool
+	switch pkc.Stage {
+	case model.PrimaryKeyChangeStageHandleIndex:
+		done, ver, err = w.doReorgWorkForChangePrimaryKeyIndexes(jobCtx, job, tblInfo, tbl, []*model.IndexInfo{handleIdx}, args)
+		if !done {
+			return ver, err
+		}
+		pkc.Stage = model.PrimaryKeyChangeStageCopyRows
+		job.SnapshotVer = 0
+		return updateVersionAndTableInfo(jobCtx, job, tblInfo, true)
+	case model.PrimaryKeyChangeStageCopyRows:
+		done, ver, err = w.doReorgWorkForChangePrimaryKeyRows(jobCtx, job, tbl, args)
+		if !done {
+			return ver, err
+		}
+		for _, idx := range getChangePrimaryKeyReorgIndexes(pkc) {
+			idx.State = model.StateWriteOnly
+		}
+		pkc.Stage = model.PrimaryKeyChangeStageIndexes
+		job.SnapshotVer = 0
+		return updateVersionAndTableInfo(jobCtx, job, tblInfo, true)
+	case model.PrimaryKeyChangeStageIndexes:
+		idxInfos := getChangePrimaryKeyReorgIndexes(pkc)
+		if len(idxInfos) > 0 {
+			if idxInfos[0].State == model.StateWriteOnly {
+				for _, idx := range idxInfos {
+					idx.State = model.StateWriteReorganization
+				}
+				return updateVersionAndTableInfo(jobCtx, job, tblInfo, true)
+			}
+			// The indexes are shared with the reorganized table, so their backfill states
+			// are persisted along with tblInfo.
+			reorgTbl, err := getTable(jobCtx.getAutoIDRequirement(), job.SchemaID, pkc.TableInfo)
+			if err != nil {
+				return ver, errors.Trace(err)
+			}
+			done, ver, err = w.doReorgWorkForChangePrimaryKeyIndexes(jobCtx, job, tblInfo, reorgTbl, idxInfos, args)
+			if !done {
+				return ver, err
+			}
+		}
+		return swapTablesForChangePrimaryKey(jobCtx, job, tblInfo)
+	default:
+		return ver, dbterror.ErrInvalidDDLState.GenWithStackByArgs("primary key change stage", pkc.Stage)
+	}
+}
+
+// doReorgWorkForChangePrimaryKeyIndexes backfills the indexes of reorgTbl like doReorgWorkForCreateIndex,
+// tblInfo is the table being changed, which is persisted instead of the table info of reorgTbl.
+func (w *worker) doReorgWorkForChangePrimaryKeyIndexes(
+	jobCtx *jobContext,
+	job *model.Job,
+	tblInfo *model.TableInfo,
+	reorgTbl table.Table,
+	idxInfos []*model.IndexInfo,
+	args *model.ChangePrimaryKeyArgs,
+) (done bool, ver int64, err error) {
+	if !job.ReorgMeta.ReorgTp.NeedMergeProcess() {
+		skipReorg := checkIfTableReorgWorkCanSkip(w.store, w.sess.Session(), reorgTbl, job)
+		if skipReorg {
+			logutil.DDLLogger().Info("table is empty, skipping reorg work",
+				zap.Int64("jobID", job.ID),
+				zap.String("table", reorgTbl.Meta().Name.O))
+			return true, ver, nil
+		}
+		return w.runReorgJobForChangePrimaryKeyIndexes(jobCtx, job, tblInfo, reorgTbl, idxInfos, args, false)
+	}
+	switch idxInfos[0].BackfillState {
+	case model.BackfillStateRunning:
+		skipReorg := checkIfTableReorgWorkCanSkip(w.store, w.sess.Session(), reorgTbl, job)
+		if !skipReorg {
+			done, ver, err = w.runReorgJobForChangePrimaryKeyIndexes(jobCtx, job, tblInfo, reorgTbl, idxInfos, args, false)
+			if err != nil || !done {
+				return false, ver, errors.Trace(err)
+			}
+		}
+		for _, indexInfo := range idxInfos {
+			indexInfo.BackfillState = model.BackfillStateReadyToMerge
+		}
+		ver, err = updateVersionAndTableInfo(jobCtx, job, tblInfo, true)
+		return false, ver, errors.Trace(err)
+	case model.BackfillStateReadyToMerge:
+		for _, indexInfo := range idxInfos {
+			indexInfo.BackfillState = model.BackfillStateMerging
+		}
+		job.SnapshotVer = 0 // Reset the snapshot version for merge index reorg.
+		ver, err = updateVersionAndTableInfo(jobCtx, job, tblInfo, true)
+		return false, ver, errors.Trace(err)
+	case model.BackfillStateMerging:
+		skipReorg := checkIfTempIndexReorgWorkCanSkip(w.store, w.sess.Session(), reorgTbl, idxInfos, job)
+		if !skipReorg {
+			done, ver, err = w.runReorgJobForChangePrimaryKeyIndexes(jobCtx, job, tblInfo, reorgTbl, idxInfos, args, true)
+			if !done {
+				return false, ver, err
+			}
+		}
+		for _, indexInfo := range idxInfos {
+			indexInfo.BackfillState = model.BackfillStateInapplicable // Prevent double-write on this index.
+		}
+		return true, ver, err
+	default:
+		return false, 0, dbterror.ErrInvalidDDLState.GenWithStackByArgs("backfill", idxInfos[0].BackfillState)
+	}
+}
+
+func (w *worker) runReorgJobForChangePrimaryKeyIndexes(
+	jobCtx *jobContext,
+	job *model.Job,
+	tblInfo *model.TableInfo,
+	reorgTbl table.Table,
+	idxInfos []*model.IndexInfo,
+	args *model.ChangePrimaryKeyArgs,
+	mergingTmpIdx bool,
+) (done bool, ver int64, err error) {
+	elements := make([]*meta.Element, 0, len(idxInfos))
+	for _, indexInfo := range idxInfos {
+		elements = append(elements, &meta.Element{ID: indexInfo.ID, TypeKey: meta.IndexElementKey})
+	}
+	sctx, err1 := w.sessPool.Get()
+	if err1 != nil {
+		return false, ver, err1
+	}
+	defer w.sessPool.Put(sctx)
+	rh := newReorgHandler(sess.NewSession(sctx))
+	dbInfo, err := jobCtx.metaMut.GetDatabase(job.SchemaID)
+	if err != nil {
+		return false, ver, errors.Trace(err)
+	}
+	reorgInfo, err := getReorgInfo(jobCtx.oldDDLCtx.jobContext(job.ID, job.ReorgMeta), jobCtx, rh, job, dbInfo, reorgTbl, elements, mergingTmpIdx)
+	if err != nil || reorgInfo == nil || reorgInfo.first {
+		// If we run reorg firstly, we should update the job snapshot version
+		// and then run the reorg next time.
+		return false, ver, errors.Trace(err)
+	}
+	err = overwriteReorgInfoFromGlobalCheckpoint(w, rh.s, job, reorgInfo)
+	if err != nil {
+		return false, ver, errors.Trace(err)
+	}
+	err = w.runReorgJob(jobCtx, reorgInfo, reorgTbl.Meta(), func() (addIndexErr error) {
+		defer util.Recover(metrics.LabelDDL, "onChangePrimaryKey",
+			func() {
+				addIndexErr = dbterror.ErrCancelledDDLJob.GenWithStack("change primary key of table `%v` panic", tblInfo.Name)
+			}, false)
+		return w.addTableIndex(jobCtx, reorgTbl, reorgInfo)
+	})
+	if err != nil {
+		err = ingest.TryConvertToKeyExistsErr(err, idxInfos[0], reorgTbl.Meta())
+		ver, err = handleChangePrimaryKeyReorgErr(jobCtx, job, rh, reorgInfo, tblInfo, args, err)
+		return false, ver, errors.Trace(err)
+	}
+	return true, ver, nil
+}
+
+func (w *worker) doReorgWorkForChangePrimaryKeyRows(jobCtx *jobContext, job *model.Job,
+	tbl table.Table, args *model.ChangePrimaryKeyArgs) (done bool, ver int64, err error) {
+	sctx, err1 := w.sessPool.Get()
+	if err1 != nil {
+		return false, ver, err1
+	}
+	defer w.sessPool.Put(sctx)
+	rh := newReorgHandler(sess.NewSession(sctx))
+	dbInfo, err := jobCtx.metaMut.GetDatabase(job.SchemaID)
+	if err != nil {
+		return false, ver, errors.Trace(err)
+	}
+	elements := BuildElements(tbl.Meta().Columns[0], nil)
+	reorgInfo, err := getReorgInfo(jobCtx.oldDDLCtx.jobContext(job.ID, job.ReorgMeta), jobCtx, rh, job, dbInfo, tbl, elements, false)
+	if err != nil || reorgInfo == nil || reorgInfo.first {
+		return false, ver, errors.Trace(err)
+	}
+	err = w.runReorgJob(jobCtx, reorgInfo, tbl.Meta(), func() (copyErr error) {
+		defer util.Recover(metrics.LabelDDL, "onChangePrimaryKey",
+			func() {
+				copyErr = dbterror.ErrCancelledDDLJob.GenWithStack("change primary key of table `%v` panic", tbl.Meta().Name)
+			}, false)
+		if canCopyRowsByIngest(job, tbl.Meta()) {
+			return w.executeDistTask(jobCtx, tbl, reorgInfo)
+		}
+		return w.writePhysicalTableRecord(jobCtx.stepCtx, w.sessPool, tbl.(table.PhysicalTable), typeChangePrimaryKeyWorker, reorgInfo)
+	})
+	if err != nil {
+		ver, err = handleChangePrimaryKeyReorgErr(jobCtx, job, rh, reorgInfo, tbl.Meta(), args, err)
+		return false, ver, errors.Trace(err)
+	}
+	return true, ver, nil
+}
+
+// copyRowsEngineID is the ID of the ingest engine which the rows are copied to, and the ID of
+// the index built by buildCopyRowsIndexInfo. The IDs of the real indexes are always positive.
+const copyRowsEngineID = 0
+
+// canCopyRowsByIngest checks whether the rows can be copied by the distributed backfill
+// framework with local ingest, instead of the transactions of changePrimaryKeyWorker.
+//
+// The rows are ingested at the TS allocated for each subtask, which is before the rows are
+// read, so the rows double written by DML are always newer than the ingested ones, see
+// tables.EncodeRecordForPrimaryKeyChange. Ingest never checks the existing rows, so it's only
+// used when the table with the new primary key has a clustered index and the uniqueness of the
+// new primary key is guaranteed by the handle index of the original table, i.e. changing a
+// table without clustered index to a clustered primary key.
+func canCopyRowsByIngest(job *model.Job, tblInfo *model.TableInfo) bool {
+	pkc := tblInfo.PrimaryKeyChange
+	return job.ReorgMeta.IsDistReorg && job.ReorgMeta.ReorgTp == model.ReorgTypeLitMerge &&
+		!job.ReorgMeta.UseCloudStorage && pkc.HandleIndexID != 0 &&
+		!tblInfo.HasClusteredIndex() && pkc.TableInfo.HasClusteredIndex()
+}
+
+// buildCopyRowsIndexInfo builds a pseudo index on all the columns of tblInfo, it's used to read
+// the whole rows by the operators of adding index.
+func buildCopyRowsIndexInfo(tblInfo *model.TableInfo) *model.IndexInfo {
+	cols := make([]*model.IndexColumn, 0, len(tblInfo.Columns))
+	for _, col := range tblInfo.Columns {
+		cols = append(cols, &model.IndexColumn{Name: col.Name, Offset: col.Offset, Length: types.UnspecifiedLength})
+	}
+	return &model.IndexInfo{ID: copyRowsEngineID, Columns: cols, Tp: ast.IndexTypeBtree}
+}
+
+func handleChangePrimaryKeyReorgErr(jobCtx *jobContext, job *model.Job, rh *reorgHandler, reorgInfo *reorgInfo,
+	tblInfo *model.TableInfo, args *model.ChangePrimaryKeyArgs, err error) (int64, error) {
+	if dbterror.ErrPausedDDLJob.Equal(err) {
+		return 0, nil
+	}
+	if dbterror.ErrWaitReorgTimeout.Equal(err) {
+		// if timeout, we should return, check for the owner and re-wait job done.
+		return 0, nil
+	}
+	if isRetryableJobError(err, job.ErrorCount) {
+		return 0, errors.Trace(err)
+	}
+	logutil.DDLLogger().Warn("run change primary key job failed, convert job to rollback", zap.Stringer("job", job), zap.Error(err))
+	ver, err := convertChangePrimaryKeyJob2RollbackJob(jobCtx, job, tblInfo, args, err)
+	if err1 := rh.RemoveDDLReorgHandle(job, reorgInfo.elements); err1 != nil {
+		logutil.DDLLogger().Warn("run change primary key job failed, convert job to rollback, RemoveDDLReorgHandle failed", zap.Stringer("job", job), zap.Error(err1))
+	}
+	return ver, err
+}
+
+// swapTablesForChangePrimaryKey replaces the original table by the table with the new primary key,
+// the original table is still double written until the job is done.
+func swapTablesForChangePrimaryKey(jobCtx *jobContext, job *model.Job, tblInfo *model.TableInfo) (ver int64, err error) {
+	pkc := tblInfo.PrimaryKeyChange
+	newInfo := pkc.TableInfo
+	for _, idx := range newInfo.Indices {
+		if idx.ID == pkc.HandleIndexID {
+			continue
+		}
+		if idx.State != model.StatePublic {
+			idx.State = model.StatePublic
+			AddIndexColumnFlag(newInfo, idx)
+		}
+	}
+	tblInfo.PrimaryKeyChange = nil
+	newInfo.PrimaryKeyChange = &model.PrimaryKeyChangeInfo{
+		TableInfo:     tblInfo,
+		DDLState:      model.StateDeleteReorganization,
+		Stage:         pkc.Stage,
+		HandleIndexID: pkc.HandleIndexID,
+	}
+
+	metaMut := jobCtx.metaMut
+	if err = syncAutoIDsForChangePrimaryKey(metaMut, job.SchemaID, tblInfo.ID, newInfo.ID); err != nil {
+		return ver, errors.Trace(err)
+	}
+	if err = metaMut.DropTableOrView(job.SchemaID, tblInfo.ID); err != nil {
+		return ver, errors.Trace(err)
+	}
+	if err = metaMut.CreateTableOrView(job.SchemaID, newInfo); err != nil {
+		return ver, errors.Trace(err)
+	}
+	job.SchemaState = model.StateDeleteReorganization
+	return updateVersionAndTableInfo(jobCtx, job, newInfo, true)
+}
+
+func (w *worker) finishChangePrimaryKey(jobCtx *jobContext, job *model.Job, args *model.ChangePrimaryKeyArgs) (ver int64, err error) {
+	tblInfo, err := getTableInfo(jobCtx.metaMut, args.NewTableID, job.SchemaID)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	pkc := tblInfo.PrimaryKeyChange
+	if pkc == nil {
+		return ver, dbterror.ErrInvalidDDLState.GenWithStackByArgs("primary key change", job.SchemaState)
+	}
+	oldTblInfo := pkc.TableInfo
+	tblInfo.PrimaryKeyChange = nil
+
+	args.DroppedTableID = oldTblInfo.ID
+	args.DroppedIndexes = nil
+	if handleIdx := tblInfo.FindIndexByID(pkc.HandleIndexID); handleIdx != nil {
+		removeIndexInfo(tblInfo, handleIdx)
+		args.DroppedIndexes = append(args.DroppedIndexes, model.TableIDIndexID{TableID: tblInfo.ID, IndexID: handleIdx.ID})
+	}
+	if job.ReorgMeta.ReorgTp.NeedMergeProcess() {
+		for _, idx := range tblInfo.Indices {
+			if idx.Primary && tblInfo.IsCommonHandle {
+				continue
+			}
+			args.DroppedIndexes = append(args.DroppedIndexes,
+				model.TableIDIndexID{TableID: tblInfo.ID, IndexID: tablecodec.TempIndexPrefix | idx.ID})
+		}
+	}
+	if err = syncAutoIDsForChangePrimaryKey(jobCtx.metaMut, job.SchemaID, oldTblInfo.ID, tblInfo.ID); err != nil {
+		return ver, errors.Trace(err)
+	}
+
+	job.SchemaState = model.StatePublic
+	ver, err = updateVersionAndTableInfo(jobCtx, job, tblInfo, true)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	job.FillFinishedArgs(args)
+
+	changePrimaryKeyEvent := notifier.NewChangePrimaryKeyEvent(tblInfo, oldTblInfo)
+	err = asyncNotifyEvent(jobCtx, changePrimaryKeyEvent, job, noSubJob, w.sess)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+
+	// Finish this job.
+	job.FinishTableJob(model.JobStateDone, model.StatePublic, ver, tblInfo)
+	return ver, nil
+}
+
+// convertChangePrimaryKeyJob2RollbackJob stops the double write to the table with the new
+// primary key, the table is dropped when the rollback is done.
+func convertChangePrimaryKeyJob2RollbackJob(jobCtx *jobContext, job *model.Job, tblInfo *model.TableInfo,
+	args *model.ChangePrimaryKeyArgs, occurredErr error) (ver int64, err error) {
+	if pkc := tblInfo.PrimaryKeyChange; pkc != nil {
+		pkc.DDLState = model.StateDeleteOnly
+		if handleIdx := tblInfo.FindIndexByID(pkc.HandleIndexID); handleIdx != nil {
+			handleIdx.State = model.StateDeleteOnly
+		}
+	}
+	for _, col := range getChangePrimaryKeyNullCols(tblInfo, args.PrimaryKey) {
+		// Field PreventNullInsertFlag flag reset.
+		col.DelFlag(mysql.PreventNullInsertFlag)
+	}
+	job.SchemaState = model.StateDeleteOnly
+	ver, err = updateVersionAndTableInfo(jobCtx, job, tblInfo, true)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	job.State = model.JobStateRollingback
+	return ver, errors.Trace(occurredErr)
+}
+
+func rollingbackChangePrimaryKey(jobCtx *jobContext, job *model.Job) (ver int64, err error) {
+	switch job.SchemaState {
+	case model.StateNone:
+		job.State = model.JobStateCancelled
+		return ver, dbterror.ErrCancelledDDLJob
+	case model.StateDeleteReorganization:
+		// The tables have been swapped, the job can't be rolled back.
+		job.State = model.JobStateRunning
+		return ver, nil
+	}
+	args, err := model.GetChangePrimaryKeyArgs(job)
+	if err != nil {
+		job.State = model.JobStateCancelled
+		return ver, errors.Trace(err)
+	}
+	tblInfo, err := GetTableInfoAndCancelFaultJob(jobCtx.metaMut, job, job.SchemaID)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	return convertChangePrimaryKeyJob2RollbackJob(jobCtx, job, tblInfo, args, dbterror.ErrCancelledDDLJob)
+}
+
+func onRollbackChangePrimaryKey(jobCtx *jobContext, job *model.Job, args *model.ChangePrimaryKeyArgs) (ver int64, err error) {
+	tblInfo, err := GetTableInfoAndCancelFaultJob(jobCtx.metaMut, job, job.SchemaID)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	args.DroppedTableID = args.NewTableID
+	args.DroppedIndexes = nil
+	if pkc := tblInfo.PrimaryKeyChange; pkc != nil {
+		if handleIdx := tblInfo.FindIndexByID(pkc.HandleIndexID); handleIdx != nil {
+			removeIndexInfo(tblInfo, handleIdx)
+			args.DroppedIndexes = append(args.DroppedIndexes, model.TableIDIndexID{TableID: tblInfo.ID, IndexID: handleIdx.ID})
+			if job.ReorgMeta.ReorgTp.NeedMergeProcess() {
+				args.DroppedIndexes = append(args.DroppedIndexes,
+					model.TableIDIndexID{TableID: tblInfo.ID, IndexID: tablecodec.TempIndexPrefix | handleIdx.ID})
+			}
+		}
+		tblInfo.PrimaryKeyChange = nil
+	}
+	if err = syncAutoIDsForChangePrimaryKey(jobCtx.metaMut, job.SchemaID, args.NewTableID, tblInfo.ID); err != nil {
+		return ver, errors.Trace(err)
+	}
+	ver, err = updateVersionAndTableInfo(jobCtx, job, tblInfo, true)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	job.FillFinishedArgs(args)
+	job.FinishTableJob(model.JobStateRollbackDone, model.StateNone, ver, tblInfo)
+	return ver, nil
+}
+
+type changePrimaryKeyWorker struct {
+	*backfillCtx
+	// Static allocated to limit memory allocations
+	rowRecords []*rowRecord
+	rowDecoder *decoder.RowDecoder
+	rowMap     map[int64]types.Datum
+}
+
+func newChangePrimaryKeyWorker(i int, t table.PhysicalTable, decodeColMap map[int64]decoder.Column, reorgInfo *reorgInfo, jc *ReorgContext) (*changePrimaryKeyWorker, error) {
+	bCtx, err := newBackfillCtx(i, reorgInfo, reorgInfo.SchemaName, t, jc, metrics.LblChangePKRate, false, false)
+	if err != nil {
+		return nil, err
+	}
+	return &changePrimaryKeyWorker{
+		backfillCtx: bCtx,
+		rowDecoder:  decoder.NewRowDecoder(t, t.WritableCols(), decodeColMap),
+		rowMap:      make(map[int64]types.Datum, len(decodeColMap)),
+	}, nil
+}
+
+func (w *changePrimaryKeyWorker) BackfillData(handleRange reorgBackfillTask) (taskCtx backfillTaskContext, errInTxn error) {
+	oprStartTime := time.Now()
+	ctx := kv.WithInternalSourceAndTaskType(context.Background(), w.jobContext.ddlJobSourceType(), kvutil.ExplicitTypeDDL)
+	errInTxn = kv.RunInNewTxn(ctx, w.ddlCtx.store, true, func(_ context.Context, txn kv.Transaction) error {
+		taskCtx.addedCount = 0
+		taskCtx.scanCount = 0
+		updateTxnEntrySizeLimitIfNeeded(txn)
+		txn.SetOption(kv.Priority, handleRange.priority)
+		if tagger := w.GetCtx().getResourceGroupTaggerForTopSQL(handleRange.getJobID()); tagger != nil {
+			txn.SetOption(kv.ResourceGroupTagger, tagger)
+		}
+		txn.SetOption(kv.ResourceGroupName, w.jobContext.resourceGroupName)
+
+		nextKey, taskDone, err := w.fetchRowColVals(txn, handleRange)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		taskCtx.nextKey = nextKey
+		taskCtx.done = taskDone
+
+		row := make([]types.Datum, len(w.table.WritableCols()))
+		for _, rr := range w.rowRecords {
+			taskCtx.scanCount++
+			// Lock the original row, since there can still be concurrent update happening on
+			// the rows from fetchRowColVals(). If the row is updated, this transaction fails
+			// to commit and retries, the row is then found as double written and skipped.
+			err = txn.LockKeys(context.Background(), new(kv.LockCtx), rr.key)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			h, err := tablecodec.DecodeRowKey(rr.key)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			_, err = w.rowDecoder.DecodeTheExistedColumnMap(w.exprCtx, h, rr.vals, w.loc, w.rowMap)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			for _, col := range w.table.WritableCols() {
+				row[col.Offset] = w.rowMap[col.ID]
+			}
+			w.cleanRowMap()
+			err = tables.CopyRecordForPrimaryKeyChange(w.tblCtx, txn, w.table, row)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			taskCtx.addedCount++
+		}
+		return nil
+	})
+	logSlowOperations(time.Since(oprStartTime), "BackfillData", 3000)
+
+	return
+}
+
+func (w *changePrimaryKeyWorker) fetchRowColVals(txn kv.Transaction, taskRange reorgBackfillTask) (kv.Key, bool, error) {
+	w.rowRecords = w.rowRecords[:0]
+	startTime := time.Now()
+
+	// taskDone means that the added handle is out of taskRange.endHandle.
+	taskDone := false
+	var lastAccessedHandle kv.Key
+	oprStartTime := startTime
+	err := iterateSnapshotKeys(w.jobContext, w.ddlCtx.store, taskRange.priority, w.table.RecordPrefix(), txn.StartTS(), taskRange.startKey, taskRange.endKey,
+		func(_ kv.Handle, recordKey kv.Key, rawRow []byte) (bool, error) {
+			oprEndTime := time.Now()
+			logSlowOperations(oprEndTime.Sub(oprStartTime), "iterateSnapshotKeys in changePrimaryKeyWorker fetchRowColVals", 0)
+			oprStartTime = oprEndTime
+
+			taskDone = recordKey.Cmp(taskRange.endKey) >= 0
+
+			if taskDone || len(w.rowRecords) >= w.batchCnt {
+				return false, nil
+			}
+
+			w.rowRecords = append(w.rowRecords, &rowRecord{key: recordKey, vals: rawRow})
+			lastAccessedHandle = recordKey
+			if recordKey.Cmp(taskRange.endKey) == 0 {
+				taskDone = true
+				return false, nil
+			}
+			return true, nil
+		})
+
+	if len(w.rowRecords) == 0 {
+		taskDone = true
+	}
+
+	logutil.DDLLogger().Debug("txn fetches handle info",
+		zap.Uint64("txnStartTS", txn.StartTS()),
+		zap.Stringer("taskRange", &taskRange),
+		zap.Duration("takeTime", time.Since(startTime)))
+	return getNextHandleKey(taskRange, taskDone, lastAccessedHandle), taskDone, errors.Trace(err)
+}
+
+func (w *changePrimaryKeyWorker) cleanRowMap() {
+	for id := range w.rowMap {
+		delete(w.rowMap, id)
+	}
+}
+
+func (w *changePrimaryKeyWorker) AddMetricInfo(cnt float64) {
+	w.metricCounter.Add(cnt)
+}
+
+func (*changePrimaryKeyWorker) String() string {
+	return typeChangePrimaryKeyWorker.String()
+}
+
+func (w *changePrimaryKeyWorker) GetCtx() *backfillCtx {
+	return w.backfillCtx
+}
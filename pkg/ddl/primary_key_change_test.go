@@ -0,0 +1,129 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pingcap/tidb/pkg/errno"
+	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/testkit"
+	"github.com/pingcap/tidb/pkg/testkit/testfailpoint"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangePrimaryKey(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+
+	checkPKType := func(tp string) {
+		tk.MustQuery("select tidb_pk_type from information_schema.tables where table_schema = 'test' and table_name = 't'").Check(testkit.Rows(tp))
+		tk.MustExec("admin check table t")
+		tk.MustQuery("select a, b, c from t order by a").Check(testkit.Rows("1 a 10", "2 b 20", "3 c 30"))
+	}
+
+	// _tidb_rowid -> clustered int.
+	tk.MustExec("create table t (a int, b varchar(10), c int, index idx_b(b), unique index idx_c(c))")
+	tk.MustExec("insert into t values (1, 'a', 10), (2, 'b', 20), (3, 'c', 30)")
+	tk.MustExec("alter table t add primary key(a) clustered")
+	checkPKType("CLUSTERED")
+	tk.MustQuery("select * from t use index(idx_c) where c = 20").Check(testkit.Rows("2 b 20"))
+
+	// clustered int -> clustered common handle.
+	tk.MustExec("alter table t drop primary key, add primary key(b, a) clustered")
+	checkPKType("CLUSTERED")
+	tk.MustQuery("select * from t where b = 'c' and a = 3").Check(testkit.Rows("3 c 30"))
+
+	// clustered -> nonclustered.
+	tk.MustExec("alter table t drop primary key, add primary key(c) nonclustered")
+	checkPKType("NONCLUSTERED")
+
+	// nonclustered -> _tidb_rowid is not handled by changing primary key.
+	tk.MustExec("alter table t drop primary key")
+	checkPKType("NONCLUSTERED")
+
+	// clustered -> _tidb_rowid, the auto id is continuous.
+	tk.MustExec("drop table t")
+	tk.MustExec("create table t (a int primary key clustered auto_increment, b varchar(10), c int)")
+	tk.MustExec("insert into t(b, c) values ('a', 10), ('b', 20), ('c', 30)")
+	tk.MustExec("alter table t drop primary key")
+	checkPKType("NONCLUSTERED")
+	tk.MustExec("insert into t(b, c) values ('d', 40)")
+	tk.MustQuery("select a from t where b = 'd'").Check(testkit.Rows("4"))
+
+	// The new primary key columns must not have NULL and duplicated values.
+	tk.MustExec("drop table t")
+	tk.MustExec("create table t (a int, b int)")
+	tk.MustExec("insert into t values (1, 1), (2, null)")
+	tk.MustGetErrCode("alter table t add primary key(b) clustered", errno.ErrInvalidUseOfNull)
+	tk.MustExec("update t set b = 1 where a = 2")
+	tk.MustGetErrCode("alter table t add primary key(b) clustered", errno.ErrDupEntry)
+	tk.MustQuery("select tidb_pk_type from information_schema.tables where table_schema = 'test' and table_name = 't'").Check(testkit.Rows("NONCLUSTERED"))
+	tk.MustExec("admin check table t")
+	tk.MustExec("insert into t values (3, null)")
+
+	// Unsupported cases.
+	tk.MustExec("drop table t")
+	tk.MustExec("create table t (a int primary key clustered, b int) partition by hash(a) partitions 2")
+	tk.MustGetErrCode("alter table t drop primary key", errno.ErrUnsupportedDDLOperation)
+	tk.MustExec("drop table t")
+	tk.MustExec("create table t (a int primary key clustered, b int, c int)")
+	tk.MustGetErrCode("alter table t drop primary key, add index idx(b)", errno.ErrUnsupportedDDLOperation)
+}
+
+func TestChangePrimaryKeyWithConcurrentDML(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk1 := testkit.NewTestKit(t, store)
+	tk1.MustExec("use test")
+
+	for _, alterSQL := range []string{
+		"alter table t add primary key(a) clustered",
+		"alter table t drop primary key",
+	} {
+		tk.MustExec("drop table if exists t")
+		if alterSQL == "alter table t drop primary key" {
+			tk.MustExec("create table t (a int primary key clustered, b int, index idx_b(b))")
+		} else {
+			tk.MustExec("create table t (a int, b int, index idx_b(b))")
+		}
+		tk.MustExec("insert into t values (1, 1), (2, 2), (3, 3)")
+
+		var (
+			next   = 100
+			states []model.SchemaState
+		)
+		testfailpoint.EnableCall(t, "github.com/pingcap/tidb/pkg/ddl/afterWaitSchemaSynced", func(job *model.Job) {
+			if job.Type != model.ActionChangePrimaryKey || job.IsDone() || job.IsRollbackDone() {
+				return
+			}
+			states = append(states, job.SchemaState)
+			next++
+			tk1.MustExec(fmt.Sprintf("insert into t values (%d, %d)", next, next))
+			tk1.MustExec(fmt.Sprintf("update t set b = b + 1 where a = %d", next-1))
+			tk1.MustExec("update t set a = a + 1000 where a = 1")
+			tk1.MustExec("delete from t where a = 2")
+			tk1.MustExec("insert into t values (2, 2)")
+		})
+		tk.MustExec(alterSQL)
+		testfailpoint.Disable(t, "github.com/pingcap/tidb/pkg/ddl/afterWaitSchemaSynced")
+		require.Contains(t, states, model.StateWriteReorganization)
+		tk.MustExec("admin check table t")
+		tk.MustQuery("select count(*) from t where a = 2").Check(testkit.Rows("1"))
+	}
+}
@@ -607,6 +607,8 @@ func convertJob2RollbackJob(w *worker, jobCtx *jobContext, job *model.Job) (ver
 	case model.ActionReorganizePartition, model.ActionRemovePartitioning,
 		model.ActionAlterTablePartitioning:
 		ver, err = onRollbackReorganizePartition(jobCtx, job)
+	case model.ActionChangePrimaryKey:
+		ver, err = rollingbackChangePrimaryKey(jobCtx, job)
 	case model.ActionDropColumn:
 		ver, err = rollingbackDropColumn(jobCtx, job)
 	case model.ActionDropIndex, model.ActionDropPrimaryKey:
@@ -0,0 +1,132 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl
+
+import (
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/format"
+	"github.com/pingcap/tidb/pkg/util/dbterror"
+)
+
+// rowPolicyExprChecker checks whether the expression can be used in a row-level security policy.
+type rowPolicyExprChecker struct {
+	unsupported string
+}
+
+// Enter implements ast.Visitor interface.
+func (c *rowPolicyExprChecker) Enter(node ast.Node) (ast.Node, bool) {
+	switch node.(type) {
+	case *ast.SubqueryExpr, *ast.ExistsSubqueryExpr:
+		c.unsupported = "subquery"
+	case *ast.AggregateFuncExpr:
+		c.unsupported = "aggregate function"
+	case *ast.WindowFuncExpr:
+		c.unsupported = "window function"
+	case *ast.VariableExpr:
+		c.unsupported = "variable"
+	case *ast.DefaultExpr:
+		c.unsupported = "DEFAULT"
+	}
+	return node, c.unsupported != ""
+}
+
+// Leave implements ast.Visitor interface.
+func (c *rowPolicyExprChecker) Leave(node ast.Node) (ast.Node, bool) {
+	return node, c.unsupported == ""
+}
+
+// restoreRowPolicyExpr checks the expression of a row-level security policy and
+// restores it to string. It returns an empty string if the expression is nil.
+func restoreRowPolicyExpr(tblInfo *model.TableInfo, expr ast.ExprNode) (string, error) {
+	if expr == nil {
+		return "", nil
+	}
+	checker := &rowPolicyExprChecker{}
+	expr.Accept(checker)
+	if checker.unsupported != "" {
+		return "", dbterror.ErrGeneralUnsupportedDDL.GenWithStackByArgs(checker.unsupported + " in row-level security policy")
+	}
+	for colName := range findDependentColsInExpr(expr) {
+		if model.FindColumnInfo(tblInfo.Columns, colName) == nil {
+			return "", dbterror.ErrBadField.GenWithStackByArgs(colName, "policy expression")
+		}
+	}
+
+	var sb strings.Builder
+	restoreFlags := format.RestoreStringSingleQuotes | format.RestoreKeyWordLowercase | format.RestoreNameBackQuotes |
+		format.RestoreSpacesAroundBinaryOperation | format.RestoreWithoutSchemaName | format.RestoreWithoutTableName
+	if err := expr.Restore(format.NewRestoreCtx(restoreFlags, &sb)); err != nil {
+		return "", errors.Trace(err)
+	}
+	return sb.String(), nil
+}
+
+func onCreateRowPolicy(jobCtx *jobContext, job *model.Job) (ver int64, _ error) {
+	args, err := model.GetRowPolicyArgs(job)
+	if err != nil {
+		job.State = model.JobStateCancelled
+		return ver, errors.Trace(err)
+	}
+	tblInfo, err := GetTableInfoAndCancelFaultJob(jobCtx.metaMut, job, job.SchemaID)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	if tblInfo.FindRowPolicy(args.Policy.Name.L) != nil {
+		job.State = model.JobStateCancelled
+		return ver, dbterror.ErrRowPolicyExists.GenWithStackByArgs(args.Policy.Name, tblInfo.Name)
+	}
+
+	tblInfo.RowPolicies = append(tblInfo.RowPolicies, args.Policy)
+	ver, err = updateVersionAndTableInfo(jobCtx, job, tblInfo, true)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	job.FinishTableJob(model.JobStateDone, model.StatePublic, ver, tblInfo)
+	return ver, nil
+}
+
+func onDropRowPolicy(jobCtx *jobContext, job *model.Job) (ver int64, _ error) {
+	args, err := model.GetRowPolicyArgs(job)
+	if err != nil {
+		job.State = model.JobStateCancelled
+		return ver, errors.Trace(err)
+	}
+	tblInfo, err := GetTableInfoAndCancelFaultJob(jobCtx.metaMut, job, job.SchemaID)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	policies := make([]*model.RowPolicyInfo, 0, len(tblInfo.RowPolicies))
+	for _, p := range tblInfo.RowPolicies {
+		if p.Name.L != args.Policy.Name.L {
+			policies = append(policies, p)
+		}
+	}
+	if len(policies) == len(tblInfo.RowPolicies) {
+		job.State = model.JobStateCancelled
+		return ver, dbterror.ErrRowPolicyNotExists.GenWithStackByArgs(args.Policy.Name, tblInfo.Name)
+	}
+
+	tblInfo.RowPolicies = policies
+	ver, err = updateVersionAndTableInfo(jobCtx, job, tblInfo, true)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	job.FinishTableJob(model.JobStateDone, model.StatePublic, ver, tblInfo)
+	return ver, nil
+}
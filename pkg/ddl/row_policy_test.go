@@ -0,0 +1,136 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl_test
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/pkg/errno"
+	"github.com/pingcap/tidb/pkg/parser/auth"
+	"github.com/pingcap/tidb/pkg/testkit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRowPolicy(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (id int primary key, owner varchar(20), region varchar(20))")
+	tk.MustExec("insert into t values (1, 'u1', 'east'), (2, 'u2', 'east'), (3, 'u1', 'west'), (4, 'u2', 'west')")
+
+	tk.MustExec("create policy p_owner on t using (owner = substring_index(current_user(), '@', 1))")
+	tk.MustExec("create policy p_manager on t for select to r_manager using (true)")
+	tk.MustExec("create policy p_region on t as restrictive using (region = 'east')")
+
+	tk.MustGetErrCode("create policy p_owner on t using (id > 0)", errno.ErrRowPolicyExists)
+	tk.MustExec("create policy if not exists p_owner on t using (id > 0)")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Note 8268 Policy 'p_owner' already exists on table 't'"))
+	tk.MustGetErrCode("create policy p_x on t using (not_exists > 0)", errno.ErrBadField)
+	tk.MustGetErrCode("create policy p_x on t using (id in (select id from t))", errno.ErrUnsupportedDDLOperation)
+	tk.MustGetErrCode("drop policy p_x on t", errno.ErrRowPolicyNotExists)
+	tk.MustExec("drop policy if exists p_x on t")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Note 8269 Unknown policy 'p_x' on table 't'"))
+
+	tk.MustExec("create user u1, u2")
+	tk.MustExec("grant select, insert, update, delete on test.t to u1, u2")
+	tk.MustExec("create role r_manager")
+	tk.MustExec("grant r_manager to u2")
+
+	// The users with SUPER bypass the policies.
+	tk.MustQuery("select id from t order by id").Check(testkit.Rows("1", "2", "3", "4"))
+
+	tk1 := testkit.NewTestKit(t, store)
+	require.NoError(t, tk1.Session().Auth(&auth.UserIdentity{Username: "u1", Hostname: "%"}, nil, nil, nil))
+	tk1.MustExec("use test")
+	tk1.MustQuery("select id from t order by id").Check(testkit.Rows("1"))
+	tk1.MustQuery("select id from t where id = 3").Check(testkit.Rows())
+	tk1.MustQuery("select id from t where id in (1, 2, 3)").Check(testkit.Rows("1"))
+	tk1.MustQuery("select count(*) from t a join t b on a.id = b.id").Check(testkit.Rows("1"))
+	tk1.MustExec("update t set region = 'east' where id = 3")
+	tk1.MustExec("delete from t where id = 2")
+	tk.MustQuery("select id, region from t order by id").Check(testkit.Rows("1 east", "2 east", "3 west", "4 west"))
+	tk1.MustExec("insert into t values (5, 'u1', 'east')")
+	tk1.MustGetErrCode("insert into t values (6, 'u2', 'east')", errno.ErrRowPolicyViolated)
+	tk1.MustGetErrCode("insert into t values (6, 'u1', 'west')", errno.ErrRowPolicyViolated)
+	// Only the users with the ALTER privilege can create or drop policies.
+	tk1.MustGetErrCode("drop policy p_owner on t", errno.ErrTableaccessDenied)
+
+	tk2 := testkit.NewTestKit(t, store)
+	require.NoError(t, tk2.Session().Auth(&auth.UserIdentity{Username: "u2", Hostname: "%"}, nil, nil, nil))
+	tk2.MustExec("use test")
+	tk2.MustQuery("select id from t order by id").Check(testkit.Rows("2"))
+	tk2.MustExec("set role r_manager")
+	tk2.MustQuery("select id from t order by id").Check(testkit.Rows("1", "2", "5"))
+	// The SELECT policies apply to the tables only read by the multi-table UPDATE or DELETE.
+	tk.MustExec("create table s (id int primary key, v int)")
+	tk.MustExec("insert into s values (1, 0), (2, 0), (3, 0), (5, 0)")
+	tk.MustExec("grant select, update, delete on test.s to u2")
+	tk2.MustExec("update s join t on s.id = t.id set s.v = 1")
+	tk.MustQuery("select id from s where v = 1 order by id").Check(testkit.Rows("1", "2", "5"))
+	tk2.MustExec("delete s from s join t on s.id = t.id")
+	tk.MustQuery("select id from s").Check(testkit.Rows("3"))
+	// The SELECT policy doesn't apply to DELETE.
+	tk2.MustExec("delete from t where id = 1")
+	tk.MustQuery("select count(*) from t").Check(testkit.Rows("5"))
+
+	tk.MustExec("grant bypass_rls on *.* to u1")
+	tk1.MustQuery("select id from t order by id").Check(testkit.Rows("1", "2", "3", "4", "5"))
+	// The plan built for the user who bypasses the policies is not cached.
+	tk1.MustExec("prepare stmt from 'select id from t where id > ? order by id'")
+	tk1.MustExec("set @a = 0")
+	tk1.MustQuery("execute stmt using @a").Check(testkit.Rows("1", "2", "3", "4", "5"))
+	tk1.MustQuery("execute stmt using @a").Check(testkit.Rows("1", "2", "3", "4", "5"))
+	tk1.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("0"))
+	tk.MustExec("revoke bypass_rls on *.* from u1")
+	tk1.MustQuery("select id from t order by id").Check(testkit.Rows("1", "5"))
+	tk1.MustQuery("execute stmt using @a").Check(testkit.Rows("1", "5"))
+
+	tk.MustExec("drop policy p_owner on t")
+	tk.MustExec("drop policy p_manager on t")
+	// No row is visible without a permissive policy.
+	tk1.MustQuery("select id from t").Check(testkit.Rows())
+	tk.MustExec("drop policy p_region on t")
+	tk1.MustQuery("select id from t order by id").Check(testkit.Rows("1", "2", "3", "4", "5"))
+}
+
+func TestRowPolicyOnDuplicateAndReplace(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (id int primary key, owner varchar(20), v int)")
+	tk.MustExec("insert into t values (1, 'u1', 0), (2, 'u2', 0)")
+	tk.MustExec("create policy p_owner on t using (owner = substring_index(current_user(), '@', 1))")
+	tk.MustExec("create user u1")
+	tk.MustExec("grant select, insert, update, delete on test.t to u1")
+
+	tk1 := testkit.NewTestKit(t, store)
+	require.NoError(t, tk1.Session().Auth(&auth.UserIdentity{Username: "u1", Hostname: "%"}, nil, nil, nil))
+	tk1.MustExec("use test")
+	// The conflicting row invisible to the UPDATE policies can't be updated.
+	tk1.MustGetErrCode("insert into t values (2, 'u1', 0) on duplicate key update v = 1", errno.ErrRowPolicyViolated)
+	tk1.MustExec("insert into t values (1, 'u1', 0) on duplicate key update v = v + 1")
+	// The updated row is checked as the inserted row.
+	tk1.MustGetErrCode("insert into t values (1, 'u1', 0) on duplicate key update owner = 'u2'", errno.ErrRowPolicyViolated)
+	tk.MustQuery("select id, owner, v from t order by id").Check(testkit.Rows("1 u1 1", "2 u2 0"))
+
+	// The conflicting row invisible to the DELETE policies can't be removed by REPLACE.
+	tk1.MustGetErrCode("replace into t values (2, 'u1', 5)", errno.ErrRowPolicyViolated)
+	tk1.MustExec("replace into t values (1, 'u1', 5)")
+	tk.MustQuery("select id, owner, v from t order by id").Check(testkit.Rows("1 u1 5", "2 u2 0"))
+
+	tk.MustExec("create policy p_all on t for delete using (true)")
+	tk1.MustExec("replace into t values (2, 'u1', 5)")
+	tk.MustQuery("select id, owner, v from t order by id").Check(testkit.Rows("1 u1 5", "2 u1 5"))
+}
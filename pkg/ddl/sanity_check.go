@@ -111,6 +111,12 @@ func expectedDeleteRangeCnt(ctx delRangeCntCtx, job *model.Job) (int, error) {
 			return 0, errors.Trace(err)
 		}
 		return len(args.OldPhysicalTblIDs) + len(args.OldGlobalIndexes), nil
+	case model.ActionChangePrimaryKey:
+		args, err := model.GetFinishedChangePrimaryKeyArgs(job)
+		if err != nil {
+			return 0, errors.Trace(err)
+		}
+		return len(args.DroppedIndexes) + 1, nil
 	case model.ActionAddIndex, model.ActionAddPrimaryKey:
 		args, err := model.GetFinishedModifyIndexArgs(job)
 		if err != nil {
@@ -241,6 +241,24 @@ func SetSchemaDiffForPartitionModify(diff *model.SchemaDiff, job *model.Job, job
 	}
 }
 
+// SetSchemaDiffForChangePrimaryKey set SchemaDiff for ActionChangePrimaryKey.
+func SetSchemaDiffForChangePrimaryKey(diff *model.SchemaDiff, job *model.Job, jobCtx *jobContext) {
+	diff.TableID = job.TableID
+	diff.OldTableID = job.TableID
+	if job.IsRollingback() {
+		return
+	}
+	args := jobCtx.jobArgs.(*model.ChangePrimaryKeyArgs)
+	switch job.SchemaState {
+	case model.StateDeleteReorganization:
+		// The table with the new handle layout replaces the old one.
+		diff.TableID = args.NewTableID
+	case model.StatePublic:
+		diff.TableID = args.NewTableID
+		diff.OldTableID = args.NewTableID
+	}
+}
+
 // SetSchemaDiffForCreateTable set SchemaDiff for ActionCreateTable.
 func SetSchemaDiffForCreateTable(diff *model.SchemaDiff, job *model.Job, jobCtx *jobContext) error {
 	diff.TableID = job.TableID
@@ -345,6 +363,8 @@ func updateSchemaVersion(jobCtx *jobContext, job *model.Job, multiInfos ...schem
 		SetSchemaDiffForReorganizePartition(diff, job, jobCtx)
 	case model.ActionRemovePartitioning, model.ActionAlterTablePartitioning:
 		SetSchemaDiffForPartitionModify(diff, job, jobCtx)
+	case model.ActionChangePrimaryKey:
+		SetSchemaDiffForChangePrimaryKey(diff, job, jobCtx)
 	case model.ActionCreateTable:
 		err = SetSchemaDiffForCreateTable(diff, job, jobCtx)
 	case model.ActionRecoverSchema:
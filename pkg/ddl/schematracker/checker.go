@@ -478,6 +478,50 @@ func (*Checker) AlterResourceGroup(_ sessionctx.Context, _ *ast.AlterResourceGro
 	return nil
 }
 
+// CreateMaskingPolicy implements the DDL interface.
+// Masking policies are not shown in SHOW CREATE TABLE, so there is nothing to check.
+func (d *Checker) CreateMaskingPolicy(ctx sessionctx.Context, stmt *ast.CreateMaskingPolicyStmt) error {
+	return d.realExecutor.CreateMaskingPolicy(ctx, stmt)
+}
+
+// DropMaskingPolicy implements the DDL interface.
+func (d *Checker) DropMaskingPolicy(ctx sessionctx.Context, stmt *ast.DropMaskingPolicyStmt) error {
+	return d.realExecutor.DropMaskingPolicy(ctx, stmt)
+}
+
+// CreateRowPolicy implements the DDL interface.
+// Row policies are not shown in SHOW CREATE TABLE, so there is nothing to check.
+func (d *Checker) CreateRowPolicy(ctx sessionctx.Context, stmt *ast.CreateRowPolicyStmt) error {
+	return d.realExecutor.CreateRowPolicy(ctx, stmt)
+}
+
+// DropRowPolicy implements the DDL interface.
+func (d *Checker) DropRowPolicy(ctx sessionctx.Context, stmt *ast.DropRowPolicyStmt) error {
+	return d.realExecutor.DropRowPolicy(ctx, stmt)
+}
+
+// CreateTrigger implements the DDL interface.
+// Triggers are not shown in SHOW CREATE TABLE, so there is nothing to check.
+func (d *Checker) CreateTrigger(ctx sessionctx.Context, stmt *ast.CreateTriggerStmt) error {
+	return d.realExecutor.CreateTrigger(ctx, stmt)
+}
+
+// DropTrigger implements the DDL interface.
+func (d *Checker) DropTrigger(ctx sessionctx.Context, stmt *ast.DropTriggerStmt) error {
+	return d.realExecutor.DropTrigger(ctx, stmt)
+}
+
+// CreateMaterializedView implements the DDL interface.
+// The columns of a materialized view are decided by the planner, which is not available for the tracker.
+func (d *Checker) CreateMaterializedView(ctx sessionctx.Context, stmt *ast.CreateMaterializedViewStmt) error {
+	return d.realExecutor.CreateMaterializedView(ctx, stmt)
+}
+
+// DropMaterializedView implements the DDL interface.
+func (d *Checker) DropMaterializedView(ctx sessionctx.Context, stmt *ast.DropTableStmt) error {
+	return d.realExecutor.DropMaterializedView(ctx, stmt)
+}
+
 // CreateSchemaWithInfo implements the DDL interface.
 func (d *Checker) CreateSchemaWithInfo(ctx sessionctx.Context, info *model.DBInfo, onExist ddl.OnExist) error {
 	err := d.realExecutor.CreateSchemaWithInfo(ctx, info, onExist)
@@ -1178,6 +1178,46 @@ func (*SchemaTracker) AlterResourceGroup(_ sessionctx.Context, _ *ast.AlterResou
 	return nil
 }
 
+// CreateMaskingPolicy implements the DDL interface, it's no-op in DM's case.
+func (*SchemaTracker) CreateMaskingPolicy(_ sessionctx.Context, _ *ast.CreateMaskingPolicyStmt) error {
+	return nil
+}
+
+// DropMaskingPolicy implements the DDL interface, it's no-op in DM's case.
+func (*SchemaTracker) DropMaskingPolicy(_ sessionctx.Context, _ *ast.DropMaskingPolicyStmt) error {
+	return nil
+}
+
+// CreateRowPolicy implements the DDL interface, it's no-op in DM's case.
+func (*SchemaTracker) CreateRowPolicy(_ sessionctx.Context, _ *ast.CreateRowPolicyStmt) error {
+	return nil
+}
+
+// DropRowPolicy implements the DDL interface, it's no-op in DM's case.
+func (*SchemaTracker) DropRowPolicy(_ sessionctx.Context, _ *ast.DropRowPolicyStmt) error {
+	return nil
+}
+
+// CreateTrigger implements the DDL interface, it's no-op in DM's case.
+func (*SchemaTracker) CreateTrigger(_ sessionctx.Context, _ *ast.CreateTriggerStmt) error {
+	return nil
+}
+
+// DropTrigger implements the DDL interface, it's no-op in DM's case.
+func (*SchemaTracker) DropTrigger(_ sessionctx.Context, _ *ast.DropTriggerStmt) error {
+	return nil
+}
+
+// CreateMaterializedView implements the DDL interface, it's no-op in DM's case.
+func (*SchemaTracker) CreateMaterializedView(_ sessionctx.Context, _ *ast.CreateMaterializedViewStmt) error {
+	return nil
+}
+
+// DropMaterializedView implements the DDL interface, it's no-op in DM's case.
+func (*SchemaTracker) DropMaterializedView(_ sessionctx.Context, _ *ast.DropTableStmt) error {
+	return nil
+}
+
 // BatchCreateTableWithInfo implements the DDL interface, it will call CreateTableWithInfo for each table.
 func (d *SchemaTracker) BatchCreateTableWithInfo(ctx sessionctx.Context, schema ast.CIStr, info []*model.TableInfo, cs ...ddl.CreateTableOption) error {
 	for _, tableInfo := range info {
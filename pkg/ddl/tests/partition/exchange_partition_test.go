@@ -142,3 +142,55 @@ func TestExchangeRangeColumnsPartition(t *testing.T) {
 	// Clean up
 	tk.MustExec("DROP TABLE t1")
 }
+
+func TestExchangePartitionValidation(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+
+	// The first row which doesn't belong to the partition is reported by a note.
+	tk.MustExec(`create table pt (id int, c varchar(10)) partition by range (id) (
+		partition p0 values less than (10),
+		partition p1 values less than (20))`)
+	tk.MustExec("create table nt (id int, c varchar(10))")
+	tk.MustExec("insert into nt values (11, 'a'), (25, 'b'), (5, 'c')")
+	tk.MustGetErrMsg("alter table pt exchange partition p1 with table nt", "[ddl:1737]Found a row that does not match the partition")
+	tk.MustQuery("show warnings").CheckContain("The row of table `nt` with (`id`) = (25) does not match partition `p1`")
+	tk.MustGetErrMsg("alter table pt exchange partition p0 with table nt with validation", "[ddl:1737]Found a row that does not match the partition")
+	tk.MustQuery("show warnings").CheckContain("The row of table `nt` with (`id`) = (11) does not match partition `p0`")
+
+	// WITHOUT VALIDATION skips the check.
+	tk.MustExec("alter table pt exchange partition p1 with table nt without validation")
+	tk.MustQuery("select id from pt partition (p1) order by id").Check(testkit.Rows("5", "11", "25"))
+	tk.MustQuery("select count(*) from nt").Check(testkit.Rows("0"))
+
+	// The rows of the DEFAULT partition must not match the values of the other partitions.
+	tk.MustExec(`create table lpt (a int, b int) partition by list columns (a, b) (
+		partition p0 values in ((1, 1), (2, 2)),
+		partition pDef values in (default))`)
+	tk.MustExec("create table lnt (a int, b int)")
+	tk.MustExec("insert into lnt values (1, 2), (2, null)")
+	tk.MustExec("alter table lpt exchange partition pDef with table lnt")
+	tk.MustExec("insert into lnt values (3, 3), (2, 2)")
+	tk.MustGetErrMsg("alter table lpt exchange partition pDef with table lnt", "[ddl:1737]Found a row that does not match the partition")
+	tk.MustQuery("show warnings").CheckContain("The row of table `lnt` with (`a`, `b`) = (2, 2) does not match partition `pDef`")
+	tk.MustGetErrMsg("alter table lpt exchange partition p0 with table lnt", "[ddl:1737]Found a row that does not match the partition")
+	tk.MustQuery("show warnings").CheckContain("The row of table `lnt` with (`a`, `b`) = (3, 3) does not match partition `p0`")
+
+	// Negative values are placed in the partition of the absolute value of the modulo.
+	tk.MustExec("create table hpt (a int) partition by hash (a) partitions 4")
+	tk.MustExec("create table hnt (a int)")
+	tk.MustExec("insert into hpt values (-5), (-4)")
+	tk.MustQuery("select a from hpt partition (p1)").Check(testkit.Rows("-5"))
+	tk.MustExec("insert into hnt values (-1), (7)")
+	tk.MustGetErrMsg("alter table hpt exchange partition p1 with table hnt", "[ddl:1737]Found a row that does not match the partition")
+	tk.MustQuery("show warnings").CheckContain("The row of table `hnt` with (`a`) = (7) does not match partition `p1`")
+	tk.MustExec("delete from hnt where a = 7")
+	tk.MustExec("alter table hpt exchange partition p1 with table hnt")
+	tk.MustQuery("select a from hpt partition (p1)").Check(testkit.Rows("-1"))
+
+	// Only EXCHANGE PARTITION validates the rows.
+	tk.MustExec("alter table hnt with validation")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Warning 8200 ALTER TABLE WITH VALIDATION is currently unsupported"))
+}
@@ -1195,3 +1195,64 @@ func TestPartitionByFailuresAddPlacementPolicyGlobalIndex(t *testing.T) {
 	afterResult := beforeResult
 	testReorganizePartitionFailures(t, create, alter, beforeDML, beforeResult, nil, afterResult)
 }
+
+func TestRebuildPartition(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	for _, tc := range []struct {
+		create  string
+		rebuild string
+		// rebuilt is whether each partition is rebuilt.
+		rebuilt []bool
+	}{
+		{
+			create:  "create table t (a int primary key, b int, key(b)) partition by range(a) (partition p0 values less than (10), partition p1 values less than (20), partition p2 values less than (30))",
+			rebuild: "alter table t rebuild partition p0, p2",
+			rebuilt: []bool{true, false, true},
+		},
+		{
+			create:  "create table t (a int primary key, b int, key(b)) partition by list(a) (partition p0 values in (1, 2, 11), partition p1 values in (12, 21), partition p2 values in (22))",
+			rebuild: "alter table t rebuild partition p2, p0",
+			rebuilt: []bool{true, false, true},
+		},
+		{
+			create:  "create table t (a int primary key, b int, key(b)) partition by hash(a) partitions 3",
+			rebuild: "alter table t rebuild partition all",
+			rebuilt: []bool{true, true, true},
+		},
+	} {
+		tk.MustExec("drop table if exists t")
+		tk.MustExec(tc.create)
+		tk.MustExec("insert into t values (1, 1), (2, 2), (11, 11), (12, 12), (21, 21), (22, 22)")
+		oldTbl := external.GetTableByName(t, tk, "test", "t").Meta()
+		partRows := make([][]any, 0, len(tc.rebuilt))
+		for _, def := range oldTbl.Partition.Definitions {
+			partRows = append(partRows, tk.MustQuery(fmt.Sprintf("select a, b from t partition (%s) order by a", def.Name.O)).Rows()...)
+		}
+
+		tk.MustExec(tc.rebuild)
+		tk.MustQuery("show warnings").CheckContain("The statistics of related partitions will be outdated after rebuilding partitions")
+		newTbl := external.GetTableByName(t, tk, "test", "t").Meta()
+		require.Equal(t, oldTbl.Partition.Type, newTbl.Partition.Type)
+		require.Len(t, newTbl.Partition.Definitions, len(tc.rebuilt))
+		for i, def := range newTbl.Partition.Definitions {
+			oldDef := oldTbl.Partition.Definitions[i]
+			require.Equal(t, oldDef.Name, def.Name)
+			require.Equal(t, oldDef.LessThan, def.LessThan)
+			require.Equal(t, oldDef.InValues, def.InValues)
+			require.Equal(t, tc.rebuilt[i], oldDef.ID != def.ID, "%s: partition %s", tc.create, def.Name.O)
+		}
+		newPartRows := make([][]any, 0, len(tc.rebuilt))
+		for _, def := range newTbl.Partition.Definitions {
+			newPartRows = append(newPartRows, tk.MustQuery(fmt.Sprintf("select a, b from t partition (%s) order by a", def.Name.O)).Rows()...)
+		}
+		require.Equal(t, partRows, newPartRows)
+		tk.MustExec("admin check table t")
+		tk.MustQuery("select a from t use index(b) where b > 10 order by b").Check(testkit.Rows("11", "12", "21", "22"))
+	}
+
+	// The HASH partitions must be rebuilt all together.
+	tk.MustGetErrMsg("alter table t rebuild partition p0", "[ddl:8200]Unsupported REBUILD PARTITION of HASH/KEY; must rebuild all partitions")
+	tk.MustGetErrCode("alter table t rebuild partition p3", errno.ErrUnknownPartition)
+}
@@ -0,0 +1,76 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl
+
+import (
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/util/dbterror"
+)
+
+func onCreateTrigger(jobCtx *jobContext, job *model.Job) (ver int64, _ error) {
+	args, err := model.GetTriggerArgs(job)
+	if err != nil {
+		job.State = model.JobStateCancelled
+		return ver, errors.Trace(err)
+	}
+	tblInfo, err := GetTableInfoAndCancelFaultJob(jobCtx.metaMut, job, job.SchemaID)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	if tblInfo.FindTrigger(args.Trigger.Name.L) != nil {
+		job.State = model.JobStateCancelled
+		return ver, dbterror.ErrTrgAlreadyExists.GenWithStackByArgs()
+	}
+
+	args.Trigger.CreateTS = job.StartTS
+	tblInfo.Triggers = append(tblInfo.Triggers, args.Trigger)
+	ver, err = updateVersionAndTableInfo(jobCtx, job, tblInfo, true)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	job.FinishTableJob(model.JobStateDone, model.StatePublic, ver, tblInfo)
+	return ver, nil
+}
+
+func onDropTrigger(jobCtx *jobContext, job *model.Job) (ver int64, _ error) {
+	args, err := model.GetTriggerArgs(job)
+	if err != nil {
+		job.State = model.JobStateCancelled
+		return ver, errors.Trace(err)
+	}
+	tblInfo, err := GetTableInfoAndCancelFaultJob(jobCtx.metaMut, job, job.SchemaID)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	triggers := make([]*model.TriggerInfo, 0, len(tblInfo.Triggers))
+	for _, trigger := range tblInfo.Triggers {
+		if trigger.Name.L != args.Trigger.Name.L {
+			triggers = append(triggers, trigger)
+		}
+	}
+	if len(triggers) == len(tblInfo.Triggers) {
+		job.State = model.JobStateCancelled
+		return ver, dbterror.ErrTrgDoesNotExist.GenWithStackByArgs()
+	}
+
+	tblInfo.Triggers = triggers
+	ver, err = updateVersionAndTableInfo(jobCtx, job, tblInfo, true)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	job.FinishTableJob(model.JobStateDone, model.StatePublic, ver, tblInfo)
+	return ver, nil
+}
@@ -54,6 +54,7 @@ import (
 	"github.com/pingcap/tidb/pkg/domain/globalconfigsync"
 	"github.com/pingcap/tidb/pkg/domain/infosync"
 	"github.com/pingcap/tidb/pkg/errno"
+	"github.com/pingcap/tidb/pkg/eventscheduler"
 	"github.com/pingcap/tidb/pkg/infoschema"
 	infoschema_metrics "github.com/pingcap/tidb/pkg/infoschema/metrics"
 	"github.com/pingcap/tidb/pkg/infoschema/perfschema"
@@ -216,6 +217,7 @@ type Domain struct {
 	logBackupAdvancer        *daemon.OwnerDaemon
 	historicalStatsWorker    *HistoricalStatsWorker
 	ttlJobManager            atomic.Pointer[ttlworker.JobManager]
+	eventScheduler           atomic.Pointer[eventscheduler.Scheduler]
 	runawayManager           *runaway.Manager
 	resourceGroupsController *rmclient.ResourceGroupsController
 
@@ -1276,6 +1278,11 @@ func (do *Domain) Close() {
 			logutil.BgLogger().Info("ttlJobManager exited.")
 		}
 	}
+	if eventScheduler := do.eventScheduler.Load(); eventScheduler != nil {
+		logutil.BgLogger().Info("stopping eventScheduler")
+		eventScheduler.Stop()
+		logutil.BgLogger().Info("eventScheduler exited.")
+	}
 	do.releaseServerID(context.Background())
 	close(do.exit)
 	if do.brOwnerMgr != nil {
@@ -3437,6 +3444,18 @@ func (do *Domain) TTLJobManager() *ttlworker.JobManager {
 	return do.ttlJobManager.Load()
 }
 
+// StartEventScheduler creates and starts the event scheduler, the events are only executed on the DDL owner.
+func (do *Domain) StartEventScheduler(newSession eventscheduler.SessionFactory) {
+	eventScheduler := eventscheduler.NewScheduler(do.ddl.GetID(), do.advancedSysSessionPool, do.etcdClient, newSession, do.ddl.OwnerManager().IsOwner)
+	do.eventScheduler.Store(eventScheduler)
+	eventScheduler.Start()
+}
+
+// EventScheduler returns the event scheduler on this domain
+func (do *Domain) EventScheduler() *eventscheduler.Scheduler {
+	return do.eventScheduler.Load()
+}
+
 // StopAutoAnalyze stops (*Domain).autoAnalyzeWorker to launch new auto analyze jobs.
 func (do *Domain) StopAutoAnalyze() {
 	do.stopAutoAnalyze.Store(true)
@@ -0,0 +1,302 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventscheduler
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	timerapi "github.com/pingcap/tidb/pkg/timer/api"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/dbterror/exeerrors"
+	"github.com/pingcap/tidb/pkg/util/dbterror/plannererrors"
+	"github.com/pingcap/tidb/pkg/util/timeutil"
+)
+
+const (
+	timerKeyPrefix = "/tidb/event/"
+	timerHookClass = "tidb.event"
+
+	// oneTimeEventInterval is the interval of the timer of a one-time event. The timer's watermark is set to
+	// `ExecuteAt - oneTimeEventInterval` to make it triggered at `ExecuteAt`.
+	oneTimeEventInterval = time.Minute
+	// maxIntervalValue is the max value of the interval of a recurring event, it's the same as MySQL.
+	maxIntervalValue = 1000000000
+)
+
+// The types of events shown in `information_schema.events`.
+const (
+	EventTypeOneTime   = "ONE TIME"
+	EventTypeRecurring = "RECURRING"
+)
+
+// The statuses of events shown in `information_schema.events`.
+const (
+	EventStatusEnabled           = "ENABLED"
+	EventStatusDisabled          = "DISABLED"
+	EventStatusSlavesideDisabled = "SLAVESIDE_DISABLED"
+)
+
+// Event is the definition of an event. It's stored as the data of the event's timer.
+type Event struct {
+	Schema      string `json:"schema"`
+	Name        string `json:"name"`
+	DefinerUser string `json:"definer_user"`
+	DefinerHost string `json:"definer_host"`
+	// TimeZone is the time zone to evaluate the schedule and to execute the body of the event.
+	TimeZone string        `json:"time_zone"`
+	Body     string        `json:"body"`
+	SQLMode  mysql.SQLMode `json:"sql_mode"`
+	// ExecuteAt is the execution time of a one-time event, it's zero for a recurring event.
+	ExecuteAt time.Time `json:"execute_at"`
+	// IntervalValue and IntervalField are the interval of a recurring event, e.g. `1:30` and `HOUR_MINUTE`.
+	IntervalValue string `json:"interval_value"`
+	IntervalField string `json:"interval_field"`
+	// Starts and Ends are the range of a recurring event, Ends is zero if it's not specified.
+	Starts time.Time `json:"starts"`
+	Ends   time.Time `json:"ends"`
+	// Preserve indicates whether the event is kept after it's completed.
+	Preserve            bool      `json:"preserve"`
+	Status              string    `json:"status"`
+	Comment             string    `json:"comment"`
+	CharacterSetClient  string    `json:"character_set_client"`
+	CollationConnection string    `json:"collation_connection"`
+	DatabaseCollation   string    `json:"database_collation"`
+	Created             time.Time `json:"created"`
+	LastAltered         time.Time `json:"last_altered"`
+}
+
+// eventSummary is the summary data of the event's timer.
+type eventSummary struct {
+	LastExecuted time.Time `json:"last_executed"`
+}
+
+// IsOneTime returns whether the event is executed only once.
+func (e *Event) IsOneTime() bool {
+	return !e.ExecuteAt.IsZero()
+}
+
+// Type returns the type of the event shown in `information_schema.events`.
+func (e *Event) Type() string {
+	if e.IsOneTime() {
+		return EventTypeOneTime
+	}
+	return EventTypeRecurring
+}
+
+// Definer returns the definer of the event in the format of `user@host`.
+func (e *Event) Definer() string {
+	return fmt.Sprintf("%s@%s", e.DefinerUser, e.DefinerHost)
+}
+
+// Enabled returns whether the event is enabled.
+func (e *Event) Enabled() bool {
+	return e.Status == EventStatusEnabled
+}
+
+// Location returns the time zone of the event.
+func (e *Event) Location() (*time.Location, error) {
+	return timeutil.ParseTimeZone(e.TimeZone)
+}
+
+// Clone returns a copy of the event.
+func (e *Event) Clone() *Event {
+	cloned := *e
+	return &cloned
+}
+
+// NextExecuteTime returns the time of the first execution after `now`, the second return value is false
+// if the event will never be executed again.
+func (e *Event) NextExecuteTime(now time.Time) (time.Time, bool, error) {
+	if e.IsOneTime() {
+		return e.ExecuteAt, !e.ExecuteAt.Before(now), nil
+	}
+	sched, err := e.schedule()
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	next := sched.next(sched.watermarkBefore(now.Add(-time.Second)))
+	return next, e.Ends.IsZero() || !next.After(e.Ends), nil
+}
+
+func eventTimerKey(schema, name string) string {
+	return timerKeyPrefix + strings.ToLower(schema) + "/" + strings.ToLower(name)
+}
+
+func eventSchemaTimerKeyPrefix(schema string) string {
+	return timerKeyPrefix + strings.ToLower(schema) + "/"
+}
+
+// timerSpec builds the spec of the event's timer, whose first event is the first execution after `now`.
+func (e *Event) timerSpec(now time.Time) (*timerapi.TimerSpec, error) {
+	sched, err := e.schedule()
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+	return &timerapi.TimerSpec{
+		Key:             eventTimerKey(e.Schema, e.Name),
+		Data:            data,
+		TimeZone:        e.TimeZone,
+		SchedPolicyType: sched.policyType,
+		SchedPolicyExpr: sched.policyExpr,
+		HookClass:       timerHookClass,
+		Watermark:       sched.watermarkBefore(now.Add(-time.Second)),
+		Enable:          e.Enabled(),
+	}, nil
+}
+
+func decodeEvent(timer *timerapi.TimerRecord) (*Event, error) {
+	var e Event
+	if err := json.Unmarshal(timer.Data, &e); err != nil {
+		return nil, errors.Annotatef(err, "invalid event timer data of '%s'", timer.Key)
+	}
+	return &e, nil
+}
+
+// ParseInterval parses the interval of a recurring event. It returns the month-based part and the fixed part
+// of the interval, only one of them is not zero.
+func ParseInterval(value, field string) (months int64, d time.Duration, err error) {
+	if strings.Contains(strings.ToUpper(field), "MICROSECOND") {
+		return 0, 0, plannererrors.ErrNotSupportedYet.GenWithStackByArgs(field)
+	}
+	y, m, days, nanos, _, err := types.ParseDurationValue(field, value)
+	if err != nil {
+		return 0, 0, err
+	}
+	months = y*12 + m
+	if days < 0 || nanos < 0 || months < 0 || months > maxIntervalValue ||
+		days >= int64(math.MaxInt64/int64(24*time.Hour)) {
+		return 0, 0, exeerrors.ErrEventIntervalNotPositiveOrTooBig
+	}
+	d = (time.Duration(days)*24*time.Hour + time.Duration(nanos)).Truncate(time.Second)
+	if months == 0 && d <= 0 {
+		return 0, 0, exeerrors.ErrEventIntervalNotPositiveOrTooBig
+	}
+	return months, d, nil
+}
+
+// eventSchedule is the schedule of an event, it converts the event's schedule to the schedule policy of the timer.
+//
+// The recurring events with a fixed interval are scheduled by the `INTERVAL` policy, and the ones with
+// a month-based interval are scheduled by the `CRON` policy in the event's time zone.
+// A one-time event is scheduled by an `INTERVAL` policy whose watermark is before the execution time.
+type eventSchedule struct {
+	policyType timerapi.SchedPolicyType
+	policyExpr string
+	policy     timerapi.SchedEventPolicy
+	loc        *time.Location
+	// first is the time of the first execution.
+	first time.Time
+	// interval is the fixed interval of a recurring event, it's zero for the month-based interval.
+	interval time.Duration
+	oneTime  bool
+}
+
+func (e *Event) schedule() (*eventSchedule, error) {
+	loc, err := e.Location()
+	if err != nil {
+		return nil, err
+	}
+
+	s := &eventSchedule{loc: loc}
+	switch {
+	case e.IsOneTime():
+		s.oneTime = true
+		s.first = e.ExecuteAt
+		s.interval = oneTimeEventInterval
+		s.policyType = timerapi.SchedEventInterval
+		s.policyExpr = formatIntervalExpr(oneTimeEventInterval)
+	default:
+		months, d, err := ParseInterval(e.IntervalValue, e.IntervalField)
+		if err != nil {
+			return nil, err
+		}
+		s.first = e.Starts
+		if months == 0 {
+			s.interval = d
+			s.policyType = timerapi.SchedEventInterval
+			s.policyExpr = formatIntervalExpr(d)
+			break
+		}
+
+		// The 5-field cron expression can only describe the month-based intervals which divide a year, and it
+		// skips the months without the day, so the other intervals and the days after 28th are not supported.
+		starts := e.Starts.In(loc)
+		if 12%months != 0 || starts.Day() > 28 {
+			return nil, plannererrors.ErrNotSupportedYet.GenWithStackByArgs(
+				fmt.Sprintf("recurring event every %s %s starts on day %d", e.IntervalValue, e.IntervalField, starts.Day()))
+		}
+		s.first = time.Date(starts.Year(), starts.Month(), starts.Day(), starts.Hour(), starts.Minute(), 0, 0, loc)
+		s.policyType = timerapi.SchedEventCron
+		s.policyExpr = fmt.Sprintf("%d %d %d %d/%d *", starts.Minute(), starts.Hour(), starts.Day(), (int64(starts.Month())-1)%months+1, months)
+	}
+
+	if s.policy, err = timerapi.CreateSchedEventPolicy(s.policyType, s.policyExpr); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// formatIntervalExpr formats the interval to the expression of the `INTERVAL` policy, which only supports the
+// units of day, hour and minute.
+func formatIntervalExpr(d time.Duration) string {
+	if d%time.Minute == 0 {
+		return fmt.Sprintf("%dm", d/time.Minute)
+	}
+	return strconv.FormatFloat(d.Minutes(), 'f', -1, 64) + "m"
+}
+
+// next returns the execution time after the watermark.
+func (s *eventSchedule) next(watermark time.Time) time.Time {
+	if s.interval > 0 {
+		// Round it to avoid the precision lost when converting the interval to the policy expression.
+		return watermark.Add(s.interval).Round(time.Second)
+	}
+	next, _ := s.policy.NextEventTime(watermark.In(s.loc))
+	return next
+}
+
+// watermarkBefore returns the watermark of the timer whose next event is the first execution after `t`.
+// The executions before `t` are skipped, they are missed or have been executed.
+func (s *eventSchedule) watermarkBefore(t time.Time) time.Time {
+	switch {
+	case s.oneTime || t.Before(s.first):
+		if s.interval > 0 {
+			return s.first.Add(-s.interval)
+		}
+		return s.first.Add(-time.Second)
+	case s.interval > 0:
+		return s.first.Add(t.Sub(s.first) / s.interval * s.interval)
+	}
+
+	watermark := s.first
+	for {
+		next := s.next(watermark)
+		if next.IsZero() || next.After(t) {
+			return watermark
+		}
+		watermark = next
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventscheduler
+
+import (
+	"testing"
+	"time"
+
+	timerapi "github.com/pingcap/tidb/pkg/timer/api"
+	"github.com/pingcap/tidb/pkg/util/dbterror/exeerrors"
+	"github.com/pingcap/tidb/pkg/util/dbterror/plannererrors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseInterval(t *testing.T) {
+	for _, c := range []struct {
+		value  string
+		field  string
+		months int64
+		d      time.Duration
+	}{
+		{"1", "SECOND", 0, time.Second},
+		{"90", "MINUTE", 0, 90 * time.Minute},
+		{"1:30", "HOUR_MINUTE", 0, 90 * time.Minute},
+		{"2", "WEEK", 0, 14 * 24 * time.Hour},
+		{"1 12", "DAY_HOUR", 0, 36 * time.Hour},
+		{"3", "MONTH", 3, 0},
+		{"1", "QUARTER", 3, 0},
+		{"1-6", "YEAR_MONTH", 18, 0},
+	} {
+		months, d, err := ParseInterval(c.value, c.field)
+		require.NoError(t, err, c.value+" "+c.field)
+		require.Equal(t, c.months, months, c.value+" "+c.field)
+		require.Equal(t, c.d, d, c.value+" "+c.field)
+	}
+
+	for _, c := range []struct {
+		value string
+		field string
+	}{
+		{"0", "SECOND"},
+		{"-1", "DAY"},
+		{"0", "MONTH"},
+		{"2000000000", "MONTH"},
+	} {
+		_, _, err := ParseInterval(c.value, c.field)
+		require.True(t, exeerrors.ErrEventIntervalNotPositiveOrTooBig.Equal(err), c.value+" "+c.field)
+	}
+
+	_, _, err := ParseInterval("1", "MICROSECOND")
+	require.True(t, plannererrors.ErrNotSupportedYet.Equal(err))
+}
+
+func TestEventSchedule(t *testing.T) {
+	tm := func(s string) time.Time {
+		v, err := time.ParseInLocation(time.DateTime, s, time.UTC)
+		require.NoError(t, err)
+		return v
+	}
+
+	// fixed interval
+	e := &Event{TimeZone: "UTC", IntervalValue: "90", IntervalField: "MINUTE", Starts: tm("2026-01-01 10:00:00")}
+	sched, err := e.schedule()
+	require.NoError(t, err)
+	require.Equal(t, timerapi.SchedEventInterval, sched.policyType)
+	require.Equal(t, "90m", sched.policyExpr)
+	require.Equal(t, tm("2026-01-01 08:30:00"), sched.watermarkBefore(tm("2025-12-31 00:00:00")))
+	require.Equal(t, tm("2026-01-01 10:00:00"), sched.watermarkBefore(tm("2026-01-01 10:00:00")))
+	require.Equal(t, tm("2026-01-01 11:30:00"), sched.watermarkBefore(tm("2026-01-01 12:59:59")))
+	require.Equal(t, tm("2026-01-01 13:00:00"), sched.next(tm("2026-01-01 11:30:00")))
+	spec, err := e.timerSpec(tm("2026-01-01 13:00:00"))
+	require.NoError(t, err)
+	require.Equal(t, tm("2026-01-01 11:30:00"), spec.Watermark)
+	require.False(t, spec.Enable)
+
+	// the interval which is not a multiple of minutes
+	e = &Event{TimeZone: "UTC", IntervalValue: "10", IntervalField: "SECOND", Starts: tm("2026-01-01 10:00:00"), Status: EventStatusEnabled}
+	sched, err = e.schedule()
+	require.NoError(t, err)
+	policy, err := timerapi.CreateSchedEventPolicy(sched.policyType, sched.policyExpr)
+	require.NoError(t, err)
+	next, ok := policy.NextEventTime(tm("2026-01-01 10:00:00"))
+	require.True(t, ok)
+	require.Equal(t, tm("2026-01-01 10:00:10"), next.Round(time.Second))
+	spec, err = e.timerSpec(tm("2026-01-01 10:00:00"))
+	require.NoError(t, err)
+	require.Equal(t, "/tidb/event//", spec.Key)
+	require.Equal(t, timerHookClass, spec.HookClass)
+	require.True(t, spec.Enable)
+	// the first execution is at STARTS if it's created at STARTS
+	require.Equal(t, tm("2026-01-01 10:00:00"), sched.next(spec.Watermark))
+
+	// month-based interval
+	e = &Event{TimeZone: "+08:00", IntervalValue: "1", IntervalField: "QUARTER", Starts: tm("2026-02-14 16:30:45")}
+	sched, err = e.schedule()
+	require.NoError(t, err)
+	require.Equal(t, timerapi.SchedEventCron, sched.policyType)
+	require.Equal(t, "30 0 15 2/3 *", sched.policyExpr)
+	wm := sched.watermarkBefore(tm("2026-01-01 00:00:00"))
+	require.Equal(t, tm("2026-02-14 16:30:00"), sched.next(wm).UTC())
+	wm = sched.watermarkBefore(tm("2026-06-01 00:00:00"))
+	require.Equal(t, tm("2026-05-14 16:30:00"), wm.UTC())
+	require.Equal(t, tm("2026-08-14 16:30:00"), sched.next(wm).UTC())
+	e.IntervalValue, e.IntervalField = "1", "YEAR"
+	sched, err = e.schedule()
+	require.NoError(t, err)
+	require.Equal(t, "30 0 15 2/12 *", sched.policyExpr)
+
+	// the month-based intervals which can't be converted to cron
+	e.IntervalValue, e.IntervalField = "5", "MONTH"
+	_, err = e.schedule()
+	require.True(t, plannererrors.ErrNotSupportedYet.Equal(err))
+	e.IntervalValue, e.IntervalField, e.Starts = "1", "MONTH", tm("2026-01-30 00:00:00")
+	_, err = e.schedule()
+	require.True(t, plannererrors.ErrNotSupportedYet.Equal(err))
+
+	// one-time event
+	e = &Event{Schema: "Test", Name: "E1", TimeZone: "SYSTEM", ExecuteAt: tm("2026-03-01 00:00:00")}
+	require.True(t, e.IsOneTime())
+	require.Equal(t, EventTypeOneTime, e.Type())
+	spec, err = e.timerSpec(tm("2026-01-01 00:00:00"))
+	require.NoError(t, err)
+	require.Equal(t, "/tidb/event/test/e1", spec.Key)
+	require.Equal(t, timerapi.SchedEventInterval, spec.SchedPolicyType)
+	require.Equal(t, tm("2026-02-28 23:59:00"), spec.Watermark)
+	next, ok, err = e.NextExecuteTime(tm("2026-01-01 00:00:00"))
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, tm("2026-03-01 00:00:00"), next)
+	_, ok, err = e.NextExecuteTime(tm("2026-03-01 00:00:01"))
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// recurring event with ENDS
+	e = &Event{TimeZone: "UTC", IntervalValue: "1", IntervalField: "DAY", Starts: tm("2026-01-01 00:00:00"), Ends: tm("2026-01-03 00:00:00")}
+	next, ok, err = e.NextExecuteTime(tm("2026-01-02 12:00:00"))
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, tm("2026-01-03 00:00:00"), next)
+	_, ok, err = e.NextExecuteTime(tm("2026-01-03 12:00:00"))
+	require.NoError(t, err)
+	require.False(t, ok)
+}
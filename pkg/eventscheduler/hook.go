@@ -0,0 +1,286 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventscheduler
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/parser/auth"
+	"github.com/pingcap/tidb/pkg/parser/terror"
+	"github.com/pingcap/tidb/pkg/privilege"
+	sessiontypes "github.com/pingcap/tidb/pkg/session/types"
+	"github.com/pingcap/tidb/pkg/sessionctx/vardef"
+	timerapi "github.com/pingcap/tidb/pkg/timer/api"
+	"github.com/pingcap/tidb/pkg/util/logutil"
+	"github.com/pingcap/tidb/pkg/util/sqlexec"
+	"github.com/pingcap/tidb/pkg/util/sqlkiller"
+	"go.uber.org/zap"
+)
+
+// The states of an execution in `mysql.tidb_event_history`.
+const (
+	executionRunning   = "running"
+	executionSucceeded = "succeeded"
+	executionFailed    = "failed"
+)
+
+// eventHook executes the events when their timers are triggered.
+//
+// Every timer event is recorded in `mysql.tidb_event_history` with the timer event id as the primary key before the
+// body is executed, so an event is executed at most once even if the timer event is triggered again after a failover.
+type eventHook struct {
+	scheduler *Scheduler
+	cli       timerapi.TimerClient
+	ctx       context.Context
+	cancel    func()
+	wg        sync.WaitGroup
+
+	mu      sync.Mutex
+	running map[string]sessiontypes.Session
+}
+
+func newEventHook(scheduler *Scheduler, cli timerapi.TimerClient) *eventHook {
+	ctx, cancel := context.WithCancel(scheduler.ctx)
+	return &eventHook{
+		scheduler: scheduler,
+		cli:       cli,
+		ctx:       ctx,
+		cancel:    cancel,
+		running:   make(map[string]sessiontypes.Session),
+	}
+}
+
+func (*eventHook) Start() {}
+
+func (h *eventHook) Stop() {
+	h.cancel()
+	h.mu.Lock()
+	for _, se := range h.running {
+		if se != nil {
+			se.GetSessionVars().SQLKiller.SendKillSignal(sqlkiller.QueryInterrupted)
+		}
+	}
+	h.mu.Unlock()
+	h.wg.Wait()
+}
+
+func (*eventHook) OnPreSchedEvent(_ context.Context, _ timerapi.TimerShedEvent) (r timerapi.PreSchedEventResult, err error) {
+	if !vardef.EnableEventScheduler.Load() {
+		r.Delay = time.Minute
+	}
+	return
+}
+
+func (h *eventHook) OnSchedEvent(ctx context.Context, event timerapi.TimerShedEvent) error {
+	timer := event.Timer()
+	eventID := event.EventID()
+	logger := logutil.Logger(h.ctx).With(
+		zap.String("key", timer.Key),
+		zap.String("eventID", eventID),
+		zap.Time("eventStart", timer.EventStart),
+	)
+
+	h.mu.Lock()
+	_, isRunning := h.running[eventID]
+	h.mu.Unlock()
+	if isRunning {
+		return nil
+	}
+
+	e, err := decodeEvent(timer)
+	if err != nil {
+		logger.Error("invalid event timer data", zap.ByteString("data", timer.Data))
+		return err
+	}
+	sched, err := e.schedule()
+	if err != nil {
+		return err
+	}
+	scheduledTime := sched.next(timer.EventWatermark)
+
+	rows, err := h.scheduler.executeSQL(ctx, "SELECT state FROM mysql.tidb_event_history WHERE event_id = %?", eventID)
+	if err != nil {
+		return err
+	}
+	if len(rows) > 0 {
+		// The timer event has been handled before, maybe by another node which is not the owner anymore.
+		if rows[0].GetEnum(0).String() == executionRunning {
+			logger.Warn("the execution of the event is interrupted")
+			if err = h.finishExecution(ctx, eventID, executionFailed, 0, errors.New("execution is interrupted")); err != nil {
+				return err
+			}
+		}
+		return h.closeTimerEvent(ctx, timer, e, sched, eventID, time.Time{})
+	}
+
+	if !timer.Enable || (!e.Ends.IsZero() && scheduledTime.After(e.Ends)) {
+		logger.Info("skip the execution of the disabled or ended event")
+		return h.closeTimerEvent(ctx, timer, e, sched, eventID, time.Time{})
+	}
+
+	startTime := time.Now()
+	if _, err = h.scheduler.executeSQL(ctx, `INSERT INTO mysql.tidb_event_history
+		(event_id, event_schema, event_name, definer, node_id, scheduled_time, start_time, state)
+		VALUES (%?, %?, %?, %?, %?, FROM_UNIXTIME(%?), FROM_UNIXTIME(%?), %?)`,
+		eventID, e.Schema, e.Name, e.Definer(), h.scheduler.id, scheduledTime.Unix(), startTime.Unix(), executionRunning); err != nil {
+		return err
+	}
+
+	h.mu.Lock()
+	h.running[eventID] = nil
+	h.mu.Unlock()
+	h.wg.Add(1)
+	go func() {
+		defer func() {
+			h.mu.Lock()
+			delete(h.running, eventID)
+			h.mu.Unlock()
+			h.wg.Done()
+		}()
+
+		logger.Info("start to execute the event")
+		affectedRows, execErr := h.execute(eventID, e)
+		state := executionSucceeded
+		if execErr != nil {
+			state = executionFailed
+			logger.Warn("fail to execute the event", zap.Error(execErr))
+		}
+		// Use a new context to record the result even if the hook is stopped.
+		ctx := context.Background()
+		if err := h.finishExecution(ctx, eventID, state, affectedRows, execErr); err != nil {
+			logger.Error("fail to record the execution of the event", zap.Error(err))
+		}
+		if err := h.closeTimerEvent(ctx, timer, e, sched, eventID, startTime); err != nil {
+			logger.Error("fail to close the timer event of the event", zap.Error(err))
+		}
+	}()
+	return nil
+}
+
+// execute executes the body of the event as its definer.
+func (h *eventHook) execute(eventID string, e *Event) (affectedRows uint64, err error) {
+	se, err := h.scheduler.newSession()
+	if err != nil {
+		return 0, err
+	}
+	defer se.Close()
+
+	h.mu.Lock()
+	h.running[eventID] = se
+	h.mu.Unlock()
+	if err = h.ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	vars := se.GetSessionVars()
+	pm := privilege.GetPrivilegeManager(se)
+	if pm == nil || !pm.GetAuthWithoutVerification(e.DefinerUser, e.DefinerHost) {
+		return 0, errors.Errorf("the definer '%s'@'%s' of the event does not exist", e.DefinerUser, e.DefinerHost)
+	}
+	vars.User = &auth.UserIdentity{
+		Username:     e.DefinerUser,
+		Hostname:     e.DefinerHost,
+		AuthUsername: e.DefinerUser,
+		AuthHostname: e.DefinerHost,
+	}
+	vars.ActiveRoles = pm.GetDefaultRoles(h.ctx, e.DefinerUser, e.DefinerHost)
+	vars.CurrentDB = e.Schema
+	for name, val := range map[string]string{
+		vardef.SQLModeVar:          e.SQLMode.String(),
+		vardef.TimeZone:            e.TimeZone,
+		vardef.CharacterSetClient:  e.CharacterSetClient,
+		vardef.CollationConnection: e.CollationConnection,
+	} {
+		if val == "" && name != vardef.SQLModeVar {
+			continue
+		}
+		if err = vars.SetSystemVar(name, val); err != nil {
+			return 0, err
+		}
+	}
+
+	stmts, err := se.Parse(h.ctx, e.Body)
+	if err != nil {
+		return 0, err
+	}
+	for _, stmt := range stmts {
+		rs, err := se.ExecuteStmt(h.ctx, stmt)
+		if err != nil {
+			return affectedRows, err
+		}
+		if rs != nil {
+			_, err = sqlexec.DrainRecordSet(h.ctx, rs, 1024)
+			terror.Call(rs.Close)
+			if err != nil {
+				return affectedRows, err
+			}
+		}
+		affectedRows += se.AffectedRows()
+	}
+	return affectedRows, nil
+}
+
+func (h *eventHook) finishExecution(ctx context.Context, eventID, state string, affectedRows uint64, execErr error) error {
+	var errMsg any
+	if execErr != nil {
+		errMsg = execErr.Error()
+	}
+	_, err := h.scheduler.executeSQL(ctx, `UPDATE mysql.tidb_event_history
+		SET state = %?, end_time = FROM_UNIXTIME(%?), affected_rows = %?, error_message = %? WHERE event_id = %?`,
+		state, time.Now().Unix(), affectedRows, errMsg, eventID)
+	return err
+}
+
+// closeTimerEvent closes the timer event and moves the watermark to skip the executions missed during the execution.
+// If the event is completed, it's dropped or disabled according to its `ON COMPLETION` option.
+func (h *eventHook) closeTimerEvent(ctx context.Context, timer *timerapi.TimerRecord, e *Event, sched *eventSchedule,
+	eventID string, startTime time.Time) error {
+	opts := []timerapi.UpdateTimerOption{timerapi.WithSetWatermark(sched.watermarkBefore(time.Now()))}
+	if !startTime.IsZero() {
+		summary, err := json.Marshal(&eventSummary{LastExecuted: startTime})
+		if err != nil {
+			return err
+		}
+		opts = append(opts, timerapi.WithSetSummaryData(summary))
+	}
+	if err := h.cli.CloseTimerEvent(ctx, timer.ID, eventID, opts...); err != nil {
+		return err
+	}
+
+	completed := e.IsOneTime()
+	if !completed && !e.Ends.IsZero() {
+		next := sched.next(sched.watermarkBefore(time.Now()))
+		completed = next.IsZero() || next.After(e.Ends)
+	}
+	if !completed {
+		return nil
+	}
+
+	if !e.Preserve {
+		_, err := h.cli.DeleteTimer(ctx, timer.ID)
+		return err
+	}
+	e = e.Clone()
+	e.Status = EventStatusDisabled
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	return h.cli.UpdateTimer(ctx, timer.ID, timerapi.WithSetEnable(false), timerapi.WithSetData(data))
+}
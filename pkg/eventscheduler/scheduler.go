@@ -0,0 +1,302 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventscheduler
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/kv"
+	"github.com/pingcap/tidb/pkg/parser/terror"
+	"github.com/pingcap/tidb/pkg/session/syssession"
+	sessiontypes "github.com/pingcap/tidb/pkg/session/types"
+	"github.com/pingcap/tidb/pkg/sessionctx/vardef"
+	timerapi "github.com/pingcap/tidb/pkg/timer/api"
+	timerrt "github.com/pingcap/tidb/pkg/timer/runtime"
+	"github.com/pingcap/tidb/pkg/timer/tablestore"
+	"github.com/pingcap/tidb/pkg/util/chunk"
+	"github.com/pingcap/tidb/pkg/util/logutil"
+	"github.com/pingcap/tidb/pkg/util/sqlexec"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+)
+
+const (
+	// loopInterval is the interval to check whether the events should be executed on this node.
+	loopInterval = time.Second
+	// historyRetention is how long the execution history of events is kept.
+	historyRetention  = 90 * 24 * time.Hour
+	gcHistoryInterval = time.Hour
+)
+
+// SessionFactory creates a new session to execute the body of events.
+type SessionFactory func() (sessiontypes.Session, error)
+
+// Scheduler manages the events and executes them.
+//
+// The events are stored as timers in `mysql.tidb_timers` and can be managed on every TiDB node,
+// but they're only executed on the DDL owner when `event_scheduler` is enabled.
+type Scheduler struct {
+	id         string
+	store      *timerapi.TimerStore
+	cli        timerapi.TimerClient
+	pool       syssession.Pool
+	newSession SessionFactory
+	leaderFunc func() bool
+
+	ctx    context.Context
+	cancel func()
+	wg     sync.WaitGroup
+}
+
+// NewScheduler creates a new event scheduler.
+func NewScheduler(id string, pool syssession.Pool, etcd *clientv3.Client, newSession SessionFactory, leaderFunc func() bool) *Scheduler {
+	store := tablestore.NewTableTimerStore(1, pool, "mysql", "tidb_timers", etcd)
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Scheduler{
+		id:         id,
+		store:      store,
+		cli:        timerapi.NewDefaultTimerClient(store),
+		pool:       pool,
+		newSession: newSession,
+		leaderFunc: leaderFunc,
+		ctx:        logutil.WithKeyValue(ctx, "event-scheduler", id),
+		cancel:     cancel,
+	}
+}
+
+// Start starts the scheduler.
+func (s *Scheduler) Start() {
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		s.loop()
+	}()
+}
+
+// Stop stops the scheduler and waits for the running events.
+func (s *Scheduler) Stop() {
+	s.cancel()
+	s.wg.Wait()
+	s.store.Close()
+}
+
+func (s *Scheduler) isLeader() bool {
+	return s.leaderFunc != nil && s.leaderFunc()
+}
+
+func (s *Scheduler) loop() {
+	logger := logutil.Logger(s.ctx)
+	var rt *timerrt.TimerGroupRuntime
+	pause := func() {
+		if rt != nil {
+			logger.Info("pause the event scheduler runtime")
+			rt.Stop()
+			rt = nil
+		}
+	}
+	defer pause()
+
+	ticker := time.NewTicker(loopInterval)
+	defer ticker.Stop()
+	gcTicker := time.NewTicker(gcHistoryInterval)
+	defer gcTicker.Stop()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			if !s.isLeader() || !vardef.EnableEventScheduler.Load() {
+				pause()
+				continue
+			}
+			if rt == nil {
+				logger.Info("resume the event scheduler runtime")
+				rt = timerrt.NewTimerRuntimeBuilder("event", s.store).
+					SetCond(&timerapi.TimerCond{Key: timerapi.NewOptionalVal(timerKeyPrefix), KeyPrefix: true}).
+					RegisterHookFactory(timerHookClass, func(_ string, cli timerapi.TimerClient) timerapi.Hook {
+						return newEventHook(s, cli)
+					}).
+					Build()
+				rt.Start()
+			}
+		case <-gcTicker.C:
+			if s.isLeader() {
+				if err := s.gcHistory(s.ctx, time.Now().Add(-historyRetention)); err != nil {
+					logger.Warn("fail to gc the event history", zap.Error(err))
+				}
+			}
+		}
+	}
+}
+
+// CreateEvent creates a new event, it returns `timerapi.ErrTimerExists` if the event already exists.
+func (s *Scheduler) CreateEvent(ctx context.Context, e *Event) error {
+	if _, err := s.GetEvent(ctx, e.Schema, e.Name); err == nil {
+		return errors.Trace(timerapi.ErrTimerExists)
+	} else if !errors.ErrorEqual(err, timerapi.ErrTimerNotExist) {
+		return err
+	}
+
+	spec, err := e.timerSpec(time.Now())
+	if err != nil {
+		return err
+	}
+	_, err = s.cli.CreateTimer(ctx, *spec)
+	if kv.ErrKeyExists.Equal(err) {
+		return errors.Trace(timerapi.ErrTimerExists)
+	}
+	return err
+}
+
+// GetEvent returns the event, it returns `timerapi.ErrTimerNotExist` if the event doesn't exist.
+func (s *Scheduler) GetEvent(ctx context.Context, schema, name string) (*Event, error) {
+	timer, err := s.cli.GetTimerByKey(ctx, eventTimerKey(schema, name))
+	if err != nil {
+		return nil, err
+	}
+	return decodeEvent(timer)
+}
+
+// EventInfo is the event with its execution information.
+type EventInfo struct {
+	*Event
+	// LastExecuted is the start time of the last execution, it's zero if the event has never been executed.
+	LastExecuted time.Time
+}
+
+// GetEvents returns the events in the schema, or all the events if the schema is empty.
+func (s *Scheduler) GetEvents(ctx context.Context, schema string) ([]*EventInfo, error) {
+	prefix := timerKeyPrefix
+	if schema != "" {
+		prefix = eventSchemaTimerKeyPrefix(schema)
+	}
+	timers, err := s.cli.GetTimers(ctx, timerapi.WithKeyPrefix(prefix))
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*EventInfo, 0, len(timers))
+	for _, timer := range timers {
+		e, err := decodeEvent(timer)
+		if err != nil {
+			logutil.Logger(ctx).Warn("skip the invalid event", zap.Error(err))
+			continue
+		}
+		// The key prefix is matched by `LIKE`, so the events in other schemas may be returned.
+		if schema != "" && !strings.EqualFold(e.Schema, schema) {
+			continue
+		}
+		info := &EventInfo{Event: e}
+		var summary eventSummary
+		if len(timer.SummaryData) > 0 && json.Unmarshal(timer.SummaryData, &summary) == nil {
+			info.LastExecuted = summary.LastExecuted
+		}
+		events = append(events, info)
+	}
+	return events, nil
+}
+
+// UpdateEvent replaces the event named `schema`.`name` with the new definition. The schedule of the event is
+// recalculated from now if `resetSchedule` is true.
+func (s *Scheduler) UpdateEvent(ctx context.Context, schema, name string, e *Event, resetSchedule bool) error {
+	timer, err := s.cli.GetTimerByKey(ctx, eventTimerKey(schema, name))
+	if err != nil {
+		return err
+	}
+
+	spec, err := e.timerSpec(time.Now())
+	if err != nil {
+		return err
+	}
+	if spec.Key != timer.Key {
+		// The event is renamed, the timer is recreated because the key of the timer can't be changed.
+		if !resetSchedule {
+			spec.Watermark = timer.Watermark
+		}
+		if _, err = s.cli.CreateTimer(ctx, *spec); err != nil {
+			if kv.ErrKeyExists.Equal(err) {
+				return errors.Trace(timerapi.ErrTimerExists)
+			}
+			return err
+		}
+		_, err = s.cli.DeleteTimer(ctx, timer.ID)
+		return err
+	}
+
+	opts := []timerapi.UpdateTimerOption{
+		timerapi.WithSetData(spec.Data),
+		timerapi.WithSetEnable(spec.Enable),
+		timerapi.WithSetTimeZone(spec.TimeZone),
+		timerapi.WithSetSchedExpr(spec.SchedPolicyType, spec.SchedPolicyExpr),
+	}
+	if resetSchedule {
+		opts = append(opts, timerapi.WithSetWatermark(spec.Watermark))
+	}
+	return s.cli.UpdateTimer(ctx, timer.ID, opts...)
+}
+
+// DropEvent drops the event, it returns `timerapi.ErrTimerNotExist` if the event doesn't exist.
+func (s *Scheduler) DropEvent(ctx context.Context, schema, name string) error {
+	timer, err := s.cli.GetTimerByKey(ctx, eventTimerKey(schema, name))
+	if err != nil {
+		return err
+	}
+	ok, err := s.cli.DeleteTimer(ctx, timer.ID)
+	if err == nil && !ok {
+		err = errors.Trace(timerapi.ErrTimerNotExist)
+	}
+	return err
+}
+
+// DropEventsInSchema drops all the events in the schema, it's called when the schema is dropped.
+func (s *Scheduler) DropEventsInSchema(ctx context.Context, schema string) error {
+	timers, err := s.cli.GetTimers(ctx, timerapi.WithKeyPrefix(eventSchemaTimerKeyPrefix(schema)))
+	if err != nil {
+		return err
+	}
+	for _, timer := range timers {
+		if e, err := decodeEvent(timer); err == nil && !strings.EqualFold(e.Schema, schema) {
+			continue
+		}
+		if _, err = s.cli.DeleteTimer(ctx, timer.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Scheduler) gcHistory(ctx context.Context, before time.Time) error {
+	_, err := s.executeSQL(ctx, "DELETE FROM mysql.tidb_event_history WHERE start_time < FROM_UNIXTIME(%?) LIMIT 10000", before.Unix())
+	return err
+}
+
+func (s *Scheduler) executeSQL(ctx context.Context, sql string, args ...any) (rows []chunk.Row, err error) {
+	ctx = kv.WithInternalSourceType(ctx, kv.InternalTxnOthers)
+	err = s.pool.WithSession(func(se *syssession.Session) error {
+		rs, err := se.ExecuteInternal(ctx, sql, args...)
+		if err != nil || rs == nil {
+			return err
+		}
+		defer terror.Call(rs.Close)
+		rows, err = sqlexec.DrainRecordSet(ctx, rs, 8)
+		return err
+	})
+	return rows, err
+}
@@ -113,6 +113,9 @@ var (
 
 	// All the AggFunc implementations for "JSON_OBJECTAGG" are listed here
 	_ AggFunc = (*jsonObjectAgg)(nil)
+
+	// All the AggFunc implementations for the aggregate functions registered by extensions are listed here
+	_ AggFunc = (*extensionAggFunc)(nil)
 )
 
 const (
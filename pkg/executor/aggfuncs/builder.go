@@ -72,8 +72,9 @@ func Build(ctx AggFuncBuildContext, aggFuncDesc *aggregation.AggFuncDesc, ordina
 		return buildVarSamp(aggFuncDesc, ordinal)
 	case ast.AggFuncStddevSamp:
 		return buildStddevSamp(aggFuncDesc, ordinal)
+	default:
+		return buildExtensionAggFunc(aggFuncDesc, ordinal)
 	}
-	return nil
 }
 
 // BuildWindowFunctions builds specific window function according to function description and order by columns.
@@ -106,6 +107,8 @@ func BuildWindowFunctions(ctx AggFuncBuildContext, windowFuncDesc *aggregation.A
 		return buildMaxMinInWindowFunction(ctx, windowFuncDesc, ordinal, true)
 	case ast.AggFuncMin:
 		return buildMaxMinInWindowFunction(ctx, windowFuncDesc, ordinal, false)
+	case ast.AggFuncGroupConcat:
+		return buildGroupConcatInWindowFunction(ctx, windowFuncDesc, ordinal)
 	default:
 		return Build(ctx, windowFuncDesc, ordinal)
 	}
@@ -467,6 +470,17 @@ func buildMaxMinInWindowFunction(ctx AggFuncBuildContext, aggFuncDesc *aggregati
 	return base
 }
 
+// buildGroupConcatInWindowFunction builds the AggFunc implementation for function "GROUP_CONCAT" using by window function.
+func buildGroupConcatInWindowFunction(ctx AggFuncBuildContext, aggFuncDesc *aggregation.AggFuncDesc, ordinal int) AggFunc {
+	base := buildGroupConcat(ctx, aggFuncDesc, ordinal)
+	// Only the values without DISTINCT and ORDER BY can be removed from the head
+	// of the frame, the others are evaluated frame by frame.
+	if baseAggFunc, ok := base.(*groupConcat); ok {
+		return &groupConcatSliding{baseAggFunc.baseGroupConcat4String}
+	}
+	return base
+}
+
 // buildGroupConcat builds the AggFunc implementation for function "GROUP_CONCAT".
 func buildGroupConcat(ctx AggFuncBuildContext, aggFuncDesc *aggregation.AggFuncDesc, ordinal int) AggFunc {
 	switch aggFuncDesc.Mode {
@@ -671,6 +685,9 @@ func buildFirstValue(aggFuncDesc *aggregation.AggFuncDesc, ordinal int) AggFunc
 		args:    aggFuncDesc.Args,
 		ordinal: ordinal,
 	}
+	if aggFuncDesc.IgnoreNull {
+		return &frameValue{baseAggFunc: base, tp: aggFuncDesc.RetTp, nth: 1, ignoreNull: true}
+	}
 	return &firstValue{baseAggFunc: base, tp: aggFuncDesc.RetTp}
 }
 
@@ -679,6 +696,9 @@ func buildLastValue(aggFuncDesc *aggregation.AggFuncDesc, ordinal int) AggFunc {
 		args:    aggFuncDesc.Args,
 		ordinal: ordinal,
 	}
+	if aggFuncDesc.IgnoreNull {
+		return &frameValue{baseAggFunc: base, tp: aggFuncDesc.RetTp, nth: 1, fromLast: true, ignoreNull: true}
+	}
 	return &lastValue{baseAggFunc: base, tp: aggFuncDesc.RetTp}
 }
 
@@ -697,6 +717,9 @@ func buildNthValue(ctx AggFuncBuildContext, aggFuncDesc *aggregation.AggFuncDesc
 	}
 	// Already checked when building the function description.
 	nth, _, _ := expression.GetUint64FromConstant(ctx.GetEvalCtx(), aggFuncDesc.Args[1])
+	if aggFuncDesc.IgnoreNull || aggFuncDesc.FromLast {
+		return &frameValue{baseAggFunc: base, tp: aggFuncDesc.RetTp, nth: nth, fromLast: aggFuncDesc.FromLast, ignoreNull: aggFuncDesc.IgnoreNull}
+	}
 	return &nthValue{baseAggFunc: base, tp: aggFuncDesc.RetTp, nth: nth}
 }
 
@@ -738,7 +761,7 @@ func buildLeadLag(ctx AggFuncBuildContext, aggFuncDesc *aggregation.AggFuncDesc,
 		ordinal: ordinal,
 	}
 	ve, _ := buildValueEvaluator(aggFuncDesc.RetTp)
-	return baseLeadLag{baseAggFunc: base, offset: offset, defaultExpr: defaultExpr, valueEvaluator: ve}
+	return baseLeadLag{baseAggFunc: base, offset: offset, defaultExpr: defaultExpr, valueEvaluator: ve, ignoreNull: aggFuncDesc.IgnoreNull}
 }
 
 func buildLead(ctx AggFuncBuildContext, aggFuncDesc *aggregation.AggFuncDesc, ordinal int) AggFunc {
@@ -0,0 +1,170 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggfuncs
+
+import (
+	"unsafe"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/expression/aggregation"
+	"github.com/pingcap/tidb/pkg/extension"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/chunk"
+)
+
+const (
+	// DefPartialResult4ExtensionSize is the size of partialResult4Extension
+	DefPartialResult4ExtensionSize = int64(unsafe.Sizeof(partialResult4Extension{}))
+)
+
+// extensionAggFunc is the AggFunc of the aggregate functions registered by extensions.
+// It's used in all the modes, because the partial results are always merged by `MergeFunc`
+// and never passed through the chunk columns.
+type extensionAggFunc struct {
+	baseAggFunc
+	def *extension.AggregateFunctionDef
+}
+
+type partialResult4Extension struct {
+	state any
+}
+
+func buildExtensionAggFunc(aggFuncDesc *aggregation.AggFuncDesc, ordinal int) AggFunc {
+	def, ok := aggregation.GetExtensionAggFunc(aggFuncDesc.Name)
+	if !ok {
+		return nil
+	}
+	return &extensionAggFunc{
+		baseAggFunc: baseAggFunc{
+			args:    aggFuncDesc.Args,
+			ordinal: ordinal,
+			retTp:   aggFuncDesc.RetTp,
+		},
+		def: def,
+	}
+}
+
+func (e *extensionAggFunc) stateMemUsage(state any) int64 {
+	if e.def.MemoryUsageFunc == nil {
+		return 0
+	}
+	return e.def.MemoryUsageFunc(state)
+}
+
+func (e *extensionAggFunc) AllocPartialResult() (pr PartialResult, memDelta int64) {
+	p := &partialResult4Extension{state: e.def.InitFunc()}
+	return PartialResult(p), DefPartialResult4ExtensionSize + DefInterfaceSize + e.stateMemUsage(p.state)
+}
+
+func (e *extensionAggFunc) ResetPartialResult(pr PartialResult) {
+	p := (*partialResult4Extension)(pr)
+	p.state = e.def.InitFunc()
+}
+
+func (e *extensionAggFunc) UpdatePartialResult(sctx AggFuncUpdateContext, rowsInGroup []chunk.Row, pr PartialResult) (memDelta int64, err error) {
+	p := (*partialResult4Extension)(pr)
+	oldMemUsage := e.stateMemUsage(p.state)
+	args := make([]types.Datum, len(e.args))
+	for _, row := range rowsInGroup {
+		for i, arg := range e.args {
+			if args[i], err = arg.Eval(sctx, row); err != nil {
+				return 0, errors.Trace(err)
+			}
+		}
+		if p.state, err = e.def.UpdateFunc(p.state, args); err != nil {
+			return 0, errors.Trace(err)
+		}
+	}
+	return e.stateMemUsage(p.state) - oldMemUsage, nil
+}
+
+func (e *extensionAggFunc) MergePartialResult(_ AggFuncUpdateContext, src, dst PartialResult) (memDelta int64, err error) {
+	p1, p2 := (*partialResult4Extension)(src), (*partialResult4Extension)(dst)
+	oldMemUsage := e.stateMemUsage(p2.state)
+	if p2.state, err = e.def.MergeFunc(p2.state, p1.state); err != nil {
+		return 0, errors.Trace(err)
+	}
+	return e.stateMemUsage(p2.state) - oldMemUsage, nil
+}
+
+func (e *extensionAggFunc) AppendFinalResult2Chunk(sctx AggFuncUpdateContext, pr PartialResult, chk *chunk.Chunk) error {
+	p := (*partialResult4Extension)(pr)
+	d, err := e.def.FinalFunc(p.state)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !d.IsNull() && !datumKindMatchEvalType(d.Kind(), e.retTp.EvalType()) {
+		if d, err = e.convertResult(sctx, d); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	chk.AppendDatum(e.ordinal, &d)
+	return nil
+}
+
+func (e *extensionAggFunc) convertResult(sctx AggFuncUpdateContext, d types.Datum) (types.Datum, error) {
+	// The scale of the return type is the max one, so don't round the decimal to it.
+	if e.retTp.EvalType() == types.ETDecimal {
+		dec, err := d.ToDecimal(sctx.TypeCtx())
+		if err != nil {
+			return d, err
+		}
+		return types.NewDecimalDatum(dec), nil
+	}
+	return d.ConvertTo(sctx.TypeCtx(), e.retTp)
+}
+
+func (e *extensionAggFunc) SerializePartialResult(partialResult PartialResult, chk *chunk.Chunk, spillHelper *SerializeHelper) {
+	pr := (*partialResult4Extension)(partialResult)
+	resBuf := spillHelper.serializePartialResult4Extension(*pr, e.def.SerializeFunc)
+	chk.AppendBytes(e.ordinal, resBuf)
+}
+
+func (e *extensionAggFunc) DeserializePartialResult(src *chunk.Chunk) ([]PartialResult, int64) {
+	return deserializePartialResultCommon(src, e.ordinal, e.deserializeForSpill)
+}
+
+func (e *extensionAggFunc) deserializeForSpill(helper *deserializeHelper) (PartialResult, int64) {
+	pr, memDelta := e.AllocPartialResult()
+	result := (*partialResult4Extension)(pr)
+	// The initial state is replaced by the deserialized one.
+	memDelta -= e.stateMemUsage(result.state)
+	success := helper.deserializePartialResult4Extension(result, e.def.DeserializeFunc)
+	if !success {
+		return nil, 0
+	}
+	return pr, memDelta + e.stateMemUsage(result.state)
+}
+
+// datumKindMatchEvalType checks whether the datum can be appended to the column of the eval type directly.
+func datumKindMatchEvalType(kind byte, evalType types.EvalType) bool {
+	switch evalType {
+	case types.ETInt:
+		return kind == types.KindInt64 || kind == types.KindUint64
+	case types.ETReal:
+		return kind == types.KindFloat64
+	case types.ETDecimal:
+		return kind == types.KindMysqlDecimal
+	case types.ETString:
+		return kind == types.KindString || kind == types.KindBytes
+	case types.ETDatetime, types.ETTimestamp:
+		return kind == types.KindMysqlTime
+	case types.ETDuration:
+		return kind == types.KindMysqlDuration
+	case types.ETJson:
+		return kind == types.KindMysqlJSON
+	}
+	return false
+}
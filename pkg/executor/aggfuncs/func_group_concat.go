@@ -41,6 +41,8 @@ const (
 	DefPartialResult4GroupConcatOrderSize = int64(unsafe.Sizeof(partialResult4GroupConcatOrder{}))
 	// DefPartialResult4GroupConcatOrderDistinctSize is the size of partialResult4GroupConcatOrderDistinct
 	DefPartialResult4GroupConcatOrderDistinctSize = int64(unsafe.Sizeof(partialResult4GroupConcatOrderDistinct{}))
+	// DefPartialResult4GroupConcatSlidingSize is the size of partialResult4GroupConcatSliding
+	DefPartialResult4GroupConcatSlidingSize = int64(unsafe.Sizeof(partialResult4GroupConcatSliding{}))
 
 	// DefBytesBufferSize is the size of bytes.Buffer.
 	DefBytesBufferSize = int64(unsafe.Sizeof(bytes.Buffer{}))
@@ -205,6 +207,120 @@ func (e *groupConcat) GetTruncated() *int32 {
 	return e.truncated
 }
 
+// partialResult4GroupConcatSliding keeps all the values of the window frame in
+// buffer, since the values at the head of the frame may be removed later. The
+// result is truncated when it is output.
+type partialResult4GroupConcatSliding struct {
+	valsBuf *bytes.Buffer
+	buffer  *bytes.Buffer
+	// lens is the length of each value in buffer, the separators are not included.
+	lens []int
+}
+
+// groupConcatSliding is the `group_concat` without DISTINCT and ORDER BY used by window functions.
+type groupConcatSliding struct {
+	baseGroupConcat4String
+}
+
+var _ SlidingWindowAggFunc = &groupConcatSliding{}
+
+func (*groupConcatSliding) AllocPartialResult() (pr PartialResult, memDelta int64) {
+	p := new(partialResult4GroupConcatSliding)
+	p.valsBuf = &bytes.Buffer{}
+	p.buffer = &bytes.Buffer{}
+	return PartialResult(p), DefPartialResult4GroupConcatSlidingSize + 2*DefBytesBufferSize
+}
+
+func (*groupConcatSliding) ResetPartialResult(pr PartialResult) {
+	p := (*partialResult4GroupConcatSliding)(pr)
+	p.buffer.Reset()
+	p.lens = p.lens[:0]
+}
+
+// evalRow concatenates the args of the row into valsBuf, isNull is true if any of them is NULL.
+func (e *groupConcatSliding) evalRow(sctx AggFuncUpdateContext, row chunk.Row, p *partialResult4GroupConcatSliding) (bool, error) {
+	p.valsBuf.Reset()
+	for _, arg := range e.args {
+		v, isNull, err := arg.EvalString(sctx, row)
+		if err != nil || isNull {
+			return isNull, err
+		}
+		p.valsBuf.WriteString(v)
+	}
+	return false, nil
+}
+
+func (e *groupConcatSliding) pushRow(sctx AggFuncUpdateContext, row chunk.Row, p *partialResult4GroupConcatSliding) error {
+	isNull, err := e.evalRow(sctx, row, p)
+	if err != nil || isNull {
+		return err
+	}
+	if len(p.lens) > 0 {
+		p.buffer.WriteString(e.sep)
+	}
+	p.lens = append(p.lens, p.valsBuf.Len())
+	p.buffer.Write(p.valsBuf.Bytes())
+	return nil
+}
+
+func (e *groupConcatSliding) popRow(sctx AggFuncUpdateContext, row chunk.Row, p *partialResult4GroupConcatSliding) error {
+	isNull, err := e.evalRow(sctx, row, p)
+	if err != nil || isNull {
+		return err
+	}
+	n := p.lens[0]
+	if len(p.lens) > 1 {
+		n += len(e.sep)
+	}
+	p.buffer.Next(n)
+	p.lens = p.lens[1:]
+	return nil
+}
+
+func (e *groupConcatSliding) UpdatePartialResult(sctx AggFuncUpdateContext, rowsInGroup []chunk.Row, pr PartialResult) (memDelta int64, err error) {
+	p := (*partialResult4GroupConcatSliding)(pr)
+	memDelta -= int64(p.valsBuf.Cap()+p.buffer.Cap()) + int64(cap(p.lens))*DefInt64Size
+	for _, row := range rowsInGroup {
+		if err = e.pushRow(sctx, row, p); err != nil {
+			break
+		}
+	}
+	memDelta += int64(p.valsBuf.Cap()+p.buffer.Cap()) + int64(cap(p.lens))*DefInt64Size
+	return memDelta, err
+}
+
+func (e *groupConcatSliding) Slide(sctx AggFuncUpdateContext, getRow func(uint64) chunk.Row, lastStart, lastEnd uint64, shiftStart, shiftEnd uint64, pr PartialResult) error {
+	p := (*partialResult4GroupConcatSliding)(pr)
+	for i := range shiftEnd {
+		if err := e.pushRow(sctx, getRow(lastEnd+i), p); err != nil {
+			return err
+		}
+	}
+	for i := range shiftStart {
+		if err := e.popRow(sctx, getRow(lastStart+i), p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *groupConcatSliding) AppendFinalResult2Chunk(sctx AggFuncUpdateContext, pr PartialResult, chk *chunk.Chunk) error {
+	p := (*partialResult4GroupConcatSliding)(pr)
+	if len(p.lens) == 0 {
+		chk.AppendNull(e.ordinal)
+		return nil
+	}
+	res := p.buffer.Bytes()
+	if e.maxLen > 0 && uint64(len(res)) > e.maxLen {
+		res = res[:e.maxLen]
+		if err := e.handleTruncateError(sctx); err != nil {
+			return err
+		}
+	}
+	chk.AppendBytes(e.ordinal, res)
+	return nil
+}
+
 type partialResult4GroupConcatDistinct struct {
 	basePartialResult4GroupConcat
 	valSet            set.StringSetWithMemoryUsage
@@ -15,6 +15,7 @@
 package aggfuncs
 
 import (
+	"sort"
 	"unsafe"
 
 	"github.com/pingcap/tidb/pkg/expression"
@@ -32,11 +33,17 @@ type baseLeadLag struct {
 
 	defaultExpr expression.Expression
 	offset      uint64
+	// ignoreNull is true for `IGNORE NULLS`, the rows whose value is NULL are not counted in the offset.
+	ignoreNull bool
 }
 
 type partialResult4LeadLag struct {
 	rows   []chunk.Row
 	curIdx uint64
+	// nonNullIdxes stores the indexes of the rows with non-NULL value in rows[:checkedRows] for `IGNORE NULLS`,
+	// so the value of each row is evaluated only once per partition.
+	nonNullIdxes []int
+	checkedRows  int
 }
 
 func (*baseLeadLag) AllocPartialResult() (pr PartialResult, memDelta int64) {
@@ -47,6 +54,8 @@ func (*baseLeadLag) ResetPartialResult(pr PartialResult) {
 	p := (*partialResult4LeadLag)(pr)
 	p.rows = p.rows[:0]
 	p.curIdx = 0
+	p.nonNullIdxes = p.nonNullIdxes[:0]
+	p.checkedRows = 0
 }
 
 func (*baseLeadLag) UpdatePartialResult(_ AggFuncUpdateContext, rowsInGroup []chunk.Row, pr PartialResult) (memDelta int64, err error) {
@@ -56,6 +65,54 @@ func (*baseLeadLag) UpdatePartialResult(_ AggFuncUpdateContext, rowsInGroup []ch
 	return memDelta, nil
 }
 
+// findNonNullRow finds the row which is the offset-th one with non-NULL value from
+// the current row, step is 1 for lead and -1 for lag.
+func (v *baseLeadLag) findNonNullRow(sctx AggFuncUpdateContext, p *partialResult4LeadLag, step int) (chunk.Row, bool, error) {
+	if v.offset == 0 {
+		return p.rows[p.curIdx], true, nil
+	}
+	for ; p.checkedRows < len(p.rows); p.checkedRows++ {
+		isNull, err := isNullValue(sctx, v.args[0], p.rows[p.checkedRows])
+		if err != nil {
+			return chunk.Row{}, false, err
+		}
+		if !isNull {
+			p.nonNullIdxes = append(p.nonNullIdxes, p.checkedRows)
+		}
+	}
+	cur := int(p.curIdx)
+	var pos uint64
+	if step > 0 {
+		// The position of the first non-NULL row after the current row.
+		pos = uint64(sort.SearchInts(p.nonNullIdxes, cur+1)) + v.offset - 1
+	} else {
+		// The number of the non-NULL rows before the current row.
+		before := uint64(sort.SearchInts(p.nonNullIdxes, cur))
+		if before < v.offset {
+			return chunk.Row{}, false, nil
+		}
+		pos = before - v.offset
+	}
+	if pos >= uint64(len(p.nonNullIdxes)) {
+		return chunk.Row{}, false, nil
+	}
+	return p.rows[p.nonNullIdxes[pos]], true, nil
+}
+
+// evaluateNonNull evaluates the value for `IGNORE NULLS`.
+func (v *baseLeadLag) evaluateNonNull(sctx AggFuncUpdateContext, p *partialResult4LeadLag, step int) error {
+	row, found, err := v.findNonNullRow(sctx, p, step)
+	if err != nil {
+		return err
+	}
+	if found {
+		_, err = v.evaluateRow(sctx, v.args[0], row)
+	} else {
+		_, err = v.evaluateRow(sctx, v.defaultExpr, p.rows[p.curIdx])
+	}
+	return err
+}
+
 type lead struct {
 	baseLeadLag
 }
@@ -63,7 +120,9 @@ type lead struct {
 func (v *lead) AppendFinalResult2Chunk(sctx AggFuncUpdateContext, pr PartialResult, chk *chunk.Chunk) error {
 	p := (*partialResult4LeadLag)(pr)
 	var err error
-	if p.curIdx+v.offset < uint64(len(p.rows)) {
+	if v.ignoreNull {
+		err = v.evaluateNonNull(sctx, p, 1)
+	} else if p.curIdx+v.offset < uint64(len(p.rows)) {
 		_, err = v.evaluateRow(sctx, v.args[0], p.rows[p.curIdx+v.offset])
 	} else {
 		_, err = v.evaluateRow(sctx, v.defaultExpr, p.rows[p.curIdx])
@@ -83,7 +142,9 @@ type lag struct {
 func (v *lag) AppendFinalResult2Chunk(sctx AggFuncUpdateContext, pr PartialResult, chk *chunk.Chunk) error {
 	p := (*partialResult4LeadLag)(pr)
 	var err error
-	if p.curIdx >= v.offset {
+	if v.ignoreNull {
+		err = v.evaluateNonNull(sctx, p, -1)
+	} else if p.curIdx >= v.offset {
 		_, err = v.evaluateRow(sctx, v.args[0], p.rows[p.curIdx-v.offset])
 	} else {
 		_, err = v.evaluateRow(sctx, v.defaultExpr, p.rows[p.curIdx])
@@ -31,6 +31,8 @@ const (
 	DefPartialResult4LastValueSize = int64(unsafe.Sizeof(partialResult4LastValue{}))
 	// DefPartialResult4NthValueSize is the size of partialResult4NthValue
 	DefPartialResult4NthValueSize = int64(unsafe.Sizeof(partialResult4NthValue{}))
+	// DefPartialResult4FrameValueSize is the size of partialResult4FrameValue
+	DefPartialResult4FrameValueSize = int64(unsafe.Sizeof(partialResult4FrameValue{}))
 
 	// DefValue4IntSize is the size of value4Int
 	DefValue4IntSize = int64(unsafe.Sizeof(value4Int{}))
@@ -402,3 +404,104 @@ func (v *nthValue) AppendFinalResult2Chunk(_ AggFuncUpdateContext, pr PartialRes
 	}
 	return nil
 }
+
+// frameValue is used for `first_value`, `last_value` and `nth_value` with `IGNORE NULLS`
+// or `FROM LAST`. It keeps all the rows of the frame, since the row to choose can't be
+// decided before all of them are seen.
+type frameValue struct {
+	baseAggFunc
+
+	tp *types.FieldType
+	// nth is 1-based, it is 1 for `first_value` and `last_value`.
+	nth        uint64
+	fromLast   bool
+	ignoreNull bool
+}
+
+type partialResult4FrameValue struct {
+	rows      []chunk.Row
+	evaluated bool
+	found     bool
+	evaluator valueEvaluator
+}
+
+func (v *frameValue) AllocPartialResult() (pr PartialResult, memDelta int64) {
+	ve, veMemDelta := buildValueEvaluator(v.tp)
+	p := &partialResult4FrameValue{evaluator: ve}
+	return PartialResult(p), DefPartialResult4FrameValueSize + veMemDelta
+}
+
+func (*frameValue) ResetPartialResult(pr PartialResult) {
+	p := (*partialResult4FrameValue)(pr)
+	p.rows = p.rows[:0]
+	p.evaluated = false
+	p.found = false
+}
+
+func (*frameValue) UpdatePartialResult(_ AggFuncUpdateContext, rowsInGroup []chunk.Row, pr PartialResult) (memDelta int64, err error) {
+	p := (*partialResult4FrameValue)(pr)
+	p.rows = append(p.rows, rowsInGroup...)
+	p.evaluated = false
+	return int64(len(rowsInGroup)) * DefRowSize, nil
+}
+
+func (v *frameValue) AppendFinalResult2Chunk(sctx AggFuncUpdateContext, pr PartialResult, chk *chunk.Chunk) error {
+	p := (*partialResult4FrameValue)(pr)
+	if !p.evaluated {
+		// The result is cached, since it may be appended for many rows with the same frame.
+		row, found, err := v.findRow(sctx, p.rows)
+		if err != nil {
+			return err
+		}
+		if found {
+			if _, err = p.evaluator.evaluateRow(sctx, v.args[0], row); err != nil {
+				return err
+			}
+		}
+		p.evaluated, p.found = true, found
+	}
+	if !p.found {
+		chk.AppendNull(v.ordinal)
+	} else {
+		p.evaluator.appendResult(chk, v.ordinal)
+	}
+	return nil
+}
+
+// findRow finds the nth row counted from the first or the last one, the rows whose
+// value is NULL are skipped if `IGNORE NULLS` is specified.
+func (v *frameValue) findRow(sctx AggFuncUpdateContext, rows []chunk.Row) (chunk.Row, bool, error) {
+	if v.nth == 0 {
+		return chunk.Row{}, false, nil
+	}
+	seen := uint64(0)
+	for i := range rows {
+		row := rows[i]
+		if v.fromLast {
+			row = rows[len(rows)-1-i]
+		}
+		if v.ignoreNull {
+			isNull, err := isNullValue(sctx, v.args[0], row)
+			if err != nil {
+				return chunk.Row{}, false, err
+			}
+			if isNull {
+				continue
+			}
+		}
+		seen++
+		if seen == v.nth {
+			return row, true, nil
+		}
+	}
+	return chunk.Row{}, false, nil
+}
+
+// isNullValue checks whether the expression is evaluated to NULL on the row.
+func isNullValue(ctx expression.EvalContext, expr expression.Expression, row chunk.Row) (bool, error) {
+	d, err := expr.Eval(ctx, row)
+	if err != nil {
+		return false, err
+	}
+	return d.IsNull(), nil
+}
@@ -395,3 +395,13 @@ func (s *deserializeHelper) deserializePartialResult4FirstRowSet(dst *partialRes
 	}
 	return false
 }
+
+func (s *deserializeHelper) deserializePartialResult4Extension(dst *partialResult4Extension, deserialize func([]byte) any) bool {
+	if s.readRowIndex < s.totalRowCnt {
+		s.pab.Reset(s.column, s.readRowIndex)
+		dst.state = deserialize(s.pab.Buf)
+		s.readRowIndex++
+		return true
+	}
+	return false
+}
@@ -1380,3 +1380,44 @@ func TestPartialResult4FirstRowSet(t *testing.T) {
 		require.Equal(t, *(*partialResult4FirstRowSet)(serializedPartialResults[i]), deserializedPartialResults[i])
 	}
 }
+
+func TestPartialResult4Extension(t *testing.T) {
+	serializeHelper := NewSerializeHelper()
+	serialize := func(state any, buf []byte) []byte {
+		return append(buf, state.(string)...)
+	}
+	deserialize := func(data []byte) any {
+		return string(data)
+	}
+
+	// Initialize test data
+	expectData := []partialResult4Extension{{state: ""}, {state: testLongStr1}, {state: "abc"}, {state: testLongStr2}}
+	testDataNum := len(expectData)
+
+	// Serialize test data
+	chunk := getChunk()
+	for i := range expectData {
+		serializedData := serializeHelper.serializePartialResult4Extension(expectData[i], serialize)
+		chunk.AppendBytes(0, serializedData)
+	}
+
+	// Deserialize test data
+	deserializeHelper := newDeserializeHelper(chunk.Column(0), testDataNum)
+	deserializedPartialResults := make([]partialResult4Extension, testDataNum+1)
+	index := 0
+	for {
+		success := deserializeHelper.deserializePartialResult4Extension(&deserializedPartialResults[index], deserialize)
+		if !success {
+			break
+		}
+		index++
+	}
+
+	chunk.Column(0).DestroyDataForTest()
+
+	// Check some results
+	require.Equal(t, testDataNum, index)
+	for i := range testDataNum {
+		require.Equal(t, expectData[i], deserializedPartialResults[i])
+	}
+}
@@ -224,3 +224,8 @@ func (s *SerializeHelper) serializePartialResult4FirstRowSet(value partialResult
 	s.buf = util.SerializeSet(&value.val, s.buf)
 	return s.buf
 }
+
+func (s *SerializeHelper) serializePartialResult4Extension(value partialResult4Extension, serialize func(any, []byte) []byte) []byte {
+	s.buf = serialize(value.state, s.buf[:0])
+	return s.buf
+}
@@ -320,7 +320,7 @@ func (e *AnalyzeColumnsExecV1) analyzeColumnsPushDownV1() *statistics.AnalyzeRes
 	} else {
 		ranges = ranger.FullIntRange(false)
 	}
-	collExtStats := e.ctx.GetSessionVars().EnableExtendedStats
+	collExtStats := needCollectExtendedStats(e.ctx)
 	hists, cms, topNs, fms, extStats, err := e.buildStats(ranges, collExtStats)
 	if err != nil {
 		return &statistics.AnalyzeResults{Err: err, Job: e.job}
@@ -23,6 +23,7 @@ import (
 	"github.com/pingcap/tidb/pkg/config"
 	"github.com/pingcap/tidb/pkg/sessionctx"
 	"github.com/pingcap/tidb/pkg/sessionctx/vardef"
+	"github.com/pingcap/tidb/pkg/sessionctx/variable"
 	"github.com/pingcap/tidb/pkg/statistics"
 	"github.com/pingcap/tidb/pkg/store/helper"
 	"github.com/pingcap/tidb/pkg/util"
@@ -86,6 +87,26 @@ func getBuildSamplingStatsConcurrency(ctx sessionctx.Context) (int, error) {
 	return getIntFromSessionVars(ctx, vardef.TiDBBuildSamplingStatsConcurrency)
 }
 
+// needCollectExtendedStats checks whether extended statistics should be built
+// along with the column statistics. Auto-analyze runs in an internal session
+// whose variables are not set by users, so the global value is consulted there
+// to keep extended statistics maintained in the background.
+func needCollectExtendedStats(ctx sessionctx.Context) bool {
+	sessionVars := ctx.GetSessionVars()
+	if sessionVars.EnableExtendedStats {
+		return true
+	}
+	if !sessionVars.InRestrictedSQL || sessionVars.GlobalVarsAccessor == nil {
+		return false
+	}
+	val, err := sessionVars.GlobalVarsAccessor.GetGlobalSysVar(vardef.TiDBEnableExtendedStats)
+	if err != nil {
+		logutil.BgLogger().Warn("fail to get global variable", zap.String("variable", vardef.TiDBEnableExtendedStats), zap.Error(err))
+		return false
+	}
+	return variable.TiDBOptOn(val)
+}
+
 var errAnalyzeWorkerPanic = errors.New("analyze worker panic")
 var errAnalyzeOOM = errors.Errorf("analyze panic due to memory quota exceeds, please try with smaller samplerate(refer to %d/count)", config.DefRowsForSampleRate)
 
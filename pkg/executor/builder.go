@@ -256,6 +256,8 @@ func (b *executorBuilder) build(p base.Plan) exec.Executor {
 		return b.buildUnionAll(v)
 	case *plannercore.Update:
 		return b.buildUpdate(v)
+	case *plannercore.Merge:
+		return b.buildMerge(v)
 	case *plannercore.PhysicalUnionScan:
 		return b.buildUnionScanExec(v)
 	case *plannercore.PhysicalHashJoin:
@@ -280,6 +282,8 @@ func (b *executorBuilder) build(p base.Plan) exec.Executor {
 		return b.buildMemTable(v)
 	case *plannercore.PhysicalTableDual:
 		return b.buildTableDual(v)
+	case *plannercore.PhysicalJSONTable:
+		return b.buildJSONTable(v)
 	case *plannercore.PhysicalApply:
 		return b.buildApply(v)
 	case *plannercore.PhysicalMaxOneRow:
@@ -527,6 +531,7 @@ func (b *executorBuilder) buildCheckTable(v *plannercore.CheckTable) exec.Execut
 			indexInfos:   v.IndexInfos,
 			is:           b.is,
 			err:          &atomic.Pointer[error]{},
+			partitionIDs: v.PartitionIDs,
 		}
 		return e
 	}
@@ -553,6 +558,7 @@ func (b *executorBuilder) buildCheckTable(v *plannercore.CheckTable) exec.Execut
 		exitCh:       make(chan struct{}),
 		retCh:        make(chan error, len(readerExecs)),
 		checkIndex:   v.CheckIndex,
+		partitionIDs: v.PartitionIDs,
 	}
 	return e
 }
@@ -994,6 +1000,8 @@ func (b *executorBuilder) buildInsert(v *plannercore.Insert) exec.Executor {
 		SelectExec:                selectExec,
 		rowLen:                    v.RowLen,
 		ignoreErr:                 v.IgnoreErr,
+		rowPolicyCheck:            v.RowPolicyCheck,
+		rowPolicyDelete:           v.RowPolicyDeleteUsing,
 	}
 	err := ivs.initInsertColumns()
 	if err != nil {
@@ -1008,13 +1016,18 @@ func (b *executorBuilder) buildInsert(v *plannercore.Insert) exec.Executor {
 	if b.err != nil {
 		return nil
 	}
+	ivs.triggers, b.err = buildTableTriggers(b.is, ivs.Table)
+	if b.err != nil {
+		return nil
+	}
 
 	if v.IsReplace {
 		return b.buildReplace(ivs)
 	}
 	insert := &InsertExec{
-		InsertValues: ivs,
-		OnDuplicate:  append(v.OnDuplicate, v.GenCols.OnDuplicates...),
+		InsertValues:    ivs,
+		OnDuplicate:     append(v.OnDuplicate, v.GenCols.OnDuplicates...),
+		rowPolicyUpdate: v.RowPolicyUpdateUsing,
 	}
 	return insert
 }
@@ -1678,6 +1691,7 @@ func (b *executorBuilder) buildHashJoinV2FromChildExecs(leftExec, rightExec exec
 	for i := range probeKeys {
 		probeKeyColIdx[i] = probeKeys[i].Index
 	}
+	e.HashJoinCtxV2.CopRuntimeFilters = setCopRuntimeFilters(v, e.ProbeSideTupleFetcher.ProbeSideExec)
 
 	colsFromChildren := v.Schema().Columns
 	if v.JoinType == logicalop.LeftOuterSemiJoin || v.JoinType == logicalop.AntiLeftOuterSemiJoin {
@@ -1755,6 +1769,11 @@ func (b *executorBuilder) buildHashJoinV2FromChildExecs(leftExec, rightExec exec
 }
 
 func (b *executorBuilder) buildHashJoin(v *plannercore.PhysicalHashJoin) exec.Executor {
+	if b.ctx.GetSessionVars().EnableAdaptiveJoin && len(v.LeftJoinKeys) > 0 && len(v.LeftNAJoinKeys) == 0 {
+		if swapped := v.SwapBuildSide(); swapped != nil {
+			return b.buildAdaptiveHashJoin(v, swapped)
+		}
+	}
 	if b.ctx.GetSessionVars().UseHashJoinV2 && joinversion.IsHashJoinV2Supported() && v.CanUseHashJoinV2() {
 		return b.buildHashJoinV2(v)
 	}
@@ -1770,6 +1789,44 @@ func (b *executorBuilder) buildHashJoin(v *plannercore.PhysicalHashJoin) exec.Ex
 	return b.buildHashJoinFromChildExecs(leftExec, rightExec, v)
 }
 
+// buildAdaptiveHashJoin builds the inner hash join which swaps its build side and probe side at runtime if the build
+// side turns out to be larger than the probe side.
+func (b *executorBuilder) buildAdaptiveHashJoin(v, swapped *plannercore.PhysicalHashJoin) exec.Executor {
+	leftExec := b.build(v.Children()[0])
+	if b.err != nil {
+		return nil
+	}
+	rightExec := b.build(v.Children()[1])
+	if b.err != nil {
+		return nil
+	}
+	replays := []*join.ReplayExec{join.NewReplayExec(leftExec), join.NewReplayExec(rightExec)}
+	joinExec := b.buildHashJoinFromChildExecsByVersion(replays[0], replays[1], v)
+	if b.err != nil {
+		return nil
+	}
+	alternative := b.buildHashJoinFromChildExecsByVersion(replays[0], replays[1], swapped)
+	if b.err != nil {
+		return nil
+	}
+	return &join.AdaptiveJoinExec{
+		BaseExecutor: exec.NewBaseExecutor(b.ctx, v.Schema(), v.ID(), leftExec, rightExec),
+		Tp:           join.AdaptiveHashJoin,
+		Threshold:    b.ctx.GetSessionVars().AdaptiveJoinRowThreshold,
+		BuildSideIdx: v.InnerChildIdx,
+		Join:         joinExec,
+		Alternative:  alternative,
+		Replays:      replays,
+	}
+}
+
+func (b *executorBuilder) buildHashJoinFromChildExecsByVersion(leftExec, rightExec exec.Executor, v *plannercore.PhysicalHashJoin) exec.Executor {
+	if b.ctx.GetSessionVars().UseHashJoinV2 && joinversion.IsHashJoinV2Supported() && v.CanUseHashJoinV2() {
+		return b.buildHashJoinV2FromChildExecs(leftExec, rightExec, v)
+	}
+	return b.buildHashJoinFromChildExecs(leftExec, rightExec, v)
+}
+
 func (b *executorBuilder) buildHashJoinFromChildExecs(leftExec, rightExec exec.Executor, v *plannercore.PhysicalHashJoin) *join.HashJoinV1Exec {
 	e := &join.HashJoinV1Exec{
 		BaseExecutor:          exec.NewBaseExecutor(b.ctx, v.Schema(), v.ID(), leftExec, rightExec),
@@ -1850,6 +1907,7 @@ func (b *executorBuilder) buildHashJoinFromChildExecs(leftExec, rightExec exec.E
 	for i := range probeNAKeys {
 		probeNAKeColIdx[i] = probeNAKeys[i].Index
 	}
+	e.HashJoinCtxV1.CopRuntimeFilters = setCopRuntimeFilters(v, e.ProbeSideTupleFetcher.ProbeSideExec)
 	isNAJoin := len(v.LeftNAJoinKeys) > 0
 	colsFromChildren := v.Schema().Columns
 	if v.JoinType == logicalop.LeftOuterSemiJoin || v.JoinType == logicalop.AntiLeftOuterSemiJoin {
@@ -2133,6 +2191,14 @@ func (b *executorBuilder) buildTableDual(v *plannercore.PhysicalTableDual) exec.
 	return e
 }
 
+func (b *executorBuilder) buildJSONTable(v *plannercore.PhysicalJSONTable) exec.Executor {
+	return &JSONTableExec{
+		BaseExecutor: exec.NewBaseExecutor(b.ctx, v.Schema(), v.ID()),
+		expr:         v.Expr,
+		root:         v.Root,
+	}
+}
+
 // `getSnapshotTS` returns for-update-ts if in insert/update/delete/lock statement otherwise the isolation read ts
 // Please notice that in RC isolation, the above two ts are the same
 func (b *executorBuilder) getSnapshotTS() (ts uint64, err error) {
@@ -2357,8 +2423,11 @@ func (b *executorBuilder) buildMemTable(v *plannercore.PhysicalMemTable) exec.Ex
 			strings.ToLower(infoschema.TableCheckConstraints),
 			strings.ToLower(infoschema.TableTiDBCheckConstraints),
 			strings.ToLower(infoschema.TableKeywords),
+			strings.ToLower(infoschema.TableEvents),
+			strings.ToLower(infoschema.TableTriggers),
 			strings.ToLower(infoschema.TableTiDBIndexUsage),
 			strings.ToLower(infoschema.TableTiDBPlanCache),
+			strings.ToLower(infoschema.TableCardinalityFeedback),
 			strings.ToLower(infoschema.ClusterTableTiDBPlanCache),
 			strings.ToLower(infoschema.ClusterTableTiDBIndexUsage),
 			strings.ToLower(infoschema.TableKeyspaceMeta):
@@ -2791,6 +2860,10 @@ func (b *executorBuilder) buildUpdate(v *plannercore.Update) exec.Executor {
 	if b.err != nil {
 		return nil
 	}
+	updateExec.triggers, b.err = buildTblID2Triggers(b.is, tblID2table)
+	if b.err != nil {
+		return nil
+	}
 	return updateExec
 }
 
@@ -2844,9 +2917,139 @@ func (b *executorBuilder) buildDelete(v *plannercore.Delete) exec.Executor {
 	if b.err != nil {
 		return nil
 	}
+	deleteExec.triggers, b.err = buildTblID2Triggers(b.is, tblID2table)
+	if b.err != nil {
+		return nil
+	}
 	return deleteExec
 }
 
+func (b *executorBuilder) buildMerge(v *plannercore.Merge) exec.Executor {
+	b.inUpdateStmt = true
+	tbl, _ := b.is.TableByID(context.Background(), v.TblColPosInfo.TblID)
+	tblID2table := map[int64]table.Table{v.TblColPosInfo.TblID: tbl}
+	if b.err = b.updateForUpdateTS(); b.err != nil {
+		return nil
+	}
+
+	selExec := b.build(v.SelectPlan)
+	if b.err != nil {
+		return nil
+	}
+	base := exec.NewBaseExecutor(b.ctx, v.Schema(), v.ID(), selExec)
+	base.SetInitCap(chunk.ZeroCapacity)
+	mergeExec := &MergeExec{
+		BaseExecutor:  base,
+		tbl:           tbl,
+		tblColPosInfo: v.TblColPosInfo,
+	}
+	for _, c := range v.Clauses {
+		clause := &mergeClauseExec{
+			notMatched: c.NotMatched,
+			condition:  c.Condition,
+			values:     c.Values,
+		}
+		switch {
+		case c.Update != nil:
+			clause.update = b.buildMergeUpdate(c.Update, tblID2table, selExec.Schema().Len())
+		case c.Delete != nil:
+			clause.delete = b.buildMergeDelete(c.Delete, tblID2table)
+		case c.Insert != nil:
+			clause.insert = b.buildMergeInsert(c.Insert)
+		}
+		if b.err != nil {
+			return nil
+		}
+		mergeExec.clauses = append(mergeExec.clauses, clause)
+	}
+	return mergeExec
+}
+
+// buildMergeUpdate builds the UpdateExec of the WHEN MATCHED THEN UPDATE clause, the rows are
+// written by the MergeExec, so the UpdateExec has no child.
+func (b *executorBuilder) buildMergeUpdate(v *plannercore.Update, tblID2table map[int64]table.Table, schemaLen int) *UpdateExec {
+	var assignFlag []int
+	assignFlag, b.err = getAssignFlag(b.ctx, v, schemaLen)
+	if b.err != nil {
+		return nil
+	}
+	b.err = plannercore.CheckUpdateList(assignFlag, v, tblID2table)
+	if b.err != nil {
+		return nil
+	}
+	updateExec := &UpdateExec{
+		BaseExecutor:             exec.NewBaseExecutor(b.ctx, nil, v.ID()),
+		OrderedList:              v.OrderedList,
+		virtualAssignmentsOffset: v.VirtualAssignmentsOffset,
+		tblID2table:              tblID2table,
+		tblColPosInfos:           v.TblColPosInfos,
+		assignFlag:               assignFlag,
+	}
+	updateExec.fkChecks, b.err = buildTblID2FKCheckExecs(b.ctx, tblID2table, v.FKChecks)
+	if b.err != nil {
+		return nil
+	}
+	updateExec.fkCascades, b.err = b.buildTblID2FKCascadeExecs(tblID2table, v.FKCascades)
+	if b.err != nil {
+		return nil
+	}
+	updateExec.triggers, b.err = buildTblID2Triggers(b.is, tblID2table)
+	if b.err != nil {
+		return nil
+	}
+	return updateExec
+}
+
+// buildMergeDelete builds the DeleteExec of the WHEN MATCHED THEN DELETE clause.
+func (b *executorBuilder) buildMergeDelete(v *plannercore.Delete, tblID2table map[int64]table.Table) *DeleteExec {
+	deleteExec := &DeleteExec{
+		BaseExecutor:   exec.NewBaseExecutor(b.ctx, nil, v.ID()),
+		tblID2Table:    tblID2table,
+		tblColPosInfos: v.TblColPosInfos,
+	}
+	deleteExec.fkChecks, b.err = buildTblID2FKCheckExecs(b.ctx, tblID2table, v.FKChecks)
+	if b.err != nil {
+		return nil
+	}
+	deleteExec.fkCascades, b.err = b.buildTblID2FKCascadeExecs(tblID2table, v.FKCascades)
+	if b.err != nil {
+		return nil
+	}
+	deleteExec.triggers, b.err = buildTblID2Triggers(b.is, tblID2table)
+	if b.err != nil {
+		return nil
+	}
+	return deleteExec
+}
+
+// buildMergeInsert builds the InsertValues of the WHEN NOT MATCHED THEN INSERT clause.
+func (b *executorBuilder) buildMergeInsert(v *plannercore.Insert) *InsertValues {
+	ivs := &InsertValues{
+		BaseExecutor:   exec.NewBaseExecutor(b.ctx, nil, v.ID()),
+		Table:          v.Table,
+		Columns:        v.Columns,
+		GenExprs:       v.GenCols.Exprs,
+		rowLen:         v.RowLen,
+		rowPolicyCheck: v.RowPolicyCheck,
+	}
+	if b.err = ivs.initInsertColumns(); b.err != nil {
+		return nil
+	}
+	ivs.fkChecks, b.err = buildFKCheckExecs(b.ctx, ivs.Table, v.FKChecks)
+	if b.err != nil {
+		return nil
+	}
+	ivs.fkCascades, b.err = b.buildFKCascadeExecs(ivs.Table, v.FKCascades)
+	if b.err != nil {
+		return nil
+	}
+	ivs.triggers, b.err = buildTableTriggers(b.is, ivs.Table)
+	if b.err != nil {
+		return nil
+	}
+	return ivs
+}
+
 func (b *executorBuilder) updateForUpdateTS() error {
 	// GetStmtForUpdateTS will auto update the for update ts if it is necessary
 	_, err := sessiontxn.GetTxnManager(b.ctx).GetStmtForUpdateTS()
@@ -3366,6 +3569,53 @@ func (b *executorBuilder) buildIndexLookUpJoin(v *plannercore.PhysicalIndexJoin)
 	if b.err != nil {
 		return nil
 	}
+	if b.ctx.GetSessionVars().EnableAdaptiveJoin && v.FallbackInnerPlan != nil {
+		return b.buildAdaptiveIndexJoin(outerExec, v, func(outerExec exec.Executor) exec.Executor {
+			return b.buildIndexLookUpJoinFromOuterExec(outerExec, v)
+		})
+	}
+	return b.buildIndexLookUpJoinFromOuterExec(outerExec, v)
+}
+
+// buildAdaptiveIndexJoin builds the index join which falls back to the hash join at runtime if the outer side returns
+// far more rows than estimated.
+func (b *executorBuilder) buildAdaptiveIndexJoin(outerExec exec.Executor, v *plannercore.PhysicalIndexJoin, buildIndexJoin func(exec.Executor) exec.Executor) exec.Executor {
+	fallback, err := v.FallbackHashJoin()
+	if err != nil {
+		b.err = err
+		return nil
+	}
+	if fallback == nil {
+		return buildIndexJoin(outerExec)
+	}
+	replay := join.NewReplayExec(outerExec)
+	indexJoin := buildIndexJoin(replay)
+	if b.err != nil {
+		return nil
+	}
+	innerExec := b.build(v.FallbackInnerPlan)
+	if b.err != nil {
+		return nil
+	}
+	var leftExec, rightExec exec.Executor = replay, innerExec
+	if v.InnerChildIdx == 0 {
+		leftExec, rightExec = innerExec, replay
+	}
+	hashJoin := b.buildHashJoinFromChildExecsByVersion(leftExec, rightExec, fallback)
+	if b.err != nil {
+		return nil
+	}
+	return &join.AdaptiveJoinExec{
+		BaseExecutor: exec.NewBaseExecutor(b.ctx, v.Schema(), v.ID(), outerExec),
+		Tp:           join.AdaptiveIndexJoin,
+		Threshold:    b.ctx.GetSessionVars().AdaptiveJoinRowThreshold,
+		Join:         indexJoin,
+		Alternative:  hashJoin,
+		Replays:      []*join.ReplayExec{replay},
+	}
+}
+
+func (b *executorBuilder) buildIndexLookUpJoinFromOuterExec(outerExec exec.Executor, v *plannercore.PhysicalIndexJoin) exec.Executor {
 	outerTypes := exec.RetTypes(outerExec)
 	innerPlan := v.Children()[v.InnerChildIdx]
 	innerTypes := make([]*types.FieldType, innerPlan.Schema().Len())
@@ -3600,7 +3850,20 @@ func (b *executorBuilder) buildIndexLookUpMergeJoin(v *plannercore.PhysicalIndex
 }
 
 func (b *executorBuilder) buildIndexNestedLoopHashJoin(v *plannercore.PhysicalIndexHashJoin) exec.Executor {
-	joinExec := b.buildIndexLookUpJoin(&(v.PhysicalIndexJoin))
+	outerExec := b.build(v.Children()[1-v.InnerChildIdx])
+	if b.err != nil {
+		return nil
+	}
+	if b.ctx.GetSessionVars().EnableAdaptiveJoin && v.FallbackInnerPlan != nil {
+		return b.buildAdaptiveIndexJoin(outerExec, &v.PhysicalIndexJoin, func(outerExec exec.Executor) exec.Executor {
+			return b.buildIndexNestedLoopHashJoinFromOuterExec(outerExec, v)
+		})
+	}
+	return b.buildIndexNestedLoopHashJoinFromOuterExec(outerExec, v)
+}
+
+func (b *executorBuilder) buildIndexNestedLoopHashJoinFromOuterExec(outerExec exec.Executor, v *plannercore.PhysicalIndexHashJoin) exec.Executor {
+	joinExec := b.buildIndexLookUpJoinFromOuterExec(outerExec, &(v.PhysicalIndexJoin))
 	if b.err != nil {
 		return nil
 	}
@@ -5120,7 +5383,7 @@ func (b *executorBuilder) buildWindow(v *plannercore.PhysicalWindow) exec.Execut
 	resultColIdx := v.Schema().Len() - len(v.WindowFuncDescs)
 	exprCtx := b.ctx.GetExprCtx()
 	for _, desc := range v.WindowFuncDescs {
-		aggDesc, err := aggregation.NewAggFuncDescForWindowFunc(exprCtx, desc, false)
+		aggDesc, err := aggregation.NewAggFuncDescForWindowFunc(exprCtx, desc, desc.HasDistinct)
 		if err != nil {
 			b.err = err
 			return nil
@@ -5159,6 +5422,11 @@ func (b *executorBuilder) buildWindow(v *plannercore.PhysicalWindow) exec.Execut
 		} else {
 			exec.start = v.Frame.Start
 			exec.end = v.Frame.End
+			if v.Frame.Type == ast.Groups {
+				exec.orderByCols = orderByCols
+				exec.peerCmpFuncs = buildPeerCmpFuncs(exprCtx, orderByCols)
+				exec.isGroupsFrame = true
+			}
 			if v.Frame.Type == ast.Ranges {
 				cmpResult := int64(-1)
 				if len(v.OrderBy) > 0 && v.OrderBy[0].Desc {
@@ -5192,6 +5460,15 @@ func (b *executorBuilder) buildWindow(v *plannercore.PhysicalWindow) exec.Execut
 			start:          v.Frame.Start,
 			end:            v.Frame.End,
 		}
+	} else if v.Frame.Type == ast.Groups {
+		processor = &groupsFrameWindowProcessor{
+			windowFuncs:    windowFuncs,
+			partialResults: partialResults,
+			start:          v.Frame.Start,
+			end:            v.Frame.End,
+			orderByCols:    orderByCols,
+			peerCmpFuncs:   buildPeerCmpFuncs(exprCtx, orderByCols),
+		}
 	} else {
 		cmpResult := int64(-1)
 		if len(v.OrderBy) > 0 && v.OrderBy[0].Desc {
@@ -5224,6 +5501,15 @@ func (b *executorBuilder) buildWindow(v *plannercore.PhysicalWindow) exec.Execut
 	}
 }
 
+// buildPeerCmpFuncs builds the functions to compare the ORDER BY values of rows for GROUPS frames.
+func buildPeerCmpFuncs(ctx expression.BuildContext, orderByCols []*expression.Column) []expression.CompareFunc {
+	cmpFuncs := make([]expression.CompareFunc, 0, len(orderByCols))
+	for _, col := range orderByCols {
+		cmpFuncs = append(cmpFuncs, expression.GetCmpFunction(ctx, col, col))
+	}
+	return cmpFuncs
+}
+
 func (b *executorBuilder) buildShuffle(v *plannercore.PhysicalShuffle) *ShuffleExec {
 	base := exec.NewBaseExecutor(b.ctx, v.Schema(), v.ID())
 	shuffle := &ShuffleExec{
@@ -5610,6 +5896,10 @@ func (b *executorBuilder) buildTableSample(v *plannercore.PhysicalTableSample) *
 			b.err = errors.New("TABLESAMPLE clause can not be applied to local temporary tables")
 			return nil
 		}
+		if tblInfo.OnCommitPreserveRows {
+			b.err = errors.New("TABLESAMPLE clause can not be applied to global temporary tables with ON COMMIT PRESERVE ROWS")
+			return nil
+		}
 		e.sampler = &emptySampler{}
 	} else if v.TableSampleInfo.AstNode.SampleMethod == ast.SampleMethodTypeTiDBRegion {
 		e.sampler = newTableRegionSampler(
@@ -5793,7 +6083,9 @@ func (b *executorBuilder) getCacheTable(tblInfo *model.TableInfo, startTS uint64
 }
 
 func (b *executorBuilder) buildCompactTable(v *plannercore.CompactTable) exec.Executor {
-	if v.ReplicaKind != ast.CompactReplicaKindTiFlash && v.ReplicaKind != ast.CompactReplicaKindAll {
+	switch v.ReplicaKind {
+	case ast.CompactReplicaKindTiFlash, ast.CompactReplicaKindAll, ast.CompactReplicaKindTiKV:
+	default:
 		b.err = errors.Errorf("compact %v replica is not supported", strings.ToLower(string(v.ReplicaKind)))
 		return nil
 	}
@@ -5801,7 +6093,7 @@ func (b *executorBuilder) buildCompactTable(v *plannercore.CompactTable) exec.Ex
 	store := b.ctx.GetStore()
 	tikvStore, ok := store.(tikv.Storage)
 	if !ok {
-		b.err = errors.New("compact tiflash replica can only run with tikv compatible storage")
+		b.err = errors.Errorf("compact %v replica can only run with tikv compatible storage", strings.ToLower(string(v.ReplicaKind)))
 		return nil
 	}
 
@@ -5827,6 +6119,15 @@ func (b *executorBuilder) buildCompactTable(v *plannercore.CompactTable) exec.Ex
 		}
 	}
 
+	if v.ReplicaKind == ast.CompactReplicaKindTiKV {
+		return &CompactTableTiKVExec{
+			BaseExecutor: exec.NewBaseExecutor(b.ctx, v.Schema(), v.ID()),
+			tableInfo:    v.TableInfo,
+			partitionIDs: partitionIDs,
+			tikvStore:    tikvStore,
+		}
+	}
+
 	return &CompactTableTiFlashExec{
 		BaseExecutor: exec.NewBaseExecutor(b.ctx, v.Schema(), v.ID()),
 		tableInfo:    v.TableInfo,
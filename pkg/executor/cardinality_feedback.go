@@ -0,0 +1,206 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+	"slices"
+	"sync/atomic"
+	"time"
+
+	"github.com/pingcap/tidb/pkg/config"
+	"github.com/pingcap/tidb/pkg/expression"
+	"github.com/pingcap/tidb/pkg/kv"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	plannercore "github.com/pingcap/tidb/pkg/planner/core"
+	"github.com/pingcap/tidb/pkg/planner/core/base"
+	"github.com/pingcap/tidb/pkg/privilege"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/sessionctx/stmtctx"
+	"github.com/pingcap/tidb/pkg/statistics"
+	"github.com/pingcap/tidb/pkg/statistics/feedback"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/execdetails"
+)
+
+// recordCardinalityFeedback compares the estimated and actual rows of the table readers in the plan of a slow or
+// frequently-run statement, and records the misestimates as the cardinality corrections of the predicates on the
+// tables, which are used by the later estimation until the tables are analyzed again.
+func (a *ExecStmt) recordCardinalityFeedback(succ bool) {
+	sessVars := a.Ctx.GetSessionVars()
+	stmtCtx := sessVars.StmtCtx
+	if !succ || !sessVars.EnableCardinalityFeedback || sessVars.InRestrictedSQL || stmtCtx.RuntimeStatsColl == nil {
+		return
+	}
+	p, ok := a.Plan.(base.PhysicalPlan)
+	if !ok {
+		return
+	}
+	// the plan got from the plan cache doesn't record the stats it used, whose estimation may come from the stats
+	// before the last analyze.
+	usedStats := stmtCtx.GetUsedStatsInfo(false)
+	if usedStats == nil {
+		return
+	}
+	_, digest := stmtCtx.SQLDigest()
+	execCount := feedback.Corrections.ObserveExec(digest.String())
+	threshold := time.Duration(atomic.LoadUint64(&config.GetGlobalConfig().Instance.SlowThreshold)) * time.Millisecond
+	if sessVars.GetTotalCostDuration() < threshold && execCount < feedback.FrequentExecCount {
+		return
+	}
+	c := &cardinalityFeedbackCollector{
+		evalCtx:   a.Ctx.GetExprCtx().GetEvalCtx(),
+		statsColl: stmtCtx.RuntimeStatsColl,
+		usedStats: usedStats,
+	}
+	c.collect(p)
+}
+
+type cardinalityFeedbackCollector struct {
+	evalCtx   expression.EvalContext
+	statsColl *execdetails.RuntimeStatsColl
+	usedStats *stmtctx.UsedStatsInfo
+}
+
+// collect walks the plan to find the table readers whose actual rows are comparable with the estimated ones, the
+// operators which may stop reading their children early, and the inner sides of the index join and apply, which are
+// executed more than once, are skipped.
+func (c *cardinalityFeedbackCollector) collect(p base.PhysicalPlan) {
+	switch x := p.(type) {
+	case *plannercore.PhysicalLimit, *plannercore.PhysicalTopN, *plannercore.PhysicalMergeJoin:
+		return
+	case *plannercore.PhysicalApply:
+		c.collect(x.Children()[0])
+		return
+	case *plannercore.PhysicalIndexJoin:
+		c.collect(x.Children()[1-x.InnerChildIdx])
+		return
+	case *plannercore.PhysicalIndexHashJoin:
+		c.collect(x.Children()[1-x.InnerChildIdx])
+		return
+	case *plannercore.PhysicalIndexMergeJoin:
+		c.collect(x.Children()[1-x.InnerChildIdx])
+		return
+	case *plannercore.PhysicalHashJoin:
+		// the probe side is not read through once the build side is empty.
+		if c.statsColl.GetPlanActRows(x.ID()) == 0 {
+			return
+		}
+	case *plannercore.PhysicalSelection:
+		// the filters which can't be pushed down are kept above the reader.
+		if physicalID, conds, ok := readerConditions(x.Children()[0]); ok {
+			c.record(x, physicalID, append(conds, x.Conditions...))
+			return
+		}
+	default:
+		if physicalID, conds, ok := readerConditions(p); ok {
+			c.record(p, physicalID, conds)
+			return
+		}
+	}
+	for _, child := range p.Children() {
+		c.collect(child)
+	}
+}
+
+func (c *cardinalityFeedbackCollector) record(p base.PhysicalPlan, physicalID int64, conds []expression.Expression) {
+	usedInfo := c.usedStats.GetUsedInfo(physicalID)
+	if usedInfo == nil || usedInfo.Version == statistics.PseudoVersion || !c.statsColl.ExistsRootStats(p.ID()) {
+		return
+	}
+	key := feedback.Key{PhysicalID: physicalID, Predicates: feedback.Predicates(c.evalCtx, conds)}
+	feedback.Corrections.Record(key, usedInfo.Version, p.StatsInfo().RowCount, float64(c.statsColl.GetPlanActRows(p.ID())))
+}
+
+// readerConditions returns the predicates on the table of the TiKV reader, if the reader only filters the rows of
+// the table, so its output rows are estimated by the selectivity of the predicates.
+func readerConditions(p base.PhysicalPlan) (physicalID int64, conds []expression.Expression, ok bool) {
+	var copPlans []base.PhysicalPlan
+	switch x := p.(type) {
+	case *plannercore.PhysicalTableReader:
+		if x.StoreType != kv.TiKV {
+			return 0, nil, false
+		}
+		copPlans = x.TablePlans
+	case *plannercore.PhysicalIndexReader:
+		copPlans = x.IndexPlans
+	case *plannercore.PhysicalIndexLookUpReader:
+		if x.PushedLimit != nil {
+			return 0, nil, false
+		}
+		copPlans = append(slices.Clone(x.IndexPlans), x.TablePlans...)
+	default:
+		return 0, nil, false
+	}
+	for _, copPlan := range copPlans {
+		switch x := copPlan.(type) {
+		case *plannercore.PhysicalTableScan:
+			_, physicalID = x.IsPartition()
+			conds = append(conds, x.AccessCondition...)
+		case *plannercore.PhysicalIndexScan:
+			_, physicalID = x.IsPartition()
+			conds = append(conds, x.AccessCondition...)
+		case *plannercore.PhysicalSelection:
+			conds = append(conds, x.Conditions...)
+		default:
+			// the pushed down aggregation or limit changes the output rows.
+			return 0, nil, false
+		}
+	}
+	return physicalID, conds, len(conds) > 0
+}
+
+func (e *memtableRetriever) setDataFromCardinalityFeedback(ctx context.Context, sctx sessionctx.Context) error {
+	checker := privilege.GetPrivilegeManager(sctx)
+	loc := sctx.GetSessionVars().Location()
+	corrections := feedback.Corrections.All()
+	rows := make([][]types.Datum, 0, len(corrections))
+	for _, c := range corrections {
+		var partitionName any
+		tblInfo, dbInfo, partDef := e.is.FindTableInfoByPartitionID(c.PhysicalID)
+		if partDef != nil {
+			partitionName = partDef.Name.O
+		} else {
+			tbl, ok := e.is.TableByID(ctx, c.PhysicalID)
+			if !ok {
+				continue
+			}
+			tblInfo = tbl.Meta()
+			if dbInfo, ok = e.is.SchemaByID(tblInfo.DBID); !ok {
+				continue
+			}
+		}
+		if checker != nil && !checker.RequestVerification(sctx.GetSessionVars().ActiveRoles, dbInfo.Name.L, tblInfo.Name.L, "", mysql.AllPrivMask) {
+			continue
+		}
+		row := types.MakeDatums(
+			dbInfo.Name.O,
+			tblInfo.Name.O,
+			partitionName,
+			c.PhysicalID,
+			c.Predicates,
+			c.Factor,
+			c.EstRows,
+			c.ActRows,
+			c.Observations,
+			c.StatsVersion,
+			types.NewTime(types.FromGoTime(c.LastUpdate.In(loc)), mysql.TypeDatetime, 0),
+		)
+		rows = append(rows, row)
+		e.recordMemoryConsume(row)
+	}
+	e.rows = rows
+	return nil
+}
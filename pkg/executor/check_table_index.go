@@ -47,8 +47,8 @@ import (
 )
 
 // CheckTableExec represents a check table executor.
-// It is built from the "admin check table" statement, and it checks if the
-// index matches the records in the table.
+// It is built from the "admin check table" and "alter table ... check partition" statements,
+// and it checks if the index matches the records in the table.
 type CheckTableExec struct {
 	exec.BaseExecutor
 
@@ -61,6 +61,8 @@ type CheckTableExec struct {
 	exitCh     chan struct{}
 	retCh      chan error
 	checkIndex bool
+	// partitionIDs is the partitions to check, all the partitions are checked if it's empty.
+	partitionIDs []int64
 }
 
 var _ exec.Executor = &CheckTableExec{}
@@ -146,7 +148,8 @@ func (e *CheckTableExec) Next(ctx context.Context, _ *chunk.Chunk) error {
 		}
 		idxNames = append(idxNames, idx.Name.O)
 	}
-	greater, idxOffset, err := admin.CheckIndicesCount(e.Ctx(), e.dbName, e.table.Meta().Name.O, idxNames)
+	greater, idxOffset, err := admin.CheckIndicesCount(e.Ctx(), e.dbName, e.table.Meta().Name.O,
+		checkedPartitionNames(e.table.Meta(), e.partitionIDs), idxNames)
 	if err != nil {
 		// For admin check index statement, for speed up and compatibility, doesn't do below checks.
 		if e.checkIndex {
@@ -232,6 +235,9 @@ func (e *CheckTableExec) checkTableRecord(ctx context.Context, idxOffset int) er
 	info := e.table.Meta().GetPartitionInfo()
 	for _, def := range info.Definitions {
 		pid := def.ID
+		if len(e.partitionIDs) > 0 && !slices.Contains(e.partitionIDs, pid) {
+			continue
+		}
 		partition := e.table.(table.PartitionedTable).GetPartition(pid)
 		idx := tables.NewIndex(def.ID, e.table.Meta(), idxInfo)
 		if err := admin.CheckRecordAndIndex(ctx, e.Ctx(), txn, partition, idx); err != nil {
@@ -256,6 +262,8 @@ type FastCheckTableExec struct {
 	err        *atomic.Pointer[error]
 	wg         sync.WaitGroup
 	contextCtx context.Context
+	// partitionIDs is the partitions to check, all the partitions are checked if it's empty.
+	partitionIDs []int64
 }
 
 var _ exec.Executor = &FastCheckTableExec{}
@@ -353,6 +361,13 @@ func (w *checkIndexWorker) HandleTask(task checkIndexTask, _ func(workerpool.Non
 
 	tblMeta := w.table.Meta()
 	tblName := TableName(w.e.dbName, tblMeta.Name.String())
+	tblSource := tblName
+	if names := checkedPartitionNames(tblMeta, w.e.partitionIDs); len(names) > 0 {
+		for i := range names {
+			names[i] = ColumnName(names[i])
+		}
+		tblSource = fmt.Sprintf("%s partition(%s)", tblName, strings.Join(names, ","))
+	}
 
 	var pkCols []string
 	var pkTypes []*types.FieldType
@@ -425,10 +440,10 @@ func (w *checkIndexWorker) HandleTask(task checkIndexTask, _ func(workerpool.Non
 
 		tblQuery := fmt.Sprintf(
 			"select /*+ read_from_storage(tikv[%s]) */ bit_xor(%s), %s, count(*) from %s use index() where %s = 0 group by %s",
-			tblName, md5HandleAndIndexCol, groupByKey, tblName, whereKey, groupByKey)
+			tblName, md5HandleAndIndexCol, groupByKey, tblSource, whereKey, groupByKey)
 		idxQuery := fmt.Sprintf(
 			"select bit_xor(%s), %s, count(*) from %s use index(`%s`) where %s = 0 group by %s",
-			md5HandleAndIndexCol, groupByKey, tblName, idxInfo.Name, whereKey, groupByKey)
+			md5HandleAndIndexCol, groupByKey, tblSource, idxInfo.Name, whereKey, groupByKey)
 
 		logutil.BgLogger().Info(
 			"fast check table by group",
@@ -523,10 +538,10 @@ func (w *checkIndexWorker) HandleTask(task checkIndexTask, _ func(workerpool.Non
 		groupByKey := fmt.Sprintf("((cast(%s as signed) - %d) %% %d)", md5Handle, offset, mod)
 		indexSQL := fmt.Sprintf(
 			"select %s, %s, %s from %s use index(`%s`) where %s = 0 order by %s",
-			handleColumns, indexColumns, md5HandleAndIndexCol, tblName, idxInfo.Name, groupByKey, handleColumns)
+			handleColumns, indexColumns, md5HandleAndIndexCol, tblSource, idxInfo.Name, groupByKey, handleColumns)
 		tableSQL := fmt.Sprintf(
 			"select /*+ read_from_storage(tikv[%s]) */ %s, %s, %s from %s use index() where %s = 0 order by %s",
-			tblName, handleColumns, indexColumns, md5HandleAndIndexCol, tblName, groupByKey, handleColumns)
+			tblName, handleColumns, indexColumns, md5HandleAndIndexCol, tblSource, groupByKey, handleColumns)
 
 		idxRow, err := queryToRow(se, indexSQL)
 		if err != nil {
@@ -715,6 +730,19 @@ func getCheckSum(ctx context.Context, se sessionctx.Context, sql string) ([]grou
 	return checksums, nil
 }
 
+// checkedPartitionNames returns the names of the partitions to check, it's empty if all the partitions are checked.
+func checkedPartitionNames(tblInfo *model.TableInfo, partitionIDs []int64) []string {
+	pi := tblInfo.GetPartitionInfo()
+	if pi == nil || len(partitionIDs) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(partitionIDs))
+	for _, id := range partitionIDs {
+		names = append(names, pi.GetNameByID(id))
+	}
+	return names
+}
+
 // TableName returns `schema`.`table`
 func TableName(schema, table string) string {
 	return fmt.Sprintf("`%s`.`%s`", escapeName(schema), escapeName(table))
@@ -20,21 +20,30 @@ import (
 	"time"
 
 	"github.com/pingcap/errors"
+	"github.com/pingcap/failpoint"
+	"github.com/pingcap/kvproto/pkg/debugpb"
 	"github.com/pingcap/kvproto/pkg/kvrpcpb"
 	"github.com/pingcap/log"
+	"github.com/pingcap/tidb/pkg/config"
 	"github.com/pingcap/tidb/pkg/executor/internal/exec"
 	"github.com/pingcap/tidb/pkg/infoschema"
 	"github.com/pingcap/tidb/pkg/kv"
 	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/parser/terror"
 	"github.com/pingcap/tidb/pkg/sessionctx"
 	"github.com/pingcap/tidb/pkg/store/driver/backoff"
+	"github.com/pingcap/tidb/pkg/tablecodec"
 	"github.com/pingcap/tidb/pkg/util/chunk"
+	"github.com/pingcap/tidb/pkg/util/codec"
 	tikverr "github.com/tikv/client-go/v2/error"
 	"github.com/tikv/client-go/v2/tikv"
 	"github.com/tikv/client-go/v2/tikvrpc"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
 )
 
@@ -365,3 +374,129 @@ func (task *storeCompactTask) sendRequestWithRetry(req *tikvrpc.Request) (*kvrpc
 		return resp.Resp.(*kvrpcpb.CompactResponse), nil
 	}
 }
+
+var _ exec.Executor = &CompactTableTiKVExec{}
+
+// tikvCompactCFs are the column families to compact in TiKV. The lock CF is skipped because its data is short-lived.
+var tikvCompactCFs = []string{"default", "write"}
+
+// CompactTableTiKVExec represents an executor for "ALTER TABLE [NAME] OPTIMIZE PARTITION" statement.
+// It compacts the key ranges of the partitions in all TiKV stores.
+type CompactTableTiKVExec struct {
+	exec.BaseExecutor
+
+	tableInfo    *model.TableInfo
+	partitionIDs []int64
+	done         bool
+
+	tikvStore tikv.Storage
+}
+
+// Next implements the Executor Next interface.
+func (e *CompactTableTiKVExec) Next(ctx context.Context, chk *chunk.Chunk) error {
+	chk.Reset()
+	if e.done {
+		return nil
+	}
+	e.done = true
+	return e.doCompact(ctx)
+}
+
+func (e *CompactTableTiKVExec) doCompact(execCtx context.Context) error {
+	physicalIDs := e.partitionIDs
+	if len(physicalIDs) == 0 {
+		if pi := e.tableInfo.GetPartitionInfo(); pi != nil {
+			for _, def := range pi.Definitions {
+				physicalIDs = append(physicalIDs, def.ID)
+			}
+		} else {
+			physicalIDs = []int64{e.tableInfo.ID}
+		}
+	}
+
+	// The keys in the RocksDB of TiKV are the memcomparable encoded keys with the data prefix 'z'.
+	// The ranges cover all the versions of the keys because the timestamps are appended after the encoded keys.
+	reqs := make([]*debugpb.CompactRequest, 0, len(physicalIDs)*len(tikvCompactCFs))
+	for _, physicalID := range physicalIDs {
+		prefix := tablecodec.GenTablePrefix(physicalID)
+		start, end := e.tikvStore.GetCodec().EncodeRange(prefix, prefix.PrefixNext())
+		for _, cf := range tikvCompactCFs {
+			reqs = append(reqs, &debugpb.CompactRequest{
+				Db:                        debugpb.DB_KV,
+				Cf:                        cf,
+				FromKey:                   append([]byte{'z'}, codec.EncodeBytes(nil, start)...),
+				ToKey:                     append([]byte{'z'}, codec.EncodeBytes(nil, end)...),
+				BottommostLevelCompaction: debugpb.BottommostLevelCompaction_IfHaveCompactionFilter,
+			})
+		}
+	}
+
+	stores, err := infoschema.GetStoreServerInfo(e.tikvStore)
+	if err != nil {
+		return err
+	}
+	vars := e.Ctx().GetSessionVars()
+	g, ctx := errgroup.WithContext(execCtx)
+	for _, store := range stores {
+		if store.ServerType != kv.TiKV.Name() {
+			continue
+		}
+		address := store.Address
+		g.Go(func() error {
+			start := time.Now()
+			if err := compactTiKVStore(ctx, address, reqs); err != nil {
+				log.Warn("Compact table failed in a TiKV store",
+					zap.String("table", e.tableInfo.Name.O),
+					zap.Int64s("physical-table-id", physicalIDs),
+					zap.String("store-address", address),
+					zap.Error(err))
+				vars.StmtCtx.AppendWarning(errors.NewNoStackErrorf("compact on store %s failed: %v", address, err))
+				return nil
+			}
+			log.Info("Compact table finished in a TiKV store",
+				zap.Duration("elapsed", time.Since(start)),
+				zap.String("table", e.tableInfo.Name.O),
+				zap.Int64s("physical-table-id", physicalIDs),
+				zap.String("store-address", address))
+			return nil
+		})
+	}
+	// Errors have been turned into warnings, so the compaction in other stores isn't stopped.
+	return g.Wait()
+}
+
+// compactTiKVStore sends the compact requests to the debug service of the TiKV store one by one.
+func compactTiKVStore(ctx context.Context, address string, reqs []*debugpb.CompactRequest) error {
+	var mockCompacted bool
+	failpoint.InjectCall("mockCompactTiKVStore", address, reqs, &mockCompacted)
+	if mockCompacted {
+		return nil
+	}
+
+	opt := grpc.WithTransportCredentials(insecure.NewCredentials())
+	security := config.GetGlobalConfig().Security
+	if len(security.ClusterSSLCA) != 0 {
+		clusterSecurity := security.ClusterSecurity()
+		tlsConfig, err := clusterSecurity.ToTLSConfig()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		opt = grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))
+	}
+	conn, err := grpc.Dial(address, opt)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer terror.Call(conn.Close)
+
+	cli := debugpb.NewDebugClient(conn)
+	for _, req := range reqs {
+		reqCtx, cancel := context.WithTimeout(ctx, compactRequestTimeout)
+		_, err = cli.Compact(reqCtx, req)
+		cancel()
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
@@ -22,11 +22,17 @@ import (
 	"time"
 
 	"github.com/pingcap/errors"
+	"github.com/pingcap/kvproto/pkg/debugpb"
 	"github.com/pingcap/kvproto/pkg/kvrpcpb"
 	"github.com/pingcap/kvproto/pkg/metapb"
+	"github.com/pingcap/tidb/pkg/errno"
 	"github.com/pingcap/tidb/pkg/store/mockstore"
 	"github.com/pingcap/tidb/pkg/store/mockstore/unistore"
+	"github.com/pingcap/tidb/pkg/tablecodec"
 	"github.com/pingcap/tidb/pkg/testkit"
+	"github.com/pingcap/tidb/pkg/testkit/external"
+	"github.com/pingcap/tidb/pkg/testkit/testfailpoint"
+	"github.com/pingcap/tidb/pkg/util/codec"
 	"github.com/pingcap/tidb/pkg/util/syncutil"
 	"github.com/stretchr/testify/require"
 	"github.com/tikv/client-go/v2/testutils"
@@ -899,3 +905,43 @@ func (client *compactRequestMocker) AsOpt() mockstore.MockTiKVStoreOption {
 		return client
 	})
 }
+
+func TestOptimizePartition(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int) partition by range(a) (partition p0 values less than (10), partition p1 values less than (20))")
+	pi := external.GetTableByName(t, tk, "test", "t").Meta().GetPartitionInfo()
+
+	var mu syncutil.Mutex
+	var reqs []*debugpb.CompactRequest
+	testfailpoint.EnableCall(t, "github.com/pingcap/tidb/pkg/executor/mockCompactTiKVStore",
+		func(_ string, storeReqs []*debugpb.CompactRequest, mocked *bool) {
+			mu.Lock()
+			defer mu.Unlock()
+			reqs = append(reqs, storeReqs...)
+			*mocked = true
+		})
+	dataKey := func(physicalID int64) []byte {
+		return append([]byte{'z'}, codec.EncodeBytes(nil, tablecodec.GenTablePrefix(physicalID))...)
+	}
+
+	tk.MustExec("alter table t optimize partition p1")
+	require.Len(t, reqs, 2)
+	for i, cf := range []string{"default", "write"} {
+		require.Equal(t, debugpb.DB_KV, reqs[i].Db)
+		require.Equal(t, cf, reqs[i].Cf)
+		require.Equal(t, dataKey(pi.Definitions[1].ID), reqs[i].FromKey)
+		require.Equal(t, dataKey(pi.Definitions[1].ID+1), reqs[i].ToKey)
+	}
+
+	reqs = nil
+	tk.MustExec("alter table t optimize partition all")
+	require.Len(t, reqs, 4)
+	require.Equal(t, dataKey(pi.Definitions[0].ID), reqs[0].FromKey)
+	require.Equal(t, dataKey(pi.Definitions[1].ID), reqs[2].FromKey)
+
+	tk.MustGetErrCode("alter table t optimize partition p2", errno.ErrUnknownPartition)
+	tk.MustExec("create table t2 (a int)")
+	tk.MustGetErrCode("alter table t2 optimize partition all", errno.ErrPartitionMgmtOnNonpartitioned)
+}
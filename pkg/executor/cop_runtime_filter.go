@@ -0,0 +1,140 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+	"slices"
+
+	"github.com/pingcap/tidb/pkg/executor/internal/exec"
+	"github.com/pingcap/tidb/pkg/executor/join"
+	"github.com/pingcap/tidb/pkg/expression"
+	plannercore "github.com/pingcap/tidb/pkg/planner/core"
+	"github.com/pingcap/tidb/pkg/planner/core/base"
+	"github.com/pingcap/tidb/pkg/planner/planctx"
+	"github.com/pingcap/tipb/go-tipb"
+)
+
+// copRuntimeFilters are the runtime filters pushed into a TiKV reader by the hash join on top of it. The reader
+// delays sending the coprocessor requests from Open to the first Next, and waits for the filters to be built there.
+type copRuntimeFilters struct {
+	filters []*join.CopRuntimeFilter
+	// pending means the coprocessor requests are not sent yet.
+	pending bool
+	// empty means no row of the reader can be joined, so no request needs to be sent.
+	empty bool
+	// placeholder is the selection planned only for the runtime filters, it's removed from the coprocessor requests
+	// when no filter is built, and selIdx is its position in the plans of the reader.
+	placeholder *tipb.Executor
+	selIdx      int
+	removed     bool
+}
+
+// reset is called in the Open of the reader, it returns whether the coprocessor requests should be delayed.
+func (rfs *copRuntimeFilters) reset() bool {
+	rfs.pending, rfs.empty = len(rfs.filters) > 0, false
+	return rfs.pending
+}
+
+// apply waits for the runtime filters, and appends them to the conditions of the pushed down selection, which is
+// always planned for the reader with the runtime filters. The selection without any condition is removed.
+func (rfs *copRuntimeFilters) apply(ctx context.Context, buildPBCtx *planctx.BuildPBContext, dagPB *tipb.DAGRequest, plans []base.PhysicalPlan) error {
+	rfs.pending = false
+	var conds []expression.Expression
+	for _, rf := range rfs.filters {
+		rfConds, empty, err := rf.Wait(ctx)
+		if err != nil {
+			return err
+		}
+		if empty {
+			rfs.empty = true
+			return nil
+		}
+		conds = append(conds, rfConds...)
+	}
+	pbConds, err := expression.ExpressionsToPBList(buildPBCtx.GetExprCtx().GetEvalCtx(), conds, buildPBCtx.GetClient())
+	if err != nil {
+		// the runtime filters are only used to reduce the scanned rows, so the query goes on without them.
+		pbConds = nil
+	}
+	for i, p := range plans {
+		sel, ok := p.(*plannercore.PhysicalSelection)
+		if !ok {
+			continue
+		}
+		if len(dagPB.Executors) < len(plans) {
+			// the placeholder removed by the last execution is added back.
+			dagPB.Executors = slices.Insert(dagPB.Executors, i, rfs.placeholder)
+		}
+		rfs.selIdx, rfs.removed = i, false
+		// the filters appended by the last execution are overwritten.
+		n := len(sel.Conditions)
+		if n == 0 && len(pbConds) == 0 {
+			rfs.placeholder, rfs.removed = dagPB.Executors[i], true
+			dagPB.Executors = slices.Delete(dagPB.Executors, i, i+1)
+			break
+		}
+		dagPB.Executors[i].Selection.Conditions = append(dagPB.Executors[i].Selection.Conditions[:n:n], pbConds...)
+		break
+	}
+	return nil
+}
+
+// planIDs returns the IDs of the plans in the coprocessor requests, which are matched with the execution summaries.
+func (rfs *copRuntimeFilters) planIDs(plans []base.PhysicalPlan) []int {
+	ids := getPhysicalPlanIDs(plans)
+	if rfs.removed {
+		ids = slices.Delete(ids, rfs.selIdx, rfs.selIdx+1)
+	}
+	return ids
+}
+
+// setCopRuntimeFilters registers the runtime filters of the hash join to the TiKV reader of its probe side, and
+// returns the filters registered.
+func setCopRuntimeFilters(v *plannercore.PhysicalHashJoin, probeSideExec exec.Executor) []*join.CopRuntimeFilter {
+	var res []*join.CopRuntimeFilter
+	for _, rf := range v.CopRuntimeFilters() {
+		rfs := findCopRuntimeFilters(probeSideExec, rf.TargetReaderID())
+		if rfs == nil {
+			continue
+		}
+		copRF := join.NewCopRuntimeFilter(rf)
+		rfs.filters = append(rfs.filters, copRF)
+		res = append(res, copRF)
+	}
+	return res
+}
+
+// findCopRuntimeFilters finds the reader the runtime filter targets, through the selections kept in TiDB.
+func findCopRuntimeFilters(e exec.Executor, readerID int) *copRuntimeFilters {
+	for {
+		switch x := e.(type) {
+		case *SelectionExec:
+			e = x.Children(0)
+		case *TableReaderExecutor:
+			if x.ID() != readerID {
+				return nil
+			}
+			return &x.runtimeFilters
+		case *IndexReaderExecutor:
+			if x.ID() != readerID {
+				return nil
+			}
+			return &x.runtimeFilters
+		default:
+			return nil
+		}
+	}
+}
@@ -109,7 +109,7 @@ func (e *DDLExec) Next(ctx context.Context, _ *chunk.Chunk) (err error) {
 			return e.createSessionTemporaryTable(s)
 		}
 	case *ast.DropTableStmt:
-		if s.IsView {
+		if s.IsView || s.IsMaterializedView {
 			break
 		}
 
@@ -172,13 +172,17 @@ func (e *DDLExec) Next(ctx context.Context, _ *chunk.Chunk) (err error) {
 		err = e.executeCreateTable(x)
 	case *ast.CreateViewStmt:
 		err = e.executeCreateView(ctx, x)
+	case *ast.CreateMaterializedViewStmt:
+		err = e.executeCreateMaterializedView(ctx, x)
 	case *ast.DropIndexStmt:
 		err = e.executeDropIndex(x)
 	case *ast.DropDatabaseStmt:
-		err = e.executeDropDatabase(x)
+		err = e.executeDropDatabase(ctx, x)
 	case *ast.DropTableStmt:
 		if x.IsView {
 			err = e.executeDropView(x)
+		} else if x.IsMaterializedView {
+			err = e.ddlExecutor.DropMaterializedView(e.Ctx(), x)
 		} else {
 			err = e.executeDropTable(x)
 			if err == nil {
@@ -227,6 +231,18 @@ func (e *DDLExec) Next(ctx context.Context, _ *chunk.Chunk) (err error) {
 		err = e.executeDropResourceGroup(x)
 	case *ast.AlterResourceGroupStmt:
 		err = e.executeAlterResourceGroup(x)
+	case *ast.CreateMaskingPolicyStmt:
+		err = e.ddlExecutor.CreateMaskingPolicy(e.Ctx(), x)
+	case *ast.DropMaskingPolicyStmt:
+		err = e.ddlExecutor.DropMaskingPolicy(e.Ctx(), x)
+	case *ast.CreateRowPolicyStmt:
+		err = e.ddlExecutor.CreateRowPolicy(e.Ctx(), x)
+	case *ast.DropRowPolicyStmt:
+		err = e.ddlExecutor.DropRowPolicy(e.Ctx(), x)
+	case *ast.CreateTriggerStmt:
+		err = e.executeCreateTrigger(x)
+	case *ast.DropTriggerStmt:
+		err = e.ddlExecutor.DropTrigger(e.Ctx(), x)
 	}
 	if err != nil {
 		// If the owner return ErrTableNotExists error when running this DDL, it may be caused by schema changed,
@@ -248,6 +264,13 @@ func (e *DDLExec) Next(ctx context.Context, _ *chunk.Chunk) (err error) {
 	return nil
 }
 
+func (e *DDLExec) executeCreateTrigger(s *ast.CreateTriggerStmt) error {
+	if err := checkTriggerDefinition(s); err != nil {
+		return err
+	}
+	return e.ddlExecutor.CreateTrigger(e.Ctx(), s)
+}
+
 func (e *DDLExec) executeTruncateTable(s *ast.TruncateTableStmt) error {
 	ident := ast.Ident{Schema: s.Table.Schema, Name: s.Table.Name}
 	_, exist, err := e.getLocalTemporaryTable(s.Table.Schema, s.Table.Name)
@@ -257,8 +280,12 @@ func (e *DDLExec) executeTruncateTable(s *ast.TruncateTableStmt) error {
 	if exist {
 		return e.tempTableDDL.TruncateLocalTemporaryTable(s.Table.Schema, s.Table.Name)
 	}
+	preservedTempTableIDs := e.getPreservedTemporaryTableIDs([]*ast.TableName{s.Table})
 	err = e.ddlExecutor.TruncateTable(e.Ctx(), ident)
-	return err
+	if err != nil {
+		return err
+	}
+	return e.releasePreservedTemporaryTables(preservedTempTableIDs)
 }
 
 func (e *DDLExec) executeRenameTable(s *ast.RenameTableStmt) error {
@@ -350,7 +377,7 @@ func (e *DDLExec) executeCreateIndex(s *ast.CreateIndexStmt) error {
 	return e.ddlExecutor.CreateIndex(e.Ctx(), s)
 }
 
-func (e *DDLExec) executeDropDatabase(s *ast.DropDatabaseStmt) error {
+func (e *DDLExec) executeDropDatabase(ctx context.Context, s *ast.DropDatabaseStmt) error {
 	dbName := s.Name
 
 	// Protect important system table from been dropped by a mistake.
@@ -360,6 +387,12 @@ func (e *DDLExec) executeDropDatabase(s *ast.DropDatabaseStmt) error {
 	}
 
 	err := e.ddlExecutor.DropSchema(e.Ctx(), s)
+	if err == nil {
+		// The events of the schema are dropped with it.
+		if scheduler, schedErr := getEventScheduler(e.Ctx()); schedErr == nil {
+			err = scheduler.DropEventsInSchema(ctx, dbName.L)
+		}
+	}
 	sessionVars := e.Ctx().GetSessionVars()
 	if err == nil && strings.ToLower(sessionVars.CurrentDB) == dbName.L {
 		sessionVars.CurrentDB = ""
@@ -376,7 +409,40 @@ func (e *DDLExec) executeDropDatabase(s *ast.DropDatabaseStmt) error {
 }
 
 func (e *DDLExec) executeDropTable(s *ast.DropTableStmt) error {
-	return e.ddlExecutor.DropTable(e.Ctx(), s)
+	preservedTempTableIDs := e.getPreservedTemporaryTableIDs(s.Tables)
+	err := e.ddlExecutor.DropTable(e.Ctx(), s)
+	if err != nil {
+		return err
+	}
+	return e.releasePreservedTemporaryTables(preservedTempTableIDs)
+}
+
+// getPreservedTemporaryTableIDs returns the IDs of the global temporary tables with ON COMMIT PRESERVE ROWS
+// in tables, the session releases them after they are dropped or truncated. The other sessions release
+// them when they start new transactions, see SessionVars.ReleaseDroppedPreservedTemporaryTables.
+func (e *DDLExec) getPreservedTemporaryTableIDs(tables []*ast.TableName) []int64 {
+	var ids []int64
+	is := e.Ctx().GetInfoSchema().(infoschema.InfoSchema)
+	for _, tn := range tables {
+		tbl, err := is.TableByName(context.Background(), tn.Schema, tn.Name)
+		if err != nil {
+			continue
+		}
+		if tblInfo := tbl.Meta(); tblInfo.TempTableType == model.TempTableGlobal && tblInfo.OnCommitPreserveRows {
+			ids = append(ids, tblInfo.ID)
+		}
+	}
+	return ids
+}
+
+func (e *DDLExec) releasePreservedTemporaryTables(ids []int64) error {
+	sessVars := e.Ctx().GetSessionVars()
+	for _, id := range ids {
+		if err := sessVars.ReleasePreservedTemporaryTable(id); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (e *DDLExec) executeDropView(s *ast.DropTableStmt) error {
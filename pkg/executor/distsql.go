@@ -240,6 +240,9 @@ type IndexReaderExecutor struct {
 
 	selectResultHook // for testing
 
+	// runtimeFilters are the runtime filters pushed down from the hash join on top of the reader.
+	runtimeFilters copRuntimeFilters
+
 	// If dummy flag is set, this is not a real IndexReader, it just provides the KV ranges for UnionScan.
 	// Used by the temporary table, cached table.
 	dummy bool
@@ -277,6 +280,21 @@ func (e *IndexReaderExecutor) Next(ctx context.Context, req *chunk.Chunk) error
 		req.Reset()
 		return nil
 	}
+	if e.runtimeFilters.pending {
+		if err := e.runtimeFilters.apply(ctx, e.buildPBCtx, e.dagPB, e.plans); err != nil {
+			return err
+		}
+		if !e.runtimeFilters.empty {
+			if err := e.openResult(ctx, e.kvRanges); err != nil {
+				return err
+			}
+		}
+	}
+	if e.runtimeFilters.empty {
+		// no row can be joined by the hash join on top of the reader.
+		req.Reset()
+		return nil
+	}
 
 	return e.result.Next(ctx, req)
 }
@@ -377,13 +395,21 @@ func (e *IndexReaderExecutor) open(ctx context.Context, kvRanges []kv.KeyRange)
 	slices.SortFunc(kvRanges, func(i, j kv.KeyRange) int {
 		return bytes.Compare(i.StartKey, j.StartKey)
 	})
+	if e.runtimeFilters.reset() {
+		// the requests are sent in Next after the runtime filters are built.
+		return nil
+	}
+	return e.openResult(ctx, kvRanges)
+}
+
+func (e *IndexReaderExecutor) openResult(ctx context.Context, kvRanges []kv.KeyRange) error {
 	// use sortedSelectResults only when byItems pushed down and partition numbers > 1
 	if e.byItems == nil || len(e.partitions) <= 1 {
 		kvReq, err := e.buildKVReq(kvRanges)
 		if err != nil {
 			return err
 		}
-		e.result, err = e.SelectResult(ctx, e.dctx, kvReq, exec.RetTypes(e), getPhysicalPlanIDs(e.plans), e.ID())
+		e.result, err = e.SelectResult(ctx, e.dctx, kvReq, exec.RetTypes(e), e.runtimeFilters.planIDs(e.plans), e.ID())
 		if err != nil {
 			return err
 		}
@@ -398,7 +424,7 @@ func (e *IndexReaderExecutor) open(ctx context.Context, kvRanges []kv.KeyRange)
 		}
 		var results []distsql.SelectResult
 		for _, kvReq := range kvReqs {
-			result, err := e.SelectResult(ctx, e.dctx, kvReq, exec.RetTypes(e), getPhysicalPlanIDs(e.plans), e.ID())
+			result, err := e.SelectResult(ctx, e.dctx, kvReq, exec.RetTypes(e), e.runtimeFilters.planIDs(e.plans), e.ID())
 			if err != nil {
 				return err
 			}
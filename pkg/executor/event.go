@@ -0,0 +1,435 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/domain"
+	"github.com/pingcap/tidb/pkg/eventscheduler"
+	"github.com/pingcap/tidb/pkg/infoschema"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/auth"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	plannerutil "github.com/pingcap/tidb/pkg/planner/util"
+	"github.com/pingcap/tidb/pkg/privilege"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/sessionctx/vardef"
+	timerapi "github.com/pingcap/tidb/pkg/timer/api"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/dbterror/exeerrors"
+)
+
+func getEventScheduler(sctx sessionctx.Context) (*eventscheduler.Scheduler, error) {
+	if dom := domain.GetDomain(sctx); dom != nil {
+		if s := dom.EventScheduler(); s != nil {
+			return s, nil
+		}
+	}
+	return nil, errors.New("event scheduler is not available")
+}
+
+func (e *SimpleExec) executeCreateEvent(ctx context.Context, s *ast.CreateEventStmt) error {
+	sessVars := e.Ctx().GetSessionVars()
+	dbName := s.EventName.Schema
+	if dbName.L == "" {
+		dbName = ast.NewCIStr(sessVars.CurrentDB)
+	}
+	db, ok := e.is.SchemaByName(dbName)
+	if !ok {
+		return infoschema.ErrDatabaseNotExists.GenWithStackByArgs(dbName.O)
+	}
+	scheduler, err := getEventScheduler(e.Ctx())
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().Truncate(time.Second)
+	event := &eventscheduler.Event{
+		Schema:            db.Name.O,
+		Name:              s.EventName.Name.O,
+		Preserve:          s.OnCompletion == ast.EventCompletionPreserve,
+		Status:            eventscheduler.EventStatusEnabled,
+		Comment:           s.Comment,
+		DatabaseCollation: db.Collate,
+		Created:           now,
+		LastAltered:       now,
+	}
+	if s.Status != ast.EventStatusUnspecified {
+		event.Status = s.Status.String()
+	}
+	e.setEventDefiner(event, s.Definer)
+	if err = e.setEventSchedule(ctx, event, s.Schedule, now); err != nil {
+		return err
+	}
+	if err = e.setEventBody(ctx, event, s.Body); err != nil {
+		return err
+	}
+
+	if eventExpired(event, now) {
+		if !event.Preserve {
+			// The event is dropped immediately after creation like MySQL, so it's not created at all.
+			sessVars.StmtCtx.AppendNote(exeerrors.ErrEventCannotCreateInThePast)
+			return nil
+		}
+		sessVars.StmtCtx.AppendWarning(exeerrors.ErrEventExecTimeInThePast)
+		event.Status = eventscheduler.EventStatusDisabled
+	}
+
+	err = scheduler.CreateEvent(ctx, event)
+	if errors.ErrorEqual(err, timerapi.ErrTimerExists) {
+		err = exeerrors.ErrEventAlreadyExists.FastGenByArgs(event.Name)
+		if s.IfNotExists {
+			sessVars.StmtCtx.AppendNote(err)
+			return nil
+		}
+	}
+	return err
+}
+
+func (e *SimpleExec) executeAlterEvent(ctx context.Context, s *ast.AlterEventStmt) error {
+	sessVars := e.Ctx().GetSessionVars()
+	dbName := s.EventName.Schema
+	if dbName.L == "" {
+		dbName = ast.NewCIStr(sessVars.CurrentDB)
+	}
+	scheduler, err := getEventScheduler(e.Ctx())
+	if err != nil {
+		return err
+	}
+	name := s.EventName.Name
+	old, err := scheduler.GetEvent(ctx, dbName.L, name.L)
+	if errors.ErrorEqual(err, timerapi.ErrTimerNotExist) {
+		return exeerrors.ErrEventDoesNotExist.GenWithStackByArgs(name.O)
+	}
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().Truncate(time.Second)
+	event := old.Clone()
+	event.LastAltered = now
+	if s.Definer != nil {
+		e.setEventDefiner(event, s.Definer)
+	}
+	if s.Schedule != nil {
+		if err = e.setEventSchedule(ctx, event, s.Schedule, now); err != nil {
+			return err
+		}
+	}
+	if s.OnCompletion != ast.EventCompletionUnspecified {
+		event.Preserve = s.OnCompletion == ast.EventCompletionPreserve
+	}
+	if s.Status != ast.EventStatusUnspecified {
+		event.Status = s.Status.String()
+	}
+	if s.Comment != nil {
+		event.Comment = *s.Comment
+	}
+	if s.Body != nil {
+		if err = e.setEventBody(ctx, event, s.Body); err != nil {
+			return err
+		}
+	}
+	if s.NewName != nil {
+		newDBName := s.NewName.Schema
+		if newDBName.L == "" {
+			newDBName = ast.NewCIStr(sessVars.CurrentDB)
+		}
+		if newDBName.L == dbName.L && s.NewName.Name.L == name.L {
+			return exeerrors.ErrEventSameName
+		}
+		db, ok := e.is.SchemaByName(newDBName)
+		if !ok {
+			return infoschema.ErrDatabaseNotExists.GenWithStackByArgs(newDBName.O)
+		}
+		event.Schema, event.Name, event.DatabaseCollation = db.Name.O, s.NewName.Name.O, db.Collate
+	}
+
+	// The missed executions are skipped when the schedule is changed or the event is enabled again.
+	resetSchedule := s.Schedule != nil || (event.Enabled() && !old.Enabled())
+	if resetSchedule && event.Enabled() && eventExpired(event, now) {
+		if !event.Preserve {
+			return exeerrors.ErrEventCannotAlterInThePast
+		}
+		sessVars.StmtCtx.AppendWarning(exeerrors.ErrEventExecTimeInThePast)
+		event.Status = eventscheduler.EventStatusDisabled
+	}
+
+	err = scheduler.UpdateEvent(ctx, dbName.L, name.L, event, resetSchedule)
+	switch {
+	case errors.ErrorEqual(err, timerapi.ErrTimerExists):
+		return exeerrors.ErrEventAlreadyExists.GenWithStackByArgs(event.Name)
+	case errors.ErrorEqual(err, timerapi.ErrTimerNotExist):
+		return exeerrors.ErrEventDoesNotExist.GenWithStackByArgs(name.O)
+	}
+	return err
+}
+
+func (e *SimpleExec) executeDropEvent(ctx context.Context, s *ast.DropEventStmt) error {
+	sessVars := e.Ctx().GetSessionVars()
+	dbName := s.EventName.Schema
+	if dbName.L == "" {
+		dbName = ast.NewCIStr(sessVars.CurrentDB)
+	}
+	scheduler, err := getEventScheduler(e.Ctx())
+	if err != nil {
+		return err
+	}
+	name := s.EventName.Name
+	err = scheduler.DropEvent(ctx, dbName.L, name.L)
+	if errors.ErrorEqual(err, timerapi.ErrTimerNotExist) {
+		err = exeerrors.ErrEventDoesNotExist.FastGenByArgs(name.O)
+		if s.IfExists {
+			sessVars.StmtCtx.AppendNote(err)
+			return nil
+		}
+	}
+	return err
+}
+
+func (e *SimpleExec) setEventDefiner(event *eventscheduler.Event, definer *auth.UserIdentity) {
+	if definer == nil || definer.CurrentUser {
+		if user := e.Ctx().GetSessionVars().User; user != nil {
+			event.DefinerUser, event.DefinerHost = user.AuthUsername, user.AuthHostname
+		}
+		return
+	}
+	event.DefinerUser, event.DefinerHost = definer.Username, definer.Hostname
+}
+
+// setEventSchedule evaluates the schedule in the session time zone, which is also used to execute the event.
+func (e *SimpleExec) setEventSchedule(ctx context.Context, event *eventscheduler.Event, schedule *ast.EventSchedule, now time.Time) error {
+	timeZone, err := e.Ctx().GetSessionVars().GetSessionOrGlobalSystemVar(ctx, vardef.TimeZone)
+	if err != nil {
+		return err
+	}
+	event.TimeZone = timeZone
+	event.ExecuteAt, event.IntervalValue, event.IntervalField = time.Time{}, "", ""
+	event.Starts, event.Ends = time.Time{}, time.Time{}
+
+	if schedule.At != nil {
+		event.ExecuteAt, err = e.evalEventTime(schedule.At)
+		return err
+	}
+
+	val, err := plannerutil.EvalAstExprWithPlanCtx(e.Ctx().GetPlanCtx(), schedule.Every)
+	if err != nil {
+		return err
+	}
+	if val.IsNull() {
+		return exeerrors.ErrEventIntervalNotPositiveOrTooBig
+	}
+	if event.IntervalValue, err = val.ToString(); err != nil {
+		return err
+	}
+	event.IntervalField = schedule.Unit.String()
+	if _, _, err = eventscheduler.ParseInterval(event.IntervalValue, event.IntervalField); err != nil {
+		return err
+	}
+
+	event.Starts = now
+	if schedule.Starts != nil {
+		if event.Starts, err = e.evalEventTime(schedule.Starts); err != nil {
+			return err
+		}
+	}
+	if schedule.Ends != nil {
+		if event.Ends, err = e.evalEventTime(schedule.Ends); err != nil {
+			return err
+		}
+		if event.Ends.Before(event.Starts) {
+			return exeerrors.ErrEventEndsBeforeStarts
+		}
+	}
+	return nil
+}
+
+func (e *SimpleExec) evalEventTime(expr ast.ExprNode) (time.Time, error) {
+	sessVars := e.Ctx().GetSessionVars()
+	val, err := plannerutil.EvalAstExprWithPlanCtx(e.Ctx().GetPlanCtx(), expr)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if val.IsNull() {
+		return time.Time{}, types.ErrWrongValue.GenWithStackByArgs(types.DateTimeStr, "NULL")
+	}
+	val, err = val.ConvertTo(sessVars.StmtCtx.TypeCtx(), types.NewFieldType(mysql.TypeDatetime))
+	if err != nil {
+		return time.Time{}, err
+	}
+	t, err := val.GetMysqlTime().GoTime(sessVars.Location())
+	if err != nil {
+		return time.Time{}, err
+	}
+	return t.Truncate(time.Second), nil
+}
+
+// setEventBody sets the body of the event with the session settings used to execute it.
+func (e *SimpleExec) setEventBody(ctx context.Context, event *eventscheduler.Event, body ast.StmtNode) error {
+	sessVars := e.Ctx().GetSessionVars()
+	charsetClient, err := sessVars.GetSessionOrGlobalSystemVar(ctx, vardef.CharacterSetClient)
+	if err != nil {
+		return err
+	}
+	_, collation := sessVars.GetCharsetInfo()
+	event.Body = body.Text()
+	event.SQLMode = sessVars.SQLMode
+	event.CharacterSetClient = charsetClient
+	event.CollationConnection = collation
+	return nil
+}
+
+// eventExpired returns whether the event will never be executed after `now`.
+func eventExpired(event *eventscheduler.Event, now time.Time) bool {
+	if event.IsOneTime() {
+		return event.ExecuteAt.Before(now)
+	}
+	return !event.Ends.IsZero() && event.Ends.Before(now)
+}
+
+// listVisibleEvents returns the events in the schema, or in all schemas if it's empty, on which the current user
+// has the EVENT privilege.
+func listVisibleEvents(ctx context.Context, sctx sessionctx.Context, schema string) ([]*eventscheduler.EventInfo, error) {
+	scheduler, err := getEventScheduler(sctx)
+	if err != nil {
+		return nil, err
+	}
+	events, err := scheduler.GetEvents(ctx, schema)
+	if err != nil {
+		return nil, err
+	}
+	checker := privilege.GetPrivilegeManager(sctx)
+	activeRoles := sctx.GetSessionVars().ActiveRoles
+	visible := events[:0]
+	for _, event := range events {
+		if checker != nil && !checker.RequestVerification(activeRoles, event.Schema, "", "", mysql.EventPriv) {
+			continue
+		}
+		visible = append(visible, event)
+	}
+	slices.SortFunc(visible, func(a, b *eventscheduler.EventInfo) int {
+		if c := cmp.Compare(a.Schema, b.Schema); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Name, b.Name)
+	})
+	return visible, nil
+}
+
+// eventTime converts the time of an event to a datetime in loc, it returns nil for the zero time.
+func eventTime(t time.Time, loc *time.Location) any {
+	if t.IsZero() {
+		return nil
+	}
+	return types.NewTime(types.FromGoTime(t.In(loc)), mysql.TypeDatetime, 0)
+}
+
+// eventScheduleTimes returns the EXECUTE AT, STARTS and ENDS of the event in its time zone.
+func eventScheduleTimes(event *eventscheduler.EventInfo) (executeAt, starts, ends any) {
+	loc, err := event.Location()
+	if err != nil {
+		loc = time.UTC
+	}
+	return eventTime(event.ExecuteAt, loc), eventTime(event.Starts, loc), eventTime(event.Ends, loc)
+}
+
+func eventCompletion(event *eventscheduler.EventInfo) string {
+	if event.Preserve {
+		return "PRESERVE"
+	}
+	return "NOT PRESERVE"
+}
+
+func (e *ShowExec) fetchShowEvents(ctx context.Context) error {
+	events, err := listVisibleEvents(ctx, e.Ctx(), e.DBName.O)
+	if err != nil {
+		return err
+	}
+	for _, event := range events {
+		executeAt, starts, ends := eventScheduleTimes(event)
+		e.appendRow([]any{
+			event.Schema,
+			event.Name,
+			event.TimeZone,
+			event.Definer(),
+			event.Type(),
+			executeAt,
+			nullIfEmpty(event.IntervalValue),
+			nullIfEmpty(event.IntervalField),
+			starts,
+			ends,
+			event.Status,
+			0,
+			event.CharacterSetClient,
+			event.CollationConnection,
+			event.DatabaseCollation,
+		})
+	}
+	return nil
+}
+
+func (e *memtableRetriever) setDataFromEvents(ctx context.Context, sctx sessionctx.Context) error {
+	events, err := listVisibleEvents(ctx, sctx, "")
+	if err != nil {
+		return err
+	}
+	loc := sctx.GetSessionVars().Location()
+	rows := make([][]types.Datum, 0, len(events))
+	for _, event := range events {
+		executeAt, starts, ends := eventScheduleTimes(event)
+		row := types.MakeDatums(
+			infoschema.CatalogVal,
+			event.Schema,
+			event.Name,
+			event.Definer(),
+			event.TimeZone,
+			"SQL",
+			event.Body,
+			event.Type(),
+			executeAt,
+			nullIfEmpty(event.IntervalValue),
+			nullIfEmpty(event.IntervalField),
+			event.SQLMode.String(),
+			starts,
+			ends,
+			event.Status,
+			eventCompletion(event),
+			eventTime(event.Created, loc),
+			eventTime(event.LastAltered, loc),
+			eventTime(event.LastExecuted, loc),
+			event.Comment,
+			0,
+			event.CharacterSetClient,
+			event.CollationConnection,
+			event.DatabaseCollation,
+		)
+		rows = append(rows, row)
+		e.recordMemoryConsume(row)
+	}
+	e.rows = rows
+	return nil
+}
+
+func nullIfEmpty(s string) any {
+	if s == "" {
+		return nil
+	}
+	return s
+}
@@ -549,7 +549,11 @@ func (e *GrantExec) grantDBLevel(priv *ast.PrivElem, user *ast.UserSpec, interna
 
 	ctx := kv.WithInternalSourceType(context.Background(), kv.InternalTxnPrivilege)
 	_, err = internalSession.GetSQLExecutor().ExecuteInternal(ctx, sql.String())
-	return err
+	if err != nil {
+		return err
+	}
+	// Granting the privileges on the database lifts the partial revokes of them.
+	return liftRestrictions(internalSession, user.User.Username, user.User.Hostname, dbName, dbPrivMask(priv.Priv))
 }
 
 // grantTableLevel manipulates mysql.tables_priv table.
@@ -793,6 +797,75 @@ func getTargetSchemaAndTable(ctx context.Context, sctx sessionctx.Context, dbNam
 	return dbName, tbl, nil
 }
 
+// getUserPrivAndRestrictions gets the global privileges and the partial revokes of them from mysql.User.
+func getUserPrivAndRestrictions(sctx sessionctx.Context, name string, host string) (mysql.PrivilegeType, privileges.Restrictions, error) {
+	ctx := kv.WithInternalSourceType(context.Background(), kv.InternalTxnPrivilege)
+	rs, err := sctx.GetSQLExecutor().ExecuteInternal(ctx, `SELECT * FROM %n.%n WHERE User=%? AND Host=%?`, mysql.SystemDB, mysql.UserTable, name, host)
+	if err != nil {
+		return 0, nil, err
+	}
+	rows, fields, err := getRowsAndFields(sctx, rs)
+	if err != nil {
+		return 0, nil, errors.Errorf("get user privilege fail for %s %s: %v", name, host, err)
+	}
+	if len(rows) < 1 {
+		return 0, nil, nil
+	}
+	var (
+		userPriv     mysql.PrivilegeType
+		restrictions privileges.Restrictions
+	)
+	row := rows[0]
+	for i, f := range fields {
+		if row.IsNull(i) {
+			continue
+		}
+		if f.ColumnAsName.L == "user_attributes" {
+			if err := restrictions.ParseJSON(row.GetJSON(i)); err != nil {
+				return 0, nil, err
+			}
+			continue
+		}
+		if f.Column.GetType() != mysql.TypeEnum || row.GetEnum(i).String() != "Y" {
+			continue
+		}
+		if priv, ok := mysql.NewPrivFromColumn(f.ColumnAsName.O); ok {
+			userPriv |= priv
+		}
+	}
+	return userPriv, restrictions, nil
+}
+
+// updateRestrictions saves the partial revokes of the global privileges into mysql.User.
+func updateRestrictions(sctx sessionctx.Context, name string, host string, restrictions privileges.Restrictions) error {
+	ctx := kv.WithInternalSourceType(context.Background(), kv.InternalTxnPrivilege)
+	_, err := sctx.GetSQLExecutor().ExecuteInternal(ctx, `UPDATE %n.%n SET user_attributes=json_merge_patch(coalesce(user_attributes, '{}'), %?) WHERE User=%? AND Host=%?`,
+		mysql.SystemDB, mysql.UserTable, restrictions.BuildJSON(), name, host)
+	return err
+}
+
+// liftRestrictions removes the privileges from the partial revokes on the database,
+// an empty db means the privileges are removed from the partial revokes on all databases.
+func liftRestrictions(sctx sessionctx.Context, name string, host string, db string, privs mysql.PrivilegeType) error {
+	_, restrictions, err := getUserPrivAndRestrictions(sctx, name, host)
+	if err != nil || len(restrictions) == 0 {
+		return err
+	}
+	return updateRestrictions(sctx, name, host, restrictions.Grant(db, privs))
+}
+
+// dbPrivMask returns the db scope privileges of priv, ALL means all of them.
+func dbPrivMask(priv mysql.PrivilegeType) mysql.PrivilegeType {
+	if priv != mysql.AllPriv {
+		return priv
+	}
+	var mask mysql.PrivilegeType
+	for _, p := range mysql.AllDBPrivs {
+		mask |= p
+	}
+	return mask
+}
+
 // getRowsAndFields is used to extract rows from record sets.
 func getRowsAndFields(sctx sessionctx.Context, rs sqlexec.RecordSet) ([]chunk.Row, []*resolve.ResultField, error) {
 	ctx := kv.WithInternalSourceType(context.Background(), kv.InternalTxnPrivilege)
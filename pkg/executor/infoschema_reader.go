@@ -229,10 +229,16 @@ func (e *memtableRetriever) retrieve(ctx context.Context, sctx sessionctx.Contex
 			err = e.setDataFromTiDBCheckConstraints(ctx, sctx)
 		case infoschema.TableKeywords:
 			err = e.setDataFromKeywords()
+		case infoschema.TableEvents:
+			err = e.setDataFromEvents(ctx, sctx)
+		case infoschema.TableTriggers:
+			err = e.setDataFromTriggers(ctx, sctx)
 		case infoschema.TableTiDBIndexUsage:
 			err = e.setDataFromIndexUsage(ctx, sctx)
 		case infoschema.ClusterTableTiDBIndexUsage:
 			err = e.setDataFromClusterIndexUsage(ctx, sctx)
+		case infoschema.TableCardinalityFeedback:
+			err = e.setDataFromCardinalityFeedback(ctx, sctx)
 		case infoschema.TableTiDBPlanCache:
 			err = e.setDataFromPlanCache(ctx, sctx, false)
 		case infoschema.ClusterTableTiDBPlanCache:
@@ -52,6 +52,9 @@ type InsertExec struct {
 	curInsertVals  chunk.MutRow
 	row4Update     []types.Datum
 
+	// rowPolicyUpdate checks the existing rows updated by ON DUPLICATE KEY UPDATE.
+	rowPolicyUpdate expression.Expression
+
 	Priority mysql.PriorityEnum
 }
 
@@ -449,6 +452,10 @@ func (e *InsertExec) doDupRowUpdate(
 	dupKeyMode table.DupKeyCheckMode,
 	autoColIdx int,
 ) error {
+	// The row invisible to the UPDATE policies can't be updated.
+	if err := e.evalRowPolicy(e.rowPolicyUpdate, oldRow); err != nil {
+		return err
+	}
 	assignFlag := make([]bool, len(e.Table.WritableCols()))
 	// See http://dev.mysql.com/doc/refman/5.7/en/miscellaneous-functions.html#function_values
 	e.curInsertVals.SetDatums(newRow...)
@@ -525,7 +532,7 @@ func (e *InsertExec) doDupRowUpdate(
 		handle, oldRow, newData,
 		0, generated, e.evalBuffer4Dup, errorHandler,
 		assignFlag, e.Table,
-		true, e.memTracker, e.fkChecks, e.fkCascades, dupKeyMode, e.ignoreErr)
+		true, e.memTracker, e.fkChecks, e.fkCascades, e.triggers, dupKeyMode, e.ignoreErr)
 
 	if ignored {
 		return nil
@@ -534,6 +541,10 @@ func (e *InsertExec) doDupRowUpdate(
 	if err != nil {
 		return errors.Trace(err)
 	}
+	// The updated row is checked as the inserted row, the error rolls back the statement.
+	if err = e.checkRowPolicy(newData); err != nil {
+		return err
+	}
 
 	if autoColIdx >= 0 {
 		if e.Ctx().GetSessionVars().StmtCtx.AffectedRows() > 0 {
@@ -96,6 +96,13 @@ type InsertValues struct {
 	fkChecks   []*FKCheckExec
 	fkCascades []*FKCascadeExec
 
+	// rowPolicyCheck checks the inserted rows by the row-level security policies.
+	rowPolicyCheck expression.Expression
+	// rowPolicyDelete checks the existing rows removed by REPLACE.
+	rowPolicyDelete expression.Expression
+	// triggers fires the row triggers of the table.
+	triggers *tableTriggers
+
 	ignoreErr bool
 }
 
@@ -693,6 +700,11 @@ func (e *InsertValues) fillRow(ctx context.Context, row []types.Datum, hasValue
 		}
 	}
 
+	// The BEFORE INSERT triggers can change the real columns, so the generated columns are evaluated after them.
+	if err := e.triggers.fire(ctx, e.Ctx(), ast.TriggerBefore, ast.TriggerInsert, nil, row); err != nil {
+		return nil, err
+	}
+
 	// Handle exchange partition
 	tbl := e.Table.Meta()
 	if tbl.ExchangePartitionInfo != nil && tbl.GetPartitionInfo() == nil {
@@ -1345,6 +1357,10 @@ func (e *InsertValues) removeRow(
 		}
 		return false, err
 	}
+	// The row invisible to the DELETE policies can't be removed.
+	if err = e.evalRowPolicy(e.rowPolicyDelete, oldRow); err != nil {
+		return false, err
+	}
 
 	identical, err := e.equalDatumsAsBinary(oldRow, newRow)
 	if err != nil {
@@ -1364,6 +1380,10 @@ func (e *InsertValues) removeRow(
 		return true, nil
 	}
 
+	// Like MySQL, REPLACE activates the DELETE triggers for the removed row.
+	if err = e.triggers.fire(ctx, e.Ctx(), ast.TriggerBefore, ast.TriggerDelete, oldRow, nil); err != nil {
+		return false, err
+	}
 	if ph, ok := handle.(kv.PartitionHandle); ok {
 		err = e.Table.(table.PartitionedTable).GetPartition(ph.PartitionID).RemoveRecord(e.Ctx().GetTableCtx(), txn, ph.Handle, oldRow)
 	} else {
@@ -1376,6 +1396,9 @@ func (e *InsertValues) removeRow(
 	if err != nil {
 		return false, err
 	}
+	if err = e.triggers.fire(ctx, e.Ctx(), ast.TriggerAfter, ast.TriggerDelete, oldRow, nil); err != nil {
+		return false, err
+	}
 	if inReplace {
 		e.Ctx().GetSessionVars().StmtCtx.AddAffectedRows(1)
 	} else {
@@ -1406,6 +1429,27 @@ func (e *InsertValues) addRecord(ctx context.Context, row []types.Datum, dupKeyC
 	return e.addRecordWithAutoIDHint(ctx, row, 0, dupKeyCheck)
 }
 
+// checkRowPolicy checks whether the row is allowed to be inserted by the row-level security policies.
+func (e *InsertValues) checkRowPolicy(row []types.Datum) error {
+	return e.evalRowPolicy(e.rowPolicyCheck, row)
+}
+
+// evalRowPolicy evaluates the expression of the row-level security policies on the row,
+// it returns an error if the row is not allowed.
+func (e *InsertValues) evalRowPolicy(expr expression.Expression, row []types.Datum) error {
+	if expr == nil {
+		return nil
+	}
+	ok, isNull, err := expr.EvalInt(e.Ctx().GetExprCtx().GetEvalCtx(), chunk.MutRowFromDatums(row).ToRow())
+	if err != nil {
+		return err
+	}
+	if ok == 0 || isNull {
+		return table.ErrRowPolicyViolated.FastGenByArgs(e.Table.Meta().Name.O)
+	}
+	return nil
+}
+
 func (e *InsertValues) addRecordWithAutoIDHint(
 	ctx context.Context, row []types.Datum, reserveAutoIDCount int, dupKeyCheck table.DupKeyCheckMode,
 ) (err error) {
@@ -1414,6 +1458,9 @@ func (e *InsertValues) addRecordWithAutoIDHint(
 	if err != nil {
 		return err
 	}
+	if err = e.checkRowPolicy(row); err != nil {
+		return err
+	}
 	pessimisticLazyCheck := getPessimisticLazyCheckMode(vars)
 	if reserveAutoIDCount > 0 {
 		_, err = e.Table.AddRecord(e.Ctx().GetTableCtx(), txn, row, table.WithCtx(ctx), table.WithReserveAutoIDHint(reserveAutoIDCount), dupKeyCheck, pessimisticLazyCheck)
@@ -1435,6 +1482,9 @@ func (e *InsertValues) addRecordWithAutoIDHint(
 			}
 		}
 	}
+	if err = e.triggers.fire(ctx, e.Ctx(), ast.TriggerAfter, ast.TriggerInsert, nil, row); err != nil {
+		return err
+	}
 
 	if e.Table.Meta().TTLInfo != nil {
 		// update the TTL metrics if the table is a TTL table
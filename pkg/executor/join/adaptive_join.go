@@ -0,0 +1,293 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package join
+
+import (
+	"bytes"
+	"context"
+	"strconv"
+
+	"github.com/pingcap/tidb/pkg/executor/internal/exec"
+	"github.com/pingcap/tidb/pkg/util/chunk"
+	"github.com/pingcap/tidb/pkg/util/execdetails"
+	"github.com/pingcap/tidb/pkg/util/memory"
+)
+
+var _ exec.Executor = &AdaptiveJoinExec{}
+
+// AdaptiveJoinType is the type of the runtime decision made by AdaptiveJoinExec.
+type AdaptiveJoinType int
+
+const (
+	// AdaptiveIndexJoin falls back from the index join to the hash join, which reads the whole inner side, when the
+	// outer side returns more rows than the threshold.
+	AdaptiveIndexJoin AdaptiveJoinType = iota
+	// AdaptiveHashJoin swaps the build side and the probe side of the inner hash join, when the build side returns
+	// more rows than the threshold and the probe side turns out to be smaller.
+	AdaptiveHashJoin
+)
+
+// AdaptiveJoinExec watches the actual row counts of its children and switches the join strategy before the join
+// starts. It buffers the rows read from the children, and the chosen join replays them before reading the rest.
+//
+//  1. For AdaptiveIndexJoin, the only child is the outer child. If the outer child returns more rows than the
+//     threshold, the index join lookups will be too many, so Alternative, a hash join building the hash table on the
+//     whole inner side, is used instead of Join.
+//  2. For AdaptiveHashJoin, the children are the left and right children of the hash join. If the build side returns
+//     more rows than the threshold, the probe side is read until it returns as many rows as the build side. If the
+//     probe side ends before that, Alternative, the hash join whose build side and probe side are swapped, is used.
+//
+// The memory used by the buffered rows is bounded by about twice the threshold.
+type AdaptiveJoinExec struct {
+	exec.BaseExecutor
+
+	Tp        AdaptiveJoinType
+	Threshold int
+	// BuildSideIdx is the index of the build side in the children, only used by AdaptiveHashJoin.
+	BuildSideIdx int
+
+	// Join is the planned join, and Alternative is the one to switch to. Their children are the Replays.
+	Join        exec.Executor
+	Alternative exec.Executor
+	// Replays wrap the children of AdaptiveJoinExec in the same order.
+	Replays []*ReplayExec
+
+	chosen     exec.Executor
+	memTracker *memory.Tracker
+	stats      *adaptiveJoinRuntimeStats
+}
+
+// Open implements the Executor Open interface.
+func (e *AdaptiveJoinExec) Open(ctx context.Context) error {
+	if err := e.BaseExecutor.Open(ctx); err != nil {
+		return err
+	}
+	e.memTracker = memory.NewTracker(e.ID(), -1)
+	e.memTracker.AttachTo(e.Ctx().GetSessionVars().StmtCtx.MemTracker)
+	for _, replay := range e.Replays {
+		replay.memTracker = e.memTracker
+	}
+	e.chosen = nil
+	e.stats = &adaptiveJoinRuntimeStats{tp: e.Tp, threshold: e.Threshold}
+	return nil
+}
+
+// Next implements the Executor Next interface.
+func (e *AdaptiveJoinExec) Next(ctx context.Context, req *chunk.Chunk) error {
+	if e.chosen == nil {
+		join, err := e.choose(ctx)
+		if err != nil {
+			return err
+		}
+		if err = join.Open(ctx); err != nil {
+			return err
+		}
+		e.chosen = join
+	}
+	// The chosen join shares the runtime stats with AdaptiveJoinExec, so call its Next directly to avoid
+	// recording the rows twice.
+	return e.chosen.Next(ctx, req)
+}
+
+func (e *AdaptiveJoinExec) choose(ctx context.Context) (exec.Executor, error) {
+	switch e.Tp {
+	case AdaptiveIndexJoin:
+		outer := e.Replays[0]
+		eof, err := outer.fill(ctx, e.Threshold)
+		if err != nil {
+			return nil, err
+		}
+		e.stats.rows[0], e.stats.exceeded[0] = outer.bufferedRows, !eof
+		if eof || e.Alternative == nil {
+			return e.Join, nil
+		}
+		e.stats.switched = true
+		return e.Alternative, nil
+	case AdaptiveHashJoin:
+		build, probe := e.Replays[e.BuildSideIdx], e.Replays[1-e.BuildSideIdx]
+		eof, err := build.fill(ctx, e.Threshold)
+		if err != nil {
+			return nil, err
+		}
+		e.stats.rows[0], e.stats.exceeded[0] = build.bufferedRows, !eof
+		if eof || e.Alternative == nil {
+			return e.Join, nil
+		}
+		eof, err = probe.fill(ctx, build.bufferedRows)
+		if err != nil {
+			return nil, err
+		}
+		e.stats.rows[1], e.stats.exceeded[1] = probe.bufferedRows, !eof
+		if !eof || probe.bufferedRows >= build.bufferedRows {
+			return e.Join, nil
+		}
+		e.stats.switched = true
+		return e.Alternative, nil
+	}
+	return e.Join, nil
+}
+
+// Close implements the Executor Close interface.
+func (e *AdaptiveJoinExec) Close() error {
+	var firstErr error
+	if e.chosen != nil {
+		firstErr = e.chosen.Close()
+		e.chosen = nil
+	}
+	for _, replay := range e.Replays {
+		replay.reset()
+	}
+	if err := e.BaseExecutor.Close(); err != nil && firstErr == nil {
+		firstErr = err
+	}
+	if e.RuntimeStats() != nil && e.stats != nil {
+		e.Ctx().GetSessionVars().StmtCtx.RuntimeStatsColl.RegisterStats(e.ID(), e.stats)
+	}
+	return firstErr
+}
+
+// ReplayExec wraps a child of AdaptiveJoinExec. It returns the rows buffered by AdaptiveJoinExec first, and then
+// reads the rest from the child. The child is opened and closed by AdaptiveJoinExec rather than the join above.
+type ReplayExec struct {
+	exec.Executor
+
+	buffered     []*chunk.Chunk
+	bufferedRows int
+	memTracker   *memory.Tracker
+}
+
+// NewReplayExec creates a new ReplayExec.
+func NewReplayExec(child exec.Executor) *ReplayExec {
+	return &ReplayExec{Executor: child}
+}
+
+// fill buffers the rows of the child until there are at least limit rows, it returns whether the child is drained.
+// The child's Next is called directly, the rows are recorded in its runtime stats when they are replayed.
+func (e *ReplayExec) fill(ctx context.Context, limit int) (bool, error) {
+	for e.bufferedRows < limit {
+		chk := exec.TryNewCacheChunk(e.Executor)
+		if err := e.Executor.Next(ctx, chk); err != nil {
+			return false, err
+		}
+		if chk.NumRows() == 0 {
+			return true, nil
+		}
+		e.buffered = append(e.buffered, chk)
+		e.bufferedRows += chk.NumRows()
+		e.memTracker.Consume(chk.MemoryUsage())
+	}
+	return false, nil
+}
+
+func (e *ReplayExec) reset() {
+	for _, chk := range e.buffered {
+		e.memTracker.Consume(-chk.MemoryUsage())
+	}
+	e.buffered = nil
+	e.bufferedRows = 0
+}
+
+// Open implements the Executor Open interface.
+func (*ReplayExec) Open(context.Context) error {
+	return nil
+}
+
+// Next implements the Executor Next interface.
+func (e *ReplayExec) Next(ctx context.Context, req *chunk.Chunk) error {
+	if len(e.buffered) == 0 {
+		return e.Executor.Next(ctx, req)
+	}
+	chk := e.buffered[0]
+	e.buffered = e.buffered[1:]
+	e.memTracker.Consume(-chk.MemoryUsage())
+	req.SwapColumns(chk)
+	return nil
+}
+
+// Close implements the Executor Close interface.
+func (*ReplayExec) Close() error {
+	return nil
+}
+
+type adaptiveJoinRuntimeStats struct {
+	tp        AdaptiveJoinType
+	threshold int
+	switched  bool
+	// rows and exceeded record the buffered rows of the outer side for AdaptiveIndexJoin, and the buffered rows of
+	// the build side and the probe side for AdaptiveHashJoin.
+	rows     [2]int
+	exceeded [2]bool
+}
+
+func writeAdaptiveJoinRows(buf *bytes.Buffer, name string, rows int, exceeded bool) {
+	buf.WriteString(", ")
+	buf.WriteString(name)
+	buf.WriteString(":")
+	if exceeded {
+		buf.WriteString(">=")
+	}
+	buf.WriteString(strconv.Itoa(rows))
+}
+
+func (e *adaptiveJoinRuntimeStats) String() string {
+	buf := bytes.NewBuffer(make([]byte, 0, 64))
+	buf.WriteString("adaptive:{")
+	switch e.tp {
+	case AdaptiveIndexJoin:
+		if e.switched {
+			buf.WriteString("switch_to:hash_join")
+		} else {
+			buf.WriteString("keep:index_join")
+		}
+		writeAdaptiveJoinRows(buf, "outer_rows", e.rows[0], e.exceeded[0])
+	case AdaptiveHashJoin:
+		if e.switched {
+			buf.WriteString("swap_build_side:true")
+		} else {
+			buf.WriteString("swap_build_side:false")
+		}
+		writeAdaptiveJoinRows(buf, "build_rows", e.rows[0], e.exceeded[0])
+		if e.exceeded[0] {
+			writeAdaptiveJoinRows(buf, "probe_rows", e.rows[1], e.exceeded[1])
+		}
+	}
+	buf.WriteString(", threshold:")
+	buf.WriteString(strconv.Itoa(e.threshold))
+	buf.WriteString("}")
+	return buf.String()
+}
+
+// Clone implements the RuntimeStats interface.
+func (e *adaptiveJoinRuntimeStats) Clone() execdetails.RuntimeStats {
+	newRs := *e
+	return &newRs
+}
+
+// Merge implements the RuntimeStats interface.
+func (e *adaptiveJoinRuntimeStats) Merge(rs execdetails.RuntimeStats) {
+	tmp, ok := rs.(*adaptiveJoinRuntimeStats)
+	if !ok {
+		return
+	}
+	// The join under an Apply may be executed multiple times, keep the decision which switches the strategy.
+	if tmp.switched && !e.switched {
+		*e = *tmp
+	}
+}
+
+// Tp implements the RuntimeStats interface.
+func (*adaptiveJoinRuntimeStats) Tp() int {
+	return execdetails.TpAdaptiveJoinRuntimeStats
+}
@@ -56,6 +56,8 @@ type hashJoinCtxBase struct {
 	IsNullAware   bool
 	memTracker    *memory.Tracker // track memory usage.
 	diskTracker   *disk.Tracker   // track disk usage.
+	// CopRuntimeFilters are built from the build side rows and pushed into the TiKV reader of the probe side.
+	CopRuntimeFilters []*CopRuntimeFilter
 }
 
 type probeSideTupleFetcherBase struct {
@@ -259,6 +261,9 @@ func (w *buildWorkerBase) fetchBuildSideRows(ctx context.Context, hashJoinCtx *h
 
 	// We must put the close of chkCh after the place of spilling remaining rows or there will be data race
 	defer close(chkCh)
+	// the readers waiting for the runtime filters are woken up without the filters if the build side is not fetched
+	// through.
+	defer hashJoinCtx.finishCopRuntimeFilters(false)
 
 	defer func() {
 		if r := recover(); r != nil {
@@ -348,8 +353,10 @@ func (w *buildWorkerBase) fetchBuildSideRows(ctx context.Context, hashJoinCtx *h
 		failpoint.Inject("ConsumeRandomPanic", nil)
 
 		if chk.NumRows() == 0 {
+			hashJoinCtx.finishCopRuntimeFilters(true)
 			return
 		}
+		hashJoinCtx.updateCopRuntimeFilters(chk)
 
 		syncerAdd(fetcherAndWorkerSyncer)
 		select {
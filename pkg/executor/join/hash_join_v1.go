@@ -119,6 +119,7 @@ func (e *HashJoinV1Exec) Close() error {
 		close(e.closeCh)
 	}
 	e.finished.Store(true)
+	e.finishCopRuntimeFilters(false)
 	if e.Prepared {
 		if e.buildFinished != nil {
 			channel.Clear(e.buildFinished)
@@ -194,6 +195,7 @@ func (e *HashJoinV1Exec) OpenSelf() error {
 	e.waiterWg = util.WaitGroupWrapper{}
 	e.closeCh = make(chan struct{})
 	e.finished.Store(false)
+	e.resetCopRuntimeFilters()
 
 	if e.RuntimeStats() != nil {
 		e.stats = &hashJoinRuntimeStats{
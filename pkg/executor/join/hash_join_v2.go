@@ -660,6 +660,7 @@ func (e *HashJoinV2Exec) Close() error {
 		close(e.closeCh)
 	}
 	e.finished.Store(true)
+	e.finishCopRuntimeFilters(false)
 	if e.prepared {
 		if e.buildFinished != nil {
 			channel.Clear(e.buildFinished)
@@ -747,6 +748,7 @@ func (e *HashJoinV2Exec) OpenSelf() error {
 	e.waiterWg = util.WaitGroupWrapper{}
 	e.closeCh = make(chan struct{})
 	e.finished.Store(false)
+	e.resetCopRuntimeFilters()
 
 	if e.RuntimeStats() != nil && e.stats == nil {
 		e.stats = &hashJoinRuntimeStatsV2{}
@@ -0,0 +1,239 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package join
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pingcap/tidb/pkg/expression"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	plannercore "github.com/pingcap/tidb/pkg/planner/core"
+	"github.com/pingcap/tidb/pkg/sessionctx/variable"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/chunk"
+	"github.com/pingcap/tidb/pkg/util/codec"
+	"github.com/pingcap/tidb/pkg/util/collate"
+)
+
+// maxCopRuntimeFilterInValues is the max number of the distinct build keys an IN runtime filter holds, the filter is
+// given up if the build side has more.
+const maxCopRuntimeFilterInValues = 1024
+
+// CopRuntimeFilter is a runtime filter of the hash join executed in TiDB. It's built from the join keys of the build
+// side rows while the hash join fetches them, and the TiKV reader on the probe side waits for it before sending the
+// coprocessor requests, which carry the filter as the predicates on the probe key.
+type CopRuntimeFilter struct {
+	tp           plannercore.RuntimeFilterType
+	buildKeyIdx  int
+	buildKeyType *types.FieldType
+	target       *expression.Column
+	collator     collate.Collator
+
+	// The following fields are only accessed by the goroutine fetching the build side rows.
+	hasKey   bool
+	invalid  bool
+	minKey   types.Datum
+	maxKey   types.Datum
+	keys     []types.Datum
+	keySet   map[string]struct{}
+	overflow bool
+	keyBuf   []byte
+
+	once  sync.Once
+	ready chan struct{}
+	conds []expression.Expression
+	empty bool
+}
+
+// NewCopRuntimeFilter creates a CopRuntimeFilter of the runtime filter pushed into a TiKV reader.
+func NewCopRuntimeFilter(rf *plannercore.RuntimeFilter) *CopRuntimeFilter {
+	src := rf.SrcColumn()
+	e := &CopRuntimeFilter{
+		tp:           rf.Type(),
+		buildKeyIdx:  src.Index,
+		buildKeyType: src.RetType,
+		target:       rf.TargetColumn(),
+		collator:     collate.GetCollator(src.RetType.GetCollate()),
+	}
+	e.reset()
+	return e
+}
+
+func (e *CopRuntimeFilter) reset() {
+	e.hasKey, e.invalid, e.overflow = false, false, false
+	e.minKey, e.maxKey = types.Datum{}, types.Datum{}
+	e.keys, e.keySet = nil, nil
+	if e.tp == variable.In {
+		e.keySet = make(map[string]struct{})
+	}
+	e.once = sync.Once{}
+	e.ready = make(chan struct{})
+	e.conds, e.empty = nil, false
+}
+
+// update collects the join keys of the build side rows.
+func (e *CopRuntimeFilter) update(tc types.Context, chk *chunk.Chunk) {
+	if e.invalid {
+		return
+	}
+	for i := range chk.NumRows() {
+		row := chk.GetRow(i)
+		// the null key can't be joined by the equal condition.
+		if row.IsNull(e.buildKeyIdx) {
+			continue
+		}
+		d := row.GetDatum(e.buildKeyIdx, e.buildKeyType)
+		var err error
+		switch e.tp {
+		case variable.In:
+			err = e.addKey(tc, d)
+		case variable.MinMax:
+			err = e.updateMinMax(tc, d)
+		}
+		if err != nil {
+			// the filter is optional, give it up rather than failing the query.
+			e.invalid = true
+			return
+		}
+		e.hasKey = true
+	}
+}
+
+func (e *CopRuntimeFilter) addKey(tc types.Context, d types.Datum) (err error) {
+	if e.overflow {
+		return nil
+	}
+	if d.Kind() == types.KindString || d.Kind() == types.KindBytes {
+		e.keyBuf = append(e.keyBuf[:0], e.collator.Key(d.GetString())...)
+	} else if e.keyBuf, err = codec.EncodeKey(tc.Location(), e.keyBuf[:0], d); err != nil {
+		return err
+	}
+	if _, ok := e.keySet[string(e.keyBuf)]; ok {
+		return nil
+	}
+	if len(e.keys) == maxCopRuntimeFilterInValues {
+		e.overflow = true
+		e.keys, e.keySet = nil, nil
+		return nil
+	}
+	e.keySet[string(e.keyBuf)] = struct{}{}
+	e.keys = append(e.keys, *d.Clone())
+	return nil
+}
+
+func (e *CopRuntimeFilter) updateMinMax(tc types.Context, d types.Datum) error {
+	if !e.hasKey {
+		e.minKey, e.maxKey = *d.Clone(), *d.Clone()
+		return nil
+	}
+	cmp, err := d.Compare(tc, &e.minKey, e.collator)
+	if err != nil {
+		return err
+	}
+	if cmp < 0 {
+		e.minKey = *d.Clone()
+		return nil
+	}
+	cmp, err = d.Compare(tc, &e.maxKey, e.collator)
+	if err != nil {
+		return err
+	}
+	if cmp > 0 {
+		e.maxKey = *d.Clone()
+	}
+	return nil
+}
+
+// finish builds the predicates of the filter if all the build side rows are collected, and wakes up the reader.
+func (e *CopRuntimeFilter) finish(ctx expression.BuildContext, built bool) {
+	e.once.Do(func() {
+		if built && !e.invalid {
+			e.conds, e.empty = e.buildConditions(ctx)
+		}
+		close(e.ready)
+	})
+}
+
+func (e *CopRuntimeFilter) buildConditions(ctx expression.BuildContext) (conds []expression.Expression, empty bool) {
+	if !e.hasKey {
+		// no probe row can be joined with the build side which is empty or has only null keys.
+		return nil, true
+	}
+	newConst := func(d types.Datum) expression.Expression {
+		return &expression.Constant{Value: d, RetType: e.buildKeyType}
+	}
+	switch e.tp {
+	case variable.In:
+		if e.overflow {
+			return nil, false
+		}
+		args := make([]expression.Expression, 0, len(e.keys)+1)
+		args = append(args, e.target)
+		for _, key := range e.keys {
+			args = append(args, newConst(key))
+		}
+		in, err := expression.NewFunction(ctx, ast.In, types.NewFieldType(mysql.TypeTiny), args...)
+		if err != nil {
+			return nil, false
+		}
+		return []expression.Expression{in}, false
+	case variable.MinMax:
+		ge, err := expression.NewFunction(ctx, ast.GE, types.NewFieldType(mysql.TypeTiny), e.target, newConst(e.minKey))
+		if err != nil {
+			return nil, false
+		}
+		le, err := expression.NewFunction(ctx, ast.LE, types.NewFieldType(mysql.TypeTiny), e.target, newConst(e.maxKey))
+		if err != nil {
+			return nil, false
+		}
+		return []expression.Expression{ge, le}, false
+	}
+	return nil, false
+}
+
+// Wait waits until the hash join finishes fetching the build side. It returns the predicates on the probe key, which
+// are nil if the filter can't be built, and whether no probe row can be joined at all.
+func (e *CopRuntimeFilter) Wait(ctx context.Context) (conds []expression.Expression, empty bool, err error) {
+	select {
+	case <-e.ready:
+		return e.conds, e.empty, nil
+	case <-ctx.Done():
+		return nil, false, ctx.Err()
+	}
+}
+
+func (hCtx *hashJoinCtxBase) resetCopRuntimeFilters() {
+	for _, rf := range hCtx.CopRuntimeFilters {
+		rf.reset()
+	}
+}
+
+func (hCtx *hashJoinCtxBase) updateCopRuntimeFilters(chk *chunk.Chunk) {
+	if len(hCtx.CopRuntimeFilters) == 0 {
+		return
+	}
+	tc := hCtx.SessCtx.GetSessionVars().StmtCtx.TypeCtx()
+	for _, rf := range hCtx.CopRuntimeFilters {
+		rf.update(tc, chk)
+	}
+}
+
+func (hCtx *hashJoinCtxBase) finishCopRuntimeFilters(built bool) {
+	for _, rf := range hCtx.CopRuntimeFilters {
+		rf.finish(hCtx.SessCtx.GetExprCtx(), built)
+	}
+}
@@ -0,0 +1,228 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+	"slices"
+
+	"github.com/pingcap/tidb/pkg/executor/internal/exec"
+	"github.com/pingcap/tidb/pkg/expression"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/planner/core/operator/logicalop"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/chunk"
+	"github.com/pingcap/tidb/pkg/util/dbterror/exeerrors"
+)
+
+// JSONTableExec produces the rows of a JSON_TABLE. The document is evaluated when
+// the executor is opened, so on the inner side of an Apply it is evaluated once
+// per outer row.
+type JSONTableExec struct {
+	exec.BaseExecutor
+
+	expr expression.Expression
+	root *logicalop.JSONTablePath
+
+	rows   [][]types.Datum
+	cursor int
+}
+
+// Open implements the Executor Open interface.
+func (e *JSONTableExec) Open(ctx context.Context) error {
+	if err := e.BaseExecutor.Open(ctx); err != nil {
+		return err
+	}
+	e.rows = e.rows[:0]
+	e.cursor = 0
+	doc, isNull, err := e.expr.EvalJSON(e.Ctx().GetExprCtx().GetEvalCtx(), chunk.Row{})
+	if err != nil || isNull {
+		return err
+	}
+	// The rows of the table itself are not outer joined, so the result is
+	// empty if the row path matches nothing.
+	_, err = e.appendPathRows(e.root, doc, make([]types.Datum, e.Schema().Len()))
+	return err
+}
+
+// Next implements the Executor Next interface.
+func (e *JSONTableExec) Next(_ context.Context, req *chunk.Chunk) error {
+	req.Reset()
+	for ; e.cursor < len(e.rows) && !req.IsFull(); e.cursor++ {
+		row := e.rows[e.cursor]
+		for i := range row {
+			req.AppendDatum(i, &row[i])
+		}
+	}
+	return nil
+}
+
+// Close implements the Executor Close interface.
+func (e *JSONTableExec) Close() error {
+	e.rows = nil
+	return e.BaseExecutor.Close()
+}
+
+// appendPathRows appends the rows produced by each value matched by the path,
+// and returns whether the path matched anything.
+func (e *JSONTableExec) appendPathRows(path *logicalop.JSONTablePath, value types.BinaryJSON, row []types.Datum) (bool, error) {
+	matches := value.ExtractAll(path.Path)
+	for i, match := range matches {
+		if err := e.fillColumns(path, match, uint64(i+1), row); err != nil {
+			return false, err
+		}
+		if err := e.appendNestedRows(path, match, row); err != nil {
+			return false, err
+		}
+	}
+	return len(matches) > 0, nil
+}
+
+// appendNestedRows appends the rows of the nested paths. Sibling nested paths
+// produce their rows one after another, with the columns of the others set to
+// NULL. If none of them matches, a single row is appended with all the nested
+// columns set to NULL, just like an outer join.
+func (e *JSONTableExec) appendNestedRows(path *logicalop.JSONTablePath, value types.BinaryJSON, row []types.Datum) error {
+	if len(path.Nested) == 0 {
+		e.rows = append(e.rows, slices.Clone(row))
+		return nil
+	}
+	matched := false
+	for _, nested := range path.Nested {
+		for _, sibling := range path.Nested {
+			setJSONTablePathNull(sibling, row)
+		}
+		ok, err := e.appendPathRows(nested, value, row)
+		if err != nil {
+			return err
+		}
+		matched = matched || ok
+	}
+	if !matched {
+		for _, nested := range path.Nested {
+			setJSONTablePathNull(nested, row)
+		}
+		e.rows = append(e.rows, slices.Clone(row))
+	}
+	return nil
+}
+
+func setJSONTablePathNull(path *logicalop.JSONTablePath, row []types.Datum) {
+	for _, col := range path.Columns {
+		row[col.Offset].SetNull()
+	}
+	for _, nested := range path.Nested {
+		setJSONTablePathNull(nested, row)
+	}
+}
+
+func (e *JSONTableExec) fillColumns(path *logicalop.JSONTablePath, value types.BinaryJSON, ordinality uint64, row []types.Datum) (err error) {
+	for _, col := range path.Columns {
+		switch col.Tp {
+		case ast.JSONTableColumnOrdinality:
+			row[col.Offset] = types.NewUintDatum(ordinality)
+		case ast.JSONTableColumnExists:
+			exists := types.NewIntDatum(0)
+			if len(value.ExtractAll(col.Path)) > 0 {
+				exists = types.NewIntDatum(1)
+			}
+			row[col.Offset], err = exists.ConvertTo(e.typeCtx(), col.RetType)
+		case ast.JSONTableColumnPath:
+			row[col.Offset], err = e.evalPathColumn(col, value)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *JSONTableExec) evalPathColumn(col *logicalop.JSONTableColumn, value types.BinaryJSON) (types.Datum, error) {
+	matches := value.ExtractAll(col.Path)
+	if len(matches) == 0 {
+		return e.onResponse(col, col.OnEmpty, exeerrors.ErrMissingJSONTableValue.FastGenByArgs(col.Name.O))
+	}
+	if len(matches) > 1 {
+		return e.onResponse(col, col.OnError, exeerrors.ErrWrongJSONTableValue.FastGenByArgs(col.Name.O))
+	}
+	d, err := e.jsonToDatum(col, matches[0])
+	if err != nil {
+		return e.onResponse(col, col.OnError, err)
+	}
+	return d, nil
+}
+
+// onResponse handles the ON EMPTY or ON ERROR clause of the column, err is
+// returned if the action is ERROR.
+func (e *JSONTableExec) onResponse(col *logicalop.JSONTableColumn, resp *ast.JSONTableOnResponse, err error) (types.Datum, error) {
+	if resp == nil || resp.Tp == ast.JSONTableOnResponseNull {
+		return types.Datum{}, nil
+	}
+	if resp.Tp == ast.JSONTableOnResponseError {
+		return types.Datum{}, err
+	}
+	def, err := types.ParseBinaryJSONFromString(resp.Default)
+	if err != nil {
+		return types.Datum{}, err
+	}
+	return e.jsonToDatum(col, def)
+}
+
+// jsonToDatum converts a scalar JSON value to the type of the column.
+func (e *JSONTableExec) jsonToDatum(col *logicalop.JSONTableColumn, bj types.BinaryJSON) (types.Datum, error) {
+	if col.RetType.GetType() == mysql.TypeJSON {
+		return types.NewJSONDatum(bj), nil
+	}
+	var d types.Datum
+	switch bj.TypeCode {
+	case types.JSONTypeCodeObject, types.JSONTypeCodeArray:
+		return d, exeerrors.ErrWrongJSONTableValue.FastGenByArgs(col.Name.O)
+	case types.JSONTypeCodeLiteral:
+		switch bj.Value[0] {
+		case types.JSONLiteralNil:
+			return d, nil
+		case types.JSONLiteralTrue:
+			d = types.NewIntDatum(1)
+		default:
+			d = types.NewIntDatum(0)
+		}
+		if col.RetType.EvalType() == types.ETString {
+			d = types.NewStringDatum(bj.String())
+		}
+	case types.JSONTypeCodeInt64:
+		d = types.NewIntDatum(bj.GetInt64())
+	case types.JSONTypeCodeUint64:
+		d = types.NewUintDatum(bj.GetUint64())
+	case types.JSONTypeCodeFloat64:
+		d = types.NewFloat64Datum(bj.GetFloat64())
+	case types.JSONTypeCodeString:
+		d = types.NewStringDatum(string(bj.GetString()))
+	case types.JSONTypeCodeDate, types.JSONTypeCodeDatetime, types.JSONTypeCodeTimestamp:
+		d = types.NewTimeDatum(bj.GetTime())
+	case types.JSONTypeCodeDuration:
+		d = types.NewDurationDatum(bj.GetDuration())
+	default:
+		d = types.NewBytesDatum(bj.GetOpaque().Buf)
+	}
+	return d.ConvertTo(e.typeCtx(), col.RetType)
+}
+
+// typeCtx returns a strict type context, so that the values which can't be
+// converted are handled by the ON ERROR clause instead of being truncated.
+func (e *JSONTableExec) typeCtx() types.Context {
+	tc := e.Ctx().GetSessionVars().StmtCtx.TypeCtx()
+	return tc.WithFlags(types.StrictFlags)
+}
@@ -0,0 +1,240 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/failpoint"
+	"github.com/pingcap/tidb/pkg/ddl"
+	"github.com/pingcap/tidb/pkg/domain"
+	"github.com/pingcap/tidb/pkg/executor/internal/exec"
+	"github.com/pingcap/tidb/pkg/infoschema"
+	"github.com/pingcap/tidb/pkg/kv"
+	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/charset"
+	"github.com/pingcap/tidb/pkg/parser/terror"
+	"github.com/pingcap/tidb/pkg/planner/core"
+	"github.com/pingcap/tidb/pkg/planner/core/resolve"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/chunk"
+	"github.com/pingcap/tidb/pkg/util/dbterror/exeerrors"
+	"github.com/pingcap/tidb/pkg/util/logutil"
+	"github.com/pingcap/tidb/pkg/util/sqlescape"
+	"go.uber.org/zap"
+)
+
+// executeCreateMaterializedView creates the table of the materialized view and populates it by the result of its query.
+func (e *DDLExec) executeCreateMaterializedView(ctx context.Context, s *ast.CreateMaterializedViewStmt) error {
+	ret := &core.PreprocessorReturn{}
+	nodeW := resolve.NewNodeW(s.Select)
+	err := core.Preprocess(ctx, e.Ctx(), nodeW, core.WithPreprocessorReturn(ret))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if ret.IsStaleness {
+		return exeerrors.ErrViewInvalid.GenWithStackByArgs(s.ViewName.Schema.L, s.ViewName.Name.L)
+	}
+
+	is := domain.GetDomain(e.Ctx()).InfoSchema()
+	exists := is.TableExists(s.ViewName.Schema, s.ViewName.Name)
+	e.Ctx().GetSessionVars().ClearRelatedTableForMDL()
+	if err = e.ddlExecutor.CreateMaterializedView(e.Ctx(), s); err != nil || exists {
+		// For IF NOT EXISTS, the existing table is kept as it is.
+		return err
+	}
+
+	mvInfo, err := ddl.BuildMaterializedViewInfo(s)
+	if err != nil {
+		return err
+	}
+	if err = populateMaterializedView(&e.BaseExecutor, s.ViewName.Schema.O, s.ViewName.Name.O, mvInfo); err != nil {
+		// Don't leave an empty materialized view if it can't be populated.
+		dropStmt := &ast.DropTableStmt{IfExists: true, Tables: []*ast.TableName{s.ViewName}, IsMaterializedView: true}
+		if dropErr := e.ddlExecutor.DropMaterializedView(e.Ctx(), dropStmt); dropErr != nil {
+			logutil.BgLogger().Warn("failed to drop the materialized view which can't be populated",
+				zap.String("schema", s.ViewName.Schema.O), zap.String("name", s.ViewName.Name.O), zap.Error(dropErr))
+		}
+		return err
+	}
+	return nil
+}
+
+func (e *SimpleExec) executeRefreshMaterializedView(ctx context.Context, s *ast.RefreshMaterializedViewStmt) error {
+	is := domain.GetDomain(e.Ctx()).InfoSchema()
+	tbl, err := is.TableByName(ctx, s.ViewName.Schema, s.ViewName.Name)
+	if err != nil {
+		return err
+	}
+	mvInfo := tbl.Meta().MaterializedView
+	if mvInfo == nil {
+		return infoschema.ErrWrongObject.GenWithStackByArgs(s.ViewName.Schema.O, s.ViewName.Name.O, "MATERIALIZED VIEW")
+	}
+	// The materialized view is swapped by the DDL of the internal session, which shouldn't wait for this statement.
+	e.Ctx().GetSessionVars().ClearRelatedTableForMDL()
+	return refreshMaterializedView(&e.BaseExecutor, s.ViewName.Schema.O, s.ViewName.Name.O, tbl.Meta().ID, mvInfo)
+}
+
+// refreshMaterializedView populates a shadow table of the materialized view by the result of its query, and
+// swaps it with the materialized view by RENAME TABLE. The readers see either the old rows or the new rows,
+// and the rows are kept if the refresh fails. It runs in internal sessions, so the rows can be read by the
+// users who can read the materialized view, even if they can't read the tables of the query, which is like
+// a view of SQL SECURITY DEFINER.
+func refreshMaterializedView(e *exec.BaseExecutor, dbName, tblName string, tblID int64, mvInfo *model.MaterializedViewInfo) error {
+	internalCtx := kv.WithInternalSourceType(context.Background(), kv.InternalTxnOthers)
+	sysSession, err := e.GetSysSession()
+	if err != nil {
+		return err
+	}
+	defer e.ReleaseSysSession(internalCtx, sysSession)
+	execDDL := func(format string, args ...any) error {
+		sql := new(strings.Builder)
+		sqlescape.MustFormatSQL(sql, format, args...)
+		_, err := sysSession.GetSQLExecutor().ExecuteInternal(internalCtx, sql.String())
+		return err
+	}
+
+	shadowName := fmt.Sprintf("_tidb_mv_refresh_%d", tblID)
+	oldName := fmt.Sprintf("_tidb_mv_old_%d", tblID)
+	// The shadow table may be left by a refresh which is interrupted.
+	if err = execDDL("DROP MATERIALIZED VIEW IF EXISTS %n.%n", dbName, shadowName); err != nil {
+		return err
+	}
+	// The shadow table is created with the indexes and the metadata of the materialized view.
+	if err = execDDL("CREATE TABLE %n.%n LIKE %n.%n", dbName, shadowName, dbName, tblName); err != nil {
+		return err
+	}
+	err = populateMaterializedView(e, dbName, shadowName, mvInfo)
+	if err == nil {
+		err = execDDL("RENAME TABLE %n.%n TO %n.%n, %n.%n TO %n.%n", dbName, tblName, dbName, oldName, dbName, shadowName, dbName, tblName)
+	}
+	if err != nil {
+		if dropErr := execDDL("DROP MATERIALIZED VIEW IF EXISTS %n.%n", dbName, shadowName); dropErr != nil {
+			logutil.BgLogger().Warn("failed to drop the shadow table of materialized view",
+				zap.String("schema", dbName), zap.String("name", shadowName), zap.Error(dropErr))
+		}
+		return err
+	}
+	return execDDL("DROP MATERIALIZED VIEW %n.%n", dbName, oldName)
+}
+
+// populateMaterializedView inserts the result of the query of the materialized view into the empty table.
+// The query is read by one statement, so the rows are consistent, while the rows are written by the
+// transactions of at most materializedViewBatchSize rows, so it isn't limited by the size of a transaction.
+func populateMaterializedView(e *exec.BaseExecutor, dbName, tblName string, mvInfo *model.MaterializedViewInfo) error {
+	internalCtx := kv.WithInternalSourceType(context.Background(), kv.InternalTxnOthers)
+	reader, err := e.GetSysSession()
+	if err != nil {
+		return err
+	}
+	defer e.ReleaseSysSession(internalCtx, reader)
+	writer, err := e.GetSysSession()
+	if err != nil {
+		return err
+	}
+	defer e.ReleaseSysSession(internalCtx, writer)
+
+	batchSize := materializedViewBatchSize
+	failpoint.Inject("mockMaterializedViewBatchSize", func(val failpoint.Value) {
+		batchSize = val.(int)
+	})
+
+	// The query is restored from the AST, it's executed without escaping.
+	rs, err := reader.GetSQLExecutor().ExecuteInternal(internalCtx, mvInfo.SelectStmt)
+	if err != nil {
+		return err
+	}
+	defer terror.Call(rs.Close)
+
+	fields := rs.Fields()
+	rowFormat := "(" + strings.Repeat("%?,", len(fields)-1) + "%?)"
+	values := make([]any, len(fields))
+	sql := new(strings.Builder)
+	rows := 0
+	flush := func() error {
+		if rows == 0 {
+			return nil
+		}
+		_, err := writer.GetSQLExecutor().ExecuteInternal(internalCtx, sql.String())
+		sql.Reset()
+		rows = 0
+		return err
+	}
+
+	req := rs.NewChunk(nil)
+	for {
+		if err = rs.Next(internalCtx, req); err != nil {
+			return err
+		}
+		if req.NumRows() == 0 {
+			return flush()
+		}
+		iter := chunk.NewIterator4Chunk(req)
+		for row := iter.Begin(); row != iter.End(); row = iter.Next() {
+			for i, field := range fields {
+				if values[i], err = materializedViewValue(row.GetDatum(i, &field.Column.FieldType)); err != nil {
+					return err
+				}
+			}
+			if rows == 0 {
+				sqlescape.MustFormatSQL(sql, "INSERT INTO %n.%n VALUES ", dbName, tblName)
+			} else {
+				sql.WriteString(",")
+			}
+			if err = sqlescape.FormatSQL(sql, rowFormat, values...); err != nil {
+				return err
+			}
+			rows++
+			if rows >= batchSize {
+				if err = flush(); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// materializedViewBatchSize is the max number of rows written by a transaction when a materialized view is populated.
+const materializedViewBatchSize = 1024
+
+// materializedViewValue converts the datum read from the query of a materialized view to the argument of the SQL
+// which writes it. The values which have no corresponding Go types are written as strings, and they're converted
+// back by the types of the columns.
+func materializedViewValue(d types.Datum) (any, error) {
+	switch d.Kind() {
+	case types.KindNull:
+		return nil, nil
+	case types.KindInt64:
+		return d.GetInt64(), nil
+	case types.KindUint64:
+		return d.GetUint64(), nil
+	case types.KindFloat32:
+		return d.GetFloat32(), nil
+	case types.KindFloat64:
+		return d.GetFloat64(), nil
+	case types.KindBytes, types.KindBinaryLiteral, types.KindMysqlBit:
+		return d.GetBytes(), nil
+	case types.KindString:
+		if d.Collation() == charset.CollationBin {
+			return d.GetBytes(), nil
+		}
+		return d.GetString(), nil
+	}
+	return d.ToString()
+}
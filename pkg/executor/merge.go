@@ -0,0 +1,243 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+	"runtime/trace"
+
+	"github.com/pingcap/tidb/pkg/executor/internal/exec"
+	"github.com/pingcap/tidb/pkg/expression"
+	"github.com/pingcap/tidb/pkg/kv"
+	plannercore "github.com/pingcap/tidb/pkg/planner/core"
+	"github.com/pingcap/tidb/pkg/table"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/chunk"
+	"github.com/pingcap/tidb/pkg/util/dbterror/exeerrors"
+	"github.com/pingcap/tidb/pkg/util/memory"
+)
+
+// MergeExec represents a merge executor.
+// For each row of the child executor, which joins the source with the target table,
+// the first clause whose condition is satisfied is applied.
+type MergeExec struct {
+	exec.BaseExecutor
+
+	tbl table.Table
+	// tblColPosInfo records the column position of the target table in the rows of the child.
+	tblColPosInfo plannercore.TblColPosInfo
+	clauses       []*mergeClauseExec
+
+	// mergedHandles records the target rows which are updated or deleted by the statement.
+	mergedHandles *kv.MemAwareHandleMap[struct{}]
+	memTracker    *memory.Tracker
+	drained       bool
+}
+
+// mergeClauseExec is a WHEN clause of the MergeExec. One of update, delete and insert is set.
+type mergeClauseExec struct {
+	notMatched bool
+	condition  expression.Expression
+
+	update *UpdateExec
+	delete *DeleteExec
+	insert *InsertValues
+	values []expression.Expression
+}
+
+// Open implements the Executor Open interface.
+func (e *MergeExec) Open(ctx context.Context) error {
+	e.memTracker = memory.NewTracker(e.ID(), -1)
+	e.memTracker.AttachTo(e.Ctx().GetSessionVars().StmtCtx.MemTracker)
+	for _, c := range e.clauses {
+		if c.update != nil {
+			c.update.memTracker = e.memTracker
+		}
+	}
+	return exec.Open(ctx, e.Children(0))
+}
+
+// Close implements the Executor Close interface.
+func (e *MergeExec) Close() error {
+	defer e.memTracker.ReplaceBytesUsed(0)
+	return exec.Close(e.Children(0))
+}
+
+// Next implements the Executor Next interface.
+func (e *MergeExec) Next(ctx context.Context, req *chunk.Chunk) error {
+	req.Reset()
+	if e.drained {
+		return nil
+	}
+	numRows, err := e.mergeRows(ctx)
+	if err != nil {
+		return err
+	}
+	e.drained = true
+	e.Ctx().GetSessionVars().StmtCtx.AddRecordRows(uint64(numRows))
+	return nil
+}
+
+func (e *MergeExec) mergeRows(ctx context.Context) (int, error) {
+	defer trace.StartRegion(ctx, "MergeExec").End()
+	txn, err := e.Ctx().Txn(true)
+	if err != nil {
+		return 0, err
+	}
+	fields := exec.RetTypes(e.Children(0))
+	tblID2table := map[int64]table.Table{e.tblColPosInfo.TblID: e.tbl}
+	colsInfo := plannercore.GetUpdateColumnsInfo(tblID2table, plannercore.TblColPosInfoSlice{e.tblColPosInfo}, len(fields))
+	for _, c := range e.clauses {
+		if c.update != nil {
+			c.update.evalBuffer = chunk.MutRowFromTypes(fields)
+			c.update.initAssignmentsPerTable()
+		}
+	}
+	updateDupKeyCheck := optimizeDupKeyCheckForUpdate(txn, false)
+	insertDupKeyCheck := optimizeDupKeyCheckForNormalInsert(e.Ctx().GetSessionVars(), txn)
+	e.mergedHandles = kv.NewMemAwareHandleMap[struct{}]()
+
+	chk := exec.TryNewCacheChunk(e.Children(0))
+	memUsageOfChk := int64(0)
+	totalNumRows := 0
+	for {
+		e.memTracker.Consume(-memUsageOfChk)
+		if err := exec.Next(ctx, e.Children(0), chk); err != nil {
+			return 0, err
+		}
+		if chk.NumRows() == 0 {
+			break
+		}
+		memUsageOfChk = chk.MemoryUsage()
+		e.memTracker.Consume(memUsageOfChk)
+		for rowIdx := range chk.NumRows() {
+			chunkRow := chk.GetRow(rowIdx)
+			datumRow := chunkRow.GetDatumRow(fields)
+			clause, err := e.matchClause(chunkRow, datumRow)
+			if err != nil {
+				return 0, err
+			}
+			if clause != nil {
+				if err := e.applyClause(ctx, clause, totalNumRows, chunkRow, datumRow, colsInfo, updateDupKeyCheck, insertDupKeyCheck); err != nil {
+					return 0, err
+				}
+			}
+			totalNumRows++
+		}
+		if err := txn.MayFlush(); err != nil {
+			return 0, err
+		}
+	}
+	return totalNumRows, nil
+}
+
+// matchClause returns the first clause which applies to the row, it returns nil if no clause applies.
+func (e *MergeExec) matchClause(chunkRow chunk.Row, row []types.Datum) (*mergeClauseExec, error) {
+	notMatched := unmatchedOuterRow(e.tblColPosInfo, row)
+	evalCtx := e.Ctx().GetExprCtx().GetEvalCtx()
+	for _, c := range e.clauses {
+		if c.notMatched != notMatched {
+			continue
+		}
+		if c.condition == nil {
+			return c, nil
+		}
+		ok, _, err := expression.EvalBool(evalCtx, []expression.Expression{c.condition}, chunkRow)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return c, nil
+		}
+	}
+	return nil, nil
+}
+
+func (e *MergeExec) applyClause(
+	ctx context.Context, c *mergeClauseExec,
+	rowIdx int, chunkRow chunk.Row, row []types.Datum, colsInfo []*table.Column,
+	updateDupKeyCheck, insertDupKeyCheck table.DupKeyCheckMode,
+) error {
+	if c.insert != nil {
+		vals := make([]types.Datum, 0, len(c.values))
+		for _, expr := range c.values {
+			val, err := expr.Eval(e.Ctx().GetExprCtx().GetEvalCtx(), chunkRow)
+			if err != nil {
+				return err
+			}
+			vals = append(vals, val)
+		}
+		c.insert.rowCount++
+		newRow, err := c.insert.getRow(ctx, vals)
+		if err != nil {
+			return err
+		}
+		return c.insert.addRecord(ctx, newRow, insertDupKeyCheck)
+	}
+
+	handle, err := e.tblColPosInfo.HandleCols.BuildHandleByDatums(e.Ctx().GetSessionVars().StmtCtx, row)
+	if err != nil {
+		return err
+	}
+	// Like the SQL standard, a target row can be modified by one source row only.
+	if _, ok := e.mergedHandles.Get(handle); ok {
+		return exeerrors.ErrMergeCardinalityViolation.GenWithStackByArgs(e.tbl.Meta().Name.O)
+	}
+	memDelta := e.mergedHandles.Set(handle, struct{}{})
+	e.memTracker.Consume(memDelta + int64(handle.ExtraMemSize()))
+
+	if c.update != nil {
+		return c.update.updateRow(ctx, rowIdx, row, colsInfo, c.update.composeNewRow, updateDupKeyCheck)
+	}
+	info := e.tblColPosInfo
+	return c.delete.removeRow(ctx, e.tbl, handle, row[info.Start:info.End], &info)
+}
+
+// GetFKChecks implements WithForeignKeyTrigger interface.
+func (e *MergeExec) GetFKChecks() []*FKCheckExec {
+	var fkChecks []*FKCheckExec
+	for _, c := range e.clauses {
+		switch {
+		case c.update != nil:
+			fkChecks = append(fkChecks, c.update.GetFKChecks()...)
+		case c.delete != nil:
+			fkChecks = append(fkChecks, c.delete.GetFKChecks()...)
+		case c.insert != nil:
+			fkChecks = append(fkChecks, c.insert.fkChecks...)
+		}
+	}
+	return fkChecks
+}
+
+// GetFKCascades implements WithForeignKeyTrigger interface.
+func (e *MergeExec) GetFKCascades() []*FKCascadeExec {
+	var fkCascades []*FKCascadeExec
+	for _, c := range e.clauses {
+		switch {
+		case c.update != nil:
+			fkCascades = append(fkCascades, c.update.GetFKCascades()...)
+		case c.delete != nil:
+			fkCascades = append(fkCascades, c.delete.GetFKCascades()...)
+		case c.insert != nil:
+			fkCascades = append(fkCascades, c.insert.fkCascades...)
+		}
+	}
+	return fkCascades
+}
+
+// HasFKCascades implements WithForeignKeyTrigger interface.
+func (e *MergeExec) HasFKCascades() bool {
+	return len(e.GetFKCascades()) > 0
+}
@@ -28,12 +28,6 @@ import (
 	"github.com/pingcap/tidb/pkg/util/chunk"
 )
 
-type dataInfo struct {
-	chk         *chunk.Chunk
-	remaining   uint64
-	accumulated uint64
-}
-
 // PipelinedWindowExec is the executor for window functions.
 type PipelinedWindowExec struct {
 	exec.BaseExecutor
@@ -45,15 +39,12 @@ type PipelinedWindowExec struct {
 	end                *logicalop.FrameBound
 	groupChecker       *vecgroupchecker.VecGroupChecker
 
-	// childResult stores the child chunk. Note that even if remaining is 0, e.rows might still references rows in data[0].chk after returned it to upper executor, since there is no guarantee what the upper executor will do to the returned chunk, it might destroy the data (as in the benchmark test, it reused the chunk to pull data, and it will be chk.Reset(), causing panicking). So dataIdx, accumulated and dropped are added to ensure that chunk will only be returned if there is no row reference.
-	childResult *chunk.Chunk
-	data        []dataInfo
-	dataIdx     int
+	// childResult stores the child chunk. The rows are copied into e.rows, so it can be reused.
+	childResult  *chunk.Chunk
+	inputColIdxs []int
 
 	// done indicates the child executor is drained or something unexpected happened.
 	done         bool
-	accumulated  uint64
-	dropped      uint64
 	rowToConsume uint64
 	newPartition bool
 
@@ -67,43 +58,51 @@ type PipelinedWindowExec struct {
 	orderByCols    []*expression.Column
 	// expectedCmpResult is used to decide if one value is included in the frame.
 	expectedCmpResult int64
+	// peerCmpFuncs is used to decide whether two rows are in the same peer group for GROUPS frames.
+	peerCmpFuncs []expression.CompareFunc
+	// groupIDs keeps the peer group ordinals of rows, it is aligned with rows.
+	groupIDs    []uint64
+	lastGroupID uint64
 
-	// rows keeps rows starting from curStartRow
-	rows                     []chunk.Row
+	// rows keeps rows starting from curStartRow, which may be spilled to disk.
+	rows                     *windowRowBuffer
 	rowCnt                   uint64
 	whole                    bool
 	isRangeFrame             bool
+	isGroupsFrame            bool
 	emptyFrame               bool
 	initializedSlidingWindow bool
 }
 
 // Close implements the Executor Close interface.
 func (e *PipelinedWindowExec) Close() error {
+	if e.rows != nil {
+		e.rows.close()
+		e.rows = nil
+	}
+	e.childResult = nil
 	return errors.Trace(e.BaseExecutor.Close())
 }
 
 // Open implements the Executor Open interface
 func (e *PipelinedWindowExec) Open(ctx context.Context) (err error) {
 	e.done, e.newPartition, e.whole, e.initializedSlidingWindow = false, false, false, false
-	e.dataIdx, e.curRowIdx, e.dropped, e.rowToConsume, e.accumulated = 0, 0, 0, 0, 0
+	e.curRowIdx, e.rowToConsume = 0, 0
 	e.lastStartRow, e.lastEndRow, e.stagedStartRow, e.stagedEndRow, e.rowStart, e.rowCnt = 0, 0, 0, 0, 0, 0
-	e.rows, e.data = make([]chunk.Row, 0), make([]dataInfo, 0)
-	return e.BaseExecutor.Open(ctx)
-}
-
-func (e *PipelinedWindowExec) firstResultChunkNotReady() bool {
-	if !e.done && len(e.data) == 0 {
-		return true
+	e.groupIDs, e.lastGroupID = e.groupIDs[:0], 0
+	if err = e.BaseExecutor.Open(ctx); err != nil {
+		return err
 	}
-	// chunk can't be ready unless, 1. all of the rows in the chunk is filled, 2. e.rows doesn't contain rows in the chunk
-	return len(e.data) > 0 && (e.data[0].remaining != 0 || e.data[0].accumulated > e.dropped)
+	e.rows = newWindowRowBuffer(e.Ctx(), e.ID(), exec.RetTypes(e.Children(0)), e.MaxChunkSize())
+	e.inputColIdxs = buildWindowInputColIdxs(e.Schema(), e.numWindowFuncs)
+	return nil
 }
 
 // Next implements the Executor Next interface.
 func (e *PipelinedWindowExec) Next(ctx context.Context, chk *chunk.Chunk) (err error) {
 	chk.Reset()
 
-	for e.firstResultChunkNotReady() {
+	for !chk.IsFull() {
 		// we firstly gathering enough rows and consume them, until we are able to produce.
 		// for unbounded frame, it needs consume the whole partition before being able to produce, in this case
 		// e.p.enoughToProduce will be false until so.
@@ -141,28 +140,17 @@ func (e *PipelinedWindowExec) Next(ctx context.Context, chk *chunk.Chunk) (err e
 		}
 
 		// e.p is ready to produce data
-		if len(e.data) > e.dataIdx && e.data[e.dataIdx].remaining != 0 {
-			produced, err := e.produce(e.Ctx(), e.data[e.dataIdx].chk, e.data[e.dataIdx].remaining)
-			if err != nil {
-				return err
-			}
-			e.data[e.dataIdx].remaining -= produced
-			if e.data[e.dataIdx].remaining == 0 {
-				e.dataIdx++
-			}
+		_, err = e.produce(e.Ctx(), chk, uint64(chk.RequiredRows()-chk.NumRows()))
+		if err != nil {
+			return err
 		}
 	}
-	if len(e.data) > 0 {
-		chk.SwapColumns(e.data[0].chk)
-		e.data = e.data[1:]
-		e.dataIdx--
-	}
-	return nil
+	return e.rows.err
 }
 
 func (e *PipelinedWindowExec) getRowsInPartition(ctx context.Context) (err error) {
 	e.newPartition = true
-	if len(e.rows) == 0 {
+	if e.rows.isEmpty() {
 		// if getRowsInPartition is called for the first time, we ignore it as a new partition
 		e.newPartition = false
 	}
@@ -189,54 +177,68 @@ func (e *PipelinedWindowExec) getRowsInPartition(ctx context.Context) (err error
 	}
 	begin, end := e.groupChecker.GetNextGroup()
 	e.rowToConsume += uint64(end - begin)
+	if !e.isGroupsFrame {
+		return e.rows.appendRows(e.childResult, begin, end)
+	}
 	for i := begin; i < end; i++ {
-		e.rows = append(e.rows, e.childResult.GetRow(i))
+		if err = e.appendGroupID(e.childResult.GetRow(i), i == begin && e.newPartition); err != nil {
+			return err
+		}
+		if err = e.rows.appendRows(e.childResult, i, i+1); err != nil {
+			return err
+		}
 	}
 	return
 }
 
-func (e *PipelinedWindowExec) fetchChild(ctx context.Context) (eof bool, err error) {
-	// TODO: reuse chunks
-	childResult := exec.TryNewCacheChunk(e.Children(0))
-	err = exec.Next(ctx, e.Children(0), childResult)
-	if err != nil {
-		return false, errors.Trace(err)
-	}
-	// No more data.
-	numRows := childResult.NumRows()
-	if numRows == 0 {
-		return true, nil
+// appendGroupID assigns the peer group ordinal for the row that is going to be appended to rows.
+func (e *PipelinedWindowExec) appendGroupID(row chunk.Row, newPartition bool) error {
+	if e.rows.isEmpty() || newPartition {
+		e.lastGroupID++
+	} else {
+		peer, err := isPeerRow(e.Ctx().GetExprCtx().GetEvalCtx(), e.orderByCols, e.peerCmpFuncs, e.rows.getRow(e.rows.numRows-1), row)
+		if err != nil {
+			return err
+		}
+		if !peer {
+			e.lastGroupID++
+		}
 	}
+	e.groupIDs = append(e.groupIDs, e.lastGroupID)
+	return nil
+}
 
-	// TODO: reuse chunks
-	resultChk := e.AllocPool.Alloc(e.RetFieldTypes(), 0, numRows)
-	err = e.copyChk(childResult, resultChk)
-	if err != nil {
-		return false, err
-	}
-	e.accumulated += uint64(numRows)
-	e.data = append(e.data, dataInfo{chk: resultChk, remaining: uint64(numRows), accumulated: e.accumulated})
+func (e *PipelinedWindowExec) getGroupID(i uint64) uint64 {
+	return e.groupIDs[i-e.rowStart]
+}
 
-	e.childResult = childResult
-	return false, nil
+// dropRows drops the first n rows that are no longer needed.
+func (e *PipelinedWindowExec) dropRows(n uint64) {
+	e.rows.drop(n)
+	if e.isGroupsFrame {
+		e.groupIDs = e.groupIDs[n:]
+	}
 }
 
-func (e *PipelinedWindowExec) copyChk(src, dst *chunk.Chunk) error {
-	columns := e.Schema().Columns[:len(e.Schema().Columns)-e.numWindowFuncs]
-	for i, col := range columns {
-		if err := dst.MakeRefTo(i, src, col.Index); err != nil {
-			return err
-		}
+func (e *PipelinedWindowExec) fetchChild(ctx context.Context) (eof bool, err error) {
+	if e.childResult == nil {
+		e.childResult = exec.TryNewCacheChunk(e.Children(0))
 	}
-	return nil
+	err = exec.Next(ctx, e.Children(0), e.childResult)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	// No more data.
+	return e.childResult.NumRows() == 0, nil
 }
 
+// getRow gets the i-th row of the current partition, the rows before rowStart are dropped.
 func (e *PipelinedWindowExec) getRow(i uint64) chunk.Row {
-	return e.rows[i-e.rowStart]
+	return e.rows.getRow(e.rows.dropped + i - e.rowStart)
 }
 
-func (e *PipelinedWindowExec) getRows(start, end uint64) []chunk.Row {
-	return e.rows[start-e.rowStart : end-e.rowStart]
+func (e *PipelinedWindowExec) getRows(start, end uint64) ([]chunk.Row, error) {
+	return e.rows.getRows(e.rows.dropped+start-e.rowStart, e.rows.dropped+end-e.rowStart)
 }
 
 // finish is called upon a whole partition is consumed
@@ -248,6 +250,15 @@ func (e *PipelinedWindowExec) getStart(ctx sessionctx.Context) (uint64, error) {
 	if e.start.UnBounded {
 		return 0, nil
 	}
+	if e.isGroupsFrame {
+		start := max(e.lastStartRow, e.stagedStartRow)
+		cur := e.getGroupID(e.curRowIdx)
+		for start < e.rowCnt && cmpGroupToBound(e.start, e.getGroupID(start), cur) < 0 {
+			start++
+		}
+		e.stagedStartRow = start
+		return start, nil
+	}
 	if e.isRangeFrame {
 		var start uint64
 		for start = max(e.lastStartRow, e.stagedStartRow); start < e.rowCnt; start++ {
@@ -288,6 +299,15 @@ func (e *PipelinedWindowExec) getEnd(ctx sessionctx.Context) (uint64, error) {
 	if e.end.UnBounded {
 		return e.rowCnt, nil
 	}
+	if e.isGroupsFrame {
+		end := max(e.lastEndRow, e.stagedEndRow)
+		cur := e.getGroupID(e.curRowIdx)
+		for end < e.rowCnt && cmpGroupToBound(e.end, e.getGroupID(end), cur) <= 0 {
+			end++
+		}
+		e.stagedEndRow = end
+		return end, nil
+	}
 	if e.isRangeFrame {
 		var end uint64
 		for end = max(e.lastEndRow, e.stagedEndRow); end < e.rowCnt; end++ {
@@ -385,7 +405,11 @@ func (e *PipelinedWindowExec) produce(ctx sessionctx.Context, chk *chunk.Chunk,
 						}
 						// TODO(zhifeng): track memory usage here
 						wf.ResetPartialResult(e.partialResults[i])
-						_, err = wf.UpdatePartialResult(ctx.GetExprCtx().GetEvalCtx(), e.getRows(start, end), e.partialResults[i])
+						var rows []chunk.Row
+						rows, err = e.getRows(start, end)
+						if err == nil {
+							_, err = wf.UpdatePartialResult(ctx.GetExprCtx().GetEvalCtx(), rows, e.partialResults[i])
+						}
 					}
 				}
 				if err != nil {
@@ -398,17 +422,19 @@ func (e *PipelinedWindowExec) produce(ctx sessionctx.Context, chk *chunk.Chunk,
 			}
 			e.initializedSlidingWindow = true
 		}
+		chk.AppendPartialRowByColIdxs(0, e.getRow(e.curRowIdx), e.inputColIdxs)
 		e.curRowIdx++
 		e.lastStartRow, e.lastEndRow = start, end
 
 		produced++
 		remained--
 	}
+	if e.rows.err != nil {
+		return produced, e.rows.err
+	}
 	extend := min(e.curRowIdx, e.lastEndRow, e.lastStartRow)
 	if extend > e.rowStart {
-		numDrop := extend - e.rowStart
-		e.dropped += numDrop
-		e.rows = e.rows[numDrop:]
+		e.dropRows(extend - e.rowStart)
 		e.rowStart = extend
 	}
 	return
@@ -441,9 +467,7 @@ func (e *PipelinedWindowExec) reset() {
 	e.emptyFrame = false
 	e.curRowIdx = 0
 	e.whole = false
-	numDrop := e.rowCnt - e.rowStart
-	e.dropped += numDrop
-	e.rows = e.rows[numDrop:]
+	e.dropRows(e.rowCnt - e.rowStart)
 	e.rowStart = 0
 	e.rowCnt = 0
 	e.initializedSlidingWindow = false
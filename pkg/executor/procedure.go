@@ -0,0 +1,1219 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/domain"
+	"github.com/pingcap/tidb/pkg/extension"
+	"github.com/pingcap/tidb/pkg/infoschema"
+	"github.com/pingcap/tidb/pkg/kv"
+	"github.com/pingcap/tidb/pkg/parser"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/auth"
+	"github.com/pingcap/tidb/pkg/parser/format"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/parser/opcode"
+	"github.com/pingcap/tidb/pkg/parser/terror"
+	"github.com/pingcap/tidb/pkg/planner/core/resolve"
+	"github.com/pingcap/tidb/pkg/privilege"
+	"github.com/pingcap/tidb/pkg/privilege/privileges"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/sessionctx/vardef"
+	"github.com/pingcap/tidb/pkg/sessionctx/variable"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/chunk"
+	"github.com/pingcap/tidb/pkg/util/dbterror/exeerrors"
+	"github.com/pingcap/tidb/pkg/util/memory"
+	"github.com/pingcap/tidb/pkg/util/sqlescape"
+	"github.com/pingcap/tidb/pkg/util/sqlexec"
+)
+
+const procedureRoutineType = "PROCEDURE"
+
+type procedureResultSetsVarKeyType int
+
+// String defines a Stringer function for debugging and pretty printing.
+func (procedureResultSetsVarKeyType) String() string {
+	return "procedure_result_sets_var"
+}
+
+// ProcedureResultSetsVarKey is a variable key for the result sets returned by the statements of the procedure
+// called by the client. Like MySQL, they are sent to the client before the result of CALL.
+const ProcedureResultSetsVarKey procedureResultSetsVarKeyType = 0
+
+func (e *SimpleExec) executeCreateProcedure(ctx context.Context, s *ast.ProcedureInfo) error {
+	sessVars := e.Ctx().GetSessionVars()
+	dbName := s.ProcedureName.Schema
+	if dbName.L == "" {
+		dbName = ast.NewCIStr(sessVars.CurrentDB)
+	}
+	db, ok := e.is.SchemaByName(dbName)
+	if !ok {
+		return infoschema.ErrDatabaseNotExists.GenWithStackByArgs(dbName.O)
+	}
+	if err := checkProcedureDefinition(s); err != nil {
+		return err
+	}
+
+	name := s.ProcedureName.Name
+	exists, err := procedureExists(ctx, e.Ctx(), db.Name.L, name.L)
+	if err != nil {
+		return err
+	}
+	if exists {
+		err := exeerrors.ErrSpAlreadyExists.FastGenByArgs(procedureRoutineType, name.O)
+		if s.IfNotExists {
+			sessVars.StmtCtx.AppendNote(err)
+			return nil
+		}
+		return err
+	}
+
+	var definer string
+	if user := sessVars.User; user != nil {
+		definer = fmt.Sprintf("%s@%s", user.AuthUsername, user.AuthHostname)
+	}
+	charsetClient, err := sessVars.GetSessionOrGlobalSystemVar(ctx, vardef.CharacterSetClient)
+	if err != nil {
+		return err
+	}
+	_, collation := sessVars.GetCharsetInfo()
+
+	internalCtx := kv.WithInternalSourceType(context.Background(), kv.InternalTxnOthers)
+	sysSession, err := e.GetSysSession()
+	if err != nil {
+		return err
+	}
+	defer e.ReleaseSysSession(internalCtx, sysSession)
+	_, err = sysSession.GetSQLExecutor().ExecuteInternal(internalCtx,
+		`INSERT INTO %n.%n (route_schema, name, type, definition, parameter_str, security_type, definer, sql_mode,
+		character_set_client, connection_collation, schema_collation) VALUES (%?, %?, %?, %?, %?, %?, %?, %?, %?, %?, %?)`,
+		mysql.SystemDB, mysql.RoutinesTable, db.Name.L, name.L, procedureRoutineType, s.ProcedureBody.Text(), s.ProcedureParamStr,
+		s.Security.String(), definer, sessVars.SQLMode.String(), charsetClient, collation, db.Collate)
+	if kv.ErrKeyExists.Equal(err) {
+		return exeerrors.ErrSpAlreadyExists.GenWithStackByArgs(procedureRoutineType, name.O)
+	}
+	return err
+}
+
+func (e *SimpleExec) executeDropProcedure(ctx context.Context, s *ast.DropProcedureStmt) error {
+	sessVars := e.Ctx().GetSessionVars()
+	dbName := s.ProcedureName.Schema
+	if dbName.L == "" {
+		dbName = ast.NewCIStr(sessVars.CurrentDB)
+	}
+	name := s.ProcedureName.Name
+	exists, err := procedureExists(ctx, e.Ctx(), dbName.L, name.L)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		err := exeerrors.ErrSpDoesNotExist.FastGenByArgs(procedureRoutineType, dbName.O+"."+name.O)
+		if s.IfExists {
+			sessVars.StmtCtx.AppendNote(err)
+			return nil
+		}
+		return err
+	}
+
+	internalCtx := kv.WithInternalSourceType(context.Background(), kv.InternalTxnOthers)
+	sysSession, err := e.GetSysSession()
+	if err != nil {
+		return err
+	}
+	defer e.ReleaseSysSession(internalCtx, sysSession)
+	_, err = sysSession.GetSQLExecutor().ExecuteInternal(internalCtx,
+		`DELETE FROM %n.%n WHERE route_schema = %? AND name = %? AND type = %?`,
+		mysql.SystemDB, mysql.RoutinesTable, dbName.L, name.L, procedureRoutineType)
+	return err
+}
+
+func (e *SimpleExec) executeCallStmt(ctx context.Context, s *ast.CallStmt) error {
+	if resultSets, ok := e.Ctx().Value(ProcedureResultSetsVarKey).([]sqlexec.RecordSet); ok {
+		for _, rs := range resultSets {
+			terror.Log(rs.Close())
+		}
+	}
+	e.Ctx().SetValue(ProcedureResultSetsVarKey, nil)
+	return callProcedure(ctx, e.Ctx(), nil, s)
+}
+
+func procedureExists(ctx context.Context, sctx sessionctx.Context, dbName, name string) (bool, error) {
+	exec := sctx.GetRestrictedSQLExecutor()
+	ctx = kv.WithInternalSourceType(ctx, kv.InternalTxnOthers)
+	rows, _, err := exec.ExecRestrictedSQL(ctx, nil, `SELECT 1 FROM %n.%n WHERE route_schema = %? AND name = %? AND type = %?`,
+		mysql.SystemDB, mysql.RoutinesTable, dbName, name, procedureRoutineType)
+	if err != nil {
+		return false, err
+	}
+	return len(rows) > 0, nil
+}
+
+// loadProcedure reads the definition of a stored procedure from mysql.routines and parses it
+// with the sql_mode it was created with. The definer of the procedure is returned as well.
+func loadProcedure(ctx context.Context, sctx sessionctx.Context, dbName, name string) (*ast.ProcedureInfo, string, error) {
+	exec := sctx.GetRestrictedSQLExecutor()
+	ctx = kv.WithInternalSourceType(ctx, kv.InternalTxnOthers)
+	rows, _, err := exec.ExecRestrictedSQL(ctx, nil, `SELECT definition, parameter_str, sql_mode, security_type, definer FROM %n.%n WHERE route_schema = %? AND name = %? AND type = %?`,
+		mysql.SystemDB, mysql.RoutinesTable, dbName, name, procedureRoutineType)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(rows) == 0 {
+		return nil, "", exeerrors.ErrSpDoesNotExist.GenWithStackByArgs(procedureRoutineType, dbName+"."+name)
+	}
+	sqlMode, err := mysql.GetSQLMode(rows[0].GetString(2))
+	if err != nil {
+		return nil, "", err
+	}
+	sessVars := sctx.GetSessionVars()
+	p := parser.New()
+	p.SetSQLMode(sqlMode)
+	p.SetParserConfig(sessVars.BuildParserConfig())
+	sql := sqlescape.MustEscapeSQL("CREATE PROCEDURE %n(", name) + rows[0].GetString(1) + ") " + rows[0].GetString(0)
+	charset, collation := sessVars.GetCharsetInfo()
+	stmt, err := p.ParseOneStmt(sql, charset, collation)
+	if err != nil {
+		return nil, "", errors.Trace(err)
+	}
+	info, ok := stmt.(*ast.ProcedureInfo)
+	if !ok {
+		return nil, "", errors.Errorf("invalid definition of procedure %s.%s", dbName, name)
+	}
+	info.Security = ast.SecurityDefiner
+	if strings.EqualFold(rows[0].GetString(3), "INVOKER") {
+		info.Security = ast.SecurityInvoker
+	}
+	return info, rows[0].GetString(4), nil
+}
+
+// callProcedure executes the stored procedure called by s. caller is the procedure which executes
+// the CALL statement, it's nil if the statement is issued by the client.
+func callProcedure(ctx context.Context, sctx sessionctx.Context, caller *procedureExec, s *ast.CallStmt) error {
+	sessVars := sctx.GetSessionVars()
+	dbName := s.Procedure.Schema.L
+	if dbName == "" {
+		dbName = strings.ToLower(sessVars.CurrentDB)
+	}
+	name := s.Procedure.FnName.L
+	info, definer, err := loadProcedure(ctx, sctx, dbName, name)
+	if err != nil {
+		return err
+	}
+	fullName := dbName + "." + name
+	if len(info.ProcedureParam) != len(s.Procedure.Args) {
+		return exeerrors.ErrSpWrongNoOfArgs.GenWithStackByArgs(procedureRoutineType, fullName, len(info.ProcedureParam), len(s.Procedure.Args))
+	}
+	depth := 0
+	for c := caller; c != nil; c = c.caller {
+		if c.name == fullName {
+			depth++
+		}
+	}
+	if depth > sessVars.MaxSpRecursionDepth {
+		return exeerrors.ErrSpRecursionLimit.GenWithStackByArgs(sessVars.MaxSpRecursionDepth, fullName)
+	}
+
+	p := &procedureExec{
+		sctx:    sctx,
+		name:    fullName,
+		caller:  caller,
+		scope:   newProcedureScope(nil),
+		execSQL: executeProcedureSQL,
+	}
+	if caller != nil {
+		// The statements of a procedure called by a trigger are executed in the same way as the trigger.
+		p.execSQL = caller.execSQL
+	}
+	params := make([]*procedureVariable, len(info.ProcedureParam))
+	for i, param := range info.ProcedureParam {
+		v := &procedureVariable{tp: procedureVariableType(param.ParamType, sessVars)}
+		arg := s.Procedure.Args[i]
+		if param.Paramstatus != ast.MODE_IN && !isProcedureOutArg(caller, arg) {
+			return exeerrors.ErrSpNotVarArg.GenWithStackByArgs(i+1, fullName)
+		}
+		if param.Paramstatus != ast.MODE_OUT {
+			// The arguments are evaluated in the context of the caller.
+			d, _, err := evalProcedureExpr(ctx, sctx, p.execSQL, arg)
+			if err != nil {
+				return err
+			}
+			if err = v.set(sessVars, d); err != nil {
+				return err
+			}
+		}
+		params[i] = v
+		p.scope.vars[strings.ToLower(param.ParamName)] = v
+	}
+
+	// The arguments are evaluated with the privileges of the caller, while the body is executed
+	// with the privileges of the definer unless the procedure is created with SQL SECURITY INVOKER.
+	switchBack := func() {}
+	if info.Security == ast.SecurityDefiner {
+		if switchBack, err = switchToDefiner(ctx, sctx, definer); err != nil {
+			return err
+		}
+	}
+	// Like MySQL, the database of the procedure is the default database while it's executing.
+	originDB, originProcedure := sessVars.CurrentDB, sessVars.ProcedureCtx
+	sessVars.CurrentDB = dbName
+	sessVars.ProcedureCtx = p
+	err = p.run(ctx, info.ProcedureBody)
+	sessVars.CurrentDB, sessVars.ProcedureCtx = originDB, originProcedure
+	switchBack()
+	if err != nil {
+		return err
+	}
+
+	for i, param := range info.ProcedureParam {
+		if param.Paramstatus == ast.MODE_IN {
+			continue
+		}
+		if err := assignProcedureOutArg(sessVars, caller, s.Procedure.Args[i], params[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isProcedureOutArg checks whether arg can receive the value of an OUT or INOUT parameter,
+// which means it's a user variable or a variable of the calling procedure.
+func isProcedureOutArg(caller *procedureExec, arg ast.ExprNode) bool {
+	switch x := arg.(type) {
+	case *ast.VariableExpr:
+		return !x.IsSystem
+	case *ast.ColumnNameExpr:
+		return caller != nil && x.Name.Table.L == "" && caller.scope.lookupVar(x.Name.Name.L) != nil
+	}
+	return false
+}
+
+func assignProcedureOutArg(sessVars *variable.SessionVars, caller *procedureExec, arg ast.ExprNode, param *procedureVariable) error {
+	switch x := arg.(type) {
+	case *ast.VariableExpr:
+		name := strings.ToLower(x.Name)
+		if param.value.IsNull() {
+			sessVars.UnsetUserVar(name)
+			return nil
+		}
+		sessVars.SetUserVarVal(name, *param.value.Clone())
+		sessVars.SetUserVarType(name, param.tp.Clone())
+	case *ast.ColumnNameExpr:
+		return caller.scope.lookupVar(x.Name.Name.L).set(sessVars, param.value)
+	}
+	return nil
+}
+
+// procedureVariableType fills the unspecified attributes of the declared type of a procedure variable.
+func procedureVariableType(declType *types.FieldType, sessVars *variable.SessionVars) *types.FieldType {
+	tp := declType.Clone()
+	defaultFlen, defaultDecimal := mysql.GetDefaultFieldLengthAndDecimal(tp.GetType())
+	if tp.GetFlen() == types.UnspecifiedLength {
+		tp.SetFlen(defaultFlen)
+	}
+	if tp.GetDecimal() == types.UnspecifiedLength {
+		tp.SetDecimal(defaultDecimal)
+	}
+	if types.IsString(tp.GetType()) && tp.GetCharset() == "" {
+		charset, collation := sessVars.GetCharsetInfo()
+		tp.SetCharset(charset)
+		tp.SetCollate(collation)
+	}
+	return tp
+}
+
+// executeProcedureSQL executes a statement of a stored procedure in the current session.
+// The rows of the result set are returned if needRows is true, otherwise they are buffered to
+// be sent to the client, see ProcedureResultSetsVarKey. The buffered rows are tracked by the memory
+// tracker of CALL, so they are limited by the memory quota of the statement.
+func executeProcedureSQL(ctx context.Context, sctx sessionctx.Context, stmt ast.StmtNode, needRows bool) (rows [][]types.Datum, fieldTypes []*types.FieldType, err error) {
+	if stmt.Text() == "" {
+		var sb strings.Builder
+		if err := stmt.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)); err != nil {
+			return nil, nil, err
+		}
+		stmt.SetText(nil, sb.String())
+	}
+	sessVars := sctx.GetSessionVars()
+	// The statement context is replaced when a statement is executed, so recover the one of CALL at the end.
+	stmtCtx := sessVars.StmtCtx
+	defer func() {
+		sessVars.StmtCtx = stmtCtx
+	}()
+	rs, err := sctx.GetSQLExecutor().ExecuteStmt(ctx, stmt)
+	if err != nil || rs == nil {
+		return nil, nil, err
+	}
+	defer func() {
+		if closeErr := rs.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+	memTracker := memory.NewTracker(memory.LabelForProcedureResultSet, -1)
+	memTracker.AttachTo(stmtCtx.MemTracker)
+	chunkRows, err := drainProcedureRecordSet(ctx, rs, sessVars.MaxChunkSize, memTracker)
+	fields := rs.Fields()
+	if err != nil || needRows || len(fields) == 0 {
+		defer memTracker.Detach()
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if !needRows {
+		if len(fields) > 0 {
+			resultSets, _ := sctx.Value(ProcedureResultSetsVarKey).([]sqlexec.RecordSet)
+			sctx.SetValue(ProcedureResultSetsVarKey, append(resultSets, &procedureResultSet{
+				fields:       fields,
+				rows:         chunkRows,
+				maxChunkSize: sessVars.MaxChunkSize,
+				memTracker:   memTracker,
+			}))
+		}
+		return nil, nil, nil
+	}
+	fieldTypes = make([]*types.FieldType, 0, len(fields))
+	for _, field := range fields {
+		fieldTypes = append(fieldTypes, &field.Column.FieldType)
+	}
+	rows = make([][]types.Datum, 0, len(chunkRows))
+	for _, row := range chunkRows {
+		rows = append(rows, row.GetDatumRow(fieldTypes))
+	}
+	return rows, fieldTypes, nil
+}
+
+// drainProcedureRecordSet is like sqlexec.DrainRecordSet, but the memory of the drained chunks is
+// consumed by memTracker, so an oversized result set is stopped by the memory quota.
+func drainProcedureRecordSet(ctx context.Context, rs sqlexec.RecordSet, maxChunkSize int, memTracker *memory.Tracker) ([]chunk.Row, error) {
+	var rows []chunk.Row
+	req := rs.NewChunk(nil)
+	for {
+		err := rs.Next(ctx, req)
+		if err != nil || req.NumRows() == 0 {
+			return rows, err
+		}
+		memTracker.Consume(req.MemoryUsage())
+		iter := chunk.NewIterator4Chunk(req)
+		for r := iter.Begin(); r != iter.End(); r = iter.Next() {
+			rows = append(rows, r)
+		}
+		req = chunk.Renew(req, maxChunkSize)
+	}
+}
+
+// procedureResultSet is a result set returned by a statement of a procedure. The rows are buffered
+// because the result sets are sent to the client after the procedure returns.
+type procedureResultSet struct {
+	fields       []*resolve.ResultField
+	rows         []chunk.Row
+	maxChunkSize int
+	idx          int
+	memTracker   *memory.Tracker
+}
+
+// Fields implements the sqlexec.RecordSet interface.
+func (r *procedureResultSet) Fields() []*resolve.ResultField {
+	return r.fields
+}
+
+// Next implements the sqlexec.RecordSet interface.
+func (r *procedureResultSet) Next(_ context.Context, req *chunk.Chunk) error {
+	req.Reset()
+	for ; r.idx < len(r.rows) && !req.IsFull(); r.idx++ {
+		req.AppendRow(r.rows[r.idx])
+	}
+	return nil
+}
+
+// NewChunk implements the sqlexec.RecordSet interface.
+func (r *procedureResultSet) NewChunk(alloc chunk.Allocator) *chunk.Chunk {
+	fieldTypes := make([]*types.FieldType, 0, len(r.fields))
+	for _, field := range r.fields {
+		fieldTypes = append(fieldTypes, &field.Column.FieldType)
+	}
+	if alloc != nil {
+		return alloc.Alloc(fieldTypes, 0, r.maxChunkSize)
+	}
+	return chunk.New(fieldTypes, r.maxChunkSize, r.maxChunkSize)
+}
+
+// Close implements the sqlexec.RecordSet interface.
+func (r *procedureResultSet) Close() error {
+	r.rows = nil
+	if r.memTracker != nil {
+		r.memTracker.Detach()
+		r.memTracker = nil
+	}
+	return nil
+}
+
+// switchToDefiner makes the statements of a stored program executed with the privileges of its definer, which is
+// in the format of `user@host`, and returns the function to switch back to the invoker. The invoker is kept if the
+// definer is unknown, e.g. the stored program is created without authentication.
+func switchToDefiner(ctx context.Context, sctx sessionctx.Context, definer string) (func(), error) {
+	pm := privilege.GetPrivilegeManager(sctx)
+	idx := strings.LastIndex(definer, "@")
+	if pm == nil || idx < 0 {
+		return func() {}, nil
+	}
+	user, host := definer[:idx], definer[idx+1:]
+	extensions, err := extension.GetExtensions()
+	if err != nil {
+		return nil, err
+	}
+	definerPM := privileges.NewUserPrivileges(domain.GetDomain(sctx).PrivilegeHandle(), extensions)
+	if !definerPM.GetAuthWithoutVerification(user, host) {
+		return nil, exeerrors.ErrNoSuchUser.GenWithStackByArgs(user, host)
+	}
+	sessVars := sctx.GetSessionVars()
+	originUser, originRoles := sessVars.User, sessVars.ActiveRoles
+	privilege.BindPrivilegeManager(sctx, definerPM)
+	sessVars.User = &auth.UserIdentity{Username: user, Hostname: host, AuthUsername: user, AuthHostname: host}
+	sessVars.ActiveRoles = definerPM.GetDefaultRoles(ctx, user, host)
+	return func() {
+		privilege.BindPrivilegeManager(sctx, pm)
+		sessVars.User, sessVars.ActiveRoles = originUser, originRoles
+	}, nil
+}
+
+// procedureSQLExecutor executes a statement of a stored program, the rows of the result set are
+// returned if needRows is true.
+type procedureSQLExecutor func(ctx context.Context, sctx sessionctx.Context, stmt ast.StmtNode, needRows bool) ([][]types.Datum, []*types.FieldType, error)
+
+// evalProcedureExpr evaluates an expression by executing `SELECT expr`, so the expression
+// can refer to procedure variables, user variables and subqueries.
+func evalProcedureExpr(ctx context.Context, sctx sessionctx.Context, execSQL procedureSQLExecutor, expr ast.ExprNode) (types.Datum, *types.FieldType, error) {
+	sel := &ast.SelectStmt{
+		SelectStmtOpts: &ast.SelectStmtOpts{SQLCache: true},
+		Kind:           ast.SelectStmtKindSelect,
+		Fields:         &ast.FieldList{Fields: []*ast.SelectField{{Expr: expr}}},
+	}
+	rows, fieldTypes, err := execSQL(ctx, sctx, sel, true)
+	if err != nil {
+		return types.Datum{}, nil, err
+	}
+	return rows[0][0], fieldTypes[0], nil
+}
+
+type procedureVariable struct {
+	tp    *types.FieldType
+	value types.Datum
+	// readOnly is true for the OLD row of a trigger and the NEW row of an AFTER trigger.
+	readOnly bool
+}
+
+func (v *procedureVariable) set(sessVars *variable.SessionVars, d types.Datum) error {
+	value, err := d.ConvertTo(sessVars.StmtCtx.TypeCtx(), v.tp)
+	if err != nil {
+		return err
+	}
+	v.value = value
+	return nil
+}
+
+type procedureCursor struct {
+	stmt ast.StmtNode
+	open bool
+	rows [][]types.Datum
+	pos  int
+}
+
+type procedureHandler struct {
+	action     int
+	conditions []ast.ErrNode
+	body       ast.StmtNode
+}
+
+// procedureScope holds the variables, cursors and handlers declared in a BEGIN ... END block.
+type procedureScope struct {
+	parent   *procedureScope
+	vars     map[string]*procedureVariable
+	cursors  map[string]*procedureCursor
+	handlers []*procedureHandler
+	// handlerOf is the scope whose handler is being executed in this scope. The handlers of
+	// that scope are invisible to the statements of the handler.
+	handlerOf *procedureScope
+}
+
+func newProcedureScope(parent *procedureScope) *procedureScope {
+	return &procedureScope{
+		parent:  parent,
+		vars:    make(map[string]*procedureVariable),
+		cursors: make(map[string]*procedureCursor),
+	}
+}
+
+func (s *procedureScope) lookupVar(name string) *procedureVariable {
+	for ; s != nil; s = s.parent {
+		if v, ok := s.vars[name]; ok {
+			return v
+		}
+	}
+	return nil
+}
+
+func (s *procedureScope) lookupCursor(name string) *procedureCursor {
+	for ; s != nil; s = s.parent {
+		if c, ok := s.cursors[name]; ok {
+			return c
+		}
+	}
+	return nil
+}
+
+// procedureJumpSignal is returned by LEAVE and ITERATE to transfer control to the labeled block or loop.
+type procedureJumpSignal struct {
+	label string
+	leave bool
+}
+
+func (s *procedureJumpSignal) Error() string {
+	return fmt.Sprintf("jump to label %s", s.label)
+}
+
+// procedureExitSignal is returned after an EXIT handler is executed to leave the block declaring the handler.
+type procedureExitSignal struct {
+	scope *procedureScope
+}
+
+func (*procedureExitSignal) Error() string {
+	return "exit handler"
+}
+
+// procedureUnhandledError wraps an error for which no handler is found, so the outer statement
+// lists pass it through instead of looking up the handlers again.
+type procedureUnhandledError struct {
+	err error
+}
+
+func (e *procedureUnhandledError) Error() string {
+	return e.err.Error()
+}
+
+// procedureExec interprets the body of a stored procedure or a trigger.
+type procedureExec struct {
+	sctx   sessionctx.Context
+	name   string
+	caller *procedureExec
+	scope  *procedureScope
+	// execSQL executes the SQL statements of the body. The statements of a trigger are executed
+	// as a part of the statement activating the trigger rather than by the session.
+	execSQL procedureSQLExecutor
+}
+
+// GetVariable implements the variable.ProcedureContext interface.
+func (p *procedureExec) GetVariable(name string) (types.Datum, *types.FieldType, bool) {
+	v := p.scope.lookupVar(name)
+	if v == nil {
+		return types.Datum{}, nil, false
+	}
+	return *v.value.Clone(), v.tp.Clone(), true
+}
+
+func (p *procedureExec) run(ctx context.Context, body ast.StmtNode) error {
+	err := p.execStmts(ctx, []ast.StmtNode{body})
+	switch x := err.(type) {
+	case *procedureUnhandledError:
+		return x.err
+	case *procedureJumpSignal:
+		typ := "ITERATE"
+		if x.leave {
+			typ = "LEAVE"
+		}
+		return exeerrors.ErrSpLilabelMismatch.GenWithStackByArgs(typ, x.label)
+	}
+	return err
+}
+
+func (p *procedureExec) execStmts(ctx context.Context, stmts []ast.StmtNode) error {
+	for _, stmt := range stmts {
+		if err := p.sctx.GetSessionVars().SQLKiller.HandleSignal(); err != nil {
+			return err
+		}
+		err := p.execStmt(ctx, stmt)
+		if err == nil {
+			continue
+		}
+		switch err.(type) {
+		case *procedureJumpSignal, *procedureExitSignal, *procedureUnhandledError:
+			return err
+		}
+		if err = p.handleError(ctx, err); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// handleError executes the handler for err. A nil error is returned if the statements after
+// the failed one should continue to execute.
+func (p *procedureExec) handleError(ctx context.Context, err error) error {
+	handler, declScope := p.findHandler(err)
+	if handler == nil {
+		return &procedureUnhandledError{err: err}
+	}
+	scope := p.scope
+	handlerScope := newProcedureScope(declScope)
+	handlerScope.handlerOf = declScope
+	p.scope = handlerScope
+	handlerErr := p.execStmts(ctx, []ast.StmtNode{handler.body})
+	p.scope = scope
+	if handlerErr != nil {
+		return handlerErr
+	}
+	if handler.action == ast.PROCEDUR_EXIT {
+		return &procedureExitSignal{scope: declScope}
+	}
+	return nil
+}
+
+// findHandler finds the handler for err from the innermost scope. When several handlers of a
+// scope match the error, the one for the error code is preferred to the one for the SQLSTATE,
+// which is preferred to the one for the condition class.
+func (p *procedureExec) findHandler(err error) (*procedureHandler, *procedureScope) {
+	code, state := uint16(mysql.ErrUnknown), mysql.DefaultMySQLState
+	if te, ok := errors.Cause(err).(*terror.Error); ok {
+		sqlErr := terror.ToSQLError(te)
+		code, state = sqlErr.Code, sqlErr.State
+	}
+	for s := p.scope; s != nil; {
+		if s.handlerOf != nil {
+			s = s.handlerOf.parent
+			continue
+		}
+		var (
+			matched  *procedureHandler
+			priority int
+		)
+		for _, h := range s.handlers {
+			for _, cond := range h.conditions {
+				if pri := matchProcedureCondition(cond, code, state); pri > priority {
+					matched, priority = h, pri
+				}
+			}
+		}
+		if matched != nil {
+			return matched, s
+		}
+		s = s.parent
+	}
+	return nil, nil
+}
+
+func matchProcedureCondition(cond ast.ErrNode, code uint16, state string) int {
+	switch x := cond.(type) {
+	case *ast.ProcedureErrorVal:
+		if x.ErrorNum == uint64(code) {
+			return 3
+		}
+	case *ast.ProcedureErrorState:
+		if x.CodeStatus == state {
+			return 2
+		}
+	case *ast.ProcedureErrorCon:
+		class := state[:2]
+		switch x.ErrorCon {
+		case ast.PROCEDUR_SQLWARNING:
+			if class == "01" {
+				return 1
+			}
+		case ast.PROCEDUR_NOT_FOUND:
+			if class == "02" {
+				return 1
+			}
+		case ast.PROCEDUR_SQLEXCEPTION:
+			if class != "00" && class != "01" && class != "02" {
+				return 1
+			}
+		}
+	}
+	return 0
+}
+
+func (p *procedureExec) execStmt(ctx context.Context, stmt ast.StmtNode) error {
+	switch x := stmt.(type) {
+	case *ast.ProcedureBlock:
+		return p.execBlock(ctx, x)
+	case *ast.ProcedureLabelBlock:
+		err := p.execBlock(ctx, x.Block)
+		if jump, ok := err.(*procedureJumpSignal); ok && jump.leave && strings.EqualFold(jump.label, x.LabelName) {
+			return nil
+		}
+		return err
+	case *ast.ProcedureLabelLoop:
+		return p.execLoop(ctx, x.LabelName, x.Block)
+	case *ast.ProcedureWhileStmt, *ast.ProcedureRepeatStmt, *ast.ProcedureLoopStmt:
+		return p.execLoop(ctx, "", x)
+	case *ast.ProcedureJump:
+		return &procedureJumpSignal{label: x.Name, leave: x.IsLeave}
+	case *ast.ProcedureIfInfo:
+		return p.execIf(ctx, x.IfBody)
+	case *ast.SimpleCaseStmt:
+		for _, when := range x.WhenCases {
+			cond := &ast.BinaryOperationExpr{Op: opcode.EQ, L: x.Condition, R: when.Expr}
+			ok, err := p.evalCondition(ctx, cond)
+			if err != nil {
+				return err
+			}
+			if ok {
+				return p.execStmts(ctx, when.ProcedureStmts)
+			}
+		}
+		if x.ElseCases == nil {
+			return exeerrors.ErrSpCaseNotFound.GenWithStackByArgs()
+		}
+		return p.execStmts(ctx, x.ElseCases)
+	case *ast.SearchCaseStmt:
+		for _, when := range x.WhenCases {
+			ok, err := p.evalCondition(ctx, when.Expr)
+			if err != nil {
+				return err
+			}
+			if ok {
+				return p.execStmts(ctx, when.ProcedureStmts)
+			}
+		}
+		if x.ElseCases == nil {
+			return exeerrors.ErrSpCaseNotFound.GenWithStackByArgs()
+		}
+		return p.execStmts(ctx, x.ElseCases)
+	case *ast.ProcedureOpenCur:
+		return p.openCursor(ctx, x.CurName)
+	case *ast.ProcedureFetchInto:
+		return p.fetchCursor(x)
+	case *ast.ProcedureCloseCur:
+		cursor := p.scope.lookupCursor(x.CurName)
+		if cursor == nil {
+			return exeerrors.ErrSpCursorMismatch.GenWithStackByArgs(x.CurName)
+		}
+		if !cursor.open {
+			return exeerrors.ErrSpCursorNotOpen.GenWithStackByArgs()
+		}
+		cursor.open, cursor.rows = false, nil
+		return nil
+	case *ast.SetStmt:
+		return p.execSet(ctx, x)
+	case *ast.CallStmt:
+		return callProcedure(ctx, p.sctx, p, x)
+	default:
+		// The result sets of the statements are sent to the client after the procedure returns.
+		_, _, err := p.execSQL(ctx, p.sctx, stmt, false)
+		return err
+	}
+}
+
+func (p *procedureExec) execBlock(ctx context.Context, block *ast.ProcedureBlock) error {
+	scope := newProcedureScope(p.scope)
+	p.scope = scope
+	defer func() {
+		p.scope = scope.parent
+	}()
+	for _, decl := range block.ProcedureVars {
+		if err := p.declare(ctx, scope, decl); err != nil {
+			return err
+		}
+	}
+	err := p.execStmts(ctx, block.ProcedureProcStmts)
+	if exit, ok := err.(*procedureExitSignal); ok && exit.scope == scope {
+		return nil
+	}
+	return err
+}
+
+func (p *procedureExec) declare(ctx context.Context, scope *procedureScope, decl ast.DeclNode) error {
+	sessVars := p.sctx.GetSessionVars()
+	switch x := decl.(type) {
+	case *ast.ProcedureDecl:
+		var value types.Datum
+		if x.DeclDefault != nil {
+			d, _, err := evalProcedureExpr(ctx, p.sctx, p.execSQL, x.DeclDefault)
+			if err != nil {
+				return err
+			}
+			value = d
+		}
+		for _, name := range x.DeclNames {
+			v := &procedureVariable{tp: procedureVariableType(x.DeclType, sessVars)}
+			if err := v.set(sessVars, value); err != nil {
+				return err
+			}
+			scope.vars[name] = v
+		}
+	case *ast.ProcedureCursor:
+		scope.cursors[x.CurName] = &procedureCursor{stmt: x.Selectstring}
+	case *ast.ProcedureErrorControl:
+		scope.handlers = append(scope.handlers, &procedureHandler{
+			action:     x.ControlHandle,
+			conditions: x.ErrorCon,
+			body:       x.Operate,
+		})
+	}
+	return nil
+}
+
+func (p *procedureExec) execLoop(ctx context.Context, label string, loop ast.StmtNode) error {
+	for {
+		if err := p.sctx.GetSessionVars().SQLKiller.HandleSignal(); err != nil {
+			return err
+		}
+		var (
+			body  []ast.StmtNode
+			until ast.ExprNode
+		)
+		switch x := loop.(type) {
+		case *ast.ProcedureWhileStmt:
+			ok, err := p.evalCondition(ctx, x.Condition)
+			if err != nil || !ok {
+				return err
+			}
+			body = x.Body
+		case *ast.ProcedureRepeatStmt:
+			body, until = x.Body, x.Condition
+		case *ast.ProcedureLoopStmt:
+			body = x.Body
+		default:
+			return errors.Errorf("unexpected loop statement %T", loop)
+		}
+		err := p.execStmts(ctx, body)
+		if jump, ok := err.(*procedureJumpSignal); ok && label != "" && strings.EqualFold(jump.label, label) {
+			if jump.leave {
+				return nil
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if until != nil {
+			ok, err := p.evalCondition(ctx, until)
+			if err != nil || ok {
+				return err
+			}
+		}
+	}
+}
+
+func (p *procedureExec) execIf(ctx context.Context, block *ast.ProcedureIfBlock) error {
+	ok, err := p.evalCondition(ctx, block.IfExpr)
+	if err != nil {
+		return err
+	}
+	if ok {
+		return p.execStmts(ctx, block.ProcedureIfStmts)
+	}
+	switch x := block.ProcedureElseStmt.(type) {
+	case *ast.ProcedureElseIfBlock:
+		return p.execIf(ctx, x.ProcedureIfStmt)
+	case *ast.ProcedureElseBlock:
+		return p.execStmts(ctx, x.ProcedureIfStmts)
+	}
+	return nil
+}
+
+func (p *procedureExec) evalCondition(ctx context.Context, expr ast.ExprNode) (bool, error) {
+	d, _, err := evalProcedureExpr(ctx, p.sctx, p.execSQL, expr)
+	if err != nil || d.IsNull() {
+		return false, err
+	}
+	b, err := d.ToBool(p.sctx.GetSessionVars().StmtCtx.TypeCtx())
+	return b != 0, err
+}
+
+// execSet assigns the procedure variables in the SET statement, the other variables are set by
+// executing the statement.
+func (p *procedureExec) execSet(ctx context.Context, s *ast.SetStmt) error {
+	var pending []*ast.VariableAssignment
+	flush := func() error {
+		if len(pending) == 0 {
+			return nil
+		}
+		_, _, err := p.execSQL(ctx, p.sctx, &ast.SetStmt{Variables: pending}, false)
+		pending = nil
+		return err
+	}
+	sessVars := p.sctx.GetSessionVars()
+	for _, assign := range s.Variables {
+		var v *procedureVariable
+		if assign.IsSystem && !assign.IsGlobal {
+			v = p.scope.lookupVar(strings.ToLower(assign.Name))
+		}
+		if v == nil {
+			pending = append(pending, assign)
+			continue
+		}
+		if v.readOnly {
+			return triggerReadOnlyRowErr(assign.Name)
+		}
+		if err := flush(); err != nil {
+			return err
+		}
+		var value types.Datum
+		if _, isDefault := assign.Value.(*ast.DefaultExpr); !isDefault {
+			d, _, err := evalProcedureExpr(ctx, p.sctx, p.execSQL, assign.Value)
+			if err != nil {
+				return err
+			}
+			value = d
+		}
+		if err := v.set(sessVars, value); err != nil {
+			return err
+		}
+	}
+	return flush()
+}
+
+func (p *procedureExec) openCursor(ctx context.Context, name string) error {
+	cursor := p.scope.lookupCursor(name)
+	if cursor == nil {
+		return exeerrors.ErrSpCursorMismatch.GenWithStackByArgs(name)
+	}
+	if cursor.open {
+		return exeerrors.ErrSpCursorAlreadyOpen.GenWithStackByArgs()
+	}
+	// The rows are materialized when the cursor is opened.
+	rows, _, err := p.execSQL(ctx, p.sctx, cursor.stmt, true)
+	if err != nil {
+		return err
+	}
+	cursor.open, cursor.rows, cursor.pos = true, rows, 0
+	return nil
+}
+
+func (p *procedureExec) fetchCursor(s *ast.ProcedureFetchInto) error {
+	cursor := p.scope.lookupCursor(s.CurName)
+	if cursor == nil {
+		return exeerrors.ErrSpCursorMismatch.GenWithStackByArgs(s.CurName)
+	}
+	if !cursor.open {
+		return exeerrors.ErrSpCursorNotOpen.GenWithStackByArgs()
+	}
+	if cursor.pos >= len(cursor.rows) {
+		return exeerrors.ErrSpFetchNoData.GenWithStackByArgs()
+	}
+	row := cursor.rows[cursor.pos]
+	if len(row) != len(s.Variables) {
+		return exeerrors.ErrSpWrongNoOfFetchArgs.GenWithStackByArgs()
+	}
+	cursor.pos++
+	sessVars := p.sctx.GetSessionVars()
+	for i, name := range s.Variables {
+		v := p.scope.lookupVar(name)
+		if v == nil {
+			return exeerrors.ErrSpUndeclaredVar.GenWithStackByArgs(name)
+		}
+		if err := v.set(sessVars, row[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// procedureChecker checks the declarations and labels of a procedure or a trigger when it's created.
+type procedureChecker struct {
+	scopes []*procedureCheckScope
+	labels []procedureLabel
+	// inTrigger is true if the body of a trigger is checked, which can't execute all kinds of statements.
+	inTrigger bool
+}
+
+type procedureCheckScope struct {
+	vars    map[string]struct{}
+	cursors map[string]struct{}
+}
+
+type procedureLabel struct {
+	name   string
+	isLoop bool
+}
+
+func checkProcedureDefinition(s *ast.ProcedureInfo) error {
+	params := &procedureCheckScope{vars: make(map[string]struct{}), cursors: make(map[string]struct{})}
+	for _, param := range s.ProcedureParam {
+		name := strings.ToLower(param.ParamName)
+		if _, ok := params.vars[name]; ok {
+			return exeerrors.ErrSpDupParam.GenWithStackByArgs(param.ParamName)
+		}
+		params.vars[name] = struct{}{}
+	}
+	c := &procedureChecker{scopes: []*procedureCheckScope{params}}
+	return c.checkStmt(s.ProcedureBody)
+}
+
+// checkTriggerDefinition checks the body of a trigger when it's created.
+func checkTriggerDefinition(s *ast.CreateTriggerStmt) error {
+	c := &procedureChecker{inTrigger: true}
+	return c.checkStmt(s.Body)
+}
+
+// checkTriggerStmt checks whether the statement can be executed by a trigger. Like MySQL, a trigger
+// can't return a result set to the client or commit the transaction.
+func checkTriggerStmt(stmt ast.StmtNode) error {
+	switch stmt.(type) {
+	case *ast.InsertStmt, *ast.UpdateStmt, *ast.DeleteStmt, *ast.MergeStmt, *ast.SetStmt, *ast.DoStmt, *ast.CallStmt:
+		return nil
+	case *ast.SelectStmt, *ast.SetOprStmt, *ast.ShowStmt, *ast.ExplainStmt:
+		return exeerrors.ErrSpNoRetset.GenWithStackByArgs("trigger")
+	}
+	return exeerrors.ErrCommitNotAllowedInSfOrTrg.GenWithStackByArgs()
+}
+
+func (c *procedureChecker) checkStmts(stmts []ast.StmtNode) error {
+	for _, stmt := range stmts {
+		if err := c.checkStmt(stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *procedureChecker) checkStmt(stmt ast.StmtNode) error {
+	switch x := stmt.(type) {
+	case *ast.ProcedureBlock:
+		return c.checkBlock(x)
+	case *ast.ProcedureLabelBlock:
+		if x.LabelError {
+			return exeerrors.ErrSpLabelMismatch.GenWithStackByArgs(x.LabelEnd)
+		}
+		c.labels = append(c.labels, procedureLabel{name: x.LabelName})
+		defer func() { c.labels = c.labels[:len(c.labels)-1] }()
+		return c.checkBlock(x.Block)
+	case *ast.ProcedureLabelLoop:
+		if x.LabelError {
+			return exeerrors.ErrSpLabelMismatch.GenWithStackByArgs(x.LabelEnd)
+		}
+		c.labels = append(c.labels, procedureLabel{name: x.LabelName, isLoop: true})
+		defer func() { c.labels = c.labels[:len(c.labels)-1] }()
+		return c.checkStmt(x.Block)
+	case *ast.ProcedureWhileStmt:
+		return c.checkStmts(x.Body)
+	case *ast.ProcedureRepeatStmt:
+		return c.checkStmts(x.Body)
+	case *ast.ProcedureLoopStmt:
+		return c.checkStmts(x.Body)
+	case *ast.ProcedureJump:
+		for i := len(c.labels) - 1; i >= 0; i-- {
+			if strings.EqualFold(c.labels[i].name, x.Name) && (x.IsLeave || c.labels[i].isLoop) {
+				return nil
+			}
+		}
+		typ := "ITERATE"
+		if x.IsLeave {
+			typ = "LEAVE"
+		}
+		return exeerrors.ErrSpLilabelMismatch.GenWithStackByArgs(typ, x.Name)
+	case *ast.ProcedureIfInfo:
+		return c.checkIf(x.IfBody)
+	case *ast.SimpleCaseStmt:
+		for _, when := range x.WhenCases {
+			if err := c.checkStmts(when.ProcedureStmts); err != nil {
+				return err
+			}
+		}
+		return c.checkStmts(x.ElseCases)
+	case *ast.SearchCaseStmt:
+		for _, when := range x.WhenCases {
+			if err := c.checkStmts(when.ProcedureStmts); err != nil {
+				return err
+			}
+		}
+		return c.checkStmts(x.ElseCases)
+	case *ast.ProcedureOpenCur:
+		return c.checkCursor(x.CurName)
+	case *ast.ProcedureCloseCur:
+		return c.checkCursor(x.CurName)
+	case *ast.ProcedureFetchInto:
+		if err := c.checkCursor(x.CurName); err != nil {
+			return err
+		}
+		for _, name := range x.Variables {
+			if !c.hasVar(name) {
+				return exeerrors.ErrSpUndeclaredVar.GenWithStackByArgs(name)
+			}
+		}
+	default:
+		if c.inTrigger {
+			return checkTriggerStmt(stmt)
+		}
+	}
+	return nil
+}
+
+func (c *procedureChecker) checkBlock(block *ast.ProcedureBlock) error {
+	scope := &procedureCheckScope{vars: make(map[string]struct{}), cursors: make(map[string]struct{})}
+	c.scopes = append(c.scopes, scope)
+	defer func() { c.scopes = c.scopes[:len(c.scopes)-1] }()
+	for _, decl := range block.ProcedureVars {
+		switch x := decl.(type) {
+		case *ast.ProcedureDecl:
+			for _, name := range x.DeclNames {
+				if _, ok := scope.vars[name]; ok {
+					return exeerrors.ErrSpDupVar.GenWithStackByArgs(name)
+				}
+				scope.vars[name] = struct{}{}
+			}
+		case *ast.ProcedureCursor:
+			if _, ok := scope.cursors[x.CurName]; ok {
+				return exeerrors.ErrSpDupCurs.GenWithStackByArgs(x.CurName)
+			}
+			scope.cursors[x.CurName] = struct{}{}
+		case *ast.ProcedureErrorControl:
+			// The labels of the enclosing block are invisible to the handler.
+			labels := c.labels
+			c.labels = nil
+			err := c.checkStmt(x.Operate)
+			c.labels = labels
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return c.checkStmts(block.ProcedureProcStmts)
+}
+
+func (c *procedureChecker) checkIf(block *ast.ProcedureIfBlock) error {
+	if err := c.checkStmts(block.ProcedureIfStmts); err != nil {
+		return err
+	}
+	switch x := block.ProcedureElseStmt.(type) {
+	case *ast.ProcedureElseIfBlock:
+		return c.checkIf(x.ProcedureIfStmt)
+	case *ast.ProcedureElseBlock:
+		return c.checkStmts(x.ProcedureIfStmts)
+	}
+	return nil
+}
+
+func (c *procedureChecker) checkCursor(name string) error {
+	for i := len(c.scopes) - 1; i >= 0; i-- {
+		if _, ok := c.scopes[i].cursors[name]; ok {
+			return nil
+		}
+	}
+	return exeerrors.ErrSpCursorMismatch.GenWithStackByArgs(name)
+}
+
+func (c *procedureChecker) hasVar(name string) bool {
+	for i := len(c.scopes) - 1; i >= 0; i-- {
+		if _, ok := c.scopes[i].vars[name]; ok {
+			return true
+		}
+	}
+	return false
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/pingcap/tidb/pkg/parser/terror"
 	"github.com/pingcap/tidb/pkg/privilege"
 	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/sessionctx/vardef"
 	"github.com/pingcap/tidb/pkg/sessiontxn"
 	"github.com/pingcap/tidb/pkg/table"
 	"github.com/pingcap/tidb/pkg/util/chunk"
@@ -168,6 +169,16 @@ func (e *RevokeExec) revokeOneUser(ctx context.Context, internalSession sessionc
 		if err != nil {
 			return err
 		}
+		if !ok && vardef.EnablePartialRevokes.Load() {
+			// The privileges granted globally can be revoked on the database partially.
+			userPriv, _, err := getUserPrivAndRestrictions(internalSession, user, host)
+			if err != nil {
+				return err
+			}
+			for _, priv := range e.Privs {
+				ok = ok || userPriv&dbPrivMask(priv.Priv) > 0
+			}
+		}
 		if !ok {
 			return errors.Errorf("There is no such grant defined for user '%s' on host '%s' on database %s", user, host, dbName)
 		}
@@ -238,7 +249,11 @@ func (e *RevokeExec) revokeGlobalPriv(internalSession sessionctx.Context, priv *
 	sqlescape.MustFormatSQL(sql, " WHERE User=%? AND Host=%?", user, strings.ToLower(host))
 
 	_, err = internalSession.GetSQLExecutor().ExecuteInternal(ctx, sql.String())
-	return err
+	if err != nil {
+		return err
+	}
+	// The partial revokes of the privileges are useless after they are revoked globally.
+	return liftRestrictions(internalSession, user, strings.ToLower(host), "", dbPrivMask(priv.Priv))
 }
 
 func (e *RevokeExec) revokeDBPriv(internalSession sessionctx.Context, priv *ast.PrivElem, userName, host string) error {
@@ -268,7 +283,19 @@ func (e *RevokeExec) revokeDBPriv(internalSession sessionctx.Context, priv *ast.
 		sqlescape.MustFormatSQL(sql, " AND %n='N'", v.ColumnString())
 	}
 	_, err = internalSession.GetSQLExecutor().ExecuteInternal(ctx, sql.String())
-	return err
+	if err != nil || !vardef.EnablePartialRevokes.Load() {
+		return err
+	}
+
+	// Revoke the global privileges on the database partially.
+	userPriv, restrictions, err := getUserPrivAndRestrictions(internalSession, userName, host)
+	if err != nil {
+		return err
+	}
+	if privs := userPriv & dbPrivMask(priv.Priv); privs > 0 {
+		return updateRestrictions(internalSession, userName, host, restrictions.Revoke(dbName, privs))
+	}
+	return nil
 }
 
 func (e *RevokeExec) revokeTablePriv(ctx context.Context, internalSession sessionctx.Context, priv *ast.PrivElem, user, host string) error {
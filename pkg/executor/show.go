@@ -211,7 +211,7 @@ func (e *ShowExec) fetchAll(ctx context.Context) error {
 	case ast.ShowIndex:
 		return e.fetchShowIndex()
 	case ast.ShowProcedureStatus:
-		return e.fetchShowProcedureStatus()
+		return e.fetchShowProcedureStatus(ctx)
 	case ast.ShowStatus:
 		return e.fetchShowStatus()
 	case ast.ShowTables:
@@ -221,7 +221,7 @@ func (e *ShowExec) fetchAll(ctx context.Context) error {
 	case ast.ShowTableStatus:
 		return e.fetchShowTableStatus(ctx)
 	case ast.ShowTriggers:
-		return e.fetchShowTriggers()
+		return e.fetchShowTriggers(ctx)
 	case ast.ShowVariables:
 		return e.fetchShowVariables(ctx)
 	case ast.ShowWarnings:
@@ -231,7 +231,7 @@ func (e *ShowExec) fetchAll(ctx context.Context) error {
 	case ast.ShowProcessList:
 		return e.fetchShowProcessList()
 	case ast.ShowEvents:
-		// empty result
+		return e.fetchShowEvents(ctx)
 	case ast.ShowStatsExtended:
 		return e.fetchShowStatsExtended(ctx)
 	case ast.ShowStatsMeta:
@@ -1085,6 +1085,10 @@ func constructResultOfShowCreateTable(ctx sessionctx.Context, dbName *ast.CIStr,
 		ConstructResultOfShowCreateSequence(ctx, tableInfo, buf)
 		return nil
 	}
+	if tableInfo.IsMaterializedView() {
+		fetchShowCreateTable4MaterializedView(ctx, tableInfo, buf)
+		return nil
+	}
 
 	tblCharset := tableInfo.Charset
 	if len(tblCharset) == 0 {
@@ -1146,6 +1150,9 @@ func constructResultOfShowCreateTable(ctx sessionctx.Context, dbName *ast.CIStr,
 			if mysql.HasNotNullFlag(col.GetFlag()) {
 				buf.WriteString(" NOT NULL")
 			}
+			if col.SRID != nil {
+				fmt.Fprintf(buf, " /*!80003 SRID %d */", *col.SRID)
+			}
 			// default values are not shown for generated columns in MySQL
 			if !mysql.HasNoDefaultValueFlag(col.GetFlag()) && !col.IsGenerated() {
 				defaultValue := col.GetDefaultValue()
@@ -1430,7 +1437,11 @@ func constructResultOfShowCreateTable(ctx sessionctx.Context, dbName *ast.CIStr,
 	}
 
 	if tableInfo.TempTableType == model.TempTableGlobal {
-		fmt.Fprintf(buf, " ON COMMIT DELETE ROWS")
+		if tableInfo.OnCommitPreserveRows {
+			fmt.Fprintf(buf, " ON COMMIT PRESERVE ROWS")
+		} else {
+			fmt.Fprintf(buf, " ON COMMIT DELETE ROWS")
+		}
 	}
 
 	if tableInfo.PlacementPolicyRef != nil {
@@ -1632,6 +1643,18 @@ func fetchShowCreateTable4View(ctx sessionctx.Context, tb *model.TableInfo, buf
 	fmt.Fprintf(buf, ") AS %s", tb.View.SelectStmt)
 }
 
+func fetchShowCreateTable4MaterializedView(ctx sessionctx.Context, tb *model.TableInfo, buf *bytes.Buffer) {
+	sqlMode := ctx.GetSessionVars().SQLMode
+	fmt.Fprintf(buf, "CREATE MATERIALIZED VIEW %s (", stringutil.Escape(tb.Name.O, sqlMode))
+	for i, col := range tb.Columns {
+		fmt.Fprintf(buf, "%s", stringutil.Escape(col.Name.O, sqlMode))
+		if i < len(tb.Columns)-1 {
+			fmt.Fprintf(buf, ", ")
+		}
+	}
+	fmt.Fprintf(buf, ") REFRESH %s AS %s", tb.MaterializedView.RefreshMethod.String(), tb.MaterializedView.SelectStmt)
+}
+
 // ConstructResultOfShowCreateDatabase constructs the result for show create database.
 func ConstructResultOfShowCreateDatabase(ctx sessionctx.Context, dbInfo *model.DBInfo, ifNotExists bool, buf *bytes.Buffer) (err error) {
 	sqlMode := ctx.GetSessionVars().SQLMode
@@ -2008,11 +2031,36 @@ func (e *ShowExec) fetchShowPrivileges() error {
 	return nil
 }
 
-func (*ShowExec) fetchShowTriggers() error {
-	return nil
-}
-
-func (*ShowExec) fetchShowProcedureStatus() error {
+func (e *ShowExec) fetchShowProcedureStatus(ctx context.Context) error {
+	exec := e.Ctx().GetRestrictedSQLExecutor()
+	ctx = kv.WithInternalSourceType(ctx, kv.InternalTxnOthers)
+	rows, _, err := exec.ExecRestrictedSQL(ctx, nil, `SELECT route_schema, name, type, definer, last_altered, created, security_type,
+		IFNULL(comment, ''), IFNULL(character_set_client, ''), IFNULL(connection_collation, ''), IFNULL(schema_collation, '')
+		FROM %n.%n WHERE type = 'PROCEDURE' ORDER BY route_schema, name`, mysql.SystemDB, mysql.RoutinesTable)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	checker := privilege.GetPrivilegeManager(e.Ctx())
+	activeRoles := e.Ctx().GetSessionVars().ActiveRoles
+	for _, row := range rows {
+		dbName := row.GetString(0)
+		if checker != nil && !checker.DBIsVisible(activeRoles, dbName) {
+			continue
+		}
+		e.appendRow([]any{
+			dbName,
+			row.GetString(1),
+			row.GetEnum(2).String(),
+			row.GetString(3),
+			row.GetTime(4),
+			row.GetTime(5),
+			row.GetEnum(6).String(),
+			row.GetString(7),
+			row.GetString(8),
+			row.GetString(9),
+			row.GetString(10),
+		})
+	}
 	return nil
 }
 
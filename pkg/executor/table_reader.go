@@ -188,6 +188,8 @@ type TableReaderExecutor struct {
 	virtualColumnRetFieldTypes []*types.FieldType
 	// batchCop indicates whether use super batch coprocessor request, only works for TiFlash engine.
 	batchCop bool
+	// runtimeFilters are the runtime filters pushed down from the hash join on top of the reader.
+	runtimeFilters copRuntimeFilters
 
 	// If dummy flag is set, this is not a real TableReader, it just provides the KV ranges for UnionScan.
 	// Used by the temporary table, cached table.
@@ -295,6 +297,14 @@ func (e *TableReaderExecutor) Open(ctx context.Context) error {
 		return nil
 	}
 
+	if e.runtimeFilters.reset() {
+		// the requests are sent in Next after the runtime filters are built.
+		return nil
+	}
+	return e.openResult(ctx, firstPartRanges, secondPartRanges)
+}
+
+func (e *TableReaderExecutor) openResult(ctx context.Context, firstPartRanges, secondPartRanges []*ranger.Range) error {
 	firstResult, err := e.buildResp(ctx, firstPartRanges)
 	if err != nil {
 		return err
@@ -312,6 +322,17 @@ func (e *TableReaderExecutor) Open(ctx context.Context) error {
 	return nil
 }
 
+func (e *TableReaderExecutor) applyRuntimeFilters(ctx context.Context) error {
+	if err := e.runtimeFilters.apply(ctx, e.buildPBCtx, e.dagPB, e.plans); err != nil {
+		return err
+	}
+	if e.runtimeFilters.empty {
+		return nil
+	}
+	firstPartRanges, secondPartRanges := distsql.SplitRangesAcrossInt64Boundary(e.ranges, e.keepOrder, e.desc, e.table.Meta() != nil && e.table.Meta().IsCommonHandle)
+	return e.openResult(ctx, firstPartRanges, secondPartRanges)
+}
+
 // Next fills data into the chunk passed by its caller.
 // The task was actually done by tableReaderHandler.
 func (e *TableReaderExecutor) Next(ctx context.Context, req *chunk.Chunk) error {
@@ -320,6 +341,16 @@ func (e *TableReaderExecutor) Next(ctx context.Context, req *chunk.Chunk) error
 		req.Reset()
 		return nil
 	}
+	if e.runtimeFilters.pending {
+		if err := e.applyRuntimeFilters(ctx); err != nil {
+			return err
+		}
+	}
+	if e.runtimeFilters.empty {
+		// no row can be joined by the hash join on top of the reader.
+		req.Reset()
+		return nil
+	}
 
 	logutil.Eventf(ctx, "table scan table: %s, range: %v", stringutil.MemoizeStr(func() string {
 		var tableName string
@@ -397,7 +428,7 @@ func (e *TableReaderExecutor) buildResp(ctx context.Context, ranges []*ranger.Ra
 		}
 		var results []distsql.SelectResult
 		for _, kvReq := range kvReqs {
-			result, err := e.SelectResult(ctx, e.dctx, kvReq, exec.RetTypes(e), getPhysicalPlanIDs(e.plans), e.ID())
+			result, err := e.SelectResult(ctx, e.dctx, kvReq, exec.RetTypes(e), e.runtimeFilters.planIDs(e.plans), e.ID())
 			if err != nil {
 				return nil, err
 			}
@@ -418,7 +449,7 @@ func (e *TableReaderExecutor) buildResp(ctx context.Context, ranges []*ranger.Ra
 	})
 	e.kvRanges = kvReq.KeyRanges.AppendSelfTo(e.kvRanges)
 
-	result, err := e.SelectResult(ctx, e.dctx, kvReq, exec.RetTypes(e), getPhysicalPlanIDs(e.plans), e.ID())
+	result, err := e.SelectResult(ctx, e.dctx, kvReq, exec.RetTypes(e), e.runtimeFilters.planIDs(e.plans), e.ID())
 	if err != nil {
 		return nil, err
 	}
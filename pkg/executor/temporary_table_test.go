@@ -22,8 +22,10 @@ import (
 	"time"
 
 	"github.com/pingcap/failpoint"
+	"github.com/pingcap/tidb/pkg/errno"
 	"github.com/pingcap/tidb/pkg/session"
 	"github.com/pingcap/tidb/pkg/testkit"
+	"github.com/pingcap/tidb/pkg/testkit/external"
 	"github.com/stretchr/testify/require"
 )
 
@@ -50,6 +52,13 @@ func TestGlobalTemporaryTableNoNetworkWithCreateAndThenCreateNormalTable(t *test
 	})
 }
 
+func TestPreservedGlobalTemporaryTableNoNetwork(t *testing.T) {
+	assertTemporaryTableNoNetwork(t, func(tk *testkit.TestKit) {
+		tk.MustExec("create global temporary table tmp_t (id int primary key, a int, b int, index(a)) on commit preserve rows")
+		tk.MustExec("begin")
+	})
+}
+
 func TestLocalTemporaryTableNoNetworkWithCreateOutsideTxn(t *testing.T) {
 	assertTemporaryTableNoNetwork(t, func(tk *testkit.TestKit) {
 		tk.MustExec("create temporary table tmp_t (id int primary key, a int, b int, index(a))")
@@ -182,3 +191,80 @@ func TestIssue58875(t *testing.T) {
 		}
 	}
 }
+
+func TestGlobalTemporaryTableOnCommitPreserveRows(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk1 := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk1.MustExec("use test")
+
+	tk.MustExec("create global temporary table tmp_t (id int auto_increment primary key, a int, index(a)) on commit preserve rows")
+	tk.MustExec("insert into tmp_t(a) values (1), (2)")
+	tk.MustExec("begin")
+	tk.MustExec("insert into tmp_t(a) values (3)")
+	tk.MustExec("commit")
+	tk.MustQuery("select * from tmp_t").Check(testkit.Rows("1 1", "2 2", "3 3"))
+	tk.MustQuery("select id from tmp_t where a = 2").Check(testkit.Rows("2"))
+
+	// The rows are only visible to the session.
+	tk1.MustQuery("select * from tmp_t").Check(testkit.Rows())
+	tk1.MustExec("insert into tmp_t(a) values (10)")
+	tk1.MustQuery("select * from tmp_t").Check(testkit.Rows("1 10"))
+	tk.MustQuery("select * from tmp_t").Check(testkit.Rows("1 1", "2 2", "3 3"))
+
+	// The rolled back changes are discarded.
+	tk.MustExec("begin")
+	tk.MustExec("update tmp_t set a = a + 10")
+	tk.MustExec("delete from tmp_t where id = 1")
+	tk.MustQuery("select * from tmp_t").Check(testkit.Rows("2 12", "3 13"))
+	tk.MustExec("rollback")
+	tk.MustQuery("select * from tmp_t").Check(testkit.Rows("1 1", "2 2", "3 3"))
+
+	tk.MustExec("delete from tmp_t where id = 1")
+	tk.MustExec("update tmp_t set a = 20 where id = 2")
+	tk.MustQuery("select * from tmp_t").Check(testkit.Rows("2 20", "3 3"))
+	tk.MustQuery("select * from tmp_t where id in (1, 2)").Check(testkit.Rows("2 20"))
+
+	// The DDL which changes the rows is not supported.
+	tk.MustGetErrCode("alter table tmp_t add column b int", errno.ErrUnsupportedDDLOperation)
+	tk.MustGetErrCode("create index idx on tmp_t(a, id)", errno.ErrUnsupportedDDLOperation)
+
+	// The rows are dropped when the session is closed.
+	tk.RefreshSession()
+	tk.MustExec("use test")
+	tk.MustQuery("select * from tmp_t").Check(testkit.Rows())
+	tk1.MustQuery("select * from tmp_t").Check(testkit.Rows("1 10"))
+	tk1.MustExec("truncate table tmp_t")
+	tk1.MustQuery("select * from tmp_t").Check(testkit.Rows())
+
+	// The size limit applies to all the rows kept in the session rather than the rows of a transaction.
+	tk.MustExec("create global temporary table tmp_s (id int primary key, v longblob) on commit preserve rows")
+	tk.MustExec("set @@tidb_tmp_table_max_size = 1048576")
+	tk.MustExec("insert into tmp_s values (1, repeat('a', 400000))")
+	tk.MustExec("insert into tmp_s values (2, repeat('a', 400000))")
+	tk.MustGetErrCode("insert into tmp_s values (3, repeat('a', 400000))", errno.ErrRecordFileFull)
+	tk.MustExec("begin")
+	tk.MustGetErrCode("insert into tmp_s values (3, repeat('a', 400000))", errno.ErrRecordFileFull)
+	tk.MustExec("rollback")
+	tk.MustQuery("select id from tmp_s").Check(testkit.Rows("1", "2"))
+	tk.MustExec("drop table tmp_s")
+	tk.MustExec("create global temporary table tmp_s (id int primary key, v longblob) on commit preserve rows")
+	tk.MustExec("insert into tmp_s values (3, repeat('a', 400000))")
+
+	// The rows are released on drop, in the session dropping the table and in the other sessions lazily.
+	tblID := external.GetTableByName(t, tk, "test", "tmp_s").Meta().ID
+	tk1.MustExec("insert into tmp_s values (4, repeat('a', 400000))")
+	require.Positive(t, tk.Session().GetSessionVars().TemporaryTableData.GetTableSize(tblID))
+	require.Positive(t, tk1.Session().GetSessionVars().TemporaryTableData.GetTableSize(tblID))
+	tk.MustExec("drop table tmp_s")
+	require.Zero(t, tk.Session().GetSessionVars().TemporaryTableData.GetTableSize(tblID))
+	tk1.MustQuery("select 1").Check(testkit.Rows("1"))
+	require.Zero(t, tk1.Session().GetSessionVars().TemporaryTableData.GetTableSize(tblID))
+	tk.MustExec("create global temporary table tmp_s (id int primary key, v longblob) on commit preserve rows")
+	tk.MustExec("insert into tmp_s values (1, repeat('a', 400000)), (2, repeat('a', 400000))")
+	tblID = external.GetTableByName(t, tk, "test", "tmp_s").Meta().ID
+	tk.MustExec("truncate table tmp_s")
+	require.Zero(t, tk.Session().GetSessionVars().TemporaryTableData.GetTableSize(tblID))
+	tk.MustExec("insert into tmp_s values (1, repeat('a', 400000)), (2, repeat('a', 400000))")
+}
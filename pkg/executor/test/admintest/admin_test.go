@@ -1005,6 +1005,50 @@ func TestAdminCheckPartitionTableFailed(t *testing.T) {
 	}
 }
 
+func TestAlterTableCheckPartition(t *testing.T) {
+	store, domain := testkit.CreateMockStoreAndDomain(t)
+
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	for _, enabled := range []bool{false, true} {
+		tk.MustExec(fmt.Sprintf("set tidb_enable_fast_table_check = %v", enabled))
+		tk.MustExec("drop table if exists admin_test_p")
+		tk.MustExec(`create table admin_test_p (c1 int key, c2 int, c3 int, index idx(c2)) partition by range(c1) (
+			partition p0 values less than (10), partition p1 values less than (20), partition p2 values less than (maxvalue))`)
+		tk.MustExec("insert admin_test_p values (1, 1, 1), (2, 2, 2), (11, 11, 11), (12, 12, 12), (21, 21, 21)")
+		tk.MustExec("alter table admin_test_p check partition all")
+
+		// Remove the index of (11, 11, 11) in p1.
+		tbl, err := domain.InfoSchema().TableByName(context.Background(), ast.NewCIStr("test"), ast.NewCIStr("admin_test_p"))
+		require.NoError(t, err)
+		tblInfo := tbl.Meta()
+		indexOpr := tables.NewIndex(tblInfo.GetPartitionInfo().Definitions[1].ID, tblInfo, tblInfo.Indices[0])
+		txn, err := store.Begin()
+		require.NoError(t, err)
+		err = indexOpr.Delete(tk.Session().GetTableCtx(), txn, types.MakeDatums(11), kv.IntHandle(11))
+		require.NoError(t, err)
+		require.NoError(t, txn.Commit(context.Background()))
+
+		for _, sql := range []string{
+			"alter table admin_test_p check partition p1",
+			"alter table admin_test_p check partition p0, p1",
+			"alter table admin_test_p check partition all",
+		} {
+			err = tk.ExecToErr(sql)
+			require.True(t, consistency.ErrAdminCheckInconsistent.Equal(err), "%s: %v", sql, err)
+			require.ErrorContains(t, err, "index: idx, handle: 11")
+		}
+		// The other partitions are not affected.
+		tk.MustExec("alter table admin_test_p check partition p0, p2")
+		tk.MustExec("alter table admin_test_p check partition p2, p2")
+		tk.MustGetErrCode("alter table admin_test_p check partition p3", mysql.ErrUnknownPartition)
+	}
+
+	tk.MustExec("drop table if exists admin_test")
+	tk.MustExec("create table admin_test (a int, index idx(a))")
+	tk.MustGetErrCode("alter table admin_test check partition all", mysql.ErrPartitionMgmtOnNonpartitioned)
+}
+
 const dbName, tblName = "test", "admin_test"
 
 type inconsistencyTestKit struct {
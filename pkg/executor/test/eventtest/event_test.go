@@ -0,0 +1,150 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventtest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pingcap/tidb/pkg/parser/auth"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/testkit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateAlterDropEvent(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	require.NoError(t, tk.Session().Auth(&auth.UserIdentity{Username: "root", Hostname: "%"}, nil, nil, nil))
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int)")
+
+	tk.MustExec("create event e1 on schedule every 1 hour disable comment 'hourly' do insert into t values (1)")
+	tk.MustExec("create event e2 on schedule at now() + interval 1 day on completion preserve do delete from t")
+	tk.MustQuery("show events").CheckAt([]int{0, 1, 3, 4, 6, 7, 10}, testkit.Rows(
+		"test e1 root@% RECURRING 1 HOUR DISABLED",
+		"test e2 root@% ONE TIME <nil> <nil> ENABLED"))
+	tk.MustQuery("show events like 'e1'").CheckAt([]int{1}, testkit.Rows("e1"))
+	tk.MustQuery("select event_name, event_definition, on_completion, event_comment from information_schema.events order by event_name").Check(testkit.Rows(
+		"e1 insert into t values (1) NOT PRESERVE hourly",
+		"e2 delete from t PRESERVE "))
+
+	tk.MustGetErrCode("create event e1 on schedule every 1 day do select 1", mysql.ErrEventAlreadyExists)
+	tk.MustExec("create event if not exists e1 on schedule every 1 day do select 1")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Note 1537 Event 'e1' already exists"))
+	tk.MustGetErrCode("create event not_exist_db.e3 on schedule every 1 day do select 1", mysql.ErrBadDB)
+	tk.MustGetErrCode("create event e3 on schedule every 0 second do select 1", mysql.ErrEventIntervalNotPositiveOrTooBig)
+	tk.MustGetErrCode("create event e3 on schedule every 1 hour starts now() + interval 1 day ends now() do select 1", mysql.ErrEventEndsBeforeStarts)
+	tk.MustGetErrCode("create event e3 on schedule every 1 microsecond do select 1", mysql.ErrNotSupportedYet)
+	tk.MustGetErrCode("create event e3 on schedule every 5 month do select 1", mysql.ErrNotSupportedYet)
+
+	// The event whose execution time is in the past is dropped immediately, or disabled if it's preserved.
+	tk.MustExec("create event e3 on schedule at now() - interval 1 day do select 1")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Note 1588 Event execution time is in the past and ON COMPLETION NOT PRESERVE is set. The event was dropped immediately after creation."))
+	tk.MustQuery("select count(*) from information_schema.events where event_name = 'e3'").Check(testkit.Rows("0"))
+	tk.MustExec("create event e3 on schedule at now() - interval 1 day on completion preserve do select 1")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Warning 1544 Event execution time is in the past. Event has been disabled"))
+	tk.MustQuery("select status from information_schema.events where event_name = 'e3'").Check(testkit.Rows("DISABLED"))
+	tk.MustExec("drop event e3")
+
+	tk.MustExec("alter event e1 enable")
+	tk.MustQuery("select status, event_comment from information_schema.events where event_name = 'e1'").Check(testkit.Rows("ENABLED hourly"))
+	tk.MustGetErrCode("alter event e1 rename to e1", mysql.ErrEventSameName)
+	tk.MustGetErrCode("alter event e1 rename to e2", mysql.ErrEventAlreadyExists)
+	tk.MustGetErrCode("alter event not_exist enable", mysql.ErrEventDoesNotExist)
+	tk.MustExec("alter event e1 rename to e3")
+	tk.MustExec("alter event e3 on schedule every 2 minute comment 'two minutes'")
+	tk.MustExec("alter event e3 do insert into t values (3)")
+	tk.MustQuery("select event_name, interval_value, interval_field, event_definition, event_comment from information_schema.events order by event_name").Check(testkit.Rows(
+		"e2 <nil> <nil> delete from t ",
+		"e3 2 MINUTE insert into t values (3) two minutes"))
+	tk.MustGetErrCode("alter event e2 on schedule at now() - interval 1 hour on completion not preserve", mysql.ErrEventCannotAlterInThePast)
+
+	tk.MustExec("drop event e3")
+	tk.MustGetErrCode("drop event e3", mysql.ErrEventDoesNotExist)
+	tk.MustExec("drop event if exists e3")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Note 1539 Unknown event 'e3'"))
+	tk.MustQuery("show events").CheckAt([]int{1}, testkit.Rows("e2"))
+
+	// The events are dropped with their database.
+	tk.MustExec("create database test2")
+	tk.MustExec("create event test2.e on schedule every 1 day do select 1")
+	tk.MustQuery("show events from test2").CheckAt([]int{0, 1}, testkit.Rows("test2 e"))
+	tk.MustExec("drop database test2")
+	tk.MustQuery("select count(*) from information_schema.events where event_schema = 'test2'").Check(testkit.Rows("0"))
+}
+
+func TestEventPrivileges(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	require.NoError(t, tk.Session().Auth(&auth.UserIdentity{Username: "root", Hostname: "%"}, nil, nil, nil))
+	tk.MustExec("create database test2")
+	tk.MustExec("create event test2.e on schedule every 1 day do select 1")
+	tk.MustExec("create user 'u1'@'%'")
+
+	tk1 := testkit.NewTestKit(t, store)
+	require.NoError(t, tk1.Session().Auth(&auth.UserIdentity{Username: "u1", Hostname: "%"}, nil, nil, nil))
+	tk1.MustExec("use test")
+	tk1.MustGetErrCode("create event e1 on schedule every 1 day do select 1", mysql.ErrDBaccessDenied)
+	tk1.MustGetErrCode("show events", mysql.ErrDBaccessDenied)
+
+	tk.MustExec("grant event on test.* to 'u1'@'%'")
+	tk1.MustExec("create event e1 on schedule every 1 day do select 1")
+	tk1.MustQuery("show events").CheckAt([]int{1, 3}, testkit.Rows("e1 u1@%"))
+	tk1.MustQuery("select event_schema, event_name from information_schema.events").Check(testkit.Rows("test e1"))
+	tk1.MustGetErrCode("drop event test2.e", mysql.ErrDBaccessDenied)
+	// Only SUPER can create events for other users.
+	tk1.MustGetErrCode("create definer = 'root'@'%' event e2 on schedule every 1 day do select 1", mysql.ErrSpecificAccessDenied)
+	tk.MustExec("create definer = 'u1'@'%' event test.e2 on schedule every 1 day do select 1")
+	tk1.MustExec("alter event e2 disable")
+	tk1.MustExec("drop event e2")
+	tk1.MustExec("drop event e1")
+}
+
+func TestExecuteEvent(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	require.NoError(t, tk.Session().Auth(&auth.UserIdentity{Username: "root", Hostname: "%"}, nil, nil, nil))
+	tk.MustExec("use test")
+	tk.MustExec("create table t (id int primary key auto_increment, v varchar(32))")
+	tk.MustQuery("select @@global.event_scheduler").Check(testkit.Rows("0"))
+
+	tk.MustExec("create event e1 on schedule every 1 second do insert into t(v) values (current_user())")
+	tk.MustExec("create event e2 on schedule at now() + interval 1 second do insert into t(v) values ('once')")
+	tk.MustExec("create event e3 on schedule every 1 second do insert into not_exist values (1)")
+	tk.MustExec("set global event_scheduler = on")
+	defer tk.MustExec("set global event_scheduler = off")
+
+	require.Eventually(t, func() bool {
+		rows := tk.MustQuery("select count(*) from mysql.tidb_event_history where event_name = 'e1' and state = 'succeeded'").Rows()
+		return rows[0][0].(string) != "0"
+	}, 30*time.Second, 100*time.Millisecond)
+	tk.MustQuery("select distinct v from t where v != 'once'").Check(testkit.Rows("root@%"))
+	tk.MustQuery("select last_executed is not null from information_schema.events where event_name = 'e1'").Check(testkit.Rows("1"))
+
+	// The one-time event is executed once and dropped.
+	require.Eventually(t, func() bool {
+		rows := tk.MustQuery("select count(*) from information_schema.events where event_name = 'e2'").Rows()
+		return rows[0][0].(string) == "0"
+	}, 30*time.Second, 100*time.Millisecond)
+	tk.MustQuery("select count(*) from t where v = 'once'").Check(testkit.Rows("1"))
+	tk.MustQuery("select state, definer from mysql.tidb_event_history where event_name = 'e2'").Check(testkit.Rows("succeeded root@%"))
+
+	// The failed executions are recorded in the history.
+	require.Eventually(t, func() bool {
+		rows := tk.MustQuery("select count(*) from mysql.tidb_event_history where event_name = 'e3' and state = 'failed' and error_message like '%doesn''t exist%'").Rows()
+		return rows[0][0].(string) != "0"
+	}, 30*time.Second, 100*time.Millisecond)
+}
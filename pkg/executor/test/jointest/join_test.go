@@ -950,3 +950,176 @@ func TestIssue11896(t *testing.T) {
 
 	tk.MustQuery("select * from t, t1 where t.c1 = t1.c1;").Check(nil)
 }
+
+func TestLateralDerivedTable(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int primary key)")
+	tk.MustExec("create table t1 (a int, b int, key(a))")
+	tk.MustExec("insert into t values (1), (2), (3)")
+	tk.MustExec("insert into t1 values (1, 10), (1, 20), (1, 30), (1, 40), (2, 5), (2, 6)")
+
+	// Top-N per group.
+	sql := "select t.a, d.b from t, lateral (select b from t1 where t1.a = t.a order by b desc limit 3) as d order by t.a, d.b"
+	tk.MustHavePlan(sql, "Apply")
+	tk.MustQuery(sql).Check(testkit.Rows("1 20", "1 30", "1 40", "2 5", "2 6"))
+	tk.MustQuery("select t.a, d.b from t left join lateral (select b from t1 where t1.a = t.a order by b limit 1) as d on true order by t.a").Check(
+		testkit.Rows("1 10", "2 5", "3 <nil>"))
+	tk.MustQuery("select t.a, d.c from t, lateral (select count(*) c from t1 where t1.a = t.a) as d order by t.a").Check(
+		testkit.Rows("1 4", "2 2", "3 0"))
+	tk.MustQuery("select t.a, d.b from t join lateral (select b from t1 where t1.a = t.a) as d on d.b > 20 order by t.a, d.b").Check(
+		testkit.Rows("1 30", "1 40"))
+	// A lateral derived table can refer to a preceding lateral derived table.
+	tk.MustQuery("select t.a, d1.m, d2.b from t, lateral (select max(b) m from t1 where t1.a = t.a) as d1, " +
+		"lateral (select b from t1 where t1.b < d1.m and t1.a = t.a) as d2 order by t.a, d2.b").Check(
+		testkit.Rows("1 40 10", "1 40 20", "1 40 30", "2 6 5"))
+	tk.MustExec("set tidb_enable_parallel_apply = 1")
+	tk.MustQuery(sql).Check(testkit.Rows("1 20", "1 30", "1 40", "2 5", "2 6"))
+	tk.MustExec("set tidb_enable_parallel_apply = default")
+
+	// An uncorrelated lateral derived table is a normal derived table.
+	tk.MustNotHavePlan("select * from t, lateral (select a from t1) as d", "Apply")
+	// Without LATERAL, or on the right side of a RIGHT JOIN, the left side can't be referred.
+	tk.MustGetErrMsg("select * from t, (select b from t1 where t1.a = t.a) as d", "[planner:1054]Unknown column 't.a' in 'where clause'")
+	tk.MustGetErrMsg("select * from t right join lateral (select b from t1 where t1.a = t.a) as d on true", "[planner:1054]Unknown column 't.a' in 'where clause'")
+}
+
+func TestAdaptiveJoin(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t1 (a int, b int, key(a))")
+	tk.MustExec("create table t2 (a int primary key, b int)")
+	tk.MustExec("create table t3 (a int, b int, key(a))")
+	tk.MustExec("insert into t1 values (1, 1), (2, 2), (3, 3), (4, 4), (5, 5), (6, 6), (7, 7), (8, 8), (9, 9), (null, 10)")
+	tk.MustExec("insert into t2 values (2, 20), (4, 40), (6, 60), (8, 80), (10, 100)")
+	tk.MustExec("insert into t3 values (2, 20), (4, 40), (6, 60), (8, 80), (10, 100)")
+
+	queries := []string{
+		"select /*+ INL_JOIN(t2) */ * from t1 join t2 on t1.a = t2.a",
+		"select /*+ INL_HASH_JOIN(t2) */ * from t1 left join t2 on t1.a = t2.a",
+		"select /*+ INL_JOIN(t3) */ * from t1 join t3 on t1.a = t3.a and t3.b > 30",
+		"select /*+ INL_HASH_JOIN(t3) */ * from t1 where exists (select 1 from t3 where t1.a = t3.a)",
+		"select /*+ INL_JOIN(t3) */ * from t1 where not exists (select 1 from t3 where t1.a = t3.a)",
+		"select /*+ HASH_JOIN_BUILD(t1) */ * from t1 join t2 on t1.a = t2.a",
+	}
+	expected := make([][][]any, 0, len(queries))
+	for _, query := range queries {
+		expected = append(expected, tk.MustQuery(query).Sort().Rows())
+	}
+
+	tk.MustExec("set @@tidb_enable_adaptive_join = on")
+	tk.MustExec("set @@tidb_adaptive_join_row_threshold = 3")
+	for i, query := range queries {
+		tk.MustQuery(query).Sort().Check(expected[i])
+	}
+	rows := tk.MustQuery("explain analyze " + queries[0]).Rows()
+	require.Regexp(t, "IndexJoin.*", rows[0][0])
+	require.Regexp(t, "adaptive:{switch_to:hash_join, outer_rows:>=\\d+, threshold:3}", rows[0][5])
+	rows = tk.MustQuery("explain analyze " + queries[1]).Rows()
+	require.Regexp(t, "IndexHashJoin.*", rows[0][0])
+	require.Regexp(t, "adaptive:{switch_to:hash_join, outer_rows:>=\\d+, threshold:3}", rows[0][5])
+	rows = tk.MustQuery("explain analyze " + queries[5]).Rows()
+	require.Regexp(t, "HashJoin.*", rows[0][0])
+	require.Regexp(t, "adaptive:{swap_build_side:true, build_rows:>=\\d+, probe_rows:5, threshold:3}", rows[0][5])
+
+	tk.MustExec("set @@tidb_adaptive_join_row_threshold = 100")
+	for i, query := range queries {
+		tk.MustQuery(query).Sort().Check(expected[i])
+	}
+	rows = tk.MustQuery("explain analyze " + queries[0]).Rows()
+	require.Regexp(t, "adaptive:{keep:index_join, outer_rows:\\d+, threshold:100}", rows[0][5])
+	rows = tk.MustQuery("explain analyze " + queries[5]).Rows()
+	require.Regexp(t, "adaptive:{swap_build_side:false, build_rows:\\d+, threshold:100}", rows[0][5])
+
+	// The index join which keeps the outer order can't fall back to hash join.
+	tk.MustExec("set @@tidb_adaptive_join_row_threshold = 3")
+	tk.MustQuery("select /*+ INL_JOIN(t2) */ t1.a, t2.b from t1 join t2 on t1.a = t2.a order by t1.a").Check(testkit.Rows("2 20", "4 40", "6 60", "8 80"))
+}
+
+func TestCopRuntimeFilter(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t1(a int, b int)")
+	tk.MustExec("create table t2(a int, b varchar(10))")
+	tk.MustExec("create table t3(a int)")
+	tk.MustExec("insert into t1 values (1, 10), (2, 20), (3, 30), (4, 40), (5, 50), (6, 60), (7, 70), (8, 80), (null, 90)")
+	tk.MustExec("insert into t2 values (2, 'b'), (4, 'd'), (4, 'dd'), (null, 'n')")
+	tk.MustExec("set @@tidb_runtime_filter_type = 'IN,MIN_MAX'")
+	queries := []string{
+		"select /*+ HASH_JOIN_BUILD(t2) */ * from t1 join t2 on t1.a = t2.a",
+		"select /*+ HASH_JOIN_BUILD(t2) */ * from t1 join t2 on t1.a = t2.a where t1.b > 20",
+		"select /*+ HASH_JOIN_BUILD(t2) */ t1.a from t1 join t2 on t1.a = t2.a and t1.b < t2.a * 20",
+		"select /*+ HASH_JOIN_BUILD(t2) */ t1.a from t1 join t2 on t1.a = t2.a where t1.a + 1 > 2",
+		"select /*+ HASH_JOIN_BUILD(t2) */ t1.a from t1 where exists (select 1 from t2 where t1.a = t2.a)",
+		"select /*+ HASH_JOIN_BUILD(t2) */ * from t2 left join t1 on t1.a = t2.a",
+		"select /*+ HASH_JOIN_BUILD(t1) */ * from t1 left join t2 on t1.a = t2.a",
+		"select /*+ HASH_JOIN_BUILD(t1) */ * from t1 join t2 on t1.a = t2.a",
+		"select /*+ HASH_JOIN_BUILD(t3) */ * from t1 join t3 on t1.a = t3.a",
+		"select /*+ HASH_JOIN_BUILD(t3) */ * from t3 left join t1 on t1.a = t3.a",
+		"select /*+ HASH_JOIN_BUILD(t2) */ * from t1 left join t2 on t1.a = t2.a",
+	}
+	expected := make([][][]any, 0, len(queries))
+	for _, query := range queries {
+		expected = append(expected, tk.MustQuery(query).Sort().Rows())
+	}
+
+	tk.MustExec("set @@tidb_enable_cop_runtime_filter = on")
+	for _, version := range []string{"legacy", "optimized"} {
+		tk.MustExec("set @@tidb_hash_join_version = " + version)
+		for i, query := range queries {
+			tk.MustQuery(query).Sort().Check(expected[i])
+		}
+	}
+
+	rows := tk.MustQuery("explain format = 'brief' " + queries[0]).Rows()
+	require.Regexp(t, "HashJoin.*", rows[0][0])
+	require.Regexp(t, "runtime filter:0\\[IN\\] <- test.t2.a, 1\\[MIN_MAX\\] <- test.t2.a", rows[0][4])
+	require.Regexp(t, "Selection.*", rows[len(rows)-2][0])
+	require.Regexp(t, "cop\\[tikv\\]", rows[len(rows)-2][2])
+	require.Regexp(t, "runtime filter:0\\[IN\\] -> test.t1.a, 1\\[MIN_MAX\\] -> test.t1.a", rows[len(rows)-2][4])
+	// only the rows of t1 which can be joined are read from the coprocessor.
+	rows = tk.MustQuery("explain analyze " + queries[0]).Rows()
+	require.Regexp(t, "Selection.*", rows[len(rows)-2][0])
+	require.Equal(t, "2", rows[len(rows)-2][2])
+	// the probe side is not read at all when the build side has no key.
+	tk.MustExec("insert into t3 values (null)")
+	rows = tk.MustQuery("explain analyze " + queries[8]).Rows()
+	require.Regexp(t, "TableReader.*", rows[len(rows)-3][0])
+	require.Equal(t, "0", rows[len(rows)-3][2])
+	tk.MustQuery(queries[8]).Check(testkit.Rows())
+	tk.MustQuery(queries[9]).Check(testkit.Rows("<nil> <nil> <nil>"))
+
+	// the outer side of the outer join can't be filtered.
+	rows = tk.MustQuery("explain format = 'brief' " + queries[10]).Rows()
+	require.NotRegexp(t, "runtime filter", rows[0][4])
+
+	// the selection planned only for the runtime filter is not sent to TiKV when the filter is given up.
+	tk.MustExec("set @@tidb_runtime_filter_type = 'IN'")
+	tk.MustExec("create table t4(a int not null)")
+	tk.MustExec("create table t5(a int)")
+	tk.MustExec("insert into t4 values (1), (2), (2000)")
+	values := make([]string, 0, 1100)
+	for i := range 1100 {
+		values = append(values, fmt.Sprintf("(%d)", i))
+	}
+	tk.MustExec("insert into t5 values " + strings.Join(values, ","))
+	query := "select /*+ HASH_JOIN_BUILD(t5) */ t4.a from t4 join t5 on t4.a = t5.a"
+	rows = tk.MustQuery("explain analyze " + query).Rows()
+	require.Regexp(t, "Selection.*", rows[len(rows)-2][0])
+	require.Regexp(t, "runtime filter:0\\[IN\\] -> test.t4.a", rows[len(rows)-2][6])
+	require.Equal(t, "", rows[len(rows)-2][5])
+	require.Regexp(t, "TableFullScan.*", rows[len(rows)-1][0])
+	require.Equal(t, "3", rows[len(rows)-1][2])
+	tk.MustQuery(query).Sort().Check(testkit.Rows("1", "2"))
+	tk.MustQuery(query).Sort().Check(testkit.Rows("1", "2"))
+	tk.MustExec("delete from t5 where a > 1")
+	rows = tk.MustQuery("explain analyze " + query).Rows()
+	require.Equal(t, "1", rows[len(rows)-2][2])
+	tk.MustQuery(query).Check(testkit.Rows("1"))
+	tk.MustExec("set @@tidb_enable_cop_runtime_filter = off")
+	rows = tk.MustQuery("explain format = 'brief' " + queries[0]).Rows()
+	require.NotRegexp(t, "runtime filter", rows[0][4])
+}
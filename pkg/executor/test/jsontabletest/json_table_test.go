@@ -0,0 +1,119 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsontabletest
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/pkg/errno"
+	"github.com/pingcap/tidb/pkg/testkit"
+)
+
+func TestJSONTableColumns(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+
+	tk.MustQuery(`select * from json_table('[{"a":1,"b":"x"},{"a":2},{"a":"3","b":[1]}]', '$[*]' columns (
+		id for ordinality,
+		a int path '$.a',
+		b varchar(10) path '$.b',
+		c int exists path '$.b',
+		d json path '$.b')) as jt`).Check(testkit.Rows(
+		`1 1 x 1 "x"`,
+		"2 2 <nil> 0 <nil>",
+		"3 3 <nil> 1 [1]"))
+	tk.MustQuery(`select jt.a + 1 from json_table('{"a":41}', '$' columns (a int path '$.a')) jt`).Check(testkit.Rows("42"))
+	tk.MustQuery(`select * from json_table('[true, 1.5, "s"]', '$[*]' columns (v varchar(10) path '$')) as jt`).Check(testkit.Rows("true", "1.5", "s"))
+	tk.MustQuery(`select count(*) from json_table('[]', '$[*]' columns (v int path '$')) as jt`).Check(testkit.Rows("0"))
+	tk.MustQuery(`select count(*) from json_table(null, '$[*]' columns (v int path '$')) as jt`).Check(testkit.Rows("0"))
+
+	// ON EMPTY and ON ERROR.
+	tk.MustQuery(`select * from json_table('[{"a":"x"},{}]', '$[*]' columns (
+		a int path '$.a' default '0' on empty default '-1' on error)) as jt`).Check(testkit.Rows("-1", "0"))
+	tk.MustQuery(`select * from json_table('[{"a":[1]},{}]', '$[*]' columns (
+		a int path '$.a' null on empty null on error)) as jt`).Check(testkit.Rows("<nil>", "<nil>"))
+	tk.MustGetErrCode(`select * from json_table('[{}]', '$[*]' columns (a int path '$.a' error on empty)) as jt`, errno.ErrMissingJSONTableValue)
+	tk.MustGetErrCode(`select * from json_table('[{"a":[1]}]', '$[*]' columns (a int path '$.a' error on error)) as jt`, errno.ErrWrongJSONTableValue)
+	tk.MustGetErrCode(`select * from json_table('[{"a":"x"}]', '$[*]' columns (a int path '$.a' error on error)) as jt`, errno.ErrTruncatedWrongValue)
+
+	tk.MustGetErrCode(`select * from json_table('[]', 'a' columns (v int path '$')) as jt`, errno.ErrInvalidJSONPath)
+	tk.MustGetErrCode(`select * from json_table('[]', '$' columns (v int path '$', v int path '$')) as jt`, errno.ErrDupFieldName)
+	tk.MustGetErrCode(`select * from json_table('[1', '$' columns (v int path '$')) as jt`, errno.ErrInvalidJSONText)
+}
+
+func TestJSONTableNestedPath(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+
+	tk.MustQuery(`select * from json_table('[{"a":1,"b":[11,111]},{"a":2,"b":[22]},{"a":3}]', '$[*]' columns (
+		a int path '$.a',
+		nested path '$.b[*]' columns (id for ordinality, b int path '$'))) as jt`).Check(testkit.Rows(
+		"1 1 11",
+		"1 2 111",
+		"2 1 22",
+		"3 <nil> <nil>"))
+	// Sibling nested paths produce their rows one after another.
+	tk.MustQuery(`select * from json_table('[{"a":1,"b":[11,111],"c":[5]}]', '$[*]' columns (
+		a int path '$.a',
+		nested path '$.b[*]' columns (b int path '$'),
+		nested '$.c[*]' columns (c int path '$'))) as jt`).Check(testkit.Rows(
+		"1 11 <nil>",
+		"1 111 <nil>",
+		"1 <nil> 5"))
+	tk.MustQuery(`select * from json_table('{"a":[{"b":[1,2]},{"b":[]}]}', '$' columns (
+		nested path '$.a[*]' columns (x for ordinality, nested path '$.b[*]' columns (b int path '$'))) ) as jt`).Check(testkit.Rows(
+		"1 1",
+		"1 2",
+		"2 <nil>"))
+}
+
+func TestJSONTableJoin(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (id int primary key, doc json)")
+	tk.MustExec(`insert into t values (1, '[1, 2]'), (2, '[]'), (3, '[3]'), (4, null)`)
+
+	sql := "select t.id, jt.v from t, json_table(t.doc, '$[*]' columns (v int path '$')) as jt order by t.id, jt.v"
+	tk.MustHavePlan(sql, "Apply")
+	tk.MustHavePlan(sql, "JSONTable")
+	tk.MustQuery(sql).Check(testkit.Rows("1 1", "1 2", "3 3"))
+	tk.MustQuery("select t.id, jt.v from t left join json_table(t.doc, '$[*]' columns (v int path '$')) as jt on true order by t.id, jt.v").Check(testkit.Rows(
+		"1 1", "1 2", "2 <nil>", "3 3", "4 <nil>"))
+	tk.MustQuery("select t.id, jt.v from t join json_table(t.doc, '$[*]' columns (v int path '$')) as jt on jt.v > 1 order by t.id").Check(testkit.Rows(
+		"1 2", "3 3"))
+	tk.MustQuery("select t.id, sum(jt.v) from t, json_table(t.doc, '$[*]' columns (v int path '$')) as jt group by t.id order by t.id").Check(testkit.Rows(
+		"1 3", "3 3"))
+
+	// An uncorrelated JSON_TABLE is joined like a normal table.
+	sql = "select t.id from t join json_table('[1, 3]', '$[*]' columns (v int path '$')) as jt on t.id = jt.v order by t.id"
+	tk.MustNotHavePlan(sql, "Apply")
+	tk.MustQuery(sql).Check(testkit.Rows("1", "3"))
+
+	// The right side of RIGHT JOIN can't refer to the left side.
+	tk.MustGetErrCode("select * from t right join json_table(t.doc, '$[*]' columns (v int path '$')) as jt on true", errno.ErrBadField)
+	tk.MustGetErrCode("select * from json_table(t.doc, '$[*]' columns (v int path '$')) as jt, t", errno.ErrBadField)
+	tk.MustGetErrCode("select * from t, json_table((select doc from t limit 1), '$[*]' columns (v int path '$')) as jt", errno.ErrNotSupportedYet)
+
+	// Prepared statements with JSON_TABLE are not cached.
+	tk.MustExec("prepare stmt from \"select v from json_table(?, '$[*]' columns (v int path '$')) as jt\"")
+	tk.MustExec("set @a = '[1, 2]'")
+	tk.MustQuery("execute stmt using @a").Check(testkit.Rows("1", "2"))
+	tk.MustExec("set @a = '[3]'")
+	tk.MustQuery("execute stmt using @a").Check(testkit.Rows("3"))
+	tk.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("0"))
+}
@@ -0,0 +1,156 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package materializedviewtest
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/pkg/errno"
+	"github.com/pingcap/tidb/pkg/parser/auth"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/testkit"
+	"github.com/pingcap/tidb/pkg/testkit/testfailpoint"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaterializedView(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (id int primary key, g varchar(10), v int)")
+	tk.MustExec("insert into t values (1, 'a', 10), (2, 'a', 20), (3, 'b', 30)")
+
+	tk.MustExec("create materialized view mv as select g, count(*) as cnt, sum(v) as total from t group by g")
+	tk.MustQuery("select * from mv order by g").Check(testkit.Rows("a 2 30", "b 1 30"))
+	rows := tk.MustQuery("show create table mv").Rows()
+	require.Contains(t, rows[0][1], "CREATE MATERIALIZED VIEW `mv` (`g`, `cnt`, `total`) REFRESH COMPLETE AS SELECT")
+
+	// The materialized view keeps the result until it's refreshed.
+	tk.MustExec("insert into t values (4, 'c', 40)")
+	tk.MustExec("update t set v = 100 where id = 1")
+	tk.MustQuery("select * from mv order by g").Check(testkit.Rows("a 2 30", "b 1 30"))
+	tk.MustExec("refresh materialized view mv")
+	tk.MustQuery("select * from mv order by g").Check(testkit.Rows("a 2 120", "b 1 30", "c 1 40"))
+	tk.MustExec("refresh materialized view test.mv complete")
+	tk.MustQuery("select count(*) from mv").Check(testkit.Rows("3"))
+
+	// The wildcard is expanded, so the materialized view can be refreshed after the table is altered.
+	tk.MustExec("create materialized view mv2 as select * from t where id > 2")
+	tk.MustQuery("select * from mv2 order by id").Check(testkit.Rows("3 b 30", "4 c 40"))
+	tk.MustExec("alter table t add column w int default 0")
+	tk.MustExec("refresh materialized view mv2")
+	tk.MustQuery("select count(*) from mv2").Check(testkit.Rows("2"))
+
+	// The indexes of a materialized view can be changed.
+	tk.MustExec("alter table mv add index idx_g (g)")
+	tk.MustQuery("select cnt from mv use index (idx_g) where g = 'c'").Check(testkit.Rows("1"))
+
+	tk.MustExec("create materialized view if not exists mv as select 1")
+	require.Equal(t, uint16(1), tk.Session().GetSessionVars().StmtCtx.WarningCount())
+	tk.MustQuery("select count(*) from mv").Check(testkit.Rows("3"))
+
+	tk.MustExec("drop materialized view mv, mv2")
+	tk.MustGetErrCode("select * from mv", mysql.ErrNoSuchTable)
+	tk.MustExec("drop materialized view if exists mv")
+}
+
+func TestMaterializedViewErrors(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (id int primary key, v int)")
+	tk.MustExec("create view vt as select * from t")
+	tk.MustExec("create materialized view mv as select id, v from t")
+
+	// The rows of a materialized view can only be changed by refresh.
+	tk.MustGetErrCode("insert into mv values (1, 1)", mysql.ErrNonUpdatableTable)
+	tk.MustGetErrCode("replace into mv values (1, 1)", mysql.ErrNonUpdatableTable)
+	tk.MustGetErrCode("update mv set v = 1", mysql.ErrNonUpdatableTable)
+	tk.MustGetErrCode("delete from mv", mysql.ErrNonUpdatableTable)
+	tk.MustGetErrCode("delete mv from mv join t on mv.id = t.id", mysql.ErrNonUpdatableTable)
+	tk.MustGetErrCode("merge into mv using t on mv.id = t.id when matched then delete", mysql.ErrNonUpdatableTable)
+	tk.MustGetErrCode("alter table mv add column c int", errno.ErrUnsupportedDDLOperation)
+
+	tk.MustGetErrCode("refresh materialized view t", mysql.ErrWrongObject)
+	tk.MustGetErrCode("refresh materialized view vt", mysql.ErrWrongObject)
+	tk.MustGetErrCode("refresh materialized view not_exists", mysql.ErrNoSuchTable)
+	tk.MustGetErrCode("drop materialized view t", mysql.ErrWrongObject)
+	tk.MustGetErrCode("drop table mv", mysql.ErrWrongObject)
+	tk.MustGetErrCode("drop view mv", mysql.ErrWrongObject)
+
+	tk.MustGetErrCode("create materialized view mv as select 1", mysql.ErrTableExists)
+	tk.MustGetErrCode("create materialized view mv1 (a) as select id, v from t", mysql.ErrViewWrongList)
+	tk.MustGetErrCode("create materialized view mv1 as select * from not_exists", mysql.ErrNoSuchTable)
+
+	// The rows are kept if the refresh fails.
+	tk.MustExec("create table t2 (a int)")
+	tk.MustExec("insert into t2 values (1)")
+	tk.MustExec("create materialized view mv2 as select a from t2")
+	tk.MustExec("drop table t2")
+	tk.MustGetErrCode("refresh materialized view mv2", mysql.ErrNoSuchTable)
+	tk.MustQuery("select * from mv2").Check(testkit.Rows("1"))
+}
+
+func TestMaterializedViewRefreshInBatches(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b varchar(10), c varbinary(10), d datetime(3), e decimal(10, 2), f json)")
+	tk.MustExec("insert into t values (1, 'x', 0x00ff, '2026-01-02 03:04:05.678', 1.5, '{\"k\": [1, 2]}'), (2, null, null, null, null, null), (3, 'it''s\\', '', '2026-01-01', -2, 'null')")
+	tk.MustGetErrCode("create materialized view mv refresh fast as select * from t", mysql.ErrParse)
+
+	// The rows are written by the transactions of 2 rows.
+	testfailpoint.Enable(t, "github.com/pingcap/tidb/pkg/executor/mockMaterializedViewBatchSize", "return(2)")
+	tk.MustExec("create materialized view mv as select * from t")
+	tk.MustQuery("select * from mv order by a").Check(tk.MustQuery("select * from t order by a").Rows())
+	tk.MustExec("alter table mv add index idx_b (b)")
+	tableID := tk.MustQuery("select tidb_table_id from information_schema.tables where table_schema = 'test' and table_name = 'mv'").Rows()[0][0]
+
+	tk.MustExec("insert into t select a + 3, b, c, d, e, f from t")
+	tk.MustExec("refresh materialized view mv")
+	tk.MustQuery("select * from mv order by a").Check(tk.MustQuery("select * from t order by a").Rows())
+	tk.MustQuery("select count(*) from mv use index (idx_b) where b = 'x'").Check(testkit.Rows("2"))
+	// The materialized view is swapped with the table populated by the refresh.
+	tk.MustQuery("select tidb_table_id != ? from information_schema.tables where table_schema = 'test' and table_name = 'mv'", tableID).Check(testkit.Rows("1"))
+	tk.MustQuery("show tables").Check(testkit.Rows("mv", "t"))
+	rows := tk.MustQuery("show create table mv").Rows()
+	require.Contains(t, rows[0][1], "CREATE MATERIALIZED VIEW `mv`")
+}
+
+func TestMaterializedViewPrivilege(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int)")
+	tk.MustExec("insert into t values (1), (2)")
+	tk.MustExec("create user u1, u2")
+	tk.MustExec("grant select on test.t to u1")
+	tk.MustExec("grant create, select on test.* to u2")
+
+	tk1 := testkit.NewTestKit(t, store)
+	require.NoError(t, tk1.Session().Auth(&auth.UserIdentity{Username: "u1", Hostname: "%"}, nil, nil, nil))
+	tk1.MustExec("use test")
+	tk1.MustGetErrCode("create materialized view mv as select * from t", mysql.ErrTableaccessDenied)
+
+	tk2 := testkit.NewTestKit(t, store)
+	require.NoError(t, tk2.Session().Auth(&auth.UserIdentity{Username: "u2", Hostname: "%"}, nil, nil, nil))
+	tk2.MustExec("use test")
+	tk2.MustExec("create materialized view mv as select * from t")
+	tk2.MustQuery("select * from mv order by a").Check(testkit.Rows("1", "2"))
+	tk2.MustGetErrCode("refresh materialized view mv", mysql.ErrTableaccessDenied)
+
+	tk.MustExec("grant insert, delete on test.mv to u2")
+	tk2.MustExec("refresh materialized view mv")
+}
@@ -0,0 +1,121 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mergetest
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/pkg/errno"
+	"github.com/pingcap/tidb/pkg/parser/auth"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/testkit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMerge(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (id int primary key, v int, note varchar(20) default 'new')")
+	tk.MustExec("create table s (id int, v int)")
+	tk.MustExec("insert into t values (1, 10, 'old'), (2, 20, 'old'), (3, 30, 'old')")
+	tk.MustExec("insert into s values (1, 100), (2, -1), (4, 400), (5, -5)")
+
+	tk.MustExec(`merge into t using s on t.id = s.id
+		when matched and s.v < 0 then delete
+		when matched then update set v = s.v, note = 'updated'
+		when not matched and s.v > 0 then insert (id, v) values (s.id, s.v)`)
+	require.Equal(t, uint64(3), tk.Session().AffectedRows())
+	tk.MustQuery("select * from t order by id").Check(testkit.Rows(
+		"1 100 updated",
+		"3 30 old",
+		"4 400 new"))
+
+	// The target can be referred by its alias, and the source can be a derived table.
+	tk.MustExec(`merge into t as x using (select id, v * 2 as v from s) as y on x.id = y.id
+		when matched then update set x.v = x.v + y.v
+		when not matched then insert values (y.id, y.v, default)`)
+	tk.MustQuery("select * from t order by id").Check(testkit.Rows(
+		"1 300 updated",
+		"2 -2 new",
+		"3 30 old",
+		"4 1200 new",
+		"5 -10 new"))
+
+	// No clause applies to the rows.
+	tk.MustExec("merge into t using s on t.id = s.id when not matched then insert values (s.id, s.v, 'x')")
+	require.Equal(t, uint64(0), tk.Session().AffectedRows())
+	tk.MustQuery("select count(*) from t").Check(testkit.Rows("5"))
+}
+
+func TestMergeCardinalityViolation(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (id int primary key, v int)")
+	tk.MustExec("create table s (id int, v int)")
+	tk.MustExec("insert into t values (1, 10)")
+	tk.MustExec("insert into s values (1, 100), (1, 200)")
+
+	tk.MustGetErrCode("merge into t using s on t.id = s.id when matched then update set v = s.v", errno.ErrMergeCardinalityViolation)
+	tk.MustGetErrCode("merge into t using s on t.id = s.id when matched then delete", errno.ErrMergeCardinalityViolation)
+	tk.MustQuery("select * from t").Check(testkit.Rows("1 10"))
+
+	// The rows which don't apply to any clause are not counted.
+	tk.MustExec("merge into t using s on t.id = s.id when matched and s.v = 200 then update set v = s.v")
+	tk.MustQuery("select * from t").Check(testkit.Rows("1 200"))
+}
+
+func TestMergeErrors(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (id int primary key, v int, g int as (v + 1))")
+	tk.MustExec("create table s (id int, v int)")
+	tk.MustExec("create view vt as select * from t")
+
+	tk.MustGetErrCode("merge into vt using s on vt.id = s.id when matched then delete", mysql.ErrNonUpdatableTable)
+	tk.MustGetErrCode("merge into t using t on t.id = t.id when matched then delete", mysql.ErrNonuniqTable)
+	tk.MustGetErrCode("merge into t using s on t.id = s.id when not matched then insert (id) values (s.id, s.v)", mysql.ErrWrongValueCountOnRow)
+	tk.MustGetErrCode("merge into t using s on t.id = s.id when not matched then insert (id, g) values (s.id, s.v)", mysql.ErrBadGeneratedColumn)
+	tk.MustGetErrCode("merge into t using s on t.id = s.id when matched then update set g = 1", mysql.ErrBadGeneratedColumn)
+	tk.MustGetErrCode("merge into t using s on t.id = s.id when matched then update set s.v = 1", mysql.ErrNonUpdatableTable)
+
+	// The generated columns are filled for the updated and inserted rows.
+	tk.MustExec("insert into t (id, v) values (1, 1)")
+	tk.MustExec("insert into s values (1, 10), (2, 20)")
+	tk.MustExec(`merge into t using s on t.id = s.id
+		when matched then update set v = s.v
+		when not matched then insert (id, v) values (s.id, s.v)`)
+	tk.MustQuery("select * from t order by id").Check(testkit.Rows("1 10 11", "2 20 21"))
+}
+
+func TestMergePrivilege(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (id int primary key, v int)")
+	tk.MustExec("create table s (id int, v int)")
+	tk.MustExec("create user u1")
+	tk.MustExec("grant select on test.s to u1")
+	tk.MustExec("grant select, update on test.t to u1")
+
+	tk1 := testkit.NewTestKit(t, store)
+	require.NoError(t, tk1.Session().Auth(&auth.UserIdentity{Username: "u1", Hostname: "%"}, nil, nil, nil))
+	tk1.MustExec("use test")
+	tk1.MustExec("merge into t using s on t.id = s.id when matched then update set v = s.v")
+	tk1.MustGetErrCode("merge into t using s on t.id = s.id when matched then delete", mysql.ErrTableaccessDenied)
+	tk1.MustGetErrCode("merge into t using s on t.id = s.id when not matched then insert values (s.id, s.v)", mysql.ErrTableaccessDenied)
+}
@@ -0,0 +1,351 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proceduretest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pingcap/tidb/pkg/executor"
+	"github.com/pingcap/tidb/pkg/parser/auth"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/testkit"
+	"github.com/pingcap/tidb/pkg/util/sqlexec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateAndDropProcedure(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+
+	tk.MustExec("create procedure p1(in a int, out b varchar(20)) begin set b = concat('v', a); end")
+	tk.MustQuery("select route_schema, name, type, parameter_str, security_type from mysql.routines").Check(testkit.Rows(
+		"test p1 PROCEDURE in a int, out b varchar(20) DEFINER"))
+	tk.MustQuery("show procedure status").CheckAt([]int{0, 1, 2, 6}, testkit.Rows("test p1 PROCEDURE DEFINER"))
+	tk.MustExec("create procedure p2() sql security invoker begin end")
+	tk.MustQuery("select security_type from mysql.routines where name = 'p2'").Check(testkit.Rows("INVOKER"))
+	tk.MustExec("drop procedure p2")
+	tk.MustGetErrCode("create procedure p1() begin end", mysql.ErrSpAlreadyExists)
+	tk.MustExec("create procedure if not exists p1() begin end")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Note 1304 PROCEDURE p1 already exists"))
+	tk.MustGetErrCode("create procedure not_exist_db.p1() begin end", mysql.ErrBadDB)
+
+	tk.MustExec("drop procedure p1")
+	tk.MustQuery("select count(*) from mysql.routines").Check(testkit.Rows("0"))
+	tk.MustGetErrCode("drop procedure p1", mysql.ErrSpDoesNotExist)
+	tk.MustExec("drop procedure if exists p1")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Note 1305 PROCEDURE test.p1 does not exist"))
+	tk.MustGetErrCode("call p1(1, @a)", mysql.ErrSpDoesNotExist)
+
+	// The definition is checked when the procedure is created.
+	tk.MustGetErrCode("create procedure p2(a int, a int) begin end", mysql.ErrSpDupParam)
+	tk.MustGetErrCode("create procedure p2() begin declare a int; declare a int; end", mysql.ErrSpDupVar)
+	tk.MustGetErrCode("create procedure p2() begin declare c cursor for select 1; declare c cursor for select 2; end", mysql.ErrSpDupCurs)
+	tk.MustGetErrCode("create procedure p2() begin open c; end", mysql.ErrSpCursorMismatch)
+	tk.MustGetErrCode("create procedure p2() begin declare c cursor for select 1; fetch c into a; end", mysql.ErrSpUndeclaredVar)
+	tk.MustGetErrCode("create procedure p2() begin leave l1; end", mysql.ErrSpLilabelMismatch)
+	tk.MustGetErrCode("create procedure p2() l1: begin iterate l1; end", mysql.ErrSpLilabelMismatch)
+	tk.MustQuery("select count(*) from mysql.routines").Check(testkit.Rows("0"))
+}
+
+func TestCallProcedureWithParameters(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (id int primary key, v varchar(20))")
+
+	tk.MustExec(`create procedure p(in a int, out b varchar(20), inout c int)
+begin
+	insert into t values (a, concat('v', a));
+	select v from t where id = a;
+	set b = concat('b', a), c = c + a;
+end`)
+	tk.MustExec("set @b = 'x', @c = 10")
+	tk.MustExec("call p(1, @b, @c)")
+	tk.MustQuery("select @b, @c").Check(testkit.Rows("b1 11"))
+	// The result set of the SELECT statement is returned with the result of CALL.
+	resultSets, ok := tk.Session().Value(executor.ProcedureResultSetsVarKey).([]sqlexec.RecordSet)
+	require.True(t, ok)
+	require.Len(t, resultSets, 1)
+	require.Equal(t, "v", resultSets[0].Fields()[0].ColumnAsName.L)
+	rows, err := sqlexec.DrainRecordSet(context.Background(), resultSets[0], 1024)
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	require.Equal(t, "v1", rows[0].GetString(0))
+	tk.MustExec("call p(1 + 1, @b, @c)")
+	tk.MustQuery("select @b, @c").Check(testkit.Rows("b2 13"))
+	tk.MustQuery("select * from t").Sort().Check(testkit.Rows("1 v1", "2 v2"))
+	require.NoError(t, resultSets[0].Close())
+
+	// The buffered rows are limited by the memory quota of CALL.
+	tk.MustExec("create table big (v varchar(200))")
+	tk.MustExec("insert into big values (repeat('a', 200))")
+	for range 10 {
+		tk.MustExec("insert into big select * from big")
+	}
+	tk.MustExec("create procedure pbig() begin select v from big; end")
+	tk.MustExec("set @@tidb_mem_quota_query = 65536")
+	err = tk.ExecToErr("call pbig()")
+	require.ErrorContains(t, err, "exceeding the allowed memory limit")
+	tk.MustExec("set @@tidb_mem_quota_query = default")
+	tk.MustExec("call pbig()")
+
+	tk.MustGetErrCode("call p(3, @b)", mysql.ErrSpWrongNoOfArgs)
+	tk.MustGetErrCode("call p(3, 'b', @c)", mysql.ErrSpNotVarArg)
+	// The statements of the procedure are executed in the database of the procedure.
+	tk.MustExec("create database test2")
+	tk.MustExec("use test2")
+	tk.MustExec("call test.p(3, @b, @c)")
+	tk.MustQuery("select count(*) from test.t").Check(testkit.Rows("3"))
+	tk.MustQuery("select database()").Check(testkit.Rows("test2"))
+	tk.MustGetErrCode("call p(4, @b, @c)", mysql.ErrSpDoesNotExist)
+}
+
+func TestProcedureControlFlow(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int)")
+
+	tk.MustExec(`create procedure p_while(n int)
+begin
+	declare i int default 0;
+	while i < n do
+		set i = i + 1;
+		insert into t values (i);
+	end while;
+end`)
+	tk.MustExec("call p_while(3)")
+	tk.MustQuery("select a from t order by a").Check(testkit.Rows("1", "2", "3"))
+
+	tk.MustExec(`create procedure p_loop(out total int)
+begin
+	declare i int default 0;
+	set total = 0;
+	l1: loop
+		set i = i + 1;
+		if i > 10 then
+			leave l1;
+		elseif i % 2 = 0 then
+			iterate l1;
+		end if;
+		set total = total + i;
+	end loop l1;
+end`)
+	tk.MustExec("call p_loop(@total)")
+	tk.MustQuery("select @total").Check(testkit.Rows("25"))
+
+	tk.MustExec(`create procedure p_repeat(out total int)
+begin
+	declare i int default 5;
+	set total = 0;
+	repeat
+		set total = total + i, i = i - 1;
+	until i = 0 end repeat;
+end`)
+	tk.MustExec("call p_repeat(@total)")
+	tk.MustQuery("select @total").Check(testkit.Rows("15"))
+
+	tk.MustExec(`create procedure p_case(a int, out s varchar(10))
+begin
+	case a
+		when 1 then set s = 'one';
+		when 2 then set s = 'two';
+		else set s = 'many';
+	end case;
+end`)
+	tk.MustExec("call p_case(2, @s)")
+	tk.MustQuery("select @s").Check(testkit.Rows("two"))
+	tk.MustExec("call p_case(5, @s)")
+	tk.MustQuery("select @s").Check(testkit.Rows("many"))
+
+	tk.MustExec(`create procedure p_search_case(a int)
+begin
+	case
+		when a > 0 then insert into t values (a);
+	end case;
+end`)
+	tk.MustExec("call p_search_case(100)")
+	tk.MustQuery("select count(*) from t where a = 100").Check(testkit.Rows("1"))
+	tk.MustGetErrCode("call p_search_case(-1)", mysql.ErrSpCaseNotFound)
+
+	tk.MustExec(`create procedure p_block(out r int)
+begin
+	declare x int default 1;
+	b1: begin
+		declare x int default 2;
+		set r = x;
+		leave b1;
+		set r = 0;
+	end b1;
+	set r = r * 10 + x;
+end`)
+	tk.MustExec("call p_block(@r)")
+	tk.MustQuery("select @r").Check(testkit.Rows("21"))
+}
+
+func TestProcedureCursorAndHandler(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int primary key, b int)")
+	tk.MustExec("insert into t values (1, 10), (2, 20), (3, 30)")
+
+	tk.MustExec(`create procedure p_sum(out total int, out cnt int)
+begin
+	declare done int default 0;
+	declare va, vb int;
+	declare cur cursor for select a, b from t order by a;
+	declare continue handler for not found set done = 1;
+	set total = 0, cnt = 0;
+	open cur;
+	read_loop: loop
+		fetch cur into va, vb;
+		if done = 1 then
+			leave read_loop;
+		end if;
+		set total = total + va * vb, cnt = cnt + 1;
+	end loop;
+	close cur;
+end`)
+	tk.MustExec("call p_sum(@total, @cnt)")
+	tk.MustQuery("select @total, @cnt").Check(testkit.Rows("140 3"))
+
+	tk.MustExec(`create procedure p_fetch_no_data()
+begin
+	declare v int;
+	declare cur cursor for select a from t where a > 100;
+	open cur;
+	fetch cur into v;
+end`)
+	tk.MustGetErrCode("call p_fetch_no_data()", mysql.ErrSpFetchNoData)
+
+	tk.MustExec(`create procedure p_cursor_state()
+begin
+	declare cur cursor for select a from t;
+	open cur;
+	open cur;
+end`)
+	tk.MustGetErrCode("call p_cursor_state()", mysql.ErrSpCursorAlreadyOpen)
+
+	tk.MustExec(`create procedure p_exit(out r varchar(20))
+begin
+	declare exit handler for 1062 set r = 'duplicated';
+	set r = 'ok';
+	insert into t values (1, 1);
+	set r = 'unreachable';
+end`)
+	tk.MustExec("call p_exit(@r)")
+	tk.MustQuery("select @r").Check(testkit.Rows("duplicated"))
+
+	tk.MustExec(`create procedure p_continue(out r int)
+begin
+	declare continue handler for sqlexception set r = r + 100;
+	set r = 0;
+	insert into t values (1, 1);
+	set r = r + 1;
+	insert into t values (4, 40);
+end`)
+	tk.MustExec("call p_continue(@r)")
+	tk.MustQuery("select @r").Check(testkit.Rows("101"))
+	tk.MustQuery("select count(*) from t").Check(testkit.Rows("4"))
+
+	tk.MustExec(`create procedure p_sqlstate(out r int)
+begin
+	declare exit handler for sqlstate '23000' set r = 1;
+	set r = 0;
+	insert into t values (1, 1);
+end`)
+	tk.MustExec("call p_sqlstate(@r)")
+	tk.MustQuery("select @r").Check(testkit.Rows("1"))
+
+	// The errors which are not handled are returned to the client.
+	tk.MustExec(`create procedure p_unhandled()
+begin
+	declare exit handler for not found begin end;
+	insert into t values (1, 1);
+end`)
+	tk.MustGetErrCode("call p_unhandled()", mysql.ErrDupEntry)
+}
+
+func TestNestedProcedureCall(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+
+	tk.MustExec("create procedure p_inc(inout v int) begin set v = v + 1; end")
+	tk.MustExec(`create procedure p_outer(out r int)
+begin
+	declare x int default 10;
+	call p_inc(x);
+	call p_inc(x);
+	set r = x;
+end`)
+	tk.MustExec("call p_outer(@r)")
+	tk.MustQuery("select @r").Check(testkit.Rows("12"))
+
+	tk.MustExec(`create procedure p_fact(n int, out r bigint)
+begin
+	if n <= 1 then
+		set r = 1;
+	else
+		call p_fact(n - 1, r);
+		set r = r * n;
+	end if;
+end`)
+	tk.MustGetErrCode("call p_fact(5, @r)", mysql.ErrSpRecursionLimit)
+	tk.MustExec("set @@max_sp_recursion_depth = 10")
+	tk.MustExec("call p_fact(5, @r)")
+	tk.MustQuery("select @r").Check(testkit.Rows("120"))
+	tk.MustExec("set @@max_sp_recursion_depth = 2")
+	tk.MustGetErrCode("call p_fact(5, @r)", mysql.ErrSpRecursionLimit)
+}
+
+func TestProcedurePrivileges(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int)")
+	tk.MustExec("create procedure p() sql security invoker begin insert into t values (1); end")
+	tk.MustExec("create user 'u1'@'%'")
+
+	tk1 := testkit.NewTestKit(t, store)
+	require.NoError(t, tk1.Session().Auth(&auth.UserIdentity{Username: "u1", Hostname: "%"}, nil, nil, nil))
+	tk1.MustExec("use test")
+	tk1.MustGetErrCode("call p()", mysql.ErrDBaccessDenied)
+	tk1.MustGetErrCode("create procedure p1() begin end", mysql.ErrDBaccessDenied)
+	tk1.MustGetErrCode("drop procedure p", mysql.ErrDBaccessDenied)
+
+	// The statements of the procedure are executed with the privileges of the invoker.
+	tk.MustExec("grant execute on test.* to 'u1'@'%'")
+	tk1.MustGetErrCode("call p()", mysql.ErrTableaccessDenied)
+	// The statements of a DEFINER procedure are executed with the privileges of the definer.
+	tk2 := testkit.NewTestKit(t, store)
+	require.NoError(t, tk2.Session().Auth(&auth.UserIdentity{Username: "root", Hostname: "%"}, nil, nil, nil))
+	tk2.MustExec("create procedure test.p2() begin insert into t values (2); end")
+	tk1.MustExec("call p2()")
+	tk.MustQuery("select * from t").Check(testkit.Rows("2"))
+	tk1.MustGetErrCode("insert into t values (3)", mysql.ErrTableaccessDenied)
+	tk.MustExec("delete from t")
+	tk.MustExec("grant insert on test.* to 'u1'@'%'")
+	tk1.MustExec("call p()")
+	tk.MustQuery("select * from t").Check(testkit.Rows("1"))
+
+	tk.MustExec("grant create routine, alter routine on test.* to 'u1'@'%'")
+	tk1.MustExec("create procedure p1() begin end")
+	tk1.MustExec("drop procedure p1")
+	tk1.MustExec("drop procedure p")
+}
@@ -0,0 +1,62 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spatialtest
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/pkg/errno"
+	"github.com/pingcap/tidb/pkg/testkit"
+)
+
+func TestSpatialColumn(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+
+	tk.MustExec("create table t (id int primary key, g geometry, p point not null srid 4326)")
+	tk.MustQuery("show create table t").Check(testkit.Rows("t CREATE TABLE `t` (\n" +
+		"  `id` int NOT NULL,\n" +
+		"  `g` geometry DEFAULT NULL,\n" +
+		"  `p` point NOT NULL /*!80003 SRID 4326 */,\n" +
+		"  PRIMARY KEY (`id`) /*T![clustered_index] CLUSTERED */\n" +
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin"))
+
+	tk.MustExec("insert into t values (1, st_geomfromtext('POLYGON((0 0,4 0,4 4,0 4,0 0))'), st_geomfromtext('POINT(30 120)', 4326))")
+	tk.MustExec("insert into t values (2, point(5, 5), st_geomfromgeojson('{\"type\": \"Point\", \"coordinates\": [121, 31]}'))")
+	tk.MustExec("insert into t values (3, null, st_geomfromtext('POINT(0 0)', 4326))")
+	tk.MustQuery("select id, st_astext(g), st_astext(p), st_srid(p) from t order by id").Check(testkit.Rows(
+		"1 POLYGON((0 0,4 0,4 4,0 4,0 0)) POINT(30 120) 4326",
+		"2 POINT(5 5) POINT(31 121) 4326",
+		"3 <nil> POINT(0 0) 4326"))
+	tk.MustQuery("select id from t where st_contains(g, point(1, 1))").Check(testkit.Rows("1"))
+	tk.MustQuery("select id, st_area(g) from t where st_geometrytype(g) = 'POLYGON'").Check(testkit.Rows("1 16"))
+	tk.MustQuery("select id, st_distance(g, point(8, 9)) from t where g is not null order by id").Check(testkit.Rows("1 6.4031242374328485", "2 5"))
+	tk.MustQuery("select st_x(p), st_y(p), st_asgeojson(p) from t where id = 2").Check(testkit.Rows(`31 121 {"coordinates": [121.0, 31.0], "type": "Point"}`))
+	tk.MustQuery("select round(st_distance_sphere(a.p, b.p)) from t a, t b where a.id = 1 and b.id = 2").Check(testkit.Rows("146775"))
+
+	// The SRID of the geometry must match the SRID of the column, and the geometry must
+	// be of the column type.
+	tk.MustGetErrCode("insert into t values (4, null, point(1, 1))", errno.ErrWrongSRIDForColumn)
+	tk.MustGetErrCode("insert into t values (4, null, st_geomfromtext('LINESTRING(0 0,1 1)', 4326))", errno.ErrCantCreateGeometryObject)
+	tk.MustGetErrCode("insert into t values (4, 'abc', st_geomfromtext('POINT(0 0)', 4326))", errno.ErrCantCreateGeometryObject)
+	tk.MustGetErrCode("select st_contains(g, p) from t", errno.ErrGISDifferentSRIDs)
+
+	// DDL checks.
+	tk.MustGetErrCode("create table t1 (a int srid 0)", errno.ErrWrongUsage)
+	tk.MustGetErrCode("create table t1 (g geometry srid 3857)", errno.ErrSRSNotFound)
+	tk.MustGetErrCode("create table t1 (g geometry default '')", errno.ErrBlobCantHaveDefault)
+	tk.MustGetErrCode("create table t1 (g geometry, key(g))", errno.ErrUnsupportedDDLOperation)
+}
@@ -0,0 +1,239 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package triggertest
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/pkg/parser/auth"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/testkit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateAndDropTrigger(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int)")
+	tk.MustExec("create view v as select * from t")
+
+	tk.MustExec("create trigger trg1 before insert on t for each row set new.b = new.a * 2")
+	tk.MustExec("create trigger test.trg2 after delete on t for each row begin end")
+	tk.MustGetErrCode("create trigger trg1 before update on t for each row begin end", mysql.ErrTrgAlreadyExists)
+	tk.MustExec("create trigger if not exists trg1 before update on t for each row begin end")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Note 1359 Trigger already exists"))
+	tk.MustGetErrCode("create trigger trg3 before insert on v for each row begin end", mysql.ErrTrgOnViewOrTempTable)
+	tk.MustGetErrCode("create trigger mysql.trg3 before insert on test.t for each row begin end", mysql.ErrTrgInWrongSchema)
+	tk.MustGetErrCode("create trigger trg3 before insert on not_exist for each row begin end", mysql.ErrNoSuchTable)
+
+	// The body is checked when the trigger is created.
+	tk.MustGetErrCode("create trigger trg3 before insert on t for each row select 1", mysql.ErrSpNoRetset)
+	tk.MustGetErrCode("create trigger trg3 before insert on t for each row begin commit; end", mysql.ErrCommitNotAllowedInSfOrTrg)
+	tk.MustGetErrCode("create trigger trg3 before insert on t for each row begin leave l1; end", mysql.ErrSpLilabelMismatch)
+
+	tk.MustQuery(`select trigger_schema, trigger_name, event_manipulation, event_object_table, action_order,
+		action_statement, action_orientation, action_timing from information_schema.triggers order by trigger_name`).Check(testkit.Rows(
+		"test trg1 INSERT t 1 set new.b = new.a * 2 ROW BEFORE",
+		"test trg2 DELETE t 1 begin end ROW AFTER"))
+	tk.MustQuery("show triggers").CheckAt([]int{0, 1, 2, 3, 4}, testkit.Rows(
+		"trg1 INSERT t set new.b = new.a * 2 BEFORE",
+		"trg2 DELETE t begin end AFTER"))
+
+	tk.MustExec("drop trigger trg1")
+	tk.MustExec("drop trigger test.trg2")
+	tk.MustGetErrCode("drop trigger trg1", mysql.ErrTrgDoesNotExist)
+	tk.MustExec("drop trigger if exists trg1")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Note 1360 Trigger does not exist"))
+	tk.MustQuery("select count(*) from information_schema.triggers").Check(testkit.Rows("0"))
+
+	// The triggers are dropped with the table.
+	tk.MustExec("create trigger trg1 before insert on t for each row begin end")
+	tk.MustExec("drop table t")
+	tk.MustExec("create table t (a int, b int)")
+	tk.MustExec("create trigger trg1 before insert on t for each row begin end")
+}
+
+func TestBeforeTriggers(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (id int primary key, a int, b int not null default 0, c int as (a + b))")
+
+	tk.MustExec(`create trigger t_bi before insert on t for each row
+begin
+	if new.a < 0 then
+		set new.a = 0;
+	end if;
+	set new.b = new.a * 10;
+end`)
+	tk.MustExec("create trigger t_bu before update on t for each row set new.b = old.b + 1")
+	tk.MustExec("insert into t (id, a) values (1, 1), (2, -5)")
+	tk.MustQuery("select * from t order by id").Check(testkit.Rows("1 1 10 11", "2 0 0 0"))
+
+	// The BEFORE UPDATE trigger is fired for each matched row, and it can change the unassigned columns.
+	tk.MustExec("update t set a = a + 1")
+	tk.MustQuery("select * from t order by id").Check(testkit.Rows("1 2 11 13", "2 1 1 2"))
+	tk.MustExec("insert into t (id, a) values (1, 100) on duplicate key update a = 5")
+	tk.MustQuery("select * from t order by id").Check(testkit.Rows("1 5 12 17", "2 1 1 2"))
+
+	// The NOT NULL constraints are checked after the triggers.
+	tk.MustExec("drop trigger t_bu")
+	tk.MustExec("create trigger t_bu before update on t for each row set new.b = null")
+	tk.MustGetErrCode("update t set a = 1 where id = 1", mysql.ErrBadNull)
+
+	// The OLD row and the NEW row of AFTER triggers can't be changed.
+	tk.MustExec("drop trigger t_bu")
+	tk.MustExec("create trigger t_bu before update on t for each row set old.b = 1")
+	tk.MustGetErrCode("update t set a = 1 where id = 1", mysql.ErrTrgCantChangeRow)
+	tk.MustExec("drop trigger t_bu")
+	tk.MustExec("create trigger t_au after update on t for each row set new.b = 1")
+	tk.MustGetErrCode("update t set a = 1 where id = 1", mysql.ErrTrgCantChangeRow)
+	tk.MustQuery("select * from t order by id").Check(testkit.Rows("1 5 12 17", "2 1 1 2"))
+}
+
+func TestAfterTriggers(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (id int primary key, v varchar(20))")
+	tk.MustExec("create table audit (seq int primary key auto_increment, action varchar(10), id int, old_v varchar(20), new_v varchar(20))")
+	tk.MustExec("create trigger t_ai after insert on t for each row insert into audit (action, id, new_v) values ('insert', new.id, new.v)")
+	tk.MustExec("create trigger t_au after update on t for each row insert into audit (action, id, old_v, new_v) values ('update', new.id, old.v, new.v)")
+	tk.MustExec("create trigger t_ad after delete on t for each row insert into audit (action, id, old_v) values ('delete', old.id, old.v)")
+
+	// The rows changed by the triggers are not counted in the affected rows.
+	tk.MustExec("insert into t values (1, 'a'), (2, 'b')")
+	require.Equal(t, uint64(2), tk.Session().GetSessionVars().StmtCtx.AffectedRows())
+	tk.MustExec("update t set v = concat(v, v) where id = 1")
+	require.Equal(t, uint64(1), tk.Session().GetSessionVars().StmtCtx.AffectedRows())
+	tk.MustExec("delete from t where id = 2")
+	tk.MustExec("replace into t values (1, 'c')")
+	tk.MustQuery("select action, id, old_v, new_v from audit order by seq").Check(testkit.Rows(
+		"insert 1 <nil> a",
+		"insert 2 <nil> b",
+		"update 1 a aa",
+		"delete 2 b <nil>",
+		"delete 1 aa <nil>",
+		"insert 1 <nil> c"))
+
+	// The triggers are executed in the transaction of the statement.
+	tk.MustExec("begin")
+	tk.MustExec("insert into t values (3, 'd')")
+	tk.MustQuery("select count(*) from audit where id = 3").Check(testkit.Rows("1"))
+	tk.MustExec("rollback")
+	tk.MustQuery("select count(*) from audit where id = 3").Check(testkit.Rows("0"))
+
+	// The statement fails with the trigger, and the changes of the statement are rolled back.
+	tk.MustExec("create table t2 (id int primary key, v int)")
+	tk.MustExec("create trigger t2_ai after insert on t2 for each row insert into audit (seq, action) values (1, 'dup')")
+	tk.MustGetErrCode("insert into t2 values (1, 1)", mysql.ErrDupEntry)
+	tk.MustQuery("select count(*) from t2").Check(testkit.Rows("0"))
+}
+
+func TestTriggerRecursion(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t1 (a int)")
+	tk.MustExec("create table t2 (a int)")
+	tk.MustExec("create trigger t1_ai after insert on t1 for each row insert into t2 values (new.a)")
+	tk.MustExec("create trigger t2_ai after insert on t2 for each row begin if new.a < 3 then insert into t1 values (new.a + 1); end if; end")
+
+	tk.MustGetErrCode("insert into t1 values (1)", mysql.ErrSpRecursionLimit)
+	tk.MustQuery("select count(*) from t1").Check(testkit.Rows("0"))
+	tk.MustExec("set @@max_sp_recursion_depth = 5")
+	tk.MustExec("insert into t1 values (1)")
+	tk.MustQuery("select * from t1 order by a").Check(testkit.Rows("1", "2", "3"))
+	tk.MustQuery("select * from t2 order by a").Check(testkit.Rows("1", "2", "3"))
+}
+
+func TestTriggerPrivileges(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int)")
+	tk.MustExec("create user 'u1'@'%'")
+	tk.MustExec("create trigger trg before insert on t for each row begin end")
+
+	tk1 := testkit.NewTestKit(t, store)
+	require.NoError(t, tk1.Session().Auth(&auth.UserIdentity{Username: "u1", Hostname: "%"}, nil, nil, nil))
+	tk1.MustExec("use test")
+	tk1.MustGetErrCode("create trigger trg1 before insert on t for each row begin end", mysql.ErrTableaccessDenied)
+	tk1.MustGetErrCode("drop trigger trg", mysql.ErrDBaccessDenied)
+	tk1.MustQuery("select count(*) from information_schema.triggers").Check(testkit.Rows("0"))
+
+	tk.MustExec("grant trigger on test.* to 'u1'@'%'")
+	tk1.MustQuery("select trigger_name from information_schema.triggers").Check(testkit.Rows("trg"))
+	tk1.MustExec("create trigger trg1 before insert on t for each row begin end")
+	tk1.MustExec("drop trigger trg")
+	tk1.MustExec("drop trigger trg1")
+}
+
+func TestTriggerDefiner(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int)")
+	tk.MustExec("create table audit (a int, u varchar(64))")
+	tk.MustExec("create user 'u1'@'%', 'u2'@'%'")
+	tk.MustExec("grant trigger on test.t to 'u1'@'%'")
+	tk.MustExec("grant insert on test.audit to 'u1'@'%'")
+	tk.MustExec("grant insert on test.t to 'u2'@'%'")
+
+	tk1 := testkit.NewTestKit(t, store)
+	require.NoError(t, tk1.Session().Auth(&auth.UserIdentity{Username: "u1", Hostname: "%"}, nil, nil, nil))
+	tk1.MustExec("use test")
+	tk1.MustExec("create trigger t_ai after insert on t for each row insert into audit values (new.a, current_user())")
+
+	// The body is executed with the privileges of the definer rather than the invoker.
+	tk2 := testkit.NewTestKit(t, store)
+	require.NoError(t, tk2.Session().Auth(&auth.UserIdentity{Username: "u2", Hostname: "%"}, nil, nil, nil))
+	tk2.MustExec("use test")
+	tk2.MustGetErrCode("insert into audit values (0, '')", mysql.ErrTableaccessDenied)
+	tk2.MustExec("insert into t values (1)")
+	tk2.MustQuery("select current_user()").Check(testkit.Rows("u2@%"))
+	tk.MustQuery("select * from audit").Check(testkit.Rows("1 u1@%"))
+
+	tk.MustExec("revoke insert on test.audit from 'u1'@'%'")
+	tk2.MustGetErrCode("insert into t values (2)", mysql.ErrTableaccessDenied)
+	tk.MustExec("drop user 'u1'@'%'")
+	tk2.MustGetErrCode("insert into t values (3)", mysql.ErrNoSuchUser)
+	tk.MustQuery("select * from t").Check(testkit.Rows("1"))
+}
+
+func TestTriggerSQLMode(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int)")
+	tk.MustExec("create table t1 (a tinyint)")
+
+	// The body is executed with the sql_mode the trigger is created with.
+	tk.MustExec("set @@sql_mode = ''")
+	tk.MustExec("create trigger t_ai after insert on t for each row insert into t1 values (new.a)")
+	tk.MustExec("set @@sql_mode = default")
+	tk.MustExec("insert into t values (1000)")
+	tk.MustQuery("select * from t1").Check(testkit.Rows("127"))
+	tk.MustGetErrCode("insert into t1 values (1000)", mysql.ErrWarnDataOutOfRange)
+
+	tk.MustExec("drop trigger t_ai")
+	tk.MustExec("create trigger t_ai after insert on t for each row insert into t1 values (new.a)")
+	tk.MustExec("set @@sql_mode = ''")
+	tk.MustGetErrCode("insert into t values (1000)", mysql.ErrWarnDataOutOfRange)
+	tk.MustQuery("select count(*) from t").Check(testkit.Rows("1"))
+	tk.MustExec("insert into t1 values (1000)")
+	tk.MustQuery("select count(*) from t1").Check(testkit.Rows("2"))
+}
@@ -0,0 +1,393 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/errctx"
+	"github.com/pingcap/tidb/pkg/executor/internal/exec"
+	"github.com/pingcap/tidb/pkg/infoschema"
+	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/parser"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/parser/terror"
+	"github.com/pingcap/tidb/pkg/planner"
+	plannercore "github.com/pingcap/tidb/pkg/planner/core"
+	"github.com/pingcap/tidb/pkg/planner/core/resolve"
+	"github.com/pingcap/tidb/pkg/privilege"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/sessionctx/variable"
+	"github.com/pingcap/tidb/pkg/sessiontxn"
+	"github.com/pingcap/tidb/pkg/table"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/dbterror/exeerrors"
+	"github.com/pingcap/tidb/pkg/util/sqlescape"
+)
+
+// tableTriggers fires the row triggers of a table for the rows changed by a DML statement.
+// The methods can be called on a nil *tableTriggers, which means the table has no triggers.
+type tableTriggers struct {
+	tbl    table.Table
+	dbName string
+	// bodies caches the parsed bodies of the triggers, the key is the name of the trigger.
+	bodies map[string]ast.StmtNode
+}
+
+// buildTableTriggers returns nil if the table has no triggers.
+func buildTableTriggers(is infoschema.InfoSchema, tbl table.Table) (*tableTriggers, error) {
+	if tbl == nil || len(tbl.Meta().Triggers) == 0 {
+		return nil, nil
+	}
+	db, ok := infoschema.SchemaByTable(is, tbl.Meta())
+	if !ok {
+		return nil, errors.Errorf("can not find the schema of table %s", tbl.Meta().Name.O)
+	}
+	return &tableTriggers{
+		tbl:    tbl,
+		dbName: db.Name.L,
+		bodies: make(map[string]ast.StmtNode),
+	}, nil
+}
+
+func buildTblID2Triggers(is infoschema.InfoSchema, tblID2Table map[int64]table.Table) (map[int64]*tableTriggers, error) {
+	var tblID2Triggers map[int64]*tableTriggers
+	for tid, tbl := range tblID2Table {
+		triggers, err := buildTableTriggers(is, tbl)
+		if err != nil {
+			return nil, err
+		}
+		if triggers == nil {
+			continue
+		}
+		if tblID2Triggers == nil {
+			tblID2Triggers = make(map[int64]*tableTriggers)
+		}
+		tblID2Triggers[tid] = triggers
+	}
+	return tblID2Triggers, nil
+}
+
+// fire executes the triggers of the timing and event for a row in the order they are created.
+// oldRow is nil for INSERT and newRow is nil for DELETE. The values assigned to the NEW row by
+// BEFORE triggers are written back to newRow.
+func (t *tableTriggers) fire(ctx context.Context, sctx sessionctx.Context, timing ast.TriggerTiming, event ast.TriggerEvent, oldRow, newRow []types.Datum) error {
+	if t == nil {
+		return nil
+	}
+	for _, trigger := range t.tbl.Meta().Triggers {
+		if trigger.Timing != timing || trigger.Event != event {
+			continue
+		}
+		if err := t.fireTrigger(ctx, sctx, trigger, oldRow, newRow); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *tableTriggers) fireTrigger(ctx context.Context, sctx sessionctx.Context, trigger *model.TriggerInfo, oldRow, newRow []types.Datum) error {
+	body, err := t.loadBody(sctx, trigger)
+	if err != nil {
+		return err
+	}
+	sessVars := sctx.GetSessionVars()
+	name := t.dbName + "." + trigger.Name.L
+	// A trigger may be activated by the statements of another trigger or procedure, the depth of
+	// the recursion is limited like the procedures.
+	caller, _ := sessVars.ProcedureCtx.(*procedureExec)
+	depth := 0
+	for c := caller; c != nil; c = c.caller {
+		if c.name == name {
+			depth++
+		}
+	}
+	if depth > sessVars.MaxSpRecursionDepth {
+		return exeerrors.ErrSpRecursionLimit.GenWithStackByArgs(sessVars.MaxSpRecursionDepth, name)
+	}
+
+	p := &procedureExec{
+		sctx:    sctx,
+		name:    name,
+		caller:  caller,
+		scope:   newProcedureScope(nil),
+		execSQL: executeTriggerSQL,
+	}
+	cols := t.tbl.Cols()
+	newVars := make([]*procedureVariable, len(cols))
+	for i, col := range cols {
+		if col.Hidden {
+			continue
+		}
+		if oldRow != nil {
+			p.scope.vars["old."+col.Name.L] = &procedureVariable{tp: col.FieldType.Clone(), value: *oldRow[i].Clone(), readOnly: true}
+		}
+		if newRow != nil {
+			v := &procedureVariable{tp: col.FieldType.Clone(), value: *newRow[i].Clone(), readOnly: trigger.Timing == ast.TriggerAfter}
+			p.scope.vars["new."+col.Name.L] = v
+			newVars[i] = v
+		}
+	}
+
+	// Like MySQL, the body is executed with the privileges of the definer and the sql_mode of the trigger.
+	switchBack, err := switchToDefiner(ctx, sctx, trigger.Definer)
+	if err != nil {
+		return err
+	}
+	restoreSQLMode := useTriggerSQLMode(sessVars, trigger.SQLMode)
+	// Like procedures, the database of the table is the default database while the trigger is executing.
+	originDB, originProcedure, originInTrigger := sessVars.CurrentDB, sessVars.ProcedureCtx, sessVars.StmtCtx.InHandleTrigger
+	sessVars.CurrentDB = t.dbName
+	sessVars.ProcedureCtx = p
+	sessVars.StmtCtx.InHandleTrigger = true
+	err = p.run(ctx, body)
+	sessVars.CurrentDB, sessVars.ProcedureCtx, sessVars.StmtCtx.InHandleTrigger = originDB, originProcedure, originInTrigger
+	restoreSQLMode()
+	switchBack()
+	if err != nil || trigger.Timing == ast.TriggerAfter {
+		return err
+	}
+
+	sc := sessVars.StmtCtx
+	for i, v := range newVars {
+		// The generated columns are evaluated after the BEFORE triggers.
+		if v == nil || cols[i].IsGenerated() {
+			continue
+		}
+		if v.value.IsNull() && !newRow[i].IsNull() {
+			if err := cols[i].HandleBadNull(sc.ErrCtx(), &v.value, 0); err != nil {
+				return err
+			}
+		}
+		newRow[i] = v.value
+	}
+	return nil
+}
+
+// useTriggerSQLMode makes the body of a trigger executed with the sql_mode it was created with, which decides how
+// the invalid values are handled like the statement activating the trigger does with its own sql_mode. It returns
+// the function to recover the sql_mode and the statement context of the activating statement.
+func useTriggerSQLMode(sessVars *variable.SessionVars, sqlMode mysql.SQLMode) func() {
+	sc := sessVars.StmtCtx
+	originMode, originFlags, originLevels := sessVars.SQLMode, sc.TypeFlags(), sc.ErrLevels()
+	if originMode == sqlMode {
+		return func() {}
+	}
+	sessVars.SQLMode = sqlMode
+	// The IGNORE of the activating statement also applies to the statements of the trigger.
+	ignoreErr := originLevels[errctx.ErrGroupDupKey] != errctx.LevelError
+	strictSQLMode := sqlMode.HasStrictMode()
+	errLevels := originLevels
+	errLevels[errctx.ErrGroupBadNull] = errctx.ResolveErrLevel(false, !strictSQLMode || ignoreErr)
+	errLevels[errctx.ErrGroupNoDefault] = errLevels[errctx.ErrGroupBadNull]
+	errLevels[errctx.ErrGroupDividedByZero] = errctx.ResolveErrLevel(
+		!sqlMode.HasErrorForDivisionByZeroMode(),
+		!strictSQLMode || ignoreErr,
+	)
+	sc.SetErrLevels(errLevels)
+	sc.SetTypeFlags(originFlags.
+		WithTruncateAsWarning(!strictSQLMode || ignoreErr).
+		WithIgnoreInvalidDateErr(sqlMode.HasAllowInvalidDatesMode()).
+		WithIgnoreZeroInDate(!sqlMode.HasNoZeroInDateMode() || !sqlMode.HasNoZeroDateMode() ||
+			!strictSQLMode || ignoreErr || sqlMode.HasAllowInvalidDatesMode()))
+	return func() {
+		sessVars.SQLMode = originMode
+		sc.SetTypeFlags(originFlags)
+		sc.SetErrLevels(originLevels)
+	}
+}
+
+// triggerReadOnlyRowErr returns the error of assigning a column of the OLD row, or the NEW row in an AFTER trigger.
+func triggerReadOnlyRowErr(name string) error {
+	if strings.HasPrefix(strings.ToLower(name), "old.") {
+		return exeerrors.ErrTrgCantChangeRow.GenWithStackByArgs("OLD", "")
+	}
+	return exeerrors.ErrTrgCantChangeRow.GenWithStackByArgs("NEW", "after ")
+}
+
+// loadBody parses the body of the trigger with the sql_mode and charset it was created with.
+func (t *tableTriggers) loadBody(sctx sessionctx.Context, trigger *model.TriggerInfo) (ast.StmtNode, error) {
+	if body, ok := t.bodies[trigger.Name.L]; ok {
+		return body, nil
+	}
+	p := parser.New()
+	p.SetSQLMode(trigger.SQLMode)
+	p.SetParserConfig(sctx.GetSessionVars().BuildParserConfig())
+	sql := sqlescape.MustEscapeSQL("CREATE TRIGGER %n ", trigger.Name.O) + trigger.Timing.String() + " " +
+		trigger.Event.String() + sqlescape.MustEscapeSQL(" ON %n FOR EACH ROW ", t.tbl.Meta().Name.O) + trigger.Body
+	stmt, err := p.ParseOneStmt(sql, trigger.CharacterSetClient, trigger.CollationConnection)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	create, ok := stmt.(*ast.CreateTriggerStmt)
+	if !ok {
+		return nil, errors.Errorf("invalid definition of trigger %s.%s", t.dbName, trigger.Name.O)
+	}
+	t.bodies[trigger.Name.L] = create.Body
+	return create.Body, nil
+}
+
+// executeTriggerSQL executes a statement of a trigger as a part of the statement activating the
+// trigger, so the changes are made in the same transaction and can be rolled back with the statement.
+func executeTriggerSQL(ctx context.Context, sctx sessionctx.Context, stmt ast.StmtNode, needRows bool) (rows [][]types.Datum, fieldTypes []*types.FieldType, err error) {
+	if !needRows {
+		if err := checkTriggerStmt(stmt); err != nil {
+			return nil, nil, err
+		}
+	}
+	nodeW := resolve.NewNodeW(stmt)
+	if err := plannercore.Preprocess(ctx, sctx, nodeW); err != nil {
+		return nil, nil, err
+	}
+	is := sessiontxn.GetTxnManager(sctx).GetTxnInfoSchema()
+	p, _, err := planner.OptimizeForTrigger(ctx, sctx.GetPlanCtx(), nodeW, is)
+	if err != nil {
+		return nil, nil, err
+	}
+	b := newExecutorBuilder(sctx, is)
+	e := b.build(p)
+	if b.err != nil {
+		return nil, nil, b.err
+	}
+	if err := exec.Open(ctx, e); err != nil {
+		terror.Log(exec.Close(e))
+		return nil, nil, err
+	}
+	fieldTypes = exec.RetTypes(e)
+	chk := exec.NewFirstChunk(e)
+	for {
+		if err = exec.Next(ctx, e, chk); err != nil || chk.NumRows() == 0 {
+			break
+		}
+		if !needRows {
+			continue
+		}
+		for i := range chk.NumRows() {
+			rows = append(rows, chk.GetRow(i).GetDatumRow(fieldTypes))
+		}
+	}
+	if closeErr := exec.Close(e); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	// The foreign keys of the rows changed by the statement are checked and cascaded immediately.
+	if err := (&ExecStmt{Ctx: sctx}).handleForeignKeyTrigger(ctx, e, 1); err != nil {
+		return nil, nil, err
+	}
+	return rows, fieldTypes, nil
+}
+
+// setDataFromTriggers fills the rows of information_schema.TRIGGERS with the triggers of the
+// tables on which the current user has the TRIGGER privilege.
+func (e *memtableRetriever) setDataFromTriggers(ctx context.Context, sctx sessionctx.Context) error {
+	checker := privilege.GetPrivilegeManager(sctx)
+	loc := sctx.GetSessionVars().Location()
+	var rows [][]types.Datum
+	for _, schemaName := range e.is.AllSchemaNames() {
+		schema, ok := e.is.SchemaByName(schemaName)
+		if !ok {
+			continue
+		}
+		tables, err := e.is.SchemaTableInfos(ctx, schemaName)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		for _, tbl := range tables {
+			if len(tbl.Triggers) == 0 {
+				continue
+			}
+			if checker != nil && !checker.RequestVerification(sctx.GetSessionVars().ActiveRoles, schema.Name.L, tbl.Name.L, "", mysql.TriggerPriv) {
+				continue
+			}
+			actionOrders := make(map[[2]int]int)
+			for _, trigger := range tbl.Triggers {
+				key := [2]int{int(trigger.Timing), int(trigger.Event)}
+				actionOrders[key]++
+				created := types.NewTime(types.FromGoTime(model.TSConvert2Time(trigger.CreateTS).In(loc)), mysql.TypeDatetime, 2)
+				row := types.MakeDatums(
+					infoschema.CatalogVal,       // TRIGGER_CATALOG
+					schema.Name.O,               // TRIGGER_SCHEMA
+					trigger.Name.O,              // TRIGGER_NAME
+					trigger.Event.String(),      // EVENT_MANIPULATION
+					infoschema.CatalogVal,       // EVENT_OBJECT_CATALOG
+					schema.Name.O,               // EVENT_OBJECT_SCHEMA
+					tbl.Name.O,                  // EVENT_OBJECT_TABLE
+					actionOrders[key],           // ACTION_ORDER
+					nil,                         // ACTION_CONDITION
+					trigger.Body,                // ACTION_STATEMENT
+					"ROW",                       // ACTION_ORIENTATION
+					trigger.Timing.String(),     // ACTION_TIMING
+					nil,                         // ACTION_REFERENCE_OLD_TABLE
+					nil,                         // ACTION_REFERENCE_NEW_TABLE
+					"OLD",                       // ACTION_REFERENCE_OLD_ROW
+					"NEW",                       // ACTION_REFERENCE_NEW_ROW
+					created,                     // CREATED
+					trigger.SQLMode.String(),    // SQL_MODE
+					trigger.Definer,             // DEFINER
+					trigger.CharacterSetClient,  // CHARACTER_SET_CLIENT
+					trigger.CollationConnection, // COLLATION_CONNECTION
+					schema.Collate,              // DATABASE_COLLATION
+				)
+				rows = append(rows, row)
+				e.recordMemoryConsume(row)
+			}
+		}
+	}
+	e.rows = rows
+	return nil
+}
+
+func (e *ShowExec) fetchShowTriggers(ctx context.Context) error {
+	schema, ok := e.is.SchemaByName(e.DBName)
+	if !ok {
+		return infoschema.ErrDatabaseNotExists.GenWithStackByArgs(e.DBName.O)
+	}
+	tables, err := e.is.SchemaTableInfos(ctx, e.DBName)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	checker := privilege.GetPrivilegeManager(e.Ctx())
+	loc := e.Ctx().GetSessionVars().Location()
+	for _, tbl := range tables {
+		if len(tbl.Triggers) == 0 {
+			continue
+		}
+		if checker != nil && !checker.RequestVerification(e.Ctx().GetSessionVars().ActiveRoles, e.DBName.L, tbl.Name.L, "", mysql.TriggerPriv) {
+			continue
+		}
+		for _, trigger := range tbl.Triggers {
+			created := types.NewTime(types.FromGoTime(model.TSConvert2Time(trigger.CreateTS).In(loc)), mysql.TypeDatetime, 2)
+			e.appendRow([]any{
+				trigger.Name.O,
+				trigger.Event.String(),
+				tbl.Name.O,
+				trigger.Body,
+				trigger.Timing.String(),
+				created,
+				trigger.SQLMode.String(),
+				trigger.Definer,
+				trigger.CharacterSetClient,
+				trigger.CollationConnection,
+				schema.Collate,
+			})
+		}
+	}
+	return nil
+}
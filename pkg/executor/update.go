@@ -76,6 +76,8 @@ type UpdateExec struct {
 	fkChecks map[int64][]*FKCheckExec
 	// fkCascades contains the foreign key cascade. the map is tableID -> []*FKCascadeExec
 	fkCascades map[int64][]*FKCascadeExec
+	// triggers contains the row triggers. the map is tableID -> *tableTriggers
+	triggers map[int64]*tableTriggers
 
 	IgnoreError bool
 }
@@ -300,6 +302,7 @@ func (e *UpdateExec) exec(
 			flags, tbl, false, e.memTracker,
 			e.fkChecks[content.TblID],
 			e.fkCascades[content.TblID],
+			e.triggers[content.TblID],
 			dupKeyCheck, e.IgnoreError)
 
 		// Copy data from new row to merge row
@@ -384,19 +387,7 @@ func (e *UpdateExec) updateRows(ctx context.Context) (int, error) {
 		return 0, err
 	}
 
-	if e.virtualAssignmentsOffset < len(e.OrderedList) {
-		e.assignmentsPerTable = make(map[int][]*expression.Assignment, 0)
-		for _, assign := range e.OrderedList[e.virtualAssignmentsOffset:] {
-			tblIdx := e.assignFlag[assign.Col.Index]
-			if tblIdx < 0 {
-				continue
-			}
-			if _, ok := e.assignmentsPerTable[tblIdx]; !ok {
-				e.assignmentsPerTable[tblIdx] = make([]*expression.Assignment, 0)
-			}
-			e.assignmentsPerTable[tblIdx] = append(e.assignmentsPerTable[tblIdx], assign)
-		}
-	}
+	e.initAssignmentsPerTable()
 
 	dupKeyCheck := optimizeDupKeyCheckForUpdate(txn, e.IgnoreError)
 	for {
@@ -428,27 +419,7 @@ func (e *UpdateExec) updateRows(ctx context.Context) (int, error) {
 		for rowIdx := range chk.NumRows() {
 			chunkRow := chk.GetRow(rowIdx)
 			datumRow := chunkRow.GetDatumRow(fields)
-			// precomputes handles
-			if err := e.prepare(datumRow); err != nil {
-				return 0, err
-			}
-			// compose non-generated columns
-			newRow, err := composeFunc(globalRowIdx, datumRow, colsInfo)
-			if err != nil {
-				return 0, err
-			}
-			// merge non-generated columns
-			if err := e.mergeNonGenerated(datumRow, newRow); err != nil {
-				return 0, err
-			}
-
-			if e.virtualAssignmentsOffset < len(e.OrderedList) {
-				e.evalBuffer.SetDatums(newRow...)
-			}
-
-			if err := e.exec(
-				ctx, e.Children(0).Schema(),
-				globalRowIdx, datumRow, newRow, dupKeyCheck); err != nil {
+			if err := e.updateRow(ctx, globalRowIdx, datumRow, colsInfo, composeFunc, dupKeyCheck); err != nil {
 				return 0, err
 			}
 			globalRowIdx++
@@ -459,6 +430,52 @@ func (e *UpdateExec) updateRows(ctx context.Context) (int, error) {
 	return totalNumRows, nil
 }
 
+// initAssignmentsPerTable groups the assignments of the generated columns by the tables.
+func (e *UpdateExec) initAssignmentsPerTable() {
+	if e.virtualAssignmentsOffset >= len(e.OrderedList) {
+		return
+	}
+	e.assignmentsPerTable = make(map[int][]*expression.Assignment, 0)
+	for _, assign := range e.OrderedList[e.virtualAssignmentsOffset:] {
+		tblIdx := e.assignFlag[assign.Col.Index]
+		if tblIdx < 0 {
+			continue
+		}
+		if _, ok := e.assignmentsPerTable[tblIdx]; !ok {
+			e.assignmentsPerTable[tblIdx] = make([]*expression.Assignment, 0)
+		}
+		e.assignmentsPerTable[tblIdx] = append(e.assignmentsPerTable[tblIdx], assign)
+	}
+}
+
+// updateRow updates the tables with a row of the child executor.
+func (e *UpdateExec) updateRow(
+	ctx context.Context,
+	rowIdx int, row []types.Datum, colsInfo []*table.Column,
+	composeFunc func(int, []types.Datum, []*table.Column) ([]types.Datum, error),
+	dupKeyCheck table.DupKeyCheckMode,
+) error {
+	// precomputes handles
+	if err := e.prepare(row); err != nil {
+		return err
+	}
+	// compose non-generated columns
+	newRow, err := composeFunc(rowIdx, row, colsInfo)
+	if err != nil {
+		return err
+	}
+	// merge non-generated columns
+	if err := e.mergeNonGenerated(row, newRow); err != nil {
+		return err
+	}
+
+	if e.virtualAssignmentsOffset < len(e.OrderedList) {
+		e.evalBuffer.SetDatums(newRow...)
+	}
+
+	return e.exec(ctx, nil, rowIdx, row, newRow, dupKeyCheck)
+}
+
 func handleUpdateError(sctx sessionctx.Context, colName ast.CIStr, colInfo *mmodel.ColumnInfo, rowIdx int, err error) error {
 	if err == nil {
 		return nil
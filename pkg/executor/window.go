@@ -15,6 +15,7 @@
 package executor
 
 import (
+	"cmp"
 	"context"
 
 	"github.com/pingcap/errors"
@@ -35,47 +36,65 @@ type WindowExec struct {
 	groupChecker *vecgroupchecker.VecGroupChecker
 	// childResult stores the child chunk
 	childResult *chunk.Chunk
+	groupRows   []chunk.Row
 	// executed indicates the child executor is drained or something unexpected happened.
 	executed bool
-	// resultChunks stores the chunks to return
-	resultChunks []*chunk.Chunk
-	// remainingRowsInChunk indicates how many rows the resultChunks[i] is not prepared.
-	remainingRowsInChunk []int
+	// partition buffers the rows of the current partition, which may be spilled to disk.
+	partition *windowRowBuffer
+	// produced indicates how many rows of the current partition are returned.
+	produced     uint64
+	inputColIdxs []int
 
 	numWindowFuncs int
 	processor      windowProcessor
 }
 
+// Open implements the Executor Open interface.
+func (e *WindowExec) Open(ctx context.Context) error {
+	if err := e.BaseExecutor.Open(ctx); err != nil {
+		return err
+	}
+	e.executed, e.produced = false, 0
+	e.partition = newWindowRowBuffer(e.Ctx(), e.ID(), exec.RetTypes(e.Children(0)), e.MaxChunkSize())
+	e.inputColIdxs = buildWindowInputColIdxs(e.Schema(), e.numWindowFuncs)
+	return nil
+}
+
 // Close implements the Executor Close interface.
 func (e *WindowExec) Close() error {
+	if e.partition != nil {
+		e.partition.close()
+		e.partition = nil
+	}
 	return errors.Trace(e.BaseExecutor.Close())
 }
 
 // Next implements the Executor Next interface.
 func (e *WindowExec) Next(ctx context.Context, chk *chunk.Chunk) error {
 	chk.Reset()
-	for !e.executed && !e.preparedChunkAvailable() {
-		err := e.consumeOneGroup(ctx)
-		if err != nil {
-			e.executed = true
+	for !chk.IsFull() {
+		if e.produced == e.partition.numRows {
+			if e.executed {
+				return nil
+			}
+			e.processor.resetPartialResult()
+			e.partition.reset()
+			e.produced = 0
+			if err := e.consumeOneGroup(ctx); err != nil {
+				e.executed = true
+				return err
+			}
+			continue
+		}
+		remained := min(uint64(chk.RequiredRows()-chk.NumRows()), e.partition.numRows-e.produced)
+		if err := e.appendResult2Chunk(chk, remained); err != nil {
 			return err
 		}
 	}
-	if len(e.resultChunks) > 0 {
-		chk.SwapColumns(e.resultChunks[0])
-		e.resultChunks[0] = nil // GC it. TODO: Reuse it.
-		e.resultChunks = e.resultChunks[1:]
-		e.remainingRowsInChunk = e.remainingRowsInChunk[1:]
-	}
 	return nil
 }
 
-func (e *WindowExec) preparedChunkAvailable() bool {
-	return len(e.resultChunks) > 0 && e.remainingRowsInChunk[0] == 0
-}
-
 func (e *WindowExec) consumeOneGroup(ctx context.Context) error {
-	var groupRows []chunk.Row
 	if e.groupChecker.IsExhausted() {
 		eof, err := e.fetchChild(ctx)
 		if err != nil {
@@ -83,7 +102,7 @@ func (e *WindowExec) consumeOneGroup(ctx context.Context) error {
 		}
 		if eof {
 			e.executed = true
-			return e.consumeGroupRows(groupRows)
+			return nil
 		}
 		_, err = e.groupChecker.SplitIntoGroups(e.childResult)
 		if err != nil {
@@ -91,8 +110,8 @@ func (e *WindowExec) consumeOneGroup(ctx context.Context) error {
 		}
 	}
 	begin, end := e.groupChecker.GetNextGroup()
-	for i := begin; i < end; i++ {
-		groupRows = append(groupRows, e.childResult.GetRow(i))
+	if err := e.consumeGroupRows(begin, end); err != nil {
+		return err
 	}
 
 	for meetLastGroup := end == e.childResult.NumRows(); meetLastGroup; {
@@ -103,7 +122,7 @@ func (e *WindowExec) consumeOneGroup(ctx context.Context) error {
 		}
 		if eof {
 			e.executed = true
-			return e.consumeGroupRows(groupRows)
+			return nil
 		}
 
 		isFirstGroupSameAsPrev, err := e.groupChecker.SplitIntoGroups(e.childResult)
@@ -113,86 +132,66 @@ func (e *WindowExec) consumeOneGroup(ctx context.Context) error {
 
 		if isFirstGroupSameAsPrev {
 			begin, end = e.groupChecker.GetNextGroup()
-			for i := begin; i < end; i++ {
-				groupRows = append(groupRows, e.childResult.GetRow(i))
+			if err := e.consumeGroupRows(begin, end); err != nil {
+				return err
 			}
 			meetLastGroup = end == e.childResult.NumRows()
 		}
 	}
-	return e.consumeGroupRows(groupRows)
+	return nil
 }
 
-func (e *WindowExec) consumeGroupRows(groupRows []chunk.Row) (err error) {
-	remainingRowsInGroup := len(groupRows)
-	if remainingRowsInGroup == 0 {
-		return nil
+// consumeGroupRows consumes the rows in [begin, end) of the child chunk, which
+// belong to the current partition.
+func (e *WindowExec) consumeGroupRows(begin, end int) error {
+	e.groupRows = e.groupRows[:0]
+	for i := begin; i < end; i++ {
+		e.groupRows = append(e.groupRows, e.childResult.GetRow(i))
+	}
+	if err := e.processor.consumeGroupRows(e.Ctx(), e.groupRows); err != nil {
+		return errors.Trace(err)
 	}
-	for i := range e.resultChunks {
-		remained := min(e.remainingRowsInChunk[i], remainingRowsInGroup)
-		e.remainingRowsInChunk[i] -= remained
-		remainingRowsInGroup -= remained
+	return e.partition.appendRows(e.childResult, begin, end)
+}
 
-		// TODO: Combine these three methods.
-		// The old implementation needs the processor has these three methods
-		// but now it does not have to.
-		groupRows, err = e.processor.consumeGroupRows(e.Ctx(), groupRows)
-		if err != nil {
-			return errors.Trace(err)
-		}
-		_, err = e.processor.appendResult2Chunk(e.Ctx(), groupRows, e.resultChunks[i], remained)
-		if err != nil {
-			return errors.Trace(err)
-		}
-		if remainingRowsInGroup == 0 {
-			e.processor.resetPartialResult()
-			break
-		}
+// appendResult2Chunk appends the next remained rows of the current partition and
+// their window function results to chk.
+func (e *WindowExec) appendResult2Chunk(chk *chunk.Chunk, remained uint64) error {
+	for i := e.produced; i < e.produced+remained; i++ {
+		chk.AppendPartialRowByColIdxs(0, e.partition.getRow(i), e.inputColIdxs)
 	}
-	return nil
+	err := e.processor.appendResult2Chunk(e.Ctx(), e.partition, chk, int(remained))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	e.produced += remained
+	return e.partition.err
 }
 
 func (e *WindowExec) fetchChild(ctx context.Context) (eof bool, err error) {
+	// The rows of the child chunk may be referenced by the window functions
+	// like RANK, so the chunk can not be reused.
 	childResult := exec.TryNewCacheChunk(e.Children(0))
 	err = exec.Next(ctx, e.Children(0), childResult)
 	if err != nil {
 		return false, errors.Trace(err)
 	}
 	// No more data.
-	numRows := childResult.NumRows()
-	if numRows == 0 {
+	if childResult.NumRows() == 0 {
 		return true, nil
 	}
-
-	resultChk := e.AllocPool.Alloc(e.RetFieldTypes(), 0, numRows)
-	err = e.copyChk(childResult, resultChk)
-	if err != nil {
-		return false, err
-	}
-	e.resultChunks = append(e.resultChunks, resultChk)
-	e.remainingRowsInChunk = append(e.remainingRowsInChunk, numRows)
-
 	e.childResult = childResult
 	return false, nil
 }
 
-func (e *WindowExec) copyChk(src, dst *chunk.Chunk) error {
-	columns := e.Schema().Columns[:len(e.Schema().Columns)-e.numWindowFuncs]
-	for i, col := range columns {
-		if err := dst.MakeRefTo(i, src, col.Index); err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
 // windowProcessor is the interface for processing different kinds of windows.
 type windowProcessor interface {
 	// consumeGroupRows updates the result for an window function using the input rows
 	// which belong to the same partition.
-	consumeGroupRows(ctx sessionctx.Context, rows []chunk.Row) ([]chunk.Row, error)
-	// appendResult2Chunk appends the final results to chunk.
+	consumeGroupRows(ctx sessionctx.Context, rows []chunk.Row) error
+	// appendResult2Chunk appends the final results of the next remained rows to chunk.
 	// It is called when there are no more rows in current partition.
-	appendResult2Chunk(ctx sessionctx.Context, rows []chunk.Row, chk *chunk.Chunk, remained int) ([]chunk.Row, error)
+	appendResult2Chunk(ctx sessionctx.Context, rows *windowRowBuffer, chk *chunk.Chunk, remained int) error
 	// resetPartialResult resets the partial result to the original state for a specific window function.
 	resetPartialResult()
 }
@@ -202,30 +201,29 @@ type aggWindowProcessor struct {
 	partialResults []aggfuncs.PartialResult
 }
 
-func (p *aggWindowProcessor) consumeGroupRows(ctx sessionctx.Context, rows []chunk.Row) ([]chunk.Row, error) {
+func (p *aggWindowProcessor) consumeGroupRows(ctx sessionctx.Context, rows []chunk.Row) error {
 	for i, windowFunc := range p.windowFuncs {
 		// @todo Add memory trace
 		_, err := windowFunc.UpdatePartialResult(ctx.GetExprCtx().GetEvalCtx(), rows, p.partialResults[i])
 		if err != nil {
-			return nil, err
+			return err
 		}
 	}
-	rows = rows[:0]
-	return rows, nil
+	return nil
 }
 
-func (p *aggWindowProcessor) appendResult2Chunk(ctx sessionctx.Context, rows []chunk.Row, chk *chunk.Chunk, remained int) ([]chunk.Row, error) {
+func (p *aggWindowProcessor) appendResult2Chunk(ctx sessionctx.Context, _ *windowRowBuffer, chk *chunk.Chunk, remained int) error {
 	for remained > 0 {
 		for i, windowFunc := range p.windowFuncs {
 			// TODO: We can extend the agg func interface to avoid the `for` loop  here.
 			err := windowFunc.AppendFinalResult2Chunk(ctx.GetExprCtx().GetEvalCtx(), p.partialResults[i], chk)
 			if err != nil {
-				return nil, err
+				return err
 			}
 		}
 		remained--
 	}
-	return rows, nil
+	return nil
 }
 
 func (p *aggWindowProcessor) resetPartialResult() {
@@ -288,12 +286,12 @@ func (p *rowFrameWindowProcessor) getEndOffset(numRows uint64) uint64 {
 	return 0
 }
 
-func (*rowFrameWindowProcessor) consumeGroupRows(_ sessionctx.Context, rows []chunk.Row) ([]chunk.Row, error) {
-	return rows, nil
+func (*rowFrameWindowProcessor) consumeGroupRows(sessionctx.Context, []chunk.Row) error {
+	return nil
 }
 
-func (p *rowFrameWindowProcessor) appendResult2Chunk(ctx sessionctx.Context, rows []chunk.Row, chk *chunk.Chunk, remained int) ([]chunk.Row, error) {
-	numRows := uint64(len(rows))
+func (p *rowFrameWindowProcessor) appendResult2Chunk(ctx sessionctx.Context, rows *windowRowBuffer, chk *chunk.Chunk, remained int) error {
+	numRows := rows.numRows
 	var (
 		err                      error
 		initializedSlidingWindow bool
@@ -322,15 +320,15 @@ func (p *rowFrameWindowProcessor) appendResult2Chunk(ctx sessionctx.Context, row
 				slidingWindowAggFunc := slidingWindowAggFuncs[i]
 				if slidingWindowAggFunc != nil && initializedSlidingWindow {
 					err = slidingWindowAggFunc.Slide(ctx.GetExprCtx().GetEvalCtx(), func(u uint64) chunk.Row {
-						return rows[u]
+						return rows.getRow(u)
 					}, lastStart, lastEnd, shiftStart, shiftEnd, p.partialResults[i])
 					if err != nil {
-						return nil, err
+						return err
 					}
 				}
 				err = windowFunc.AppendFinalResult2Chunk(ctx.GetExprCtx().GetEvalCtx(), p.partialResults[i], chk)
 				if err != nil {
-					return nil, err
+					return err
 				}
 			}
 			continue
@@ -340,7 +338,7 @@ func (p *rowFrameWindowProcessor) appendResult2Chunk(ctx sessionctx.Context, row
 			slidingWindowAggFunc := slidingWindowAggFuncs[i]
 			if slidingWindowAggFunc != nil && initializedSlidingWindow {
 				err = slidingWindowAggFunc.Slide(ctx.GetExprCtx().GetEvalCtx(), func(u uint64) chunk.Row {
-					return rows[u]
+					return rows.getRow(u)
 				}, lastStart, lastEnd, shiftStart, shiftEnd, p.partialResults[i])
 			} else {
 				// For MinMaxSlidingWindowAggFuncs, it needs the absolute value of each start of window, to compare
@@ -349,14 +347,18 @@ func (p *rowFrameWindowProcessor) appendResult2Chunk(ctx sessionctx.Context, row
 					// Store start inside MaxMinSlidingWindowAggFunc.windowInfo
 					minMaxSlidingWindowAggFunc.SetWindowStart(start)
 				}
-				_, err = windowFunc.UpdatePartialResult(ctx.GetExprCtx().GetEvalCtx(), rows[start:end], p.partialResults[i])
+				var frameRows []chunk.Row
+				frameRows, err = rows.getRows(start, end)
+				if err == nil {
+					_, err = windowFunc.UpdatePartialResult(ctx.GetExprCtx().GetEvalCtx(), frameRows, p.partialResults[i])
+				}
 			}
 			if err != nil {
-				return nil, err
+				return err
 			}
 			err = windowFunc.AppendFinalResult2Chunk(ctx.GetExprCtx().GetEvalCtx(), p.partialResults[i], chk)
 			if err != nil {
-				return nil, err
+				return err
 			}
 			if slidingWindowAggFunc == nil {
 				windowFunc.ResetPartialResult(p.partialResults[i])
@@ -369,7 +371,7 @@ func (p *rowFrameWindowProcessor) appendResult2Chunk(ctx sessionctx.Context, row
 	for i, windowFunc := range p.windowFuncs {
 		windowFunc.ResetPartialResult(p.partialResults[i])
 	}
-	return rows, nil
+	return nil
 }
 
 func (p *rowFrameWindowProcessor) resetPartialResult() {
@@ -389,16 +391,16 @@ type rangeFrameWindowProcessor struct {
 	expectedCmpResult int64
 }
 
-func (p *rangeFrameWindowProcessor) getStartOffset(ctx sessionctx.Context, rows []chunk.Row) (uint64, error) {
+func (p *rangeFrameWindowProcessor) getStartOffset(ctx sessionctx.Context, rows *windowRowBuffer) (uint64, error) {
 	if p.start.UnBounded {
 		return 0, nil
 	}
-	numRows := uint64(len(rows))
+	numRows := rows.numRows
 	for ; p.lastStartOffset < numRows; p.lastStartOffset++ {
 		var res int64
 		var err error
 		for i := range p.orderByCols {
-			res, _, err = p.start.CmpFuncs[i](ctx.GetExprCtx().GetEvalCtx(), p.start.CompareCols[i], p.start.CalcFuncs[i], rows[p.lastStartOffset], rows[p.curRowIdx])
+			res, _, err = p.start.CmpFuncs[i](ctx.GetExprCtx().GetEvalCtx(), p.start.CompareCols[i], p.start.CalcFuncs[i], rows.getRow(p.lastStartOffset), rows.getRow(p.curRowIdx))
 			if err != nil {
 				return 0, err
 			}
@@ -415,8 +417,8 @@ func (p *rangeFrameWindowProcessor) getStartOffset(ctx sessionctx.Context, rows
 	return p.lastStartOffset, nil
 }
 
-func (p *rangeFrameWindowProcessor) getEndOffset(ctx sessionctx.Context, rows []chunk.Row) (uint64, error) {
-	numRows := uint64(len(rows))
+func (p *rangeFrameWindowProcessor) getEndOffset(ctx sessionctx.Context, rows *windowRowBuffer) (uint64, error) {
+	numRows := rows.numRows
 	if p.end.UnBounded {
 		return numRows, nil
 	}
@@ -424,7 +426,7 @@ func (p *rangeFrameWindowProcessor) getEndOffset(ctx sessionctx.Context, rows []
 		var res int64
 		var err error
 		for i := range p.orderByCols {
-			res, _, err = p.end.CmpFuncs[i](ctx.GetExprCtx().GetEvalCtx(), p.end.CalcFuncs[i], p.end.CompareCols[i], rows[p.curRowIdx], rows[p.lastEndOffset])
+			res, _, err = p.end.CmpFuncs[i](ctx.GetExprCtx().GetEvalCtx(), p.end.CalcFuncs[i], p.end.CompareCols[i], rows.getRow(p.curRowIdx), rows.getRow(p.lastEndOffset))
 			if err != nil {
 				return 0, err
 			}
@@ -441,7 +443,7 @@ func (p *rangeFrameWindowProcessor) getEndOffset(ctx sessionctx.Context, rows []
 	return p.lastEndOffset, nil
 }
 
-func (p *rangeFrameWindowProcessor) appendResult2Chunk(ctx sessionctx.Context, rows []chunk.Row, chk *chunk.Chunk, remained int) ([]chunk.Row, error) {
+func (p *rangeFrameWindowProcessor) appendResult2Chunk(ctx sessionctx.Context, rows *windowRowBuffer, chk *chunk.Chunk, remained int) error {
 	var (
 		err                      error
 		initializedSlidingWindow bool
@@ -461,11 +463,11 @@ func (p *rangeFrameWindowProcessor) appendResult2Chunk(ctx sessionctx.Context, r
 	for ; remained > 0; lastStart, lastEnd = start, end {
 		start, err = p.getStartOffset(ctx, rows)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		end, err = p.getEndOffset(ctx, rows)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		p.curRowIdx++
 		remained--
@@ -476,15 +478,15 @@ func (p *rangeFrameWindowProcessor) appendResult2Chunk(ctx sessionctx.Context, r
 				slidingWindowAggFunc := slidingWindowAggFuncs[i]
 				if slidingWindowAggFunc != nil && initializedSlidingWindow {
 					err = slidingWindowAggFunc.Slide(ctx.GetExprCtx().GetEvalCtx(), func(u uint64) chunk.Row {
-						return rows[u]
+						return rows.getRow(u)
 					}, lastStart, lastEnd, shiftStart, shiftEnd, p.partialResults[i])
 					if err != nil {
-						return nil, err
+						return err
 					}
 				}
 				err = windowFunc.AppendFinalResult2Chunk(ctx.GetExprCtx().GetEvalCtx(), p.partialResults[i], chk)
 				if err != nil {
-					return nil, err
+					return err
 				}
 			}
 			continue
@@ -494,20 +496,24 @@ func (p *rangeFrameWindowProcessor) appendResult2Chunk(ctx sessionctx.Context, r
 			slidingWindowAggFunc := slidingWindowAggFuncs[i]
 			if slidingWindowAggFunc != nil && initializedSlidingWindow {
 				err = slidingWindowAggFunc.Slide(ctx.GetExprCtx().GetEvalCtx(), func(u uint64) chunk.Row {
-					return rows[u]
+					return rows.getRow(u)
 				}, lastStart, lastEnd, shiftStart, shiftEnd, p.partialResults[i])
 			} else {
 				if minMaxSlidingWindowAggFunc, ok := windowFunc.(aggfuncs.MaxMinSlidingWindowAggFunc); ok {
 					minMaxSlidingWindowAggFunc.SetWindowStart(start)
 				}
-				_, err = windowFunc.UpdatePartialResult(ctx.GetExprCtx().GetEvalCtx(), rows[start:end], p.partialResults[i])
+				var frameRows []chunk.Row
+				frameRows, err = rows.getRows(start, end)
+				if err == nil {
+					_, err = windowFunc.UpdatePartialResult(ctx.GetExprCtx().GetEvalCtx(), frameRows, p.partialResults[i])
+				}
 			}
 			if err != nil {
-				return nil, err
+				return err
 			}
 			err = windowFunc.AppendFinalResult2Chunk(ctx.GetExprCtx().GetEvalCtx(), p.partialResults[i], chk)
 			if err != nil {
-				return nil, err
+				return err
 			}
 			if slidingWindowAggFunc == nil {
 				windowFunc.ResetPartialResult(p.partialResults[i])
@@ -520,11 +526,11 @@ func (p *rangeFrameWindowProcessor) appendResult2Chunk(ctx sessionctx.Context, r
 	for i, windowFunc := range p.windowFuncs {
 		windowFunc.ResetPartialResult(p.partialResults[i])
 	}
-	return rows, nil
+	return nil
 }
 
-func (*rangeFrameWindowProcessor) consumeGroupRows(_ sessionctx.Context, rows []chunk.Row) ([]chunk.Row, error) {
-	return rows, nil
+func (*rangeFrameWindowProcessor) consumeGroupRows(sessionctx.Context, []chunk.Row) error {
+	return nil
 }
 
 func (p *rangeFrameWindowProcessor) resetPartialResult() {
@@ -532,3 +538,181 @@ func (p *rangeFrameWindowProcessor) resetPartialResult() {
 	p.lastStartOffset = 0
 	p.lastEndOffset = 0
 }
+
+// groupsFrameWindowProcessor processes the GROUPS frames, whose offsets are counted
+// in peer groups, i.e. the groups of rows with the same ORDER BY values.
+type groupsFrameWindowProcessor struct {
+	windowFuncs     []aggfuncs.AggFunc
+	partialResults  []aggfuncs.PartialResult
+	start           *logicalop.FrameBound
+	end             *logicalop.FrameBound
+	curRowIdx       uint64
+	lastStartOffset uint64
+	lastEndOffset   uint64
+	orderByCols     []*expression.Column
+	peerCmpFuncs    []expression.CompareFunc
+	// groupIDs stores the peer group ordinal of each row in the current partition.
+	groupIDs []uint64
+}
+
+func (p *groupsFrameWindowProcessor) buildGroupIDs(ctx sessionctx.Context, rows *windowRowBuffer) error {
+	p.groupIDs = p.groupIDs[:0]
+	var groupID uint64
+	for i := range rows.numRows {
+		if i > 0 {
+			peer, err := isPeerRow(ctx.GetExprCtx().GetEvalCtx(), p.orderByCols, p.peerCmpFuncs, rows.getRow(i-1), rows.getRow(i))
+			if err != nil {
+				return err
+			}
+			if !peer {
+				groupID++
+			}
+		}
+		p.groupIDs = append(p.groupIDs, groupID)
+	}
+	return nil
+}
+
+func (p *groupsFrameWindowProcessor) getStartOffset(numRows uint64) uint64 {
+	if p.start.UnBounded {
+		return 0
+	}
+	cur := p.groupIDs[p.curRowIdx]
+	for p.lastStartOffset < numRows && cmpGroupToBound(p.start, p.groupIDs[p.lastStartOffset], cur) < 0 {
+		p.lastStartOffset++
+	}
+	return p.lastStartOffset
+}
+
+func (p *groupsFrameWindowProcessor) getEndOffset(numRows uint64) uint64 {
+	if p.end.UnBounded {
+		return numRows
+	}
+	cur := p.groupIDs[p.curRowIdx]
+	for p.lastEndOffset < numRows && cmpGroupToBound(p.end, p.groupIDs[p.lastEndOffset], cur) <= 0 {
+		p.lastEndOffset++
+	}
+	return p.lastEndOffset
+}
+
+func (p *groupsFrameWindowProcessor) appendResult2Chunk(ctx sessionctx.Context, rows *windowRowBuffer, chk *chunk.Chunk, remained int) error {
+	numRows := rows.numRows
+	if p.curRowIdx == 0 {
+		if err := p.buildGroupIDs(ctx, rows); err != nil {
+			return err
+		}
+	}
+	var (
+		err                      error
+		initializedSlidingWindow bool
+		start                    uint64
+		end                      uint64
+		lastStart                uint64
+		lastEnd                  uint64
+		shiftStart               uint64
+		shiftEnd                 uint64
+	)
+	slidingWindowAggFuncs := make([]aggfuncs.SlidingWindowAggFunc, len(p.windowFuncs))
+	for i, windowFunc := range p.windowFuncs {
+		if slidingWindowAggFunc, ok := windowFunc.(aggfuncs.SlidingWindowAggFunc); ok {
+			slidingWindowAggFuncs[i] = slidingWindowAggFunc
+		}
+	}
+	// Both offsets only move forward within a partition, so the frame of the
+	// sliding window functions is updated by the rows entering and leaving it.
+	for ; remained > 0; lastStart, lastEnd = start, end {
+		start = p.getStartOffset(numRows)
+		end = p.getEndOffset(numRows)
+		p.curRowIdx++
+		remained--
+		shiftStart = start - lastStart
+		shiftEnd = end - lastEnd
+		if start >= end {
+			for i, windowFunc := range p.windowFuncs {
+				slidingWindowAggFunc := slidingWindowAggFuncs[i]
+				if slidingWindowAggFunc != nil && initializedSlidingWindow {
+					err = slidingWindowAggFunc.Slide(ctx.GetExprCtx().GetEvalCtx(), func(u uint64) chunk.Row {
+						return rows.getRow(u)
+					}, lastStart, lastEnd, shiftStart, shiftEnd, p.partialResults[i])
+					if err != nil {
+						return err
+					}
+				}
+				err = windowFunc.AppendFinalResult2Chunk(ctx.GetExprCtx().GetEvalCtx(), p.partialResults[i], chk)
+				if err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		for i, windowFunc := range p.windowFuncs {
+			slidingWindowAggFunc := slidingWindowAggFuncs[i]
+			if slidingWindowAggFunc != nil && initializedSlidingWindow {
+				err = slidingWindowAggFunc.Slide(ctx.GetExprCtx().GetEvalCtx(), func(u uint64) chunk.Row {
+					return rows.getRow(u)
+				}, lastStart, lastEnd, shiftStart, shiftEnd, p.partialResults[i])
+			} else {
+				if minMaxSlidingWindowAggFunc, ok := windowFunc.(aggfuncs.MaxMinSlidingWindowAggFunc); ok {
+					minMaxSlidingWindowAggFunc.SetWindowStart(start)
+				}
+				var frameRows []chunk.Row
+				frameRows, err = rows.getRows(start, end)
+				if err == nil {
+					_, err = windowFunc.UpdatePartialResult(ctx.GetExprCtx().GetEvalCtx(), frameRows, p.partialResults[i])
+				}
+			}
+			if err != nil {
+				return err
+			}
+			err = windowFunc.AppendFinalResult2Chunk(ctx.GetExprCtx().GetEvalCtx(), p.partialResults[i], chk)
+			if err != nil {
+				return err
+			}
+			if slidingWindowAggFunc == nil {
+				windowFunc.ResetPartialResult(p.partialResults[i])
+			}
+		}
+		if !initializedSlidingWindow {
+			initializedSlidingWindow = true
+		}
+	}
+	for i, windowFunc := range p.windowFuncs {
+		windowFunc.ResetPartialResult(p.partialResults[i])
+	}
+	return nil
+}
+
+func (*groupsFrameWindowProcessor) consumeGroupRows(sessionctx.Context, []chunk.Row) error {
+	return nil
+}
+
+func (p *groupsFrameWindowProcessor) resetPartialResult() {
+	p.curRowIdx = 0
+	p.lastStartOffset = 0
+	p.lastEndOffset = 0
+}
+
+// cmpGroupToBound compares the peer group of a row with the frame bound of the
+// current row, whose peer group is cur.
+func cmpGroupToBound(bound *logicalop.FrameBound, group, cur uint64) int {
+	switch bound.Type {
+	case ast.Preceding:
+		return cmp.Compare(group+bound.Num, cur)
+	case ast.Following:
+		return cmp.Compare(group, cur+bound.Num)
+	default: // ast.CurrentRow
+		return cmp.Compare(group, cur)
+	}
+}
+
+// isPeerRow checks whether the two rows have the same ORDER BY values.
+func isPeerRow(ctx expression.EvalContext, orderByCols []*expression.Column, cmpFuncs []expression.CompareFunc, lhs, rhs chunk.Row) (bool, error) {
+	for i, col := range orderByCols {
+		res, _, err := cmpFuncs[i](ctx, col, col, lhs, rhs)
+		if err != nil || res != 0 {
+			return false, err
+		}
+	}
+	return true, nil
+}
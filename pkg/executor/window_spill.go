@@ -0,0 +1,322 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"sync/atomic"
+
+	"github.com/pingcap/failpoint"
+	"github.com/pingcap/tidb/pkg/expression"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/sessionctx/vardef"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/chunk"
+	"github.com/pingcap/tidb/pkg/util/disk"
+	"github.com/pingcap/tidb/pkg/util/logutil"
+	"github.com/pingcap/tidb/pkg/util/memory"
+	"go.uber.org/zap"
+)
+
+const (
+	// windowSpillCacheSize is the number of spilled chunks kept in memory after they
+	// are read back. The frames only move forward, so a few chunks are enough.
+	windowSpillCacheSize = 4
+
+	windowSpillLogInfo string = "memory exceeds quota, spill the rows of window partition to disk"
+)
+
+// spilledChunk is a chunk read back from the disk.
+type spilledChunk struct {
+	idx int
+	chk *chunk.Chunk
+}
+
+// windowRowBuffer buffers the rows that the window executors are processing.
+// The rows are copied into chunks with a fixed capacity, so a row can be located
+// by its ordinal. When the memory quota is exceeded, the full chunks are moved
+// to the disk and read back on demand.
+//
+// The ordinal counts from the last reset of the buffer. Rows before dropped are no
+// longer needed, and the chunks that only contain such rows are released.
+type windowRowBuffer struct {
+	fieldTypes []*types.FieldType
+	chunkSize  int
+
+	// chunks keeps the chunks in memory, chunks[0] is the memBase-th chunk.
+	chunks  []*chunk.Chunk
+	memBase int
+	// tailChk is the last chunk in memory and tailUsage is the memory consumed for it.
+	tailChk   *chunk.Chunk
+	tailUsage int64
+
+	// inDisk keeps the spilled chunks, the first of them is the diskBase-th chunk.
+	// The spilled rows always precede the rows in memory, which means
+	// diskBase+inDisk.NumChunks() == memBase.
+	inDisk      *chunk.DataInDiskByChunks
+	diskBase    int
+	cache       []spilledChunk
+	cacheCursor int
+
+	numRows uint64
+	dropped uint64
+	rowsBuf []chunk.Row
+	nullRow chunk.Row
+	// err records the error of reading rows from the disk in getRow, since its callers
+	// such as SlidingWindowAggFunc.Slide can not return errors.
+	err error
+
+	needSpill   atomic.Bool
+	spillAction *windowSpillDiskAction
+	memTracker  *memory.Tracker
+	diskTracker *disk.Tracker
+}
+
+// newWindowRowBuffer creates the row buffer for the window executor with the id,
+// and registers the spill action if tmp storage is enabled on OOM.
+func newWindowRowBuffer(sctx sessionctx.Context, id int, fieldTypes []*types.FieldType, chunkSize int) *windowRowBuffer {
+	b := &windowRowBuffer{
+		fieldTypes:  fieldTypes,
+		chunkSize:   chunkSize,
+		memTracker:  memory.NewTracker(id, -1),
+		diskTracker: disk.NewTracker(id, -1),
+	}
+	b.memTracker.AttachTo(sctx.GetSessionVars().StmtCtx.MemTracker)
+	b.diskTracker.AttachTo(sctx.GetSessionVars().StmtCtx.DiskTracker)
+
+	nullChk := chunk.NewChunkWithCapacity(fieldTypes, 1)
+	for i := range fieldTypes {
+		nullChk.AppendNull(i)
+	}
+	b.nullRow = nullChk.GetRow(0)
+
+	if vardef.EnableTmpStorageOnOOM.Load() {
+		b.spillAction = &windowSpillDiskAction{buffer: b}
+		sctx.GetSessionVars().MemTracker.FallbackOldAndSetNewAction(b.spillAction)
+	}
+	return b
+}
+
+// appendRows copies the rows in [begin, end) of chk into the buffer.
+func (b *windowRowBuffer) appendRows(chk *chunk.Chunk, begin, end int) error {
+	for i := begin; i < end; i++ {
+		b.getTailChunk().AppendRow(chk.GetRow(i))
+		b.numRows++
+	}
+	b.trackTail()
+	failpoint.Inject("testWindowRowBufferSpill", func(val failpoint.Value) {
+		if val.(bool) {
+			b.needSpill.Store(true)
+		}
+	})
+	if b.needSpill.Load() {
+		return b.spill()
+	}
+	return nil
+}
+
+func (b *windowRowBuffer) getTailChunk() *chunk.Chunk {
+	if b.tailChk != nil && b.tailChk.NumRows() < b.chunkSize {
+		return b.tailChk
+	}
+	b.trackTail()
+	b.tailChk = chunk.NewChunkWithCapacity(b.fieldTypes, b.chunkSize)
+	b.tailUsage = 0
+	b.chunks = append(b.chunks, b.tailChk)
+	return b.tailChk
+}
+
+// trackTail tracks the memory usage of the last chunk, which grows while appending rows.
+func (b *windowRowBuffer) trackTail() {
+	if b.tailChk == nil {
+		return
+	}
+	usage := b.tailChk.MemoryUsage()
+	b.memTracker.Consume(usage - b.tailUsage)
+	b.tailUsage = usage
+}
+
+func (b *windowRowBuffer) releaseChunk(chk *chunk.Chunk) {
+	b.trackTail()
+	if chk == b.tailChk {
+		b.tailChk, b.tailUsage = nil, 0
+	}
+	b.memTracker.Consume(-chk.MemoryUsage())
+}
+
+// spill moves the full chunks in memory to the disk.
+func (b *windowRowBuffer) spill() error {
+	b.needSpill.Store(false)
+	if b.inDisk == nil {
+		b.inDisk = chunk.NewDataInDiskByChunks(b.fieldTypes)
+		b.inDisk.GetDiskTracker().AttachTo(b.diskTracker)
+		b.diskBase = b.memBase
+	}
+	for len(b.chunks) > 0 && b.chunks[0].NumRows() == b.chunkSize {
+		if err := b.inDisk.Add(b.chunks[0]); err != nil {
+			return err
+		}
+		b.releaseChunk(b.chunks[0])
+		b.chunks[0] = nil
+		b.chunks = b.chunks[1:]
+		b.memBase++
+	}
+	return nil
+}
+
+// getRow gets the row by its ordinal. If the row fails to be read from the disk,
+// the error is recorded in b.err and a row of NULLs is returned.
+func (b *windowRowBuffer) getRow(idx uint64) chunk.Row {
+	chkIdx, rowIdx := int(idx/uint64(b.chunkSize)), int(idx%uint64(b.chunkSize))
+	if chkIdx >= b.memBase {
+		return b.chunks[chkIdx-b.memBase].GetRow(rowIdx)
+	}
+	chk, err := b.getSpilledChunk(chkIdx)
+	if err != nil {
+		if b.err == nil {
+			b.err = err
+		}
+		return b.nullRow
+	}
+	return chk.GetRow(rowIdx)
+}
+
+// getRows gets the rows in [start, end). The returned slice is reused by the next call.
+func (b *windowRowBuffer) getRows(start, end uint64) ([]chunk.Row, error) {
+	b.rowsBuf = b.rowsBuf[:0]
+	for i := start; i < end; i++ {
+		b.rowsBuf = append(b.rowsBuf, b.getRow(i))
+	}
+	return b.rowsBuf, b.err
+}
+
+// getSpilledChunk reads the chunk from the disk. A new chunk is allocated for every
+// read, because the aggregate functions like LEAD may still reference the rows of
+// the chunk that is evicted from the cache.
+func (b *windowRowBuffer) getSpilledChunk(chkIdx int) (*chunk.Chunk, error) {
+	for _, cached := range b.cache {
+		if cached.idx == chkIdx {
+			return cached.chk, nil
+		}
+	}
+	chk, err := b.inDisk.GetChunk(chkIdx - b.diskBase)
+	if err != nil {
+		return nil, err
+	}
+	b.memTracker.Consume(chk.MemoryUsage())
+	if len(b.cache) < windowSpillCacheSize {
+		b.cache = append(b.cache, spilledChunk{idx: chkIdx, chk: chk})
+		return chk, nil
+	}
+	b.memTracker.Consume(-b.cache[b.cacheCursor].chk.MemoryUsage())
+	b.cache[b.cacheCursor] = spilledChunk{idx: chkIdx, chk: chk}
+	b.cacheCursor = (b.cacheCursor + 1) % windowSpillCacheSize
+	return chk, nil
+}
+
+// isEmpty returns whether all the appended rows are dropped.
+func (b *windowRowBuffer) isEmpty() bool {
+	return b.dropped == b.numRows
+}
+
+// drop drops the first n rows that are not dropped yet.
+func (b *windowRowBuffer) drop(n uint64) {
+	b.dropped += n
+	// The chunks before droppedChks only contain dropped rows.
+	droppedChks := int(b.dropped / uint64(b.chunkSize))
+	if b.inDisk != nil {
+		if droppedChks < b.memBase {
+			return
+		}
+		b.closeDisk()
+	}
+	for b.memBase < droppedChks && len(b.chunks) > 0 {
+		b.releaseChunk(b.chunks[0])
+		b.chunks[0] = nil
+		b.chunks = b.chunks[1:]
+		b.memBase++
+	}
+}
+
+func (b *windowRowBuffer) closeDisk() {
+	if b.inDisk == nil {
+		return
+	}
+	for _, cached := range b.cache {
+		b.memTracker.Consume(-cached.chk.MemoryUsage())
+	}
+	b.cache, b.cacheCursor = b.cache[:0], 0
+	b.inDisk.Close()
+	b.inDisk = nil
+}
+
+// reset drops all the rows and restarts the ordinal from 0.
+func (b *windowRowBuffer) reset() {
+	b.closeDisk()
+	for _, chk := range b.chunks {
+		b.releaseChunk(chk)
+	}
+	clear(b.chunks)
+	b.chunks = b.chunks[:0]
+	b.memBase, b.diskBase = 0, 0
+	b.numRows, b.dropped = 0, 0
+	b.err = nil
+}
+
+func (b *windowRowBuffer) close() {
+	b.reset()
+	if b.spillAction != nil {
+		b.spillAction.SetFinished()
+	}
+}
+
+// windowSpillDiskAction implements memory.ActionOnExceed for the window executors.
+// It only marks the buffer, and the rows are spilled by the executor the next time
+// it appends rows to the buffer.
+type windowSpillDiskAction struct {
+	memory.BaseOOMAction
+	buffer *windowRowBuffer
+}
+
+// Action marks the buffer to be spilled.
+func (a *windowSpillDiskAction) Action(t *memory.Tracker) {
+	if a.buffer.needSpill.Load() {
+		return
+	}
+	// Guarantee that the spilled data is not too small, to avoid spilling too frequently.
+	if a.buffer.memTracker.BytesConsumed() >= t.GetBytesLimit()/10 {
+		logutil.BgLogger().Info(windowSpillLogInfo,
+			zap.Int64("consumed", t.BytesConsumed()),
+			zap.Int64("quota", t.GetBytesLimit()))
+		a.buffer.needSpill.Store(true)
+		return
+	}
+	a.TriggerFallBackAction(t)
+}
+
+// GetPriority get the priority of the Action
+func (*windowSpillDiskAction) GetPriority() int64 {
+	return memory.DefSpillPriority
+}
+
+// buildWindowInputColIdxs returns the indices of the child columns that the window
+// executor outputs before the results of the window functions.
+func buildWindowInputColIdxs(schema *expression.Schema, numWindowFuncs int) []int {
+	columns := schema.Columns[:len(schema.Columns)-numWindowFuncs]
+	colIdxs := make([]int, 0, len(columns))
+	for _, col := range columns {
+		colIdxs = append(colIdxs, col.Index)
+	}
+	return colIdxs
+}
@@ -17,10 +17,13 @@ package executor_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/pingcap/tidb/pkg/parser/mysql"
 	"github.com/pingcap/tidb/pkg/testkit"
+	"github.com/pingcap/tidb/pkg/testkit/testfailpoint"
+	"github.com/stretchr/testify/require"
 )
 
 func TestWindowFunctions(t *testing.T) {
@@ -479,3 +482,118 @@ func TestVarSampAsAWindowFunction(t *testing.T) {
 	tk.MustExec("select var_samp(c1) from t1")
 	tk.MustExec("select c1, var_samp(c1) over (partition by c1) from t1")
 }
+
+func TestWindowGroupsFrameAndNullTreatment(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (p int, o int, v int)")
+	tk.MustExec("insert into t values (1, 1, null), (1, 1, 10), (1, 2, 20), (1, 3, null), (1, 3, 30), (1, 5, 50), (2, 1, null), (2, 2, 7)")
+	for _, pipelined := range []int{0, 1} {
+		tk.MustExec(fmt.Sprintf("set @@tidb_enable_pipelined_window_function = %d", pipelined))
+		// GROUPS frames count peer groups instead of rows.
+		tk.MustQuery("select p, o, sum(v) over (partition by p order by o groups between 1 preceding and current row) from t order by p, o, v").Check(testkit.Rows(
+			"1 1 10", "1 1 10", "1 2 30", "1 3 50", "1 3 50", "1 5 80", "2 1 <nil>", "2 2 7"))
+		tk.MustQuery("select p, o, count(*) over (partition by p order by o groups between 1 following and 2 following) from t order by p, o, v").Check(testkit.Rows(
+			"1 1 3", "1 1 3", "1 2 3", "1 3 1", "1 3 1", "1 5 0", "2 1 1", "2 2 0"))
+		tk.MustQuery("select o, count(*) over (order by o desc groups between current row and unbounded following) from t where p = 1 order by o, v").Check(testkit.Rows(
+			"1 2", "1 2", "2 3", "3 5", "3 5", "5 6"))
+		tk.MustQuery("select o, count(*) over (groups current row) from t where p = 2 order by o").Check(testkit.Rows("1 2", "2 2"))
+		// The sliding window functions slide the frame by the peer groups.
+		tk.MustQuery("select p, o, max(v) over (partition by p order by o groups between 1 preceding and 1 following) from t order by p, o, v").Check(testkit.Rows(
+			"1 1 20", "1 1 20", "1 2 30", "1 3 50", "1 3 50", "1 5 50", "2 1 7", "2 2 7"))
+		tk.MustQuery("select p, o, avg(v) over (partition by p order by o groups between 1 following and 2 following) from t order by p, o, v").Check(testkit.Rows(
+			"1 1 25.0000", "1 1 25.0000", "1 2 40.0000", "1 3 50.0000", "1 3 50.0000", "1 5 <nil>", "2 1 7.0000", "2 2 <nil>"))
+
+		// IGNORE NULLS and FROM LAST.
+		tk.MustQuery("select o, v, lead(v) ignore nulls over w, lag(v, 1, -1) ignore nulls over w from t where p = 1 window w as (order by o, v) order by o, v").Check(testkit.Rows(
+			"1 <nil> 10 -1", "1 10 20 -1", "2 20 30 10", "3 <nil> 30 20", "3 30 50 20", "5 50 <nil> 30"))
+		tk.MustQuery("select o, v, lead(v, 2) ignore nulls over w, lag(v, 2) ignore nulls over w from t where p = 1 window w as (order by o, v) order by o, v").Check(testkit.Rows(
+			"1 <nil> 20 <nil>", "1 10 30 <nil>", "2 20 50 <nil>", "3 <nil> 50 10", "3 30 <nil> 10", "5 50 <nil> 20"))
+		tk.MustQuery("select o, v, first_value(v) ignore nulls over w, last_value(v) ignore nulls over w from t where p = 1 " +
+			"window w as (order by o, v rows between 1 preceding and current row) order by o, v").Check(testkit.Rows(
+			"1 <nil> <nil> <nil>", "1 10 10 10", "2 20 10 20", "3 <nil> 20 20", "3 30 30 30", "5 50 30 50"))
+		tk.MustQuery("select p, nth_value(v, 2) from last over w, nth_value(v, 2) from last ignore nulls over w, nth_value(v, 3) ignore nulls over w from t " +
+			"window w as (partition by p order by o, v rows between unbounded preceding and unbounded following) order by p, o, v limit 1").Check(testkit.Rows(
+			"1 30 30 30"))
+		tk.MustQuery("select nth_value(v, 1) from last ignore nulls over (partition by p) from t where p = 2").Check(testkit.Rows("7", "7"))
+
+		// DISTINCT in window aggregates.
+		tk.MustQuery("select p, count(distinct o) over (partition by p), sum(distinct o) over (partition by p) from t order by p, o, v").Check(testkit.Rows(
+			"1 4 11", "1 4 11", "1 4 11", "1 4 11", "1 4 11", "1 4 11", "2 2 3", "2 2 3"))
+		tk.MustQuery("select o, count(distinct o) over (order by o, v rows between 2 preceding and current row) from t where p = 1 order by o, v").Check(testkit.Rows(
+			"1 1", "1 1", "2 2", "3 3", "3 2", "5 2"))
+	}
+	tk.MustQuery("explain format = 'brief' select sum(v) over (order by o groups 1 preceding) from t").CheckContain("groups between 1 preceding and current row")
+	tk.MustGetErrCode("select sum(v) over (order by o groups interval 1 day preceding) from t", mysql.ErrWindowRowsIntervalUse)
+}
+
+func TestWindowGroupConcat(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (p int, o int, v varchar(10))")
+	tk.MustExec("insert into t values (1, 1, 'a'), (1, 2, 'b'), (1, 3, null), (1, 4, 'd'), (2, 1, 'x'), (2, 2, 'y')")
+	for _, pipelined := range []int{0, 1} {
+		tk.MustExec(fmt.Sprintf("set @@tidb_enable_pipelined_window_function = %d", pipelined))
+		tk.MustQuery("select p, o, group_concat(v) over (partition by p order by o) from t order by p, o").Check(testkit.Rows(
+			"1 1 a", "1 2 a,b", "1 3 a,b", "1 4 a,b,d", "2 1 x", "2 2 x,y"))
+		tk.MustQuery("select p, o, group_concat(v separator '-') over (partition by p order by o rows 1 preceding) from t order by p, o").Check(testkit.Rows(
+			"1 1 a", "1 2 a-b", "1 3 b", "1 4 d", "2 1 x", "2 2 x-y"))
+		tk.MustQuery("select p, o, group_concat(v order by o desc) over (partition by p) from t order by p, o").Check(testkit.Rows(
+			"1 1 d,b,a", "1 2 d,b,a", "1 3 d,b,a", "1 4 d,b,a", "2 1 y,x", "2 2 y,x"))
+		tk.MustQuery("select p, o, group_concat(v order by v desc) over (partition by p order by o rows between current row and 1 following) from t order by p, o").Check(testkit.Rows(
+			"1 1 b,a", "1 2 b", "1 3 d", "1 4 d", "2 1 y,x", "2 2 y"))
+		tk.MustQuery("select group_concat(distinct p) over () from t").Check(testkit.Rows("1,2", "1,2", "1,2", "1,2", "1,2", "1,2"))
+
+		// The values removed from the head of the frame are not affected by the truncation.
+		tk.MustExec("set @@group_concat_max_len = 4")
+		tk.MustQuery("select p, o, group_concat(v, v) over (partition by p order by o rows 2 preceding) from t order by p, o").Check(testkit.Rows(
+			"1 1 aa", "1 2 aa,b", "1 3 aa,b", "1 4 bb,d", "2 1 xx", "2 2 xx,y"))
+		tk.MustQuery("show warnings").CheckContain("Some rows were cut by GROUPCONCAT")
+		tk.MustExec("set @@group_concat_max_len = default")
+	}
+	tk.MustQuery("explain format = 'brief' select group_concat(v order by o desc separator ';') over (partition by p) from t").CheckContain("group_concat(test.t.v, ; order by test.t.o true)")
+}
+
+func TestWindowSpill(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("set @@tidb_window_concurrency = 1")
+	tk.MustExec("set @@tidb_max_chunk_size = 32")
+	tk.MustExec("create table t (p int, o int, v int)")
+	values := make([]string, 0, 900)
+	for i := range 900 {
+		values = append(values, fmt.Sprintf("(%d, %d, %d)", i%3, i/3, (i*7)%50))
+	}
+	tk.MustExec("insert into t values " + strings.Join(values, ","))
+
+	queries := []string{
+		"select p, o, sum(v) over (partition by p order by o rows between 40 preceding and 40 following) from t",
+		"select p, o, max(v) over (partition by p order by o rows between 100 preceding and current row) from t",
+		"select p, o, count(v) over (partition by p order by v range between 5 preceding and 5 following) from t",
+		"select p, o, sum(v) over (partition by p order by v groups between 1 preceding and 1 following) from t",
+		"select p, o, avg(v) over (partition by p) from t",
+		"select p, o, rank() over (partition by p order by v), lead(v, 50) over (partition by p order by o) from t",
+		"select p, o, first_value(v) over (partition by p order by o rows between unbounded preceding and unbounded following) from t",
+	}
+	for _, pipelined := range []int{0, 1} {
+		tk.MustExec(fmt.Sprintf("set @@tidb_enable_pipelined_window_function = %d", pipelined))
+		expected := make([][][]any, 0, len(queries))
+		for _, query := range queries {
+			expected = append(expected, tk.MustQuery(query).Sort().Rows())
+		}
+		testfailpoint.Enable(t, "github.com/pingcap/tidb/pkg/executor/testWindowRowBufferSpill", "return(true)")
+		for i, query := range queries {
+			tk.MustQuery(query).Sort().Check(expected[i])
+		}
+		rows := tk.MustQuery("explain analyze " + queries[0]).Rows()
+		for _, row := range rows {
+			if strings.Contains(row[0].(string), "Window") {
+				require.NotEqual(t, "N/A", row[len(row)-1])
+			}
+		}
+		testfailpoint.Disable(t, "github.com/pingcap/tidb/pkg/executor/testWindowRowBufferSpill")
+	}
+}
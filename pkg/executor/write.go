@@ -89,6 +89,7 @@ func updateRecord(
 	_ *memory.Tracker,
 	fkChecks []*FKCheckExec,
 	fkCascades []*FKCascadeExec,
+	triggers *tableTriggers,
 	dupKeyMode table.DupKeyCheckMode,
 	ignoreErr bool,
 ) (changed bool, ignored bool, retErr error) {
@@ -162,6 +163,19 @@ func updateRecord(
 	// Step 5: handle foreign key errors, bad null errors and exchange partition errors.
 	// After these are done, we can finally update the record.
 
+	// The BEFORE UPDATE triggers can change the non-generated columns, so they are fired before
+	// checking whether the row is changed.
+	if err := triggers.fire(ctx, sctx, ast.TriggerBefore, ast.TriggerUpdate, oldData, newData); err != nil {
+		return false, false, err
+	}
+	if triggers != nil && chunk.Row(evalBuffer).Chunk() != nil {
+		for i, col := range cols {
+			if !col.IsGenerated() {
+				evalBuffer.SetDatum(i+offset, newData[i])
+			}
+		}
+	}
+
 	// Step 2: compare already evaluated columns and update changed, handleChanged and handleChanged flags.
 	for i := range cols {
 		if err := checkColumnFunc(i, true); err != nil {
@@ -180,8 +194,11 @@ func updateRecord(
 		if sessVars.LockUnchangedKeys {
 			keySet |= lockUniqueKeys
 		}
-		_, err := addUnchangedKeysForLockByRow(sctx, t, h, oldData, keySet)
-		return false, false, err
+		if _, err := addUnchangedKeysForLockByRow(sctx, t, h, oldData, keySet); err != nil {
+			return false, false, err
+		}
+		// Like MySQL, the AFTER UPDATE triggers are fired for the matched rows even if they are unchanged.
+		return false, false, triggers.fire(ctx, sctx, ast.TriggerAfter, ast.TriggerUpdate, oldData, newData)
 	}
 
 	// Step 3: fill values into on-update-now fields.
@@ -336,6 +353,9 @@ func updateRecord(
 			return false, false, err
 		}
 	}
+	if err := triggers.fire(ctx, sctx, ast.TriggerAfter, ast.TriggerUpdate, oldData, newData); err != nil {
+		return false, false, err
+	}
 	if onDup {
 		sc.AddAffectedRows(2)
 	} else {
@@ -239,6 +239,10 @@ func CheckAggPushDown(ctx expression.EvalContext, aggFunc *AggFuncDesc, storeTyp
 	if aggFunc.Name == ast.AggFuncApproxPercentile {
 		return false
 	}
+	// The aggregate functions registered by extensions can only be executed in TiDB.
+	if IsExtensionAggFunc(aggFunc.Name) {
+		return false
+	}
 	if !checkVectorAggPushDown(ctx, aggFunc) {
 		return false
 	}
@@ -160,6 +160,9 @@ func (a *baseFuncDesc) TypeInfer(ctx expression.BuildContext) error {
 	case ast.AggFuncJsonObjectAgg:
 		return a.typeInfer4JsonObjectAgg(ctx)
 	default:
+		if def, ok := GetExtensionAggFunc(a.Name); ok {
+			return a.typeInfer4Extension(ctx, def)
+		}
 		return errors.Errorf("unsupported agg function: %s", a.Name)
 	}
 	return nil
@@ -456,6 +459,10 @@ func (a *baseFuncDesc) WrapCastForAggArgs(ctx expression.BuildContext) {
 	if _, ok := noNeedCastAggFuncs[a.Name]; ok {
 		return
 	}
+	if def, ok := GetExtensionAggFunc(a.Name); ok {
+		a.wrapCastForExtensionArgs(ctx, def)
+		return
+	}
 	var castFunc func(ctx expression.BuildContext, expr expression.Expression) expression.Expression
 	switch retTp := a.RetTp; retTp.EvalType() {
 	case types.ETInt:
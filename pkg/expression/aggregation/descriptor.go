@@ -40,6 +40,9 @@ type AggFuncDesc struct {
 	OrderByItems []*util.ByItems
 	// GroupingID is used for distinguishing with not-set 0, starting from 1.
 	GroupingID int
+	// IgnoreNull and FromLast are only used by window functions, see WindowFuncDesc.
+	IgnoreNull bool
+	FromLast   bool
 }
 
 // NewAggFuncDesc creates an aggregation function signature descriptor.
@@ -57,7 +60,13 @@ func NewAggFuncDescForWindowFunc(ctx expression.BuildContext, desc *WindowFuncDe
 	if desc.RetTp == nil { // safety check
 		return NewAggFuncDesc(ctx, desc.Name, desc.Args, hasDistinct)
 	}
-	return &AggFuncDesc{baseFuncDesc: baseFuncDesc{desc.Name, desc.Args, desc.RetTp}, HasDistinct: hasDistinct}, nil
+	return &AggFuncDesc{
+		baseFuncDesc: baseFuncDesc{desc.Name, desc.Args, desc.RetTp},
+		HasDistinct:  hasDistinct,
+		OrderByItems: desc.OrderByItems,
+		IgnoreNull:   desc.IgnoreNull,
+		FromLast:     desc.FromLast,
+	}, nil
 }
 
 // Hash64 returns the hash64 for the aggregation function signature.
@@ -359,6 +368,10 @@ func (a *AggFuncDesc) UpdateNotNullFlag4RetType(hasGroupBy, allAggsFirstRow bool
 			removeNotNull = true
 		}
 	default:
+		// The return type of the aggregate functions registered by extensions is always nullable.
+		if IsExtensionAggFunc(a.Name) {
+			break
+		}
 		return errors.Errorf("unsupported agg function: %s", a.Name)
 	}
 	if removeNotNull {
@@ -0,0 +1,159 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregation
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/expression"
+	"github.com/pingcap/tidb/pkg/extension"
+	"github.com/pingcap/tidb/pkg/parser/charset"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/types"
+)
+
+var extensionAggFuncs sync.Map
+
+func registerExtensionAggFunc(def *extension.AggregateFunctionDef) error {
+	if def == nil {
+		return errors.New("extension aggregate function def is nil")
+	}
+
+	if err := def.Validate(); err != nil {
+		return err
+	}
+
+	switch def.EvalTp {
+	case types.ETInt, types.ETReal, types.ETDecimal, types.ETString, types.ETDatetime, types.ETTimestamp,
+		types.ETDuration, types.ETJson:
+	default:
+		return errors.Errorf("unsupported extension aggregate function ret type: '%v'", def.EvalTp)
+	}
+
+	lowerName := strings.ToLower(def.Name)
+	if expression.IsFunctionSupported(lowerName) || expression.IsExtensionFunc(lowerName) {
+		return errors.Errorf("extension aggregate function name '%s' conflict with scalar function", def.Name)
+	}
+
+	_, exist := extensionAggFuncs.LoadOrStore(lowerName, def)
+	if exist {
+		return errors.Errorf("duplicated extension aggregate function name '%s'", def.Name)
+	}
+
+	return nil
+}
+
+func removeExtensionAggFunc(name string) {
+	extensionAggFuncs.Delete(strings.ToLower(name))
+}
+
+// GetExtensionAggFunc returns the definition of the aggregate function registered by extensions.
+func GetExtensionAggFunc(name string) (*extension.AggregateFunctionDef, bool) {
+	def, ok := extensionAggFuncs.Load(strings.ToLower(name))
+	if !ok {
+		return nil, false
+	}
+	return def.(*extension.AggregateFunctionDef), true
+}
+
+// IsExtensionAggFunc checks whether the function is an aggregate function registered by extensions.
+func IsExtensionAggFunc(name string) bool {
+	_, ok := GetExtensionAggFunc(name)
+	return ok
+}
+
+func (a *baseFuncDesc) typeInfer4Extension(ctx expression.BuildContext, def *extension.AggregateFunctionDef) error {
+	if len(a.Args) != len(def.ArgTps) {
+		return expression.ErrIncorrectParameterCount.GenWithStackByArgs(a.Name)
+	}
+
+	switch def.EvalTp {
+	case types.ETInt:
+		a.RetTp = types.NewFieldType(mysql.TypeLonglong)
+		a.RetTp.SetFlen(mysql.MaxIntWidth)
+	case types.ETReal:
+		a.RetTp = types.NewFieldType(mysql.TypeDouble)
+		a.RetTp.SetFlen(mysql.MaxRealWidth)
+		a.RetTp.SetDecimal(types.UnspecifiedLength)
+	case types.ETDecimal:
+		a.RetTp = types.NewFieldType(mysql.TypeNewDecimal)
+		a.RetTp.SetFlen(mysql.MaxDecimalWidth)
+		a.RetTp.SetDecimal(mysql.MaxDecimalScale)
+	case types.ETString:
+		a.RetTp = types.NewFieldType(mysql.TypeVarString)
+		a.RetTp.SetFlen(mysql.MaxFieldVarCharLength)
+		chs, coll := ctx.GetCharsetInfo()
+		a.RetTp.SetCharset(chs)
+		a.RetTp.SetCollate(coll)
+		return nil
+	case types.ETDatetime:
+		a.RetTp = types.NewFieldType(mysql.TypeDatetime)
+		a.RetTp.SetFlen(mysql.MaxDatetimeWidthWithFsp)
+		a.RetTp.SetDecimal(types.MaxFsp)
+	case types.ETTimestamp:
+		a.RetTp = types.NewFieldType(mysql.TypeTimestamp)
+		a.RetTp.SetFlen(mysql.MaxDatetimeWidthWithFsp)
+		a.RetTp.SetDecimal(types.MaxFsp)
+	case types.ETDuration:
+		a.RetTp = types.NewFieldType(mysql.TypeDuration)
+		a.RetTp.SetFlen(mysql.MaxDurationWidthWithFsp)
+		a.RetTp.SetDecimal(types.MaxFsp)
+	case types.ETJson:
+		a.RetTp = types.NewFieldType(mysql.TypeJSON)
+		a.RetTp.SetFlen(mysql.MaxBlobWidth)
+		a.RetTp.SetCharset(mysql.DefaultCharset)
+		a.RetTp.SetCollate(mysql.DefaultCollationName)
+		return nil
+	default:
+		return errors.Errorf("unsupported extension aggregate function ret type: '%v'", def.EvalTp)
+	}
+	a.RetTp.AddFlag(mysql.BinaryFlag)
+	a.RetTp.SetCharset(charset.CharsetBin)
+	a.RetTp.SetCollate(charset.CollationBin)
+	return nil
+}
+
+// wrapCastForExtensionArgs converts the arguments to the types declared by the extension.
+func (a *baseFuncDesc) wrapCastForExtensionArgs(ctx expression.BuildContext, def *extension.AggregateFunctionDef) {
+	for i := range a.Args {
+		switch def.ArgTps[i] {
+		case types.ETInt:
+			a.Args[i] = expression.WrapWithCastAsInt(ctx, a.Args[i], nil)
+		case types.ETReal:
+			a.Args[i] = expression.WrapWithCastAsReal(ctx, a.Args[i])
+		case types.ETDecimal:
+			a.Args[i] = expression.WrapWithCastAsDecimal(ctx, a.Args[i])
+		case types.ETString:
+			a.Args[i] = expression.WrapWithCastAsString(ctx, a.Args[i])
+		case types.ETDatetime:
+			a.Args[i] = expression.WrapWithCastAsTime(ctx, a.Args[i], types.NewFieldType(mysql.TypeDatetime))
+		case types.ETTimestamp:
+			a.Args[i] = expression.WrapWithCastAsTime(ctx, a.Args[i], types.NewFieldType(mysql.TypeTimestamp))
+		case types.ETDuration:
+			a.Args[i] = expression.WrapWithCastAsDuration(ctx, a.Args[i])
+		case types.ETJson:
+			a.Args[i] = expression.WrapWithCastAsJSON(ctx, a.Args[i])
+		case types.ETVectorFloat32:
+			a.Args[i] = expression.WrapWithCastAsVectorFloat32(ctx, a.Args[i])
+		}
+	}
+}
+
+func init() {
+	extension.RegisterExtensionAggFunc = registerExtensionAggFunc
+	extension.RemoveExtensionAggFunc = removeExtensionAggFunc
+}
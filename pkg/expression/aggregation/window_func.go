@@ -15,18 +15,29 @@
 package aggregation
 
 import (
+	"bytes"
 	"strings"
 
 	"github.com/pingcap/tidb/pkg/expression"
 	"github.com/pingcap/tidb/pkg/kv"
 	"github.com/pingcap/tidb/pkg/parser/ast"
 	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/planner/cascades/base"
+	"github.com/pingcap/tidb/pkg/planner/util"
 	"github.com/pingcap/tipb/go-tipb"
 )
 
 // WindowFuncDesc describes a window function signature, only used in planner.
 type WindowFuncDesc struct {
 	baseFuncDesc
+	// HasDistinct is true for aggregate window functions like `count(DISTINCT a)`.
+	HasDistinct bool
+	// IgnoreNull is true for `IGNORE NULLS` of lead, lag, first_value, last_value and nth_value.
+	IgnoreNull bool
+	// FromLast is true for `FROM LAST` of nth_value.
+	FromLast bool
+	// OrderByItems represents the order by clause used in GROUP_CONCAT.
+	OrderByItems []*util.ByItems
 }
 
 // NewWindowFuncDesc creates a window function signature descriptor.
@@ -79,7 +90,7 @@ func NewWindowFuncDesc(ctx expression.BuildContext, name string, args []expressi
 	if err != nil {
 		return nil, err
 	}
-	return &WindowFuncDesc{base}, nil
+	return &WindowFuncDesc{baseFuncDesc: base}, nil
 }
 
 // noFrameWindowFuncs is the functions that operate on the entire partition,
@@ -119,7 +130,90 @@ func NeedFrame(name string) bool {
 
 // Clone makes a copy of SortItem.
 func (s *WindowFuncDesc) Clone() *WindowFuncDesc {
-	return &WindowFuncDesc{*s.baseFuncDesc.clone()}
+	clone := &WindowFuncDesc{
+		baseFuncDesc: *s.baseFuncDesc.clone(),
+		HasDistinct:  s.HasDistinct,
+		IgnoreNull:   s.IgnoreNull,
+		FromLast:     s.FromLast,
+	}
+	if len(s.OrderByItems) > 0 {
+		clone.OrderByItems = make([]*util.ByItems, len(s.OrderByItems))
+		for i, byItem := range s.OrderByItems {
+			clone.OrderByItems[i] = byItem.Clone()
+		}
+	}
+	return clone
+}
+
+// Hash64 implements the base.Hasher interface.
+func (s *WindowFuncDesc) Hash64(h base.Hasher) {
+	s.baseFuncDesc.Hash64(h)
+	h.HashBool(s.HasDistinct)
+	h.HashBool(s.IgnoreNull)
+	h.HashBool(s.FromLast)
+	h.HashInt(len(s.OrderByItems))
+	for _, item := range s.OrderByItems {
+		item.Hash64(h)
+	}
+}
+
+// Equals implements the base.Equals interface.
+func (s *WindowFuncDesc) Equals(other any) bool {
+	s2, ok := other.(*WindowFuncDesc)
+	if !ok {
+		return false
+	}
+	if s == nil {
+		return s2 == nil
+	}
+	if s2 == nil {
+		return false
+	}
+	if s.HasDistinct != s2.HasDistinct || s.IgnoreNull != s2.IgnoreNull || s.FromLast != s2.FromLast ||
+		len(s.OrderByItems) != len(s2.OrderByItems) {
+		return false
+	}
+	for i := range s.OrderByItems {
+		if !s.OrderByItems[i].Equals(s2.OrderByItems[i]) {
+			return false
+		}
+	}
+	return s.baseFuncDesc.Equals(&s2.baseFuncDesc)
+}
+
+// StringWithCtx returns the string within given context.
+func (s *WindowFuncDesc) StringWithCtx(ctx expression.ParamValues, redact string) string {
+	if !s.HasDistinct && !s.IgnoreNull && !s.FromLast && len(s.OrderByItems) == 0 {
+		return s.baseFuncDesc.StringWithCtx(ctx, redact)
+	}
+	buffer := bytes.NewBufferString(s.Name)
+	buffer.WriteString("(")
+	if s.HasDistinct {
+		buffer.WriteString("distinct ")
+	}
+	for i, arg := range s.Args {
+		buffer.WriteString(arg.StringWithCtx(ctx, redact))
+		if i+1 != len(s.Args) {
+			buffer.WriteString(", ")
+		}
+	}
+	if len(s.OrderByItems) > 0 {
+		buffer.WriteString(" order by ")
+	}
+	for i, item := range s.OrderByItems {
+		buffer.WriteString(item.StringWithCtx(ctx, redact))
+		if i+1 != len(s.OrderByItems) {
+			buffer.WriteString(", ")
+		}
+	}
+	buffer.WriteString(")")
+	if s.FromLast {
+		buffer.WriteString(" from last")
+	}
+	if s.IgnoreNull {
+		buffer.WriteString(" ignore nulls")
+	}
+	return buffer.String()
 }
 
 // WindowFuncToPBExpr converts aggregate function to pb.
@@ -147,6 +241,9 @@ func (s *WindowFuncDesc) CanPushDownToTiFlash(ctx expression.PushDownContext) bo
 	if !expression.CanExprsPushDown(ctx, s.Args, kv.TiFlash) {
 		return false
 	}
+	if s.HasDistinct || s.IgnoreNull || s.FromLast {
+		return false
+	}
 	// window functions
 	switch s.Name {
 	case ast.WindowFuncRowNumber, ast.WindowFuncRank, ast.WindowFuncDenseRank, ast.WindowFuncLead, ast.WindowFuncLag,
@@ -978,6 +978,40 @@ var funcs = map[string]functionClass{
 	ast.VecFromText:             &vecFromTextFunctionClass{baseFunctionClass{ast.VecFromText, 1, 1}},
 	ast.VecAsText:               &vecAsTextFunctionClass{baseFunctionClass{ast.VecAsText, 1, 1}},
 
+	// spatial functions
+	ast.Point:              &pointFunctionClass{baseFunctionClass{ast.Point, 2, 2}},
+	ast.LineString:         &geometryConstructorFunctionClass{baseFunctionClass{ast.LineString, 1, -1}, mysql.GeometryTypeLineString},
+	ast.Polygon:            &geometryConstructorFunctionClass{baseFunctionClass{ast.Polygon, 1, -1}, mysql.GeometryTypePolygon},
+	ast.MultiPoint:         &geometryConstructorFunctionClass{baseFunctionClass{ast.MultiPoint, 1, -1}, mysql.GeometryTypeMultiPoint},
+	ast.MultiLineString:    &geometryConstructorFunctionClass{baseFunctionClass{ast.MultiLineString, 1, -1}, mysql.GeometryTypeMultiLineString},
+	ast.MultiPolygon:       &geometryConstructorFunctionClass{baseFunctionClass{ast.MultiPolygon, 1, -1}, mysql.GeometryTypeMultiPolygon},
+	ast.GeomCollection:     &geometryConstructorFunctionClass{baseFunctionClass{ast.GeomCollection, 0, -1}, mysql.GeometryTypeGeometryCollection},
+	ast.GeometryCollection: &geometryConstructorFunctionClass{baseFunctionClass{ast.GeometryCollection, 0, -1}, mysql.GeometryTypeGeometryCollection},
+	ast.STGeomFromText:     &stGeomFromTextFunctionClass{baseFunctionClass{ast.STGeomFromText, 1, 2}},
+	ast.STGeometryFromText: &stGeomFromTextFunctionClass{baseFunctionClass{ast.STGeometryFromText, 1, 2}},
+	ast.STGeomFromWKB:      &stGeomFromWKBFunctionClass{baseFunctionClass{ast.STGeomFromWKB, 1, 2}},
+	ast.STGeometryFromWKB:  &stGeomFromWKBFunctionClass{baseFunctionClass{ast.STGeometryFromWKB, 1, 2}},
+	ast.STGeomFromGeoJSON:  &stGeomFromGeoJSONFunctionClass{baseFunctionClass{ast.STGeomFromGeoJSON, 1, 3}},
+	ast.STAsText:           &stAsTextFunctionClass{baseFunctionClass{ast.STAsText, 1, 1}},
+	ast.STAsWKT:            &stAsTextFunctionClass{baseFunctionClass{ast.STAsWKT, 1, 1}},
+	ast.STAsBinary:         &stAsBinaryFunctionClass{baseFunctionClass{ast.STAsBinary, 1, 1}},
+	ast.STAsWKB:            &stAsBinaryFunctionClass{baseFunctionClass{ast.STAsWKB, 1, 1}},
+	ast.STAsGeoJSON:        &stAsGeoJSONFunctionClass{baseFunctionClass{ast.STAsGeoJSON, 1, 3}},
+	ast.STSRID:             &stSRIDFunctionClass{baseFunctionClass{ast.STSRID, 1, 1}},
+	ast.STGeometryType:     &stGeometryTypeFunctionClass{baseFunctionClass{ast.STGeometryType, 1, 1}},
+	ast.STIsEmpty:          &stIsEmptyFunctionClass{baseFunctionClass{ast.STIsEmpty, 1, 1}},
+	ast.STX:                &stCoordinateFunctionClass{baseFunctionClass{ast.STX, 1, 1}},
+	ast.STY:                &stCoordinateFunctionClass{baseFunctionClass{ast.STY, 1, 1}},
+	ast.STContains:         &stRelationFunctionClass{baseFunctionClass{ast.STContains, 2, 2}},
+	ast.STWithin:           &stRelationFunctionClass{baseFunctionClass{ast.STWithin, 2, 2}},
+	ast.STIntersects:       &stRelationFunctionClass{baseFunctionClass{ast.STIntersects, 2, 2}},
+	ast.STDisjoint:         &stRelationFunctionClass{baseFunctionClass{ast.STDisjoint, 2, 2}},
+	ast.STEquals:           &stRelationFunctionClass{baseFunctionClass{ast.STEquals, 2, 2}},
+	ast.STDistance:         &stDistanceFunctionClass{baseFunctionClass{ast.STDistance, 2, 2}},
+	ast.STDistanceSphere:   &stDistanceSphereFunctionClass{baseFunctionClass{ast.STDistanceSphere, 2, 3}},
+	ast.STArea:             &stMeasureFunctionClass{baseFunctionClass{ast.STArea, 1, 1}},
+	ast.STLength:           &stMeasureFunctionClass{baseFunctionClass{ast.STLength, 1, 1}},
+
 	// fts functions
 	ast.FTSMatchWord: &ftsMatchWordFunctionClass{baseFunctionClass{ast.FTSMatchWord, 2, 2}},
 
@@ -0,0 +1,925 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"math"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/chunk"
+)
+
+var (
+	_ functionClass = &pointFunctionClass{}
+	_ functionClass = &geometryConstructorFunctionClass{}
+	_ functionClass = &stGeomFromTextFunctionClass{}
+	_ functionClass = &stGeomFromWKBFunctionClass{}
+	_ functionClass = &stGeomFromGeoJSONFunctionClass{}
+	_ functionClass = &stAsTextFunctionClass{}
+	_ functionClass = &stAsBinaryFunctionClass{}
+	_ functionClass = &stAsGeoJSONFunctionClass{}
+	_ functionClass = &stSRIDFunctionClass{}
+	_ functionClass = &stGeometryTypeFunctionClass{}
+	_ functionClass = &stIsEmptyFunctionClass{}
+	_ functionClass = &stCoordinateFunctionClass{}
+	_ functionClass = &stRelationFunctionClass{}
+	_ functionClass = &stDistanceFunctionClass{}
+	_ functionClass = &stDistanceSphereFunctionClass{}
+	_ functionClass = &stMeasureFunctionClass{}
+)
+
+var (
+	_ builtinFunc = &builtinPointSig{}
+	_ builtinFunc = &builtinGeometryConstructorSig{}
+	_ builtinFunc = &builtinSTGeomFromTextSig{}
+	_ builtinFunc = &builtinSTGeomFromWKBSig{}
+	_ builtinFunc = &builtinSTGeomFromGeoJSONSig{}
+	_ builtinFunc = &builtinSTAsTextSig{}
+	_ builtinFunc = &builtinSTAsBinarySig{}
+	_ builtinFunc = &builtinSTAsGeoJSONSig{}
+	_ builtinFunc = &builtinSTSRIDSig{}
+	_ builtinFunc = &builtinSTGeometryTypeSig{}
+	_ builtinFunc = &builtinSTIsEmptySig{}
+	_ builtinFunc = &builtinSTCoordinateSig{}
+	_ builtinFunc = &builtinSTRelationSig{}
+	_ builtinFunc = &builtinSTDistanceSig{}
+	_ builtinFunc = &builtinSTDistanceSphereSig{}
+	_ builtinFunc = &builtinSTMeasureSig{}
+)
+
+// defaultSphereRadius is the default radius of ST_Distance_Sphere in meters, which is
+// the same as MySQL.
+const defaultSphereRadius = 6370986
+
+// setGeometryRetType sets the return type of the function to the geometry of tp.
+func setGeometryRetType(bf *baseBuiltinFunc, tp byte) {
+	bf.tp.SetType(mysql.TypeGeometry)
+	bf.tp.SetGeometryType(tp)
+	bf.tp.SetFlen(mysql.MaxLongBlobWidth)
+	bf.tp.SetDecimal(0)
+	types.SetBinChsClnFlag(bf.tp)
+}
+
+// evalGeometry evaluates the geometry argument of the function.
+func evalGeometry(ctx EvalContext, row chunk.Row, arg Expression, funcName string) (g types.Geometry, isNull bool, err error) {
+	s, isNull, err := arg.EvalString(ctx, row)
+	if isNull || err != nil {
+		return g, isNull, err
+	}
+	g, err = types.DecodeGeometry([]byte(s))
+	if err != nil {
+		return g, false, types.ErrGISInvalidData.GenWithStackByArgs(funcName)
+	}
+	return g, false, nil
+}
+
+// evalGeometryPair evaluates the geometry arguments of the binary function, which must
+// be in the same spatial reference system.
+func evalGeometryPair(ctx EvalContext, row chunk.Row, args []Expression, funcName string) (g1, g2 types.Geometry, isNull bool, err error) {
+	g1, isNull, err = evalGeometry(ctx, row, args[0], funcName)
+	if isNull || err != nil {
+		return g1, g2, isNull, err
+	}
+	g2, isNull, err = evalGeometry(ctx, row, args[1], funcName)
+	if isNull || err != nil {
+		return g1, g2, isNull, err
+	}
+	if g1.SRID != g2.SRID {
+		return g1, g2, false, types.ErrGISDifferentSRIDs.GenWithStackByArgs(funcName, g1.SRID, g2.SRID)
+	}
+	return g1, g2, false, nil
+}
+
+// evalSRID evaluates the SRID argument of the function.
+func evalSRID(ctx EvalContext, row chunk.Row, arg Expression, funcName string) (srid uint32, isNull bool, err error) {
+	v, isNull, err := arg.EvalInt(ctx, row)
+	if isNull || err != nil {
+		return 0, isNull, err
+	}
+	if v < 0 || v > math.MaxUint32 {
+		return 0, false, types.ErrOverflow.GenWithStackByArgs("SRID", funcName)
+	}
+	srid = uint32(v)
+	return srid, false, types.CheckSRID(srid)
+}
+
+// notImplementedForGeographic returns the error for the functions which are only
+// implemented for the Cartesian spatial reference system.
+func notImplementedForGeographic(funcName string, geoms ...*types.Geometry) error {
+	names := make([]string, 0, len(geoms))
+	for _, g := range geoms {
+		names = append(names, g.TypeName())
+	}
+	return types.ErrNotImplementedForGeographicSRS.GenWithStackByArgs(funcName, strings.Join(names, ", "))
+}
+
+type pointFunctionClass struct {
+	baseFunctionClass
+}
+
+type builtinPointSig struct {
+	baseBuiltinFunc
+
+	funcName string
+}
+
+func (b *builtinPointSig) Clone() builtinFunc {
+	newSig := &builtinPointSig{funcName: b.funcName}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+func (c *pointFunctionClass) getFunction(ctx BuildContext, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETString, types.ETReal, types.ETReal)
+	if err != nil {
+		return nil, err
+	}
+	setGeometryRetType(&bf, mysql.GeometryTypePoint)
+	sig := &builtinPointSig{bf, c.funcName}
+	return sig, nil
+}
+
+func (b *builtinPointSig) evalString(ctx EvalContext, row chunk.Row) (string, bool, error) {
+	x, isNull, err := b.args[0].EvalReal(ctx, row)
+	if isNull || err != nil {
+		return "", isNull, err
+	}
+	y, isNull, err := b.args[1].EvalReal(ctx, row)
+	if isNull || err != nil {
+		return "", isNull, err
+	}
+	g := types.NewPointGeometry(types.SRIDCartesian, x, y)
+	return string(g.Encode()), false, nil
+}
+
+// geometryConstructorFunctionClass is the function class of LineString, Polygon, MultiPoint,
+// MultiLineString, MultiPolygon and GeomCollection, which construct the geometry of tp from
+// the geometry arguments.
+type geometryConstructorFunctionClass struct {
+	baseFunctionClass
+
+	tp byte
+}
+
+type builtinGeometryConstructorSig struct {
+	baseBuiltinFunc
+
+	funcName string
+	tp       byte
+}
+
+func (b *builtinGeometryConstructorSig) Clone() builtinFunc {
+	newSig := &builtinGeometryConstructorSig{funcName: b.funcName, tp: b.tp}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+func (c *geometryConstructorFunctionClass) getFunction(ctx BuildContext, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	argTps := make([]types.EvalType, 0, len(args))
+	for range args {
+		argTps = append(argTps, types.ETString)
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETString, argTps...)
+	if err != nil {
+		return nil, err
+	}
+	setGeometryRetType(&bf, c.tp)
+	sig := &builtinGeometryConstructorSig{bf, c.funcName, c.tp}
+	return sig, nil
+}
+
+// elemType returns the type of the arguments, it returns GeometryTypeGeometry if the
+// arguments can be of any type.
+func (b *builtinGeometryConstructorSig) elemType() byte {
+	switch b.tp {
+	case mysql.GeometryTypeLineString, mysql.GeometryTypeMultiPoint:
+		return mysql.GeometryTypePoint
+	case mysql.GeometryTypePolygon, mysql.GeometryTypeMultiLineString:
+		return mysql.GeometryTypeLineString
+	case mysql.GeometryTypeMultiPolygon:
+		return mysql.GeometryTypePolygon
+	}
+	return mysql.GeometryTypeGeometry
+}
+
+func (b *builtinGeometryConstructorSig) evalString(ctx EvalContext, row chunk.Row) (string, bool, error) {
+	elemTp := b.elemType()
+	res := types.Geometry{Tp: b.tp}
+	for i, arg := range b.args {
+		g, isNull, err := evalGeometry(ctx, row, arg, b.funcName)
+		if isNull || err != nil {
+			return "", isNull, err
+		}
+		if elemTp != mysql.GeometryTypeGeometry && g.Tp != elemTp {
+			return "", false, types.ErrGISUnsupportedArgument.GenWithStackByArgs(b.funcName)
+		}
+		if i == 0 {
+			res.SRID = g.SRID
+		} else if g.SRID != res.SRID {
+			return "", false, types.ErrGISDifferentSRIDs.GenWithStackByArgs(b.funcName, res.SRID, g.SRID)
+		}
+		switch b.tp {
+		case mysql.GeometryTypeLineString:
+			res.Points = append(res.Points, g.Points...)
+		case mysql.GeometryTypePolygon:
+			res.Rings = append(res.Rings, g.Points)
+		default:
+			res.Geoms = append(res.Geoms, g)
+		}
+	}
+	if !res.Valid() {
+		return "", false, types.ErrGISInvalidData.GenWithStackByArgs(b.funcName)
+	}
+	return string(res.Encode()), false, nil
+}
+
+type stGeomFromTextFunctionClass struct {
+	baseFunctionClass
+}
+
+type builtinSTGeomFromTextSig struct {
+	baseBuiltinFunc
+
+	funcName string
+}
+
+func (b *builtinSTGeomFromTextSig) Clone() builtinFunc {
+	newSig := &builtinSTGeomFromTextSig{funcName: b.funcName}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+func (c *stGeomFromTextFunctionClass) getFunction(ctx BuildContext, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	argTps := []types.EvalType{types.ETString}
+	if len(args) == 2 {
+		argTps = append(argTps, types.ETInt)
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETString, argTps...)
+	if err != nil {
+		return nil, err
+	}
+	setGeometryRetType(&bf, mysql.GeometryTypeGeometry)
+	sig := &builtinSTGeomFromTextSig{bf, c.funcName}
+	return sig, nil
+}
+
+func (b *builtinSTGeomFromTextSig) evalString(ctx EvalContext, row chunk.Row) (string, bool, error) {
+	wkt, isNull, err := b.args[0].EvalString(ctx, row)
+	if isNull || err != nil {
+		return "", isNull, err
+	}
+	srid := types.SRIDCartesian
+	if len(b.args) == 2 {
+		if srid, isNull, err = evalSRID(ctx, row, b.args[1], b.funcName); isNull || err != nil {
+			return "", isNull, err
+		}
+	}
+	g, err := types.ParseGeometryWKT(wkt, srid)
+	if err != nil {
+		return "", false, types.ErrGISInvalidData.GenWithStackByArgs(b.funcName)
+	}
+	return string(g.Encode()), false, nil
+}
+
+type stGeomFromWKBFunctionClass struct {
+	baseFunctionClass
+}
+
+type builtinSTGeomFromWKBSig struct {
+	baseBuiltinFunc
+
+	funcName string
+}
+
+func (b *builtinSTGeomFromWKBSig) Clone() builtinFunc {
+	newSig := &builtinSTGeomFromWKBSig{funcName: b.funcName}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+func (c *stGeomFromWKBFunctionClass) getFunction(ctx BuildContext, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	argTps := []types.EvalType{types.ETString}
+	if len(args) == 2 {
+		argTps = append(argTps, types.ETInt)
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETString, argTps...)
+	if err != nil {
+		return nil, err
+	}
+	setGeometryRetType(&bf, mysql.GeometryTypeGeometry)
+	sig := &builtinSTGeomFromWKBSig{bf, c.funcName}
+	return sig, nil
+}
+
+func (b *builtinSTGeomFromWKBSig) evalString(ctx EvalContext, row chunk.Row) (string, bool, error) {
+	wkb, isNull, err := b.args[0].EvalString(ctx, row)
+	if isNull || err != nil {
+		return "", isNull, err
+	}
+	srid := types.SRIDCartesian
+	if len(b.args) == 2 {
+		if srid, isNull, err = evalSRID(ctx, row, b.args[1], b.funcName); isNull || err != nil {
+			return "", isNull, err
+		}
+	}
+	g, err := types.ParseGeometryWKB([]byte(wkb), srid)
+	if err != nil {
+		return "", false, types.ErrGISInvalidData.GenWithStackByArgs(b.funcName)
+	}
+	return string(g.Encode()), false, nil
+}
+
+type stGeomFromGeoJSONFunctionClass struct {
+	baseFunctionClass
+}
+
+type builtinSTGeomFromGeoJSONSig struct {
+	baseBuiltinFunc
+
+	funcName string
+}
+
+func (b *builtinSTGeomFromGeoJSONSig) Clone() builtinFunc {
+	newSig := &builtinSTGeomFromGeoJSONSig{funcName: b.funcName}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+func (c *stGeomFromGeoJSONFunctionClass) getFunction(ctx BuildContext, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	argTps := []types.EvalType{types.ETJson}
+	for range args[1:] {
+		argTps = append(argTps, types.ETInt)
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETString, argTps...)
+	if err != nil {
+		return nil, err
+	}
+	setGeometryRetType(&bf, mysql.GeometryTypeGeometry)
+	sig := &builtinSTGeomFromGeoJSONSig{bf, c.funcName}
+	return sig, nil
+}
+
+func (b *builtinSTGeomFromGeoJSONSig) evalString(ctx EvalContext, row chunk.Row) (string, bool, error) {
+	bj, isNull, err := b.args[0].EvalJSON(ctx, row)
+	if isNull || err != nil {
+		return "", isNull, err
+	}
+	options := int64(types.GeoJSONOptionRejectHigherDimensions)
+	if len(b.args) > 1 {
+		if options, isNull, err = b.args[1].EvalInt(ctx, row); isNull || err != nil {
+			return "", isNull, err
+		}
+		if options < 1 || options > 4 {
+			return "", false, errIncorrectArgs.GenWithStackByArgs(b.funcName)
+		}
+	}
+	// GeoJSON uses WGS 84 by default.
+	srid := types.SRIDWGS84
+	if len(b.args) > 2 {
+		if srid, isNull, err = evalSRID(ctx, row, b.args[2], b.funcName); isNull || err != nil {
+			return "", isNull, err
+		}
+	}
+	g, err := types.ParseGeometryGeoJSON(bj, int(options), srid)
+	if err != nil {
+		return "", false, types.ErrGISInvalidData.GenWithStackByArgs(b.funcName)
+	}
+	return string(g.Encode()), false, nil
+}
+
+type stAsTextFunctionClass struct {
+	baseFunctionClass
+}
+
+type builtinSTAsTextSig struct {
+	baseBuiltinFunc
+
+	funcName string
+}
+
+func (b *builtinSTAsTextSig) Clone() builtinFunc {
+	newSig := &builtinSTAsTextSig{funcName: b.funcName}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+func (c *stAsTextFunctionClass) getFunction(ctx BuildContext, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETString, types.ETString)
+	if err != nil {
+		return nil, err
+	}
+	charset, collate := ctx.GetCharsetInfo()
+	bf.tp.SetCharset(charset)
+	bf.tp.SetCollate(collate)
+	bf.tp.DelFlag(mysql.BinaryFlag)
+	bf.tp.SetFlen(mysql.MaxLongBlobWidth)
+	sig := &builtinSTAsTextSig{bf, c.funcName}
+	return sig, nil
+}
+
+func (b *builtinSTAsTextSig) evalString(ctx EvalContext, row chunk.Row) (string, bool, error) {
+	g, isNull, err := evalGeometry(ctx, row, b.args[0], b.funcName)
+	if isNull || err != nil {
+		return "", isNull, err
+	}
+	return g.WKT(), false, nil
+}
+
+type stAsBinaryFunctionClass struct {
+	baseFunctionClass
+}
+
+type builtinSTAsBinarySig struct {
+	baseBuiltinFunc
+
+	funcName string
+}
+
+func (b *builtinSTAsBinarySig) Clone() builtinFunc {
+	newSig := &builtinSTAsBinarySig{funcName: b.funcName}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+func (c *stAsBinaryFunctionClass) getFunction(ctx BuildContext, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETString, types.ETString)
+	if err != nil {
+		return nil, err
+	}
+	types.SetBinChsClnFlag(bf.tp)
+	bf.tp.SetFlen(mysql.MaxLongBlobWidth)
+	sig := &builtinSTAsBinarySig{bf, c.funcName}
+	return sig, nil
+}
+
+func (b *builtinSTAsBinarySig) evalString(ctx EvalContext, row chunk.Row) (string, bool, error) {
+	g, isNull, err := evalGeometry(ctx, row, b.args[0], b.funcName)
+	if isNull || err != nil {
+		return "", isNull, err
+	}
+	return string(g.WKB()), false, nil
+}
+
+type stAsGeoJSONFunctionClass struct {
+	baseFunctionClass
+}
+
+type builtinSTAsGeoJSONSig struct {
+	baseBuiltinFunc
+
+	funcName string
+}
+
+func (b *builtinSTAsGeoJSONSig) Clone() builtinFunc {
+	newSig := &builtinSTAsGeoJSONSig{funcName: b.funcName}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+func (c *stAsGeoJSONFunctionClass) getFunction(ctx BuildContext, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	argTps := []types.EvalType{types.ETString}
+	for range args[1:] {
+		argTps = append(argTps, types.ETInt)
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETJson, argTps...)
+	if err != nil {
+		return nil, err
+	}
+	sig := &builtinSTAsGeoJSONSig{bf, c.funcName}
+	return sig, nil
+}
+
+func (b *builtinSTAsGeoJSONSig) evalJSON(ctx EvalContext, row chunk.Row) (types.BinaryJSON, bool, error) {
+	g, isNull, err := evalGeometry(ctx, row, b.args[0], b.funcName)
+	if isNull || err != nil {
+		return types.BinaryJSON{}, isNull, err
+	}
+	maxDecimals, options := int64(math.MaxInt32), int64(0)
+	if len(b.args) > 1 {
+		if maxDecimals, isNull, err = b.args[1].EvalInt(ctx, row); isNull || err != nil {
+			return types.BinaryJSON{}, isNull, err
+		}
+		if maxDecimals < 0 {
+			return types.BinaryJSON{}, false, errIncorrectArgs.GenWithStackByArgs(b.funcName)
+		}
+	}
+	if len(b.args) > 2 {
+		if options, isNull, err = b.args[2].EvalInt(ctx, row); isNull || err != nil {
+			return types.BinaryJSON{}, isNull, err
+		}
+		if options < 0 || options > 7 {
+			return types.BinaryJSON{}, false, errIncorrectArgs.GenWithStackByArgs(b.funcName)
+		}
+	}
+	bj, err := g.GeoJSON(int(min(maxDecimals, math.MaxInt32)), int(options))
+	return bj, false, errors.Trace(err)
+}
+
+type stSRIDFunctionClass struct {
+	baseFunctionClass
+}
+
+type builtinSTSRIDSig struct {
+	baseBuiltinFunc
+
+	funcName string
+}
+
+func (b *builtinSTSRIDSig) Clone() builtinFunc {
+	newSig := &builtinSTSRIDSig{funcName: b.funcName}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+func (c *stSRIDFunctionClass) getFunction(ctx BuildContext, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETInt, types.ETString)
+	if err != nil {
+		return nil, err
+	}
+	bf.tp.AddFlag(mysql.UnsignedFlag)
+	bf.tp.SetFlen(10)
+	sig := &builtinSTSRIDSig{bf, c.funcName}
+	return sig, nil
+}
+
+func (b *builtinSTSRIDSig) evalInt(ctx EvalContext, row chunk.Row) (int64, bool, error) {
+	g, isNull, err := evalGeometry(ctx, row, b.args[0], b.funcName)
+	if isNull || err != nil {
+		return 0, isNull, err
+	}
+	return int64(g.SRID), false, nil
+}
+
+type stGeometryTypeFunctionClass struct {
+	baseFunctionClass
+}
+
+type builtinSTGeometryTypeSig struct {
+	baseBuiltinFunc
+
+	funcName string
+}
+
+func (b *builtinSTGeometryTypeSig) Clone() builtinFunc {
+	newSig := &builtinSTGeometryTypeSig{funcName: b.funcName}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+func (c *stGeometryTypeFunctionClass) getFunction(ctx BuildContext, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETString, types.ETString)
+	if err != nil {
+		return nil, err
+	}
+	charset, collate := ctx.GetCharsetInfo()
+	bf.tp.SetCharset(charset)
+	bf.tp.SetCollate(collate)
+	bf.tp.DelFlag(mysql.BinaryFlag)
+	bf.tp.SetFlen(len("GEOMCOLLECTION"))
+	sig := &builtinSTGeometryTypeSig{bf, c.funcName}
+	return sig, nil
+}
+
+func (b *builtinSTGeometryTypeSig) evalString(ctx EvalContext, row chunk.Row) (string, bool, error) {
+	g, isNull, err := evalGeometry(ctx, row, b.args[0], b.funcName)
+	if isNull || err != nil {
+		return "", isNull, err
+	}
+	return g.TypeName(), false, nil
+}
+
+type stIsEmptyFunctionClass struct {
+	baseFunctionClass
+}
+
+type builtinSTIsEmptySig struct {
+	baseBuiltinFunc
+
+	funcName string
+}
+
+func (b *builtinSTIsEmptySig) Clone() builtinFunc {
+	newSig := &builtinSTIsEmptySig{funcName: b.funcName}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+func (c *stIsEmptyFunctionClass) getFunction(ctx BuildContext, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETInt, types.ETString)
+	if err != nil {
+		return nil, err
+	}
+	bf.tp.SetFlen(1)
+	sig := &builtinSTIsEmptySig{bf, c.funcName}
+	return sig, nil
+}
+
+func (b *builtinSTIsEmptySig) evalInt(ctx EvalContext, row chunk.Row) (int64, bool, error) {
+	g, isNull, err := evalGeometry(ctx, row, b.args[0], b.funcName)
+	if isNull || err != nil {
+		return 0, isNull, err
+	}
+	if g.IsEmpty() {
+		return 1, false, nil
+	}
+	return 0, false, nil
+}
+
+// stCoordinateFunctionClass is the function class of ST_X and ST_Y.
+type stCoordinateFunctionClass struct {
+	baseFunctionClass
+}
+
+// builtinSTCoordinateSig returns the first (ST_X) or the second (ST_Y) coordinate of the
+// point in the axis order of the spatial reference system, so ST_X returns the latitude
+// for the geographic spatial reference system.
+type builtinSTCoordinateSig struct {
+	baseBuiltinFunc
+
+	funcName string
+	second   bool
+}
+
+func (b *builtinSTCoordinateSig) Clone() builtinFunc {
+	newSig := &builtinSTCoordinateSig{funcName: b.funcName, second: b.second}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+func (c *stCoordinateFunctionClass) getFunction(ctx BuildContext, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETReal, types.ETString)
+	if err != nil {
+		return nil, err
+	}
+	sig := &builtinSTCoordinateSig{bf, c.funcName, c.funcName == ast.STY}
+	return sig, nil
+}
+
+func (b *builtinSTCoordinateSig) evalReal(ctx EvalContext, row chunk.Row) (float64, bool, error) {
+	g, isNull, err := evalGeometry(ctx, row, b.args[0], b.funcName)
+	if isNull || err != nil {
+		return 0, isNull, err
+	}
+	if g.Tp != mysql.GeometryTypePoint {
+		return 0, false, types.ErrGISUnsupportedArgument.GenWithStackByArgs(b.funcName)
+	}
+	pt := g.Points[0]
+	// The points are stored in the longitude-latitude order, which is the reverse of
+	// the axis order of the geographic spatial reference system.
+	if b.second != types.IsGeographicSRID(g.SRID) {
+		return pt.Y, false, nil
+	}
+	return pt.X, false, nil
+}
+
+// stRelationFunctionClass is the function class of the spatial relation functions like
+// ST_Contains and ST_Intersects.
+type stRelationFunctionClass struct {
+	baseFunctionClass
+}
+
+type builtinSTRelationSig struct {
+	baseBuiltinFunc
+
+	funcName string
+	relation func(g1, g2 *types.Geometry) bool
+}
+
+func (b *builtinSTRelationSig) Clone() builtinFunc {
+	newSig := &builtinSTRelationSig{funcName: b.funcName, relation: b.relation}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+func (c *stRelationFunctionClass) getFunction(ctx BuildContext, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	var relation func(g1, g2 *types.Geometry) bool
+	switch c.funcName {
+	case ast.STContains:
+		relation = types.GeoContains
+	case ast.STWithin:
+		relation = func(g1, g2 *types.Geometry) bool { return types.GeoContains(g2, g1) }
+	case ast.STIntersects:
+		relation = types.GeoIntersects
+	case ast.STDisjoint:
+		relation = func(g1, g2 *types.Geometry) bool { return !types.GeoIntersects(g1, g2) }
+	case ast.STEquals:
+		relation = types.GeoEquals
+	default:
+		return nil, errors.Errorf("unexpected spatial relation function %s", c.funcName)
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETInt, types.ETString, types.ETString)
+	if err != nil {
+		return nil, err
+	}
+	bf.tp.SetFlen(1)
+	sig := &builtinSTRelationSig{bf, c.funcName, relation}
+	return sig, nil
+}
+
+func (b *builtinSTRelationSig) evalInt(ctx EvalContext, row chunk.Row) (int64, bool, error) {
+	g1, g2, isNull, err := evalGeometryPair(ctx, row, b.args, b.funcName)
+	if isNull || err != nil {
+		return 0, isNull, err
+	}
+	if b.relation(&g1, &g2) {
+		return 1, false, nil
+	}
+	return 0, false, nil
+}
+
+type stDistanceFunctionClass struct {
+	baseFunctionClass
+}
+
+type builtinSTDistanceSig struct {
+	baseBuiltinFunc
+
+	funcName string
+}
+
+func (b *builtinSTDistanceSig) Clone() builtinFunc {
+	newSig := &builtinSTDistanceSig{funcName: b.funcName}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+func (c *stDistanceFunctionClass) getFunction(ctx BuildContext, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETReal, types.ETString, types.ETString)
+	if err != nil {
+		return nil, err
+	}
+	sig := &builtinSTDistanceSig{bf, c.funcName}
+	return sig, nil
+}
+
+func (b *builtinSTDistanceSig) evalReal(ctx EvalContext, row chunk.Row) (float64, bool, error) {
+	g1, g2, isNull, err := evalGeometryPair(ctx, row, b.args, b.funcName)
+	if isNull || err != nil {
+		return 0, isNull, err
+	}
+	if types.IsGeographicSRID(g1.SRID) {
+		return 0, false, notImplementedForGeographic(b.funcName, &g1, &g2)
+	}
+	dist, ok := types.GeoDistance(&g1, &g2)
+	return dist, !ok, nil
+}
+
+type stDistanceSphereFunctionClass struct {
+	baseFunctionClass
+}
+
+type builtinSTDistanceSphereSig struct {
+	baseBuiltinFunc
+
+	funcName string
+}
+
+func (b *builtinSTDistanceSphereSig) Clone() builtinFunc {
+	newSig := &builtinSTDistanceSphereSig{funcName: b.funcName}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+func (c *stDistanceSphereFunctionClass) getFunction(ctx BuildContext, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	argTps := []types.EvalType{types.ETString, types.ETString}
+	if len(args) == 3 {
+		argTps = append(argTps, types.ETReal)
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETReal, argTps...)
+	if err != nil {
+		return nil, err
+	}
+	sig := &builtinSTDistanceSphereSig{bf, c.funcName}
+	return sig, nil
+}
+
+func (b *builtinSTDistanceSphereSig) evalReal(ctx EvalContext, row chunk.Row) (float64, bool, error) {
+	g1, g2, isNull, err := evalGeometryPair(ctx, row, b.args, b.funcName)
+	if isNull || err != nil {
+		return 0, isNull, err
+	}
+	radius := float64(defaultSphereRadius)
+	if len(b.args) == 3 {
+		if radius, isNull, err = b.args[2].EvalReal(ctx, row); isNull || err != nil {
+			return 0, isNull, err
+		}
+		if radius <= 0 {
+			return 0, false, errIncorrectArgs.GenWithStackByArgs(b.funcName)
+		}
+	}
+	dist, err := types.GeoDistanceSphere(&g1, &g2, radius)
+	if err != nil {
+		return 0, false, types.ErrGISUnsupportedArgument.GenWithStackByArgs(b.funcName)
+	}
+	return dist, false, nil
+}
+
+// stMeasureFunctionClass is the function class of ST_Area and ST_Length.
+type stMeasureFunctionClass struct {
+	baseFunctionClass
+}
+
+type builtinSTMeasureSig struct {
+	baseBuiltinFunc
+
+	funcName string
+	measure  func(g *types.Geometry) (float64, error)
+}
+
+func (b *builtinSTMeasureSig) Clone() builtinFunc {
+	newSig := &builtinSTMeasureSig{funcName: b.funcName, measure: b.measure}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+func (c *stMeasureFunctionClass) getFunction(ctx BuildContext, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	measure := types.GeoArea
+	if c.funcName == ast.STLength {
+		measure = types.GeoLength
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETReal, types.ETString)
+	if err != nil {
+		return nil, err
+	}
+	sig := &builtinSTMeasureSig{bf, c.funcName, measure}
+	return sig, nil
+}
+
+func (b *builtinSTMeasureSig) evalReal(ctx EvalContext, row chunk.Row) (float64, bool, error) {
+	g, isNull, err := evalGeometry(ctx, row, b.args[0], b.funcName)
+	if isNull || err != nil {
+		return 0, isNull, err
+	}
+	if types.IsGeographicSRID(g.SRID) {
+		return 0, false, notImplementedForGeographic(b.funcName, &g)
+	}
+	res, err := b.measure(&g)
+	if err != nil {
+		return 0, false, types.ErrGISUnsupportedArgument.GenWithStackByArgs(b.funcName)
+	}
+	return res, false, nil
+}
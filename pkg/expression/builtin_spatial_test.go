@@ -0,0 +1,218 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/chunk"
+	"github.com/stretchr/testify/require"
+)
+
+func geomFromTextForTest(t *testing.T, ctx BuildContext, wkt string, srid int64) Expression {
+	g, err := newFunctionForTest(ctx, ast.STGeomFromText, datumsToConstants(types.MakeDatums(wkt, srid))...)
+	require.NoError(t, err)
+	return g
+}
+
+func TestSpatialConstructors(t *testing.T) {
+	ctx := createContext(t)
+	point := func(x, y float64) Expression {
+		p, err := newFunctionForTest(ctx, ast.Point, datumsToConstants(types.MakeDatums(x, y))...)
+		require.NoError(t, err)
+		require.Equal(t, mysql.TypeGeometry, p.GetType(ctx).GetType())
+		require.Equal(t, mysql.GeometryTypePoint, p.GetType(ctx).GetGeometryType())
+		return p
+	}
+	construct := func(funcName string, args ...Expression) (Expression, error) {
+		return newFunctionForTest(ctx, funcName, args...)
+	}
+
+	line, err := construct(ast.LineString, point(0, 0), point(4, 0), point(4, 4), point(0, 0))
+	require.NoError(t, err)
+	polygon, err := construct(ast.Polygon, line)
+	require.NoError(t, err)
+	multiPoint, err := construct(ast.MultiPoint, point(1, 1), point(2, 2))
+	require.NoError(t, err)
+	collection, err := construct(ast.GeomCollection, point(1, 1), line)
+	require.NoError(t, err)
+	emptyCollection, err := construct(ast.GeometryCollection)
+	require.NoError(t, err)
+	for _, tt := range []struct {
+		geom Expression
+		wkt  string
+	}{
+		{point(1.5, -2), "POINT(1.5 -2)"},
+		{line, "LINESTRING(0 0,4 0,4 4,0 0)"},
+		{polygon, "POLYGON((0 0,4 0,4 4,0 0))"},
+		{multiPoint, "MULTIPOINT((1 1),(2 2))"},
+		{collection, "GEOMETRYCOLLECTION(POINT(1 1),LINESTRING(0 0,4 0,4 4,0 0))"},
+		{emptyCollection, "GEOMETRYCOLLECTION EMPTY"},
+	} {
+		f, err := construct(ast.STAsText, tt.geom)
+		require.NoError(t, err)
+		d, err := f.Eval(ctx, chunk.Row{})
+		require.NoError(t, err)
+		require.Equal(t, tt.wkt, d.GetString())
+	}
+
+	// The arguments must be of the element type.
+	f, err := construct(ast.LineString, point(0, 0), line)
+	require.NoError(t, err)
+	_, err = f.Eval(ctx, chunk.Row{})
+	require.True(t, types.ErrGISUnsupportedArgument.Equal(err), "%v", err)
+	// The ring of the polygon must be closed.
+	openLine, err := construct(ast.LineString, point(0, 0), point(4, 0), point(4, 4))
+	require.NoError(t, err)
+	f, err = construct(ast.Polygon, openLine)
+	require.NoError(t, err)
+	_, err = f.Eval(ctx, chunk.Row{})
+	require.True(t, types.ErrGISInvalidData.Equal(err), "%v", err)
+}
+
+func TestSpatialFunctions(t *testing.T) {
+	ctx := createContext(t)
+	const polygon = "POLYGON((0 0,4 0,4 4,0 4,0 0))"
+	type geom struct {
+		wkt  string
+		srid int64
+	}
+	for _, tt := range []struct {
+		funcName string
+		geoms    []geom
+		extra    []any
+		expected any
+	}{
+		{ast.STAsText, []geom{{"point(1 2)", 4326}}, nil, "POINT(1 2)"},
+		{ast.STAsText, []geom{{"multipoint(0 0, 1 1)", 0}}, nil, "MULTIPOINT((0 0),(1 1))"},
+		{ast.STSRID, []geom{{"POINT(1 2)", 4326}}, nil, int64(4326)},
+		{ast.STGeometryType, []geom{{polygon, 0}}, nil, "POLYGON"},
+		{ast.STIsEmpty, []geom{{"GEOMETRYCOLLECTION EMPTY", 0}}, nil, int64(1)},
+		{ast.STIsEmpty, []geom{{"POINT(1 2)", 0}}, nil, int64(0)},
+		// ST_X and ST_Y follow the axis order of the spatial reference system.
+		{ast.STX, []geom{{"POINT(1 2)", 0}}, nil, float64(1)},
+		{ast.STY, []geom{{"POINT(1 2)", 0}}, nil, float64(2)},
+		{ast.STX, []geom{{"POINT(1 2)", 4326}}, nil, float64(1)},
+		{ast.STY, []geom{{"POINT(1 2)", 4326}}, nil, float64(2)},
+		{ast.STContains, []geom{{polygon, 0}, {"POINT(1 1)", 0}}, nil, int64(1)},
+		{ast.STContains, []geom{{polygon, 0}, {"POINT(4 4)", 0}}, nil, int64(0)},
+		{ast.STContains, []geom{{polygon, 0}, {"LINESTRING(1 1,3 3)", 0}}, nil, int64(1)},
+		{ast.STWithin, []geom{{"POINT(1 1)", 0}, {polygon, 0}}, nil, int64(1)},
+		{ast.STIntersects, []geom{{"LINESTRING(0 0,4 4)", 0}, {"LINESTRING(0 4,4 0)", 0}}, nil, int64(1)},
+		{ast.STIntersects, []geom{{polygon, 0}, {"POINT(5 5)", 0}}, nil, int64(0)},
+		{ast.STDisjoint, []geom{{polygon, 0}, {"POINT(5 5)", 0}}, nil, int64(1)},
+		{ast.STEquals, []geom{{"LINESTRING(0 0,2 2)", 0}, {"LINESTRING(2 2,1 1,0 0)", 0}}, nil, int64(1)},
+		{ast.STEquals, []geom{{"POINT(0 0)", 0}, {"POINT(0 1)", 0}}, nil, int64(0)},
+		{ast.STDistance, []geom{{"POINT(0 0)", 0}, {"POINT(3 4)", 0}}, nil, float64(5)},
+		{ast.STDistance, []geom{{polygon, 0}, {"POINT(6 4)", 0}}, nil, float64(2)},
+		{ast.STDistance, []geom{{"POINT(0 0)", 0}, {"GEOMETRYCOLLECTION EMPTY", 0}}, nil, nil},
+		{ast.STArea, []geom{{polygon, 0}}, nil, float64(16)},
+		{ast.STArea, []geom{{"POLYGON((0 0,4 0,4 4,0 4,0 0),(1 1,2 1,2 2,1 2,1 1))", 0}}, nil, float64(15)},
+		{ast.STLength, []geom{{"LINESTRING(0 0,3 4,3 5)", 0}}, nil, float64(6)},
+		{ast.STLength, []geom{{"MULTILINESTRING((0 0,3 4),(0 0,0 1))", 0}}, nil, float64(6)},
+		{ast.STAsGeoJSON, []geom{{"POINT(1 2)", 4326}}, nil, `{"coordinates": [2.0, 1.0], "type": "Point"}`},
+		{ast.STAsGeoJSON, []geom{{"POINT(1.2345 2)", 0}}, []any{2}, `{"coordinates": [1.23, 2.0], "type": "Point"}`},
+	} {
+		args := make([]Expression, 0, len(tt.geoms)+len(tt.extra))
+		for _, g := range tt.geoms {
+			args = append(args, geomFromTextForTest(t, ctx, g.wkt, g.srid))
+		}
+		args = append(args, datumsToConstants(types.MakeDatums(tt.extra...))...)
+		f, err := newFunctionForTest(ctx, tt.funcName, args...)
+		require.NoError(t, err, tt.funcName)
+		d, err := f.Eval(ctx, chunk.Row{})
+		require.NoError(t, err, tt.funcName)
+		switch expected := tt.expected.(type) {
+		case nil:
+			require.True(t, d.IsNull(), tt.funcName)
+		case string:
+			if d.Kind() == types.KindMysqlJSON {
+				require.Equal(t, expected, d.GetMysqlJSON().String(), tt.funcName)
+			} else {
+				require.Equal(t, expected, d.GetString(), tt.funcName)
+			}
+		case float64:
+			require.InDelta(t, expected, d.GetFloat64(), 1e-9, tt.funcName)
+		default:
+			require.Equal(t, expected, d.GetInt64(), tt.funcName)
+		}
+	}
+}
+
+func TestSpatialIO(t *testing.T) {
+	ctx := createContext(t)
+	eval := func(funcName string, args ...Expression) (types.Datum, error) {
+		f, err := newFunctionForTest(ctx, funcName, args...)
+		require.NoError(t, err)
+		return f.Eval(ctx, chunk.Row{})
+	}
+
+	// GeoJSON is in the longitude-latitude order and uses WGS 84 by default.
+	geoJSON := datumsToConstants(types.MakeDatums(`{"type": "Point", "coordinates": [2, 1]}`))[0]
+	g, err := newFunctionForTest(ctx, ast.STGeomFromGeoJSON, geoJSON)
+	require.NoError(t, err)
+	d, err := eval(ast.STAsText, g)
+	require.NoError(t, err)
+	require.Equal(t, "POINT(1 2)", d.GetString())
+	d, err = eval(ast.STSRID, g)
+	require.NoError(t, err)
+	require.Equal(t, int64(4326), d.GetInt64())
+
+	// WKB round trip.
+	point := geomFromTextForTest(t, ctx, "POINT(1 2)", 4326)
+	wkb, err := newFunctionForTest(ctx, ast.STAsBinary, point)
+	require.NoError(t, err)
+	srid := datumsToConstants(types.MakeDatums(4326))[0]
+	g, err = newFunctionForTest(ctx, ast.STGeomFromWKB, wkb, srid)
+	require.NoError(t, err)
+	d, err = eval(ast.STEquals, g, point)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), d.GetInt64())
+
+	// The default radius of ST_Distance_Sphere is the mean radius of the earth.
+	d, err = eval(ast.STDistanceSphere, geomFromTextForTest(t, ctx, "POINT(0 0)", 0), geomFromTextForTest(t, ctx, "POINT(0 1)", 0))
+	require.NoError(t, err)
+	require.InDelta(t, 111194.68, d.GetFloat64(), 0.01)
+}
+
+func TestSpatialErrors(t *testing.T) {
+	ctx := createContext(t)
+	eval := func(funcName string, args ...Expression) error {
+		f, err := newFunctionForTest(ctx, funcName, args...)
+		require.NoError(t, err)
+		_, err = f.Eval(ctx, chunk.Row{})
+		return err
+	}
+
+	err := eval(ast.STAsText, geomFromTextForTest(t, ctx, "POINT(1)", 0))
+	require.True(t, types.ErrGISInvalidData.Equal(err), "%v", err)
+	err = eval(ast.STAsText, geomFromTextForTest(t, ctx, "POINT(1 2)", 1234))
+	require.True(t, types.ErrSRSNotFound.Equal(err), "%v", err)
+	err = eval(ast.STAsText, datumsToConstants(types.MakeDatums("not a geometry"))...)
+	require.True(t, types.ErrGISInvalidData.Equal(err), "%v", err)
+	err = eval(ast.STContains, geomFromTextForTest(t, ctx, "POINT(1 2)", 4326), geomFromTextForTest(t, ctx, "POINT(1 2)", 0))
+	require.True(t, types.ErrGISDifferentSRIDs.Equal(err), "%v", err)
+	err = eval(ast.STArea, geomFromTextForTest(t, ctx, "POLYGON((0 0,1 0,1 1,0 0))", 4326))
+	require.True(t, types.ErrNotImplementedForGeographicSRS.Equal(err), "%v", err)
+	err = eval(ast.STArea, geomFromTextForTest(t, ctx, "POINT(1 2)", 0))
+	require.True(t, types.ErrGISUnsupportedArgument.Equal(err), "%v", err)
+	err = eval(ast.STX, geomFromTextForTest(t, ctx, "LINESTRING(0 0,1 1)", 0))
+	require.True(t, types.ErrGISUnsupportedArgument.Equal(err), "%v", err)
+	err = eval(ast.STDistanceSphere, geomFromTextForTest(t, ctx, "LINESTRING(0 0,1 1)", 0), geomFromTextForTest(t, ctx, "POINT(0 0)", 0))
+	require.True(t, types.ErrGISUnsupportedArgument.Equal(err), "%v", err)
+}
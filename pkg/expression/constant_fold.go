@@ -165,8 +165,8 @@ func foldConstant(ctx BuildContext, expr Expression) (Expression, bool) {
 		if _, ok := unFoldableFunctions[x.FuncName.L]; ok {
 			return expr, false
 		}
-		if _, ok := x.Function.(*extensionFuncSig); ok {
-			// we should not fold the extension function, because it may have a side effect.
+		if sig, ok := x.Function.(*extensionFuncSig); ok && !isDeterministicExtensionFunc(&sig.FunctionDef) {
+			// we should not fold the non-deterministic extension function, because it may have a side effect.
 			return expr, false
 		}
 		if function := specialFoldHandler[x.FuncName.L]; function != nil && !MaybeOverOptimized4PlanCache(ctx, []Expression{expr}) {
@@ -63,39 +63,66 @@ func removeExtensionFunc(name string) {
 	extensionFuncs.Delete(name)
 }
 
+// IsExtensionFunc checks whether the function is a scalar function registered by extensions.
+func IsExtensionFunc(name string) bool {
+	_, ok := extensionFuncs.Load(strings.ToLower(name))
+	return ok
+}
+
 type extensionFuncClass struct {
 	baseFunctionClass
 	expropt.PrivilegeCheckerPropReader
 	funcDef extension.FunctionDef
 	flen    int
+	decimal int
 }
 
 func newExtensionFuncClass(def *extension.FunctionDef) (*extensionFuncClass, error) {
-	var flen int
+	flen, decimal := types.UnspecifiedLength, types.UnspecifiedLength
+	var hasEvalFunc bool
 	switch def.EvalTp {
 	case types.ETString:
 		flen = mysql.MaxFieldVarCharLength
-		if def.EvalStringFunc == nil {
-			return nil, errors.New("eval function is nil")
-		}
+		hasEvalFunc = def.EvalStringFunc != nil
 	case types.ETInt:
 		flen = mysql.MaxIntWidth
-		if def.EvalIntFunc == nil {
-			return nil, errors.New("eval function is nil")
-		}
+		hasEvalFunc = def.EvalIntFunc != nil
+	case types.ETReal:
+		hasEvalFunc = def.EvalRealFunc != nil
+	case types.ETDecimal:
+		flen, decimal = mysql.MaxDecimalWidth, mysql.MaxDecimalScale
+		hasEvalFunc = def.EvalDecimalFunc != nil
+	case types.ETDatetime, types.ETTimestamp:
+		hasEvalFunc = def.EvalTimeFunc != nil
+	case types.ETDuration:
+		hasEvalFunc = def.EvalDurationFunc != nil
+	case types.ETJson:
+		hasEvalFunc = def.EvalJSONFunc != nil
+	case types.ETVectorFloat32:
+		hasEvalFunc = def.EvalVectorFloat32Func != nil
 	default:
 		return nil, errors.Errorf("unsupported extension function ret type: '%v'", def.EvalTp)
 	}
+	if !hasEvalFunc {
+		return nil, errors.New("eval function is nil")
+	}
 
 	maxArgs := len(def.ArgTps)
 	minArgs := maxArgs - def.OptionalArgsLen
 	return &extensionFuncClass{
 		baseFunctionClass: baseFunctionClass{def.Name, minArgs, maxArgs},
 		flen:              flen,
+		decimal:           decimal,
 		funcDef:           *def,
 	}, nil
 }
 
+// isDeterministicExtensionFunc returns whether the extension function can be constant-folded
+// and cached in the plans.
+func isDeterministicExtensionFunc(def *extension.FunctionDef) bool {
+	return def.Deterministic && def.RequireDynamicPrivileges == nil
+}
+
 func (c *extensionFuncClass) getFunction(ctx BuildContext, args []Expression) (builtinFunc, error) {
 	checker, err := c.GetPrivilegeChecker(ctx.GetEvalCtx())
 	if err != nil {
@@ -115,10 +142,17 @@ func (c *extensionFuncClass) getFunction(ctx BuildContext, args []Expression) (b
 	}
 
 	// Though currently, `getFunction` does not require too much information that makes it safe to be cached,
-	// we still skip the plan cache for extension functions because there are no strong requirements to do it.
-	// Skipping the plan cache can make the behavior simple.
-	ctx.SetSkipPlanCache("extension function should not be cached")
-	bf.tp.SetFlen(c.flen)
+	// we still skip the plan cache for the non-deterministic extension functions because they may have side
+	// effects. Skipping the plan cache can make the behavior simple.
+	if !isDeterministicExtensionFunc(&c.funcDef) {
+		ctx.SetSkipPlanCache("extension function should not be cached")
+	}
+	if c.flen != types.UnspecifiedLength {
+		bf.tp.SetFlen(c.flen)
+	}
+	if c.decimal != types.UnspecifiedLength {
+		bf.tp.SetDecimal(c.decimal)
+	}
 	sig := &extensionFuncSig{baseBuiltinFunc: bf, FunctionDef: c.funcDef}
 	return sig, nil
 }
@@ -170,48 +204,154 @@ func (b *extensionFuncSig) RequiredOptionalEvalProps() OptionalEvalPropKeySet {
 		b.PrivilegeCheckerPropReader.RequiredOptionalEvalProps()
 }
 
-func (b *extensionFuncSig) evalString(ctx EvalContext, row chunk.Row) (string, bool, error) {
+// newFnContext checks the privileges and creates the context for the eval functions.
+func (b *extensionFuncSig) newFnContext(ctx EvalContext) (extensionFnContext, error) {
 	checker, err := b.GetPrivilegeChecker(ctx)
 	if err != nil {
-		return "", true, err
+		return extensionFnContext{}, err
 	}
 
 	if err := checkPrivileges(checker, &b.FunctionDef); err != nil {
-		return "", true, err
+		return extensionFnContext{}, err
 	}
 
 	vars, err := b.GetSessionVars(ctx)
 	if err != nil {
-		return "", true, err
+		return extensionFnContext{}, err
 	}
+	return newExtensionFnContext(ctx, vars, b), nil
+}
 
-	if b.EvalTp == types.ETString {
-		fnCtx := newExtensionFnContext(ctx, vars, b)
-		return b.EvalStringFunc(fnCtx, row)
+func (b *extensionFuncSig) evalString(ctx EvalContext, row chunk.Row) (string, bool, error) {
+	if b.EvalTp != types.ETString {
+		return b.baseBuiltinFunc.evalString(ctx, row)
 	}
-	return b.baseBuiltinFunc.evalString(ctx, row)
+	fnCtx, err := b.newFnContext(ctx)
+	if err != nil {
+		return "", true, err
+	}
+	return b.EvalStringFunc(fnCtx, row)
 }
 
 func (b *extensionFuncSig) evalInt(ctx EvalContext, row chunk.Row) (int64, bool, error) {
-	checker, err := b.GetPrivilegeChecker(ctx)
+	if b.EvalTp != types.ETInt {
+		return b.baseBuiltinFunc.evalInt(ctx, row)
+	}
+	fnCtx, err := b.newFnContext(ctx)
 	if err != nil {
 		return 0, true, err
 	}
+	return b.EvalIntFunc(fnCtx, row)
+}
 
-	if err := checkPrivileges(checker, &b.FunctionDef); err != nil {
+func (b *extensionFuncSig) evalReal(ctx EvalContext, row chunk.Row) (float64, bool, error) {
+	if b.EvalTp != types.ETReal {
+		return b.baseBuiltinFunc.evalReal(ctx, row)
+	}
+	fnCtx, err := b.newFnContext(ctx)
+	if err != nil {
 		return 0, true, err
 	}
+	return b.EvalRealFunc(fnCtx, row)
+}
 
-	vars, err := b.GetSessionVars(ctx)
+func (b *extensionFuncSig) evalDecimal(ctx EvalContext, row chunk.Row) (*types.MyDecimal, bool, error) {
+	if b.EvalTp != types.ETDecimal {
+		return b.baseBuiltinFunc.evalDecimal(ctx, row)
+	}
+	fnCtx, err := b.newFnContext(ctx)
 	if err != nil {
-		return 0, true, err
+		return nil, true, err
+	}
+	return b.EvalDecimalFunc(fnCtx, row)
+}
+
+func (b *extensionFuncSig) evalTime(ctx EvalContext, row chunk.Row) (types.Time, bool, error) {
+	if b.EvalTp != types.ETDatetime && b.EvalTp != types.ETTimestamp {
+		return b.baseBuiltinFunc.evalTime(ctx, row)
+	}
+	fnCtx, err := b.newFnContext(ctx)
+	if err != nil {
+		return types.ZeroTime, true, err
+	}
+	return b.EvalTimeFunc(fnCtx, row)
+}
+
+func (b *extensionFuncSig) evalDuration(ctx EvalContext, row chunk.Row) (types.Duration, bool, error) {
+	if b.EvalTp != types.ETDuration {
+		return b.baseBuiltinFunc.evalDuration(ctx, row)
+	}
+	fnCtx, err := b.newFnContext(ctx)
+	if err != nil {
+		return types.Duration{}, true, err
+	}
+	return b.EvalDurationFunc(fnCtx, row)
+}
+
+func (b *extensionFuncSig) evalJSON(ctx EvalContext, row chunk.Row) (types.BinaryJSON, bool, error) {
+	if b.EvalTp != types.ETJson {
+		return b.baseBuiltinFunc.evalJSON(ctx, row)
+	}
+	fnCtx, err := b.newFnContext(ctx)
+	if err != nil {
+		return types.BinaryJSON{}, true, err
 	}
+	return b.EvalJSONFunc(fnCtx, row)
+}
 
-	if b.EvalTp == types.ETInt {
-		fnCtx := newExtensionFnContext(ctx, vars, b)
-		return b.EvalIntFunc(fnCtx, row)
+func (b *extensionFuncSig) evalVectorFloat32(ctx EvalContext, row chunk.Row) (types.VectorFloat32, bool, error) {
+	if b.EvalTp != types.ETVectorFloat32 {
+		return b.baseBuiltinFunc.evalVectorFloat32(ctx, row)
+	}
+	fnCtx, err := b.newFnContext(ctx)
+	if err != nil {
+		return types.ZeroVectorFloat32, true, err
 	}
-	return b.baseBuiltinFunc.evalInt(ctx, row)
+	return b.EvalVectorFloat32Func(fnCtx, row)
+}
+
+func (b *extensionFuncSig) vectorized() bool {
+	return b.VecEvalFunc != nil
+}
+
+func (b *extensionFuncSig) vecEval(ctx EvalContext, input *chunk.Chunk, result *chunk.Column) error {
+	fnCtx, err := b.newFnContext(ctx)
+	if err != nil {
+		return err
+	}
+	return b.VecEvalFunc(fnCtx, input, result)
+}
+
+func (b *extensionFuncSig) vecEvalString(ctx EvalContext, input *chunk.Chunk, result *chunk.Column) error {
+	return b.vecEval(ctx, input, result)
+}
+
+func (b *extensionFuncSig) vecEvalInt(ctx EvalContext, input *chunk.Chunk, result *chunk.Column) error {
+	return b.vecEval(ctx, input, result)
+}
+
+func (b *extensionFuncSig) vecEvalReal(ctx EvalContext, input *chunk.Chunk, result *chunk.Column) error {
+	return b.vecEval(ctx, input, result)
+}
+
+func (b *extensionFuncSig) vecEvalDecimal(ctx EvalContext, input *chunk.Chunk, result *chunk.Column) error {
+	return b.vecEval(ctx, input, result)
+}
+
+func (b *extensionFuncSig) vecEvalTime(ctx EvalContext, input *chunk.Chunk, result *chunk.Column) error {
+	return b.vecEval(ctx, input, result)
+}
+
+func (b *extensionFuncSig) vecEvalDuration(ctx EvalContext, input *chunk.Chunk, result *chunk.Column) error {
+	return b.vecEval(ctx, input, result)
+}
+
+func (b *extensionFuncSig) vecEvalJSON(ctx EvalContext, input *chunk.Chunk, result *chunk.Column) error {
+	return b.vecEval(ctx, input, result)
+}
+
+func (b *extensionFuncSig) vecEvalVectorFloat32(ctx EvalContext, input *chunk.Chunk, result *chunk.Column) error {
+	return b.vecEval(ctx, input, result)
 }
 
 type extensionFnContext struct {
@@ -242,6 +382,24 @@ func (b extensionFnContext) EvalArgs(row chunk.Row) ([]types.Datum, error) {
 	return result, nil
 }
 
+func (b extensionFnContext) VecEvalArgs(input *chunk.Chunk) ([]*chunk.Column, error) {
+	if len(b.sig.args) == 0 {
+		return nil, nil
+	}
+
+	result := make([]*chunk.Column, 0, len(b.sig.args))
+	for _, arg := range b.sig.args {
+		tp := arg.GetType(b.ctx)
+		col := chunk.NewColumn(tp, input.NumRows())
+		if err := EvalExpr(b.ctx, true, arg, tp.EvalType(), input, col); err != nil {
+			return nil, err
+		}
+		result = append(result, col)
+	}
+
+	return result, nil
+}
+
 func (b extensionFnContext) ConnectionInfo() *variable.ConnectionInfo {
 	return b.vars.ConnectionInfo
 }
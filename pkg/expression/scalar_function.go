@@ -409,8 +409,8 @@ func (sf *ScalarFunction) ConstLevel() ConstLevel {
 		return ConstNone
 	}
 
-	if _, ok := sf.Function.(*extensionFuncSig); ok {
-		// we should return `ConstNone` for extension functions for safety, because it may have a side effect.
+	if sig, ok := sf.Function.(*extensionFuncSig); ok && !isDeterministicExtensionFunc(&sig.FunctionDef) {
+		// we should return `ConstNone` for the non-deterministic extension functions for safety, because it may have a side effect.
 		return ConstNone
 	}
 
@@ -0,0 +1,80 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extension
+
+import (
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/types"
+)
+
+// AggregateFunctionDef is the definition for the custom aggregate function.
+//
+// The aggregate function keeps an intermediate state for every group. The state is created by `InitFunc`,
+// updated by `UpdateFunc` for every row in the group, and turned into the result by `FinalFunc`. When the
+// aggregation is executed in parallel, the states of the same group built by different workers are combined
+// by `MergeFunc`. When the aggregation spills to disk, the states are encoded by `SerializeFunc` and restored
+// by `DeserializeFunc`.
+//
+// The function returns NULL when there is no input row and no GROUP BY clause.
+type AggregateFunctionDef struct {
+	// Name is the name of the function
+	Name string
+	// EvalTp is the type of the return value
+	EvalTp types.EvalType
+	// ArgTps is the argument types, the arguments are converted to these types before calling `UpdateFunc`
+	ArgTps []types.EvalType
+	// InitFunc returns a new empty state
+	InitFunc func() any
+	// UpdateFunc updates the state with the arguments of a row and returns the new state.
+	// The datums in `args` are only valid during the call, they should be copied if they need to be
+	// kept in the state.
+	UpdateFunc func(state any, args []types.Datum) (any, error)
+	// MergeFunc merges the state `src` into `dst` and returns the new state
+	MergeFunc func(dst, src any) (any, error)
+	// FinalFunc returns the result of the state, the result is converted to `EvalTp` if its kind
+	// does not match.
+	FinalFunc func(state any) (types.Datum, error)
+	// SerializeFunc appends the encoded state to `buf` and returns the extended buffer
+	SerializeFunc func(state any, buf []byte) []byte
+	// DeserializeFunc decodes the state encoded by `SerializeFunc`. The `data` is only valid during
+	// the call.
+	DeserializeFunc func(data []byte) any
+	// MemoryUsageFunc is an optional function to return the memory usage of the state in bytes.
+	// It is used to track the memory and decide when to spill.
+	MemoryUsageFunc func(state any) int64
+}
+
+// Validate validates the aggregate function definition
+func (def *AggregateFunctionDef) Validate() error {
+	if def.Name == "" {
+		return errors.New("extension aggregate function name should not be empty")
+	}
+
+	if def.InitFunc == nil || def.UpdateFunc == nil || def.MergeFunc == nil || def.FinalFunc == nil {
+		return errors.Errorf("extension aggregate function '%s' should have init, update, merge and final functions", def.Name)
+	}
+
+	if def.SerializeFunc == nil || def.DeserializeFunc == nil {
+		return errors.Errorf("extension aggregate function '%s' should have serialize and deserialize functions", def.Name)
+	}
+
+	return nil
+}
+
+// RegisterExtensionAggFunc is to avoid dependency cycle
+var RegisterExtensionAggFunc func(*AggregateFunctionDef) error
+
+// RemoveExtensionAggFunc is to avoid dependency cycle
+var RemoveExtensionAggFunc func(string)
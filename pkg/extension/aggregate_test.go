@@ -0,0 +1,202 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extension_test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/pingcap/tidb/pkg/extension"
+	"github.com/pingcap/tidb/pkg/testkit"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+type percentileState struct {
+	percent float64
+	vals    []float64
+}
+
+// customPercentile returns the value at the given percent of the non-null values, e.g. `custom_percentile(a, 0.5)`.
+var customPercentile = &extension.AggregateFunctionDef{
+	Name:   "custom_percentile",
+	EvalTp: types.ETReal,
+	ArgTps: []types.EvalType{types.ETReal, types.ETReal},
+	InitFunc: func() any {
+		return &percentileState{}
+	},
+	UpdateFunc: func(state any, args []types.Datum) (any, error) {
+		s := state.(*percentileState)
+		if !args[0].IsNull() {
+			s.vals = append(s.vals, args[0].GetFloat64())
+		}
+		s.percent = args[1].GetFloat64()
+		return s, nil
+	},
+	MergeFunc: func(dst, src any) (any, error) {
+		d, s := dst.(*percentileState), src.(*percentileState)
+		d.vals = append(d.vals, s.vals...)
+		d.percent = max(d.percent, s.percent)
+		return d, nil
+	},
+	FinalFunc: func(state any) (types.Datum, error) {
+		s := state.(*percentileState)
+		if len(s.vals) == 0 {
+			return types.Datum{}, nil
+		}
+		slices.Sort(s.vals)
+		idx := int(math.Ceil(s.percent*float64(len(s.vals)))) - 1
+		return types.NewFloat64Datum(s.vals[max(idx, 0)]), nil
+	},
+	SerializeFunc: func(state any, buf []byte) []byte {
+		s := state.(*percentileState)
+		buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(s.percent))
+		for _, v := range s.vals {
+			buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(v))
+		}
+		return buf
+	},
+	DeserializeFunc: func(data []byte) any {
+		s := &percentileState{percent: math.Float64frombits(binary.LittleEndian.Uint64(data))}
+		for i := 8; i < len(data); i += 8 {
+			s.vals = append(s.vals, math.Float64frombits(binary.LittleEndian.Uint64(data[i:])))
+		}
+		return s
+	},
+	MemoryUsageFunc: func(state any) int64 {
+		return int64(cap(state.(*percentileState).vals)) * 8
+	},
+}
+
+// customCountOdd counts the odd values, its state is a plain int64 and its result is converted to decimal.
+var customCountOdd = &extension.AggregateFunctionDef{
+	Name:   "custom_count_odd",
+	EvalTp: types.ETDecimal,
+	ArgTps: []types.EvalType{types.ETInt},
+	InitFunc: func() any {
+		return int64(0)
+	},
+	UpdateFunc: func(state any, args []types.Datum) (any, error) {
+		if !args[0].IsNull() && args[0].GetInt64()%2 != 0 {
+			return state.(int64) + 1, nil
+		}
+		return state, nil
+	},
+	MergeFunc: func(dst, src any) (any, error) {
+		return dst.(int64) + src.(int64), nil
+	},
+	FinalFunc: func(state any) (types.Datum, error) {
+		return types.NewIntDatum(state.(int64)), nil
+	},
+	SerializeFunc: func(state any, buf []byte) []byte {
+		return binary.LittleEndian.AppendUint64(buf, uint64(state.(int64)))
+	},
+	DeserializeFunc: func(data []byte) any {
+		return int64(binary.LittleEndian.Uint64(data))
+	},
+}
+
+func TestExtensionAggFunc(t *testing.T) {
+	defer extension.Reset()
+	extension.Reset()
+
+	require.NoError(t, extension.Register("test", extension.WithCustomAggregateFunctions([]*extension.AggregateFunctionDef{
+		customPercentile,
+		customCountOdd,
+	})))
+
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t(id int primary key, a double, b int, key(b))")
+	tk.MustExec("insert into t values (1, 10, 1), (2, 30, 1), (3, 20, 1), (4, null, 2), (5, 5, 2), (6, 7, 3)")
+
+	tk.MustQuery("select custom_percentile(a, 0.5), custom_count_odd(id) from t").Check(testkit.Rows("10 3"))
+	tk.MustQuery("select CUSTOM_PERCENTILE(a, 1) from t where id > 100").Check(testkit.Rows("<nil>"))
+
+	// hash agg, both parallel and non-parallel
+	for _, concurrency := range []int{1, 4} {
+		tk.MustExec(fmt.Sprintf("set @@tidb_hashagg_partial_concurrency = %d", concurrency))
+		tk.MustExec(fmt.Sprintf("set @@tidb_hashagg_final_concurrency = %d", concurrency))
+		tk.MustQuery("select /*+ HASH_AGG() */ b, custom_percentile(a, 0.5), custom_count_odd(id) from t group by b order by b").Check(testkit.Rows(
+			"1 20 2", "2 5 1", "3 7 0"))
+	}
+	tk.MustExec("set @@tidb_hashagg_partial_concurrency = default")
+	tk.MustExec("set @@tidb_hashagg_final_concurrency = default")
+
+	// stream agg
+	tk.MustQuery("select /*+ STREAM_AGG() */ b, custom_percentile(a, 1) from t use index(b) group by b order by b").Check(testkit.Rows(
+		"1 30", "2 5", "3 7"))
+	tk.MustQuery("select b from t group by b having custom_count_odd(id) > 0 order by b").Check(testkit.Rows("1", "2"))
+
+	// window function
+	tk.MustQuery("select id, custom_percentile(a, 1) over (order by id rows between 1 preceding and current row) from t order by id").Check(testkit.Rows(
+		"1 10", "2 30", "3 30", "4 20", "5 5", "6 7"))
+	tk.MustQuery("select id, custom_count_odd(id) over (partition by b) from t order by id").Check(testkit.Rows(
+		"1 2", "2 2", "3 2", "4 1", "5 1", "6 0"))
+
+	// view
+	tk.MustExec("create view v as select b, custom_percentile(a, 0.5) as p from t group by b")
+	tk.MustQuery("select * from v order by b").Check(testkit.Rows("1 20", "2 5", "3 7"))
+
+	// the aggregate functions registered by extensions are never pushed down
+	for _, row := range tk.MustQuery("explain format='brief' select b, custom_count_odd(id) from t group by b").Rows() {
+		if row[2] == "cop[tikv]" {
+			require.NotContains(t, row[0], "Agg")
+		}
+	}
+
+	tk.MustGetErrCode("select custom_percentile(a) from t", 1582)
+	tk.MustGetErrCode("select * from t where custom_count_odd(id) > 0", 1111)
+}
+
+func TestRegisterExtensionAggFunc(t *testing.T) {
+	defer extension.Reset()
+
+	extension.Reset()
+	require.NoError(t, extension.Register("test", extension.WithCustomAggregateFunctions([]*extension.AggregateFunctionDef{
+		customCountOdd,
+		nil,
+	})))
+	require.EqualError(t, extension.Setup(), "extension aggregate function def is nil")
+
+	extension.Reset()
+	def := *customCountOdd
+	def.Name = "concat"
+	require.NoError(t, extension.Register("test", extension.WithCustomAggregateFunctions([]*extension.AggregateFunctionDef{
+		&def,
+	})))
+	require.EqualError(t, extension.Setup(), "extension aggregate function name 'concat' conflict with scalar function")
+
+	extension.Reset()
+	def = *customCountOdd
+	def.MergeFunc = nil
+	require.NoError(t, extension.Register("test", extension.WithCustomAggregateFunctions([]*extension.AggregateFunctionDef{
+		&def,
+	})))
+	require.EqualError(t, extension.Setup(), "extension aggregate function 'custom_count_odd' should have init, update, merge and final functions")
+
+	extension.Reset()
+	require.NoError(t, extension.Register("test1", extension.WithCustomAggregateFunctions([]*extension.AggregateFunctionDef{
+		customCountOdd,
+	})))
+	require.NoError(t, extension.Register("test2", extension.WithCustomAggregateFunctions([]*extension.AggregateFunctionDef{
+		customCountOdd,
+	})))
+	require.EqualError(t, extension.Setup(), "duplicated extension aggregate function name 'custom_count_odd'")
+}
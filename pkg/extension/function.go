@@ -32,6 +32,9 @@ type FunctionContext interface {
 	CurrentDB() string
 	ConnectionInfo() *variable.ConnectionInfo
 	EvalArgs(row chunk.Row) ([]types.Datum, error)
+	// VecEvalArgs evaluates the arguments for all rows of the input chunk. The returned
+	// columns are newly allocated and owned by the caller.
+	VecEvalArgs(input *chunk.Chunk) ([]*chunk.Column, error)
 }
 
 // FunctionDef is the definition for the custom function
@@ -48,6 +51,28 @@ type FunctionDef struct {
 	EvalStringFunc func(ctx FunctionContext, row chunk.Row) (string, bool, error)
 	// EvalIntFunc is the eval function when `EvalTp` is `types.ETInt`
 	EvalIntFunc func(ctx FunctionContext, row chunk.Row) (int64, bool, error)
+	// EvalRealFunc is the eval function when `EvalTp` is `types.ETReal`
+	EvalRealFunc func(ctx FunctionContext, row chunk.Row) (float64, bool, error)
+	// EvalDecimalFunc is the eval function when `EvalTp` is `types.ETDecimal`
+	EvalDecimalFunc func(ctx FunctionContext, row chunk.Row) (*types.MyDecimal, bool, error)
+	// EvalTimeFunc is the eval function when `EvalTp` is `types.ETDatetime` or `types.ETTimestamp`
+	EvalTimeFunc func(ctx FunctionContext, row chunk.Row) (types.Time, bool, error)
+	// EvalDurationFunc is the eval function when `EvalTp` is `types.ETDuration`
+	EvalDurationFunc func(ctx FunctionContext, row chunk.Row) (types.Duration, bool, error)
+	// EvalJSONFunc is the eval function when `EvalTp` is `types.ETJson`
+	EvalJSONFunc func(ctx FunctionContext, row chunk.Row) (types.BinaryJSON, bool, error)
+	// EvalVectorFloat32Func is the eval function when `EvalTp` is `types.ETVectorFloat32`
+	EvalVectorFloat32Func func(ctx FunctionContext, row chunk.Row) (types.VectorFloat32, bool, error)
+	// VecEvalFunc is the optional vectorized eval function. It should fill `result` with the
+	// values of all rows in `input` in the same way as the builtin functions, for example,
+	// `result.ResizeFloat64(input.NumRows(), false)` for `types.ETReal`. The row-based eval
+	// function of `EvalTp` is still required because not all the executors are vectorized.
+	VecEvalFunc func(ctx FunctionContext, input *chunk.Chunk, result *chunk.Column) error
+	// Deterministic indicates that the function always returns the same result for the same
+	// arguments and has no side effects, so it can be constant-folded and the plans using it
+	// can be cached. It's ignored if `RequireDynamicPrivileges` is set, because the privileges
+	// need to be checked on every evaluation.
+	Deterministic bool
 	// RequireDynamicPrivileges is a function to return a list of dynamic privileges to check.
 	RequireDynamicPrivileges func(sem bool) []string
 }
@@ -20,11 +20,13 @@ import (
 	"strings"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/tidb/pkg/expression"
 	"github.com/pingcap/tidb/pkg/extension"
 	"github.com/pingcap/tidb/pkg/parser/auth"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
 	"github.com/pingcap/tidb/pkg/planner/util/fixcontrol"
 	"github.com/pingcap/tidb/pkg/sessionctx/variable"
 	"github.com/pingcap/tidb/pkg/testkit"
@@ -255,6 +257,16 @@ func TestRegisterExtensionFunc(t *testing.T) {
 	})))
 	require.EqualError(t, extension.Setup(), "duplicated extension function name 'custom_func1'")
 	checkFuncList(t, orgFuncList)
+
+	// eval func of the return type is nil
+	extension.Reset()
+	def = *customFunc2
+	def.EvalTp = types.ETReal
+	require.NoError(t, extension.Register("test", extension.WithCustomFunctions([]*extension.FunctionDef{
+		&def,
+	})))
+	require.EqualError(t, extension.Setup(), "eval function is nil")
+	checkFuncList(t, orgFuncList)
 }
 
 func checkFuncList(t *testing.T, orgList []string, customFuncs ...string) {
@@ -523,3 +535,196 @@ func TestShouldNotOptimizeExtensionFunc(t *testing.T) {
 		require.False(t, ctx.GetSessionVars().StmtCtx.UseCache())
 	}
 }
+
+func TestExtensionFuncEvalTypes(t *testing.T) {
+	defer extension.Reset()
+	extension.Reset()
+
+	require.NoError(t, extension.Register("test", extension.WithCustomFunctions([]*extension.FunctionDef{
+		{
+			Name:   "custom_real",
+			EvalTp: types.ETReal,
+			ArgTps: []types.EvalType{types.ETReal},
+			EvalRealFunc: func(ctx extension.FunctionContext, row chunk.Row) (float64, bool, error) {
+				args, err := ctx.EvalArgs(row)
+				if err != nil || args[0].IsNull() {
+					return 0, true, err
+				}
+				return args[0].GetFloat64() * 2, false, nil
+			},
+		},
+		{
+			Name:   "custom_decimal",
+			EvalTp: types.ETDecimal,
+			ArgTps: []types.EvalType{types.ETDecimal},
+			EvalDecimalFunc: func(ctx extension.FunctionContext, row chunk.Row) (*types.MyDecimal, bool, error) {
+				args, err := ctx.EvalArgs(row)
+				if err != nil || args[0].IsNull() {
+					return nil, true, err
+				}
+				result := new(types.MyDecimal)
+				if err = types.DecimalMul(args[0].GetMysqlDecimal(), types.NewDecFromInt(3), result); err != nil {
+					return nil, true, err
+				}
+				return result, false, nil
+			},
+		},
+		{
+			Name:   "custom_datetime",
+			EvalTp: types.ETDatetime,
+			EvalTimeFunc: func(ctx extension.FunctionContext, row chunk.Row) (types.Time, bool, error) {
+				return types.NewTime(types.FromDate(2024, 1, 2, 3, 4, 5, 0), mysql.TypeDatetime, 0), false, nil
+			},
+		},
+		{
+			Name:   "custom_duration",
+			EvalTp: types.ETDuration,
+			EvalDurationFunc: func(ctx extension.FunctionContext, row chunk.Row) (types.Duration, bool, error) {
+				return types.Duration{Duration: time.Hour + 2*time.Minute}, false, nil
+			},
+		},
+		{
+			Name:   "custom_json",
+			EvalTp: types.ETJson,
+			ArgTps: []types.EvalType{types.ETString},
+			EvalJSONFunc: func(ctx extension.FunctionContext, row chunk.Row) (types.BinaryJSON, bool, error) {
+				args, err := ctx.EvalArgs(row)
+				if err != nil || args[0].IsNull() {
+					return types.BinaryJSON{}, true, err
+				}
+				return types.CreateBinaryJSON(map[string]any{"name": args[0].GetString()}), false, nil
+			},
+		},
+	})))
+
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustQuery("select custom_real(1.25), custom_real(null)").Check(testkit.Rows("2.5 <nil>"))
+	tk.MustQuery("select custom_decimal(1.1), custom_decimal(null)").Check(testkit.Rows("3.3 <nil>"))
+	tk.MustQuery("select custom_datetime(), custom_duration()").Check(testkit.Rows("2024-01-02 03:04:05 01:02:00"))
+	tk.MustQuery("select custom_json('a'), json_extract(custom_json('b'), '$.name')").Check(testkit.Rows(`{"name": "a"} "b"`))
+}
+
+func TestVectorizedExtensionFunc(t *testing.T) {
+	defer extension.Reset()
+	extension.Reset()
+
+	var rowCnt, vecCnt atomic.Int64
+	require.NoError(t, extension.Register("test", extension.WithCustomFunctions([]*extension.FunctionDef{
+		{
+			Name:   "custom_vec_add",
+			EvalTp: types.ETReal,
+			ArgTps: []types.EvalType{types.ETReal, types.ETReal},
+			EvalRealFunc: func(ctx extension.FunctionContext, row chunk.Row) (float64, bool, error) {
+				rowCnt.Add(1)
+				args, err := ctx.EvalArgs(row)
+				if err != nil || args[0].IsNull() || args[1].IsNull() {
+					return 0, true, err
+				}
+				return args[0].GetFloat64() + args[1].GetFloat64(), false, nil
+			},
+			VecEvalFunc: func(ctx extension.FunctionContext, input *chunk.Chunk, result *chunk.Column) error {
+				vecCnt.Add(1)
+				args, err := ctx.VecEvalArgs(input)
+				if err != nil {
+					return err
+				}
+				n := input.NumRows()
+				result.ResizeFloat64(n, false)
+				result.MergeNulls(args[0], args[1])
+				x, y, res := args[0].Float64s(), args[1].Float64s(), result.Float64s()
+				for i := 0; i < n; i++ {
+					if result.IsNull(i) {
+						continue
+					}
+					res[i] = x[i] + y[i]
+				}
+				return nil
+			},
+		},
+	})))
+
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t(id int primary key, a double, b double)")
+	tk.MustExec("insert into t values (1, 1, 2), (2, 3.5, null), (3, -1, 0.25)")
+	tk.MustQuery("select id, custom_vec_add(a, b) from t order by id").Check(testkit.Rows("1 3", "2 <nil>", "3 -0.75"))
+	require.Positive(t, vecCnt.Load())
+	require.Zero(t, rowCnt.Load())
+}
+
+func TestDeterministicExtensionFunc(t *testing.T) {
+	defer extension.Reset()
+	extension.Reset()
+
+	var cnt atomic.Int64
+	require.NoError(t, extension.Register("test", extension.WithCustomFunctions([]*extension.FunctionDef{
+		{
+			Name:          "custom_price",
+			EvalTp:        types.ETDecimal,
+			ArgTps:        []types.EvalType{types.ETDecimal},
+			Deterministic: true,
+			EvalDecimalFunc: func(ctx extension.FunctionContext, row chunk.Row) (*types.MyDecimal, bool, error) {
+				cnt.Add(1)
+				args, err := ctx.EvalArgs(row)
+				if err != nil || args[0].IsNull() {
+					return nil, true, err
+				}
+				result := new(types.MyDecimal)
+				if err = types.DecimalAdd(args[0].GetMysqlDecimal(), types.NewDecFromInt(1), result); err != nil {
+					return nil, true, err
+				}
+				return result, false, nil
+			},
+		},
+		{
+			Name:          "custom_price_priv",
+			EvalTp:        types.ETInt,
+			Deterministic: true,
+			EvalIntFunc: func(ctx extension.FunctionContext, row chunk.Row) (int64, bool, error) {
+				return 1, false, nil
+			},
+			RequireDynamicPrivileges: func(sem bool) []string {
+				return []string{"CUSTOM_PRICE_PRIV"}
+			},
+		},
+	}), extension.WithCustomDynPrivs([]string{"CUSTOM_PRICE_PRIV"})))
+
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t(a int primary key)")
+	tk.MustExec("insert into t values (1), (2), (3)")
+
+	// A deterministic function with constant arguments is folded and only evaluated once.
+	tk.MustQuery("select a, custom_price(1.5) from t order by a").Check(testkit.Rows("1 2.5", "2 2.5", "3 2.5"))
+	require.Equal(t, int64(1), cnt.Load())
+
+	// The plans using a deterministic function can be cached.
+	tk.MustExec("prepare s1 from 'select custom_price(a) from t where a = ?'")
+	tk.MustExec("set @a = 1")
+	tk.MustQuery("execute s1 using @a").Check(testkit.Rows("2"))
+	tk.MustExec("set @a = 2")
+	tk.MustQuery("execute s1 using @a").Check(testkit.Rows("3"))
+	tk.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("1"))
+
+	ctx := mock.NewContext()
+	ctx.GetSessionVars().StmtCtx.EnablePlanCache()
+	expr, err := expression.ParseSimpleExpr(ctx, "custom_price(1)")
+	require.NoError(t, err)
+	_, ok := expr.(*expression.Constant)
+	require.True(t, ok)
+	require.True(t, ctx.GetSessionVars().StmtCtx.UseCache())
+
+	// The flag is ignored for the functions requiring dynamic privileges.
+	ctx = mock.NewContext()
+	ctx.GetSessionVars().StmtCtx.EnablePlanCache()
+	expr, err = expression.ParseSimpleExpr(ctx, "custom_price_priv()")
+	require.NoError(t, err)
+	scalar, ok := expr.(*expression.ScalarFunction)
+	require.True(t, ok)
+	require.Equal(t, expression.ConstNone, scalar.ConstLevel())
+	require.False(t, ctx.GetSessionVars().StmtCtx.UseCache())
+}
@@ -55,6 +55,13 @@ func WithCustomFunctions(funcs []*FunctionDef) Option {
 	}
 }
 
+// WithCustomAggregateFunctions specifies custom aggregate functions
+func WithCustomAggregateFunctions(funcs []*AggregateFunctionDef) Option {
+	return func(m *Manifest) {
+		m.aggFuncs = funcs
+	}
+}
+
 // AccessCheckFunc is a function that returns a dynamic privilege list for db/tbl/column access
 type AccessCheckFunc func(db, tbl, column string, priv mysql.PrivilegeType, sem bool) []string
 
@@ -124,6 +131,7 @@ type Manifest struct {
 	dynPrivs              []string
 	bootstrap             func(BootstrapContext) error
 	funcs                 []*FunctionDef
+	aggFuncs              []*AggregateFunctionDef
 	accessCheckFunc       AccessCheckFunc
 	authPlugins           []*AuthPlugin
 	sessionHandlerFactory func() *SessionHandler
@@ -223,6 +231,24 @@ func newManifestWithSetup(name string, factory func() ([]Option, error)) (_ *Man
 		}
 	}
 
+	// setup aggregate functions
+	for i := range m.aggFuncs {
+		def := m.aggFuncs[i]
+		err = clearBuilder.DoWithCollectClear(func() (func(), error) {
+			if err := RegisterExtensionAggFunc(def); err != nil {
+				return nil, err
+			}
+
+			return func() {
+				RemoveExtensionAggFunc(def.Name)
+			}, nil
+		})
+
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
 	if err := validateAuthPlugin(m); err != nil {
 		return nil, nil, err
 	}
@@ -417,7 +417,7 @@ func (b *Builder) getTableIDs(m meta.Reader, diff *model.SchemaDiff) (oldTableID
 		}
 	case model.ActionTruncateTable, model.ActionCreateView,
 		model.ActionExchangeTablePartition, model.ActionAlterTablePartitioning,
-		model.ActionRemovePartitioning:
+		model.ActionRemovePartitioning, model.ActionChangePrimaryKey:
 		oldTableID = diff.OldTableID
 		newTableID = diff.TableID
 	default:
@@ -522,6 +522,9 @@ func (b *Builder) applyTableUpdate(m meta.Reader, diff *model.SchemaDiff) ([]int
 func getKeptAllocators(diff *model.SchemaDiff, oldAllocs autoid.Allocators) autoid.Allocators {
 	var autoIDChanged, autoRandomChanged bool
 	switch diff.Type {
+	case model.ActionChangePrimaryKey:
+		// The _tidb_rowid allocator may be moved to the table with the other handle layout.
+		return autoid.Allocators{}
 	case model.ActionRebaseAutoID, model.ActionModifyTableAutoIDCache:
 		autoIDChanged = true
 	case model.ActionRebaseAutoRandomBase:
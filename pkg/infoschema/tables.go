@@ -95,7 +95,8 @@ const (
 	tablePlugins    = "PLUGINS"
 	// TableConstraints is the string constant of TABLE_CONSTRAINTS.
 	TableConstraints = "TABLE_CONSTRAINTS"
-	tableTriggers    = "TRIGGERS"
+	// TableTriggers is the string constant of infoschema table.
+	TableTriggers = "TRIGGERS"
 	// TableUserPrivileges is the string constant of infoschema user privilege table.
 	TableUserPrivileges   = "USER_PRIVILEGES"
 	tableSchemaPrivileges = "SCHEMA_PRIVILEGES"
@@ -104,10 +105,11 @@ const (
 	// TableEngines is the string constant of infoschema table.
 	TableEngines = "ENGINES"
 	// TableViews is the string constant of infoschema table.
-	TableViews          = "VIEWS"
-	tableRoutines       = "ROUTINES"
-	tableParameters     = "PARAMETERS"
-	tableEvents         = "EVENTS"
+	TableViews      = "VIEWS"
+	tableRoutines   = "ROUTINES"
+	tableParameters = "PARAMETERS"
+	// TableEvents is the string constant of infoschema table.
+	TableEvents         = "EVENTS"
 	tableOptimizerTrace = "OPTIMIZER_TRACE"
 	tableTableSpaces    = "TABLESPACES"
 	// TableCollationCharacterSetApplicability is the string constant of infoschema memory table.
@@ -220,6 +222,8 @@ const (
 	TableTiDBPlanCache = "TIDB_PLAN_CACHE"
 	// TableKeyspaceMeta is the table to show the keyspace meta.
 	TableKeyspaceMeta = "KEYSPACE_META"
+	// TableCardinalityFeedback is the table to show the cardinality corrections learned from the executed plans.
+	TableCardinalityFeedback = "CARDINALITY_FEEDBACK"
 )
 
 const (
@@ -263,7 +267,7 @@ var tableIDMap = map[string]int64{
 	// TableSessionVar:    autoid.InformationSchemaDBID + 14,
 	tablePlugins:          autoid.InformationSchemaDBID + 15,
 	TableConstraints:      autoid.InformationSchemaDBID + 16,
-	tableTriggers:         autoid.InformationSchemaDBID + 17,
+	TableTriggers:         autoid.InformationSchemaDBID + 17,
 	TableUserPrivileges:   autoid.InformationSchemaDBID + 18,
 	tableSchemaPrivileges: autoid.InformationSchemaDBID + 19,
 	tableTablePrivileges:  autoid.InformationSchemaDBID + 20,
@@ -272,7 +276,7 @@ var tableIDMap = map[string]int64{
 	TableViews:            autoid.InformationSchemaDBID + 23,
 	tableRoutines:         autoid.InformationSchemaDBID + 24,
 	tableParameters:       autoid.InformationSchemaDBID + 25,
-	tableEvents:           autoid.InformationSchemaDBID + 26,
+	TableEvents:           autoid.InformationSchemaDBID + 26,
 	// Removed, see https://github.com/pingcap/tidb/issues/9154
 	// tableGlobalStatus:                    autoid.InformationSchemaDBID + 27,
 	// tableGlobalVariables:                 autoid.InformationSchemaDBID + 28,
@@ -349,6 +353,7 @@ var tableIDMap = map[string]int64{
 	TableTiDBStatementsStats:             autoid.InformationSchemaDBID + 98,
 	ClusterTableTiDBStatementsStats:      autoid.InformationSchemaDBID + 99,
 	TableKeyspaceMeta:                    autoid.InformationSchemaDBID + 100,
+	TableCardinalityFeedback:             autoid.InformationSchemaDBID + 101,
 }
 
 // columnInfo represents the basic column information of all kinds of INFORMATION_SCHEMA tables
@@ -1854,6 +1859,20 @@ var tablePlanCache = []columnInfo{
 	{name: "LAST_ACTIVE_TIME", tp: mysql.TypeDatetime, size: 19},
 }
 
+var tableCardinalityFeedbackCols = []columnInfo{
+	{name: "TABLE_SCHEMA", tp: mysql.TypeVarchar, size: 64},
+	{name: "TABLE_NAME", tp: mysql.TypeVarchar, size: 64},
+	{name: "PARTITION_NAME", tp: mysql.TypeVarchar, size: 64},
+	{name: "TABLE_ID", tp: mysql.TypeLonglong, size: 21},
+	{name: "PREDICATES", tp: mysql.TypeLongBlob, size: types.UnspecifiedLength},
+	{name: "CORRECTION_FACTOR", tp: mysql.TypeDouble, size: 22},
+	{name: "LAST_EST_ROWS", tp: mysql.TypeDouble, size: 22},
+	{name: "LAST_ACT_ROWS", tp: mysql.TypeDouble, size: 22},
+	{name: "OBSERVATIONS", tp: mysql.TypeLonglong, size: 21},
+	{name: "STATS_VERSION", tp: mysql.TypeLonglong, size: 21, flag: mysql.UnsignedFlag},
+	{name: "LAST_UPDATE_TIME", tp: mysql.TypeDatetime, size: 19},
+}
+
 var tableKeyspaceMetaCols = []columnInfo{
 	{name: "KEYSPACE_NAME", tp: mysql.TypeVarchar, size: 128},
 	{name: "KEYSPACE_ID", tp: mysql.TypeVarchar, size: 64},
@@ -2439,7 +2458,7 @@ var tableNameToColumns = map[string][]columnInfo{
 	TableReferConst:                         referConstCols,
 	tablePlugins:                            pluginsCols,
 	TableConstraints:                        tableConstraintsCols,
-	tableTriggers:                           tableTriggersCols,
+	TableTriggers:                           tableTriggersCols,
 	TableUserPrivileges:                     tableUserPrivilegesCols,
 	tableSchemaPrivileges:                   tableSchemaPrivilegesCols,
 	tableTablePrivileges:                    tableTablePrivilegesCols,
@@ -2448,7 +2467,7 @@ var tableNameToColumns = map[string][]columnInfo{
 	TableViews:                              tableViewsCols,
 	tableRoutines:                           tableRoutinesCols,
 	tableParameters:                         tableParametersCols,
-	tableEvents:                             tableEventsCols,
+	TableEvents:                             tableEventsCols,
 	tableOptimizerTrace:                     tableOptimizerTraceCols,
 	tableTableSpaces:                        tableTableSpacesCols,
 	TableCollationCharacterSetApplicability: tableCollationCharacterSetApplicabilityCols,
@@ -2506,6 +2525,7 @@ var tableNameToColumns = map[string][]columnInfo{
 	TableTiDBIndexUsage:                     tableTiDBIndexUsage,
 	TableTiDBPlanCache:                      tablePlanCache,
 	TableKeyspaceMeta:                       tableKeyspaceMetaCols,
+	TableCardinalityFeedback:                tableCardinalityFeedbackCols,
 }
 
 func createInfoSchemaTable(_ autoid.Allocators, _ func() (pools.Resource, error), meta *model.TableInfo) (table.Table, error) {
@@ -678,9 +678,16 @@ func NewAllocatorsFromTblInfo(r Requirement, dbID int64, tblInfo *model.TableInf
 	tblVer := AllocOptionTableInfoVersion(tblInfo.Version)
 
 	hasRowID := !tblInfo.PKIsHandle && !tblInfo.IsCommonHandle
+	rowIDTblID := tblInfo.ID
+	if pkc := tblInfo.PrimaryKeyChange; pkc != nil && !pkc.TableInfo.HasClusteredIndex() {
+		// The primary key of the table is being changed, _tidb_rowid is always allocated
+		// from the non-clustered side, both before and after the two tables are swapped.
+		hasRowID = true
+		rowIDTblID = pkc.TableInfo.ID
+	}
 	hasAutoIncID := tblInfo.GetAutoIncrementColInfo() != nil
 	if hasRowID || (hasAutoIncID && !tblInfo.SepAutoInc()) {
-		alloc := NewAllocator(r, dbID, tblInfo.ID, tblInfo.IsAutoIncColUnsigned(), RowIDAllocType, idCacheOpt, tblVer)
+		alloc := NewAllocator(r, dbID, rowIDTblID, tblInfo.IsAutoIncColUnsigned(), RowIDAllocType, idCacheOpt, tblVer)
 		allocs = append(allocs, alloc)
 	}
 	if hasAutoIncID && tblInfo.SepAutoInc() {
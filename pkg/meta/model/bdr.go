@@ -105,6 +105,13 @@ var BDRActionMap = map[DDLBDRType][]ActionType{
 		ActionModifyEngineAttribute,
 		ActionAlterTableMode,
 		ActionRefreshMeta,
+		ActionChangePrimaryKey,
+		ActionCreateMaskingPolicy,
+		ActionDropMaskingPolicy,
+		ActionCreateRowPolicy,
+		ActionDropRowPolicy,
+		ActionCreateTrigger,
+		ActionDropTrigger,
 	},
 	UnmanagementDDL: {
 		ActionCreatePlacementPolicy,
@@ -64,7 +64,13 @@ type ColumnInfo struct {
 	State               SchemaState         `json:"state"`
 	Comment             string              `json:"comment"`
 	// A hidden column is used internally(expression index) and are not accessible by users.
-	Hidden           bool `json:"hidden"`
+	Hidden bool `json:"hidden"`
+	// SRID is the spatial reference system ID of the geometry column. Nil means the
+	// column accepts the geometries of any SRID.
+	SRID *uint32 `json:"srid,omitempty"`
+	// MaskingPolicy masks the values of the column in the query results for the
+	// users without the UNMASKED privilege.
+	MaskingPolicy    *MaskingPolicyInfo `json:"masking_policy,omitempty"`
 	*ChangeStateInfo `json:"change_state_info"`
 	// Version means the version of the column info.
 	// Version = 0: For OriginDefaultValue and DefaultValue of timestamp column will stores the default time in system time zone.
@@ -74,6 +80,17 @@ type ColumnInfo struct {
 	Version uint64 `json:"version"`
 }
 
+// MaskingPolicyInfo is the masking policy of a column, see ast.CreateMaskingPolicyStmt.
+type MaskingPolicyInfo struct {
+	Name ast.CIStr       `json:"name"`
+	Type ast.MaskingType `json:"type"`
+	// KeepPrefix and KeepSuffix are the numbers of characters kept by ast.MaskingPartial.
+	KeepPrefix uint64 `json:"keep_prefix,omitempty"`
+	KeepSuffix uint64 `json:"keep_suffix,omitempty"`
+	// Unit is the unit which ast.MaskingDateTrunc truncates the value to.
+	Unit ast.TimeUnitType `json:"unit,omitempty"`
+}
+
 // IsVirtualGenerated checks the column if it is virtual.
 func (c *ColumnInfo) IsVirtualGenerated() bool {
 	return c.IsGenerated() && !c.GeneratedStored
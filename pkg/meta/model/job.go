@@ -115,6 +115,13 @@ const (
 	ActionModifyEngineAttribute  ActionType = 74
 	ActionAlterTableMode         ActionType = 75
 	ActionRefreshMeta            ActionType = 76
+	ActionChangePrimaryKey       ActionType = 77
+	ActionCreateMaskingPolicy    ActionType = 78
+	ActionDropMaskingPolicy      ActionType = 79
+	ActionCreateRowPolicy        ActionType = 80
+	ActionDropRowPolicy          ActionType = 81
+	ActionCreateTrigger          ActionType = 82
+	ActionDropTrigger            ActionType = 83
 )
 
 // ActionMap is the map of DDL ActionType to string.
@@ -190,6 +197,13 @@ var ActionMap = map[ActionType]string{
 	ActionModifyEngineAttribute:         "modify engine attribute",
 	ActionAlterTableMode:                "alter table mode",
 	ActionRefreshMeta:                   "refresh meta",
+	ActionChangePrimaryKey:              "change primary key",
+	ActionCreateMaskingPolicy:           "create masking policy",
+	ActionDropMaskingPolicy:             "drop masking policy",
+	ActionCreateRowPolicy:               "create row policy",
+	ActionDropRowPolicy:                 "drop row policy",
+	ActionCreateTrigger:                 "create trigger",
+	ActionDropTrigger:                   "drop trigger",
 
 	// `ActionAlterTableAlterPartition` is removed and will never be used.
 	// Just left a tombstone here for compatibility.
@@ -760,6 +774,9 @@ func (job *Job) IsRollbackable() bool {
 			// so no-longer rollbackable.
 			return false
 		}
+	case ActionChangePrimaryKey:
+		// The table with the new primary key is visible after StateWriteReorganization.
+		return job.SchemaState != StateDeleteReorganization
 	}
 	return true
 }
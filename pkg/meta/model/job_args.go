@@ -1804,3 +1804,100 @@ func (a *RefreshMetaArgs) decodeV1(job *Job) error {
 func GetRefreshMetaArgs(job *Job) (*RefreshMetaArgs, error) {
 	return getOrDecodeArgs[*RefreshMetaArgs](&RefreshMetaArgs{}, job)
 }
+
+// ChangePrimaryKeyArgs is the arguments for change primary key job.
+type ChangePrimaryKeyArgs struct {
+	// NewTableID is the ID of the table after the primary key is changed.
+	// It's filled by job submitter.
+	NewTableID int64 `json:"new_table_id,omitempty"`
+	// PrimaryKey is the new primary key, nil means the primary key is dropped.
+	PrimaryKey *IndexArg `json:"primary_key,omitempty"`
+
+	// Below are used for finished args.
+	// DroppedTableID is the ID of the table whose data should be deleted, it's
+	// the original table if the job is done, and the new table if it's rolled back.
+	DroppedTableID int64 `json:"dropped_table_id,omitempty"`
+	// DroppedIndexes are the temporary indexes of the remaining table.
+	DroppedIndexes []TableIDIndexID `json:"dropped_indexes,omitempty"`
+}
+
+func (a *ChangePrimaryKeyArgs) getArgsV1(*Job) []any {
+	return []any{a}
+}
+
+func (a *ChangePrimaryKeyArgs) decodeV1(job *Job) error {
+	return errors.Trace(job.decodeArgs(a))
+}
+
+func (a *ChangePrimaryKeyArgs) getFinishedArgsV1(*Job) []any {
+	return []any{a}
+}
+
+// GetChangePrimaryKeyArgs gets the change primary key args.
+func GetChangePrimaryKeyArgs(job *Job) (*ChangePrimaryKeyArgs, error) {
+	return getOrDecodeArgs[*ChangePrimaryKeyArgs](&ChangePrimaryKeyArgs{}, job)
+}
+
+// GetFinishedChangePrimaryKeyArgs gets the change primary key args after the job is finished.
+func GetFinishedChangePrimaryKeyArgs(job *Job) (*ChangePrimaryKeyArgs, error) {
+	return getOrDecodeArgs[*ChangePrimaryKeyArgs](&ChangePrimaryKeyArgs{}, job)
+}
+
+// MaskingPolicyArgs is the arguments for create/drop masking policy job.
+type MaskingPolicyArgs struct {
+	// ColumnName is the column which the policy is created on, it's empty when
+	// the policy is dropped.
+	ColumnName ast.CIStr          `json:"column_name,omitempty"`
+	Policy     *MaskingPolicyInfo `json:"policy,omitempty"`
+}
+
+func (a *MaskingPolicyArgs) getArgsV1(*Job) []any {
+	return []any{a}
+}
+
+func (a *MaskingPolicyArgs) decodeV1(job *Job) error {
+	return errors.Trace(job.decodeArgs(a))
+}
+
+// GetMaskingPolicyArgs gets the create/drop masking policy args.
+func GetMaskingPolicyArgs(job *Job) (*MaskingPolicyArgs, error) {
+	return getOrDecodeArgs[*MaskingPolicyArgs](&MaskingPolicyArgs{}, job)
+}
+
+// RowPolicyArgs is the arguments for create/drop row policy job.
+type RowPolicyArgs struct {
+	// Policy is the policy to create, only its name is used when the policy is dropped.
+	Policy *RowPolicyInfo `json:"policy,omitempty"`
+}
+
+func (a *RowPolicyArgs) getArgsV1(*Job) []any {
+	return []any{a}
+}
+
+func (a *RowPolicyArgs) decodeV1(job *Job) error {
+	return errors.Trace(job.decodeArgs(a))
+}
+
+// GetRowPolicyArgs gets the create/drop row policy args.
+func GetRowPolicyArgs(job *Job) (*RowPolicyArgs, error) {
+	return getOrDecodeArgs[*RowPolicyArgs](&RowPolicyArgs{}, job)
+}
+
+// TriggerArgs is the arguments for create/drop trigger job.
+type TriggerArgs struct {
+	// Trigger is the trigger to create, only its name is used when the trigger is dropped.
+	Trigger *TriggerInfo `json:"trigger,omitempty"`
+}
+
+func (a *TriggerArgs) getArgsV1(*Job) []any {
+	return []any{a}
+}
+
+func (a *TriggerArgs) decodeV1(job *Job) error {
+	return errors.Trace(job.decodeArgs(a))
+}
+
+// GetTriggerArgs gets the create/drop trigger args.
+func GetTriggerArgs(job *Job) (*TriggerArgs, error) {
+	return getOrDecodeArgs[*TriggerArgs](&TriggerArgs{}, job)
+}
@@ -19,6 +19,7 @@ import (
 	"testing"
 
 	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/auth"
 	"github.com/pingcap/tidb/pkg/parser/mysql"
 	"github.com/stretchr/testify/require"
 	pdhttp "github.com/tikv/pd/client/http"
@@ -1182,3 +1183,101 @@ func TestModifyColumnsArgs(t *testing.T) {
 	require.NoError(t, json.Unmarshal(j2.RawArgs, &rawArgs))
 	require.Len(t, rawArgs, 5)
 }
+
+func TestChangePrimaryKeyArgs(t *testing.T) {
+	inArgs := &ChangePrimaryKeyArgs{
+		NewTableID: 123,
+		PrimaryKey: &IndexArg{
+			IndexName: ast.NewCIStr("PRIMARY"),
+			IsPK:      true,
+			Unique:    true,
+			IndexPartSpecifications: []*ast.IndexPartSpecification{
+				{Column: &ast.ColumnName{Name: ast.NewCIStr("a")}, Length: -1},
+			},
+			IndexOption: &ast.IndexOption{PrimaryKeyTp: ast.PrimaryKeyTypeClustered},
+		},
+	}
+	for _, v := range []JobVersion{JobVersion1, JobVersion2} {
+		j2 := &Job{}
+		require.NoError(t, j2.Decode(getJobBytes(t, inArgs, v, ActionChangePrimaryKey)))
+		args, err := GetChangePrimaryKeyArgs(j2)
+		require.NoError(t, err)
+		require.Equal(t, inArgs.NewTableID, args.NewTableID)
+		require.Equal(t, inArgs.PrimaryKey.IndexName, args.PrimaryKey.IndexName)
+		require.Equal(t, "a", args.PrimaryKey.IndexPartSpecifications[0].Column.Name.L)
+		require.Equal(t, ast.PrimaryKeyTypeClustered, args.PrimaryKey.IndexOption.PrimaryKeyTp)
+	}
+
+	finishedArgs := &ChangePrimaryKeyArgs{
+		DroppedTableID: 122,
+		DroppedIndexes: []TableIDIndexID{{TableID: 123, IndexID: 3}},
+	}
+	for _, v := range []JobVersion{JobVersion1, JobVersion2} {
+		j2 := &Job{}
+		require.NoError(t, j2.Decode(getFinishedJobBytes(t, finishedArgs, v, ActionChangePrimaryKey)))
+		args, err := GetFinishedChangePrimaryKeyArgs(j2)
+		require.NoError(t, err)
+		require.Equal(t, finishedArgs, args)
+	}
+}
+
+func TestMaskingPolicyArgs(t *testing.T) {
+	inArgs := &MaskingPolicyArgs{
+		ColumnName: ast.NewCIStr("c"),
+		Policy: &MaskingPolicyInfo{
+			Name:       ast.NewCIStr("p"),
+			Type:       ast.MaskingPartial,
+			KeepPrefix: 2,
+			KeepSuffix: 4,
+		},
+	}
+	for _, v := range []JobVersion{JobVersion1, JobVersion2} {
+		j2 := &Job{}
+		require.NoError(t, j2.Decode(getJobBytes(t, inArgs, v, ActionCreateMaskingPolicy)))
+		args, err := GetMaskingPolicyArgs(j2)
+		require.NoError(t, err)
+		require.Equal(t, inArgs, args)
+	}
+}
+
+func TestRowPolicyArgs(t *testing.T) {
+	inArgs := &RowPolicyArgs{
+		Policy: &RowPolicyInfo{
+			Name:        ast.NewCIStr("p"),
+			Restrictive: true,
+			Command:     ast.RowPolicySelect,
+			Roles:       []*auth.RoleIdentity{{Username: "r1", Hostname: "%"}},
+			UsingExpr:   "`a` > 1",
+		},
+	}
+	for _, v := range []JobVersion{JobVersion1, JobVersion2} {
+		j2 := &Job{}
+		require.NoError(t, j2.Decode(getJobBytes(t, inArgs, v, ActionCreateRowPolicy)))
+		args, err := GetRowPolicyArgs(j2)
+		require.NoError(t, err)
+		require.Equal(t, inArgs, args)
+	}
+}
+
+func TestTriggerArgs(t *testing.T) {
+	inArgs := &TriggerArgs{
+		Trigger: &TriggerInfo{
+			Name:                ast.NewCIStr("tr"),
+			Timing:              ast.TriggerAfter,
+			Event:               ast.TriggerUpdate,
+			Body:                "insert into log values (old.a, new.a)",
+			Definer:             "root@%",
+			SQLMode:             mysql.ModeStrictTransTables,
+			CharacterSetClient:  "utf8mb4",
+			CollationConnection: "utf8mb4_bin",
+			CreateTS:            123,
+		},
+	}
+	for _, v := range []JobVersion{JobVersion1, JobVersion2} {
+		j2 := &Job{}
+		require.NoError(t, j2.Decode(getJobBytes(t, inArgs, v, ActionCreateTrigger)))
+		args, err := GetTriggerArgs(j2)
+		require.NoError(t, err)
+		require.Equal(t, inArgs, args)
+	}
+}
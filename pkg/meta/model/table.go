@@ -191,6 +191,10 @@ type TableInfo struct {
 	TableCacheStatusType `json:"cache_table_status"`
 	PlacementPolicyRef   *PolicyRefInfo `json:"policy_ref_info"`
 
+	// OnCommitPreserveRows means the rows of the global temporary table are kept
+	// in the session after the transaction commits.
+	OnCommitPreserveRows bool `json:"on_commit_preserve_rows,omitempty"`
+
 	// StatsOptions is used when do analyze/auto-analyze for each table
 	StatsOptions *StatsOptions `json:"stats_options"`
 
@@ -198,6 +202,18 @@ type TableInfo struct {
 
 	TTLInfo *TTLInfo `json:"ttl_info"`
 
+	// PrimaryKeyChange is not nil when the primary key of the table is being changed.
+	PrimaryKeyChange *PrimaryKeyChangeInfo `json:"primary_key_change,omitempty"`
+
+	// RowPolicies are the row-level security policies of the table.
+	RowPolicies []*RowPolicyInfo `json:"row_policies,omitempty"`
+
+	// Triggers are the row triggers of the table, they are activated in the order of the slice.
+	Triggers []*TriggerInfo `json:"triggers,omitempty"`
+
+	// MaterializedView is not nil when the table stores the result of a materialized view.
+	MaterializedView *MaterializedViewInfo `json:"materialized_view,omitempty"`
+
 	// Revision is per table schema's version, it will be increased when the schema changed.
 	Revision uint64 `json:"revision"`
 
@@ -273,6 +289,24 @@ func (t *TableInfo) Clone() *TableInfo {
 	if t.TTLInfo != nil {
 		nt.TTLInfo = t.TTLInfo.Clone()
 	}
+	if t.PrimaryKeyChange != nil {
+		nt.PrimaryKeyChange = t.PrimaryKeyChange.Clone()
+	}
+	if len(t.RowPolicies) > 0 {
+		nt.RowPolicies = make([]*RowPolicyInfo, len(t.RowPolicies))
+		for i := range t.RowPolicies {
+			nt.RowPolicies[i] = t.RowPolicies[i].Clone()
+		}
+	}
+	if len(t.Triggers) > 0 {
+		nt.Triggers = make([]*TriggerInfo, len(t.Triggers))
+		for i := range t.Triggers {
+			nt.Triggers[i] = t.Triggers[i].Clone()
+		}
+	}
+	if t.MaterializedView != nil {
+		nt.MaterializedView = t.MaterializedView.Clone()
+	}
 
 	return &nt
 }
@@ -500,6 +534,12 @@ func (t *TableInfo) HasClusteredIndex() bool {
 	return t.PKIsHandle || t.IsCommonHandle
 }
 
+// HasSessionTemporaryData checks if the rows of the temporary table are kept
+// in the session across transactions.
+func (t *TableInfo) HasSessionTemporaryData() bool {
+	return t.TempTableType == TempTableLocal || (t.TempTableType == TempTableGlobal && t.OnCommitPreserveRows)
+}
+
 // IsView checks if TableInfo is a view.
 func (t *TableInfo) IsView() bool {
 	return t.View != nil
@@ -510,6 +550,12 @@ func (t *TableInfo) IsSequence() bool {
 	return t.Sequence != nil
 }
 
+// IsMaterializedView checks if TableInfo is a materialized view.
+// A materialized view is also a base table, which stores the result of its query.
+func (t *TableInfo) IsMaterializedView() bool {
+	return t.MaterializedView != nil
+}
+
 // IsBaseTable checks to see the table is neither a view nor a sequence.
 func (t *TableInfo) IsBaseTable() bool {
 	return t.Sequence == nil && t.View == nil
@@ -726,6 +772,19 @@ type ViewInfo struct {
 	Cols        []ast.CIStr         `json:"view_cols"`
 }
 
+// MaterializedViewInfo provides meta data describing a materialized view.
+type MaterializedViewInfo struct {
+	// SelectStmt is the query of the materialized view, the table names in it are qualified by the schema.
+	SelectStmt    string                            `json:"select"`
+	RefreshMethod ast.MaterializedViewRefreshMethod `json:"refresh_method"`
+}
+
+// Clone clones MaterializedViewInfo.
+func (m *MaterializedViewInfo) Clone() *MaterializedViewInfo {
+	nm := *m
+	return &nm
+}
+
 // Some constants for sequence.
 const (
 	DefaultSequenceCacheBool          = true
@@ -762,6 +821,126 @@ type ExchangePartitionInfo struct {
 	XXXExchangePartitionFlag bool `json:"exchange_partition_flag"`
 }
 
+// PrimaryKeyChangeStage is the stage of the write reorganization of a primary key change.
+type PrimaryKeyChangeStage byte
+
+// List of PrimaryKeyChangeStage.
+const (
+	// PrimaryKeyChangeStageNone means the write reorganization is not started.
+	PrimaryKeyChangeStageNone PrimaryKeyChangeStage = iota
+	// PrimaryKeyChangeStageHandleIndex backfills the handle index of the original table.
+	PrimaryKeyChangeStageHandleIndex
+	// PrimaryKeyChangeStageCopyRows copies the rows to the table with the new primary key.
+	PrimaryKeyChangeStageCopyRows
+	// PrimaryKeyChangeStageIndexes backfills the indexes of the table with the new primary key.
+	PrimaryKeyChangeStageIndexes
+)
+
+// PrimaryKeyChangeInfo provides the info of a table whose primary key is being changed.
+// The rows of the table are double written to the table of TableInfo, which has the
+// other handle layout.
+type PrimaryKeyChangeInfo struct {
+	// TableInfo is the table with the new primary key until the two tables are swapped,
+	// and the table with the original primary key after that.
+	TableInfo *TableInfo `json:"table_info"`
+	// DDLState is the state of the double write to TableInfo.
+	DDLState SchemaState `json:"ddl_state"`
+	// Stage is the stage of the write reorganization.
+	Stage PrimaryKeyChangeStage `json:"stage"`
+	// HandleIndexID is the ID of a temporary unique index on the clustered primary key
+	// columns, which is used to locate the double written rows in the table without a
+	// clustered index. It's 0 if both tables have a clustered index.
+	HandleIndexID int64 `json:"handle_index_id,omitempty"`
+}
+
+// Clone clones PrimaryKeyChangeInfo.
+func (p *PrimaryKeyChangeInfo) Clone() *PrimaryKeyChangeInfo {
+	np := *p
+	np.TableInfo = p.TableInfo.Clone()
+	return &np
+}
+
+// ReorgTableInfo returns the table whose indexes are backfilled in the current stage,
+// tblInfo is the table that p belongs to.
+func (p *PrimaryKeyChangeInfo) ReorgTableInfo(tblInfo *TableInfo) *TableInfo {
+	if p.Stage == PrimaryKeyChangeStageIndexes {
+		return p.TableInfo
+	}
+	return tblInfo
+}
+
+// RowPolicyInfo is a row-level security policy of a table, see ast.CreateRowPolicyStmt.
+type RowPolicyInfo struct {
+	Name        ast.CIStr            `json:"name"`
+	Restrictive bool                 `json:"restrictive,omitempty"`
+	Command     ast.RowPolicyCommand `json:"command,omitempty"`
+	// Roles is empty if the policy applies to all users.
+	Roles []*auth.RoleIdentity `json:"roles,omitempty"`
+	// UsingExpr filters the rows which are visible to SELECT, UPDATE and DELETE.
+	UsingExpr string `json:"using_expr,omitempty"`
+	// CheckExpr checks the rows written by INSERT.
+	CheckExpr string `json:"check_expr,omitempty"`
+}
+
+// Clone clones RowPolicyInfo.
+func (p *RowPolicyInfo) Clone() *RowPolicyInfo {
+	np := *p
+	if len(p.Roles) > 0 {
+		np.Roles = make([]*auth.RoleIdentity, len(p.Roles))
+		for i, role := range p.Roles {
+			r := *role
+			np.Roles[i] = &r
+		}
+	}
+	return &np
+}
+
+// AppliesTo returns whether the policy applies to the command.
+func (p *RowPolicyInfo) AppliesTo(cmd ast.RowPolicyCommand) bool {
+	return p.Command == ast.RowPolicyAll || p.Command == cmd
+}
+
+// FindRowPolicy finds the row-level security policy by name.
+func (t *TableInfo) FindRowPolicy(name string) *RowPolicyInfo {
+	for _, p := range t.RowPolicies {
+		if p.Name.L == strings.ToLower(name) {
+			return p
+		}
+	}
+	return nil
+}
+
+// TriggerInfo is a row trigger of a table, see ast.CreateTriggerStmt.
+type TriggerInfo struct {
+	Name   ast.CIStr         `json:"name"`
+	Timing ast.TriggerTiming `json:"timing"`
+	Event  ast.TriggerEvent  `json:"event"`
+	// Body is the text of the statement executed when the trigger is activated.
+	Body    string `json:"body"`
+	Definer string `json:"definer"`
+	// SQLMode is the sql_mode when the trigger is created, the body is parsed and executed with it.
+	SQLMode             mysql.SQLMode `json:"sql_mode"`
+	CharacterSetClient  string        `json:"character_set_client"`
+	CollationConnection string        `json:"collation_connection"`
+	CreateTS            uint64        `json:"create_ts"`
+}
+
+// Clone clones TriggerInfo.
+func (t *TriggerInfo) Clone() *TriggerInfo {
+	nt := *t
+	return &nt
+}
+
+// FindTrigger finds the trigger by name.
+func (t *TableInfo) FindTrigger(name string) *TriggerInfo {
+	for _, trigger := range t.Triggers {
+		if trigger.Name.L == strings.ToLower(name) {
+			return trigger
+		}
+	}
+	return nil
+}
+
 // UpdateIndexInfo is to carry the entries in the list of indexes in UPDATE INDEXES
 // during ALTER TABLE t PARTITION BY ... UPDATE INDEXES (idx_a GLOBAL, idx_b LOCAL...)
 type UpdateIndexInfo struct {
@@ -231,10 +231,11 @@ const (
 	LblAction = "action"
 
 	// Used by BackfillProgressGauge
-	LblAddIndex       = "add_index"
-	LblAddIndexMerge  = "add_index_merge_tmp"
-	LblModifyColumn   = "modify_column"
-	LblReorgPartition = "reorganize_partition"
+	LblAddIndex         = "add_index"
+	LblAddIndexMerge    = "add_index_merge_tmp"
+	LblModifyColumn     = "modify_column"
+	LblReorgPartition   = "reorganize_partition"
+	LblChangePrimaryKey = "change_primary_key"
 
 	// Used by BackfillTotalCounter
 	LblAddIdxRate         = "add_idx_rate"
@@ -242,6 +243,7 @@ const (
 	LblCleanupIdxRate     = "cleanup_idx_rate"
 	LblUpdateColRate      = "update_col_rate"
 	LblReorgPartitionRate = "reorg_partition_rate"
+	LblChangePKRate       = "change_pk_rate"
 )
 
 // generateReorgLabel returns the label with schema name, table name and optional column/index names.
@@ -184,6 +184,9 @@ func GetStmtLabel(stmtNode StmtNode) string {
 		if x.IsView {
 			return "DropView"
 		}
+		if x.IsMaterializedView {
+			return "DropMaterializedView"
+		}
 		return "DropTable"
 	case *ExplainStmt:
 		if _, ok := x.Stmt.(*ShowStmt); ok {
@@ -202,6 +205,8 @@ func GetStmtLabel(stmtNode StmtNode) string {
 		return "ImportInto"
 	case *LoadDataStmt:
 		return "LoadData"
+	case *MergeStmt:
+		return "Merge"
 	case *RollbackStmt:
 		return "Rollback"
 	case *SelectStmt:
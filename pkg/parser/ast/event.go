@@ -0,0 +1,368 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import (
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/parser/auth"
+	"github.com/pingcap/tidb/pkg/parser/format"
+)
+
+var (
+	_ StmtNode = &CreateEventStmt{}
+	_ StmtNode = &AlterEventStmt{}
+	_ StmtNode = &DropEventStmt{}
+)
+
+// EventCompletion is the `ON COMPLETION [NOT] PRESERVE` option of an event.
+type EventCompletion int
+
+// EventCompletion types.
+const (
+	EventCompletionUnspecified EventCompletion = iota
+	EventCompletionNotPreserve
+	EventCompletionPreserve
+)
+
+// Restore implements Node interface.
+func (c EventCompletion) Restore(ctx *format.RestoreCtx) error {
+	switch c {
+	case EventCompletionNotPreserve:
+		ctx.WriteKeyWord("ON COMPLETION NOT PRESERVE")
+	case EventCompletionPreserve:
+		ctx.WriteKeyWord("ON COMPLETION PRESERVE")
+	default:
+		return errors.Errorf("invalid EventCompletion: %d", c)
+	}
+	return nil
+}
+
+// EventStatus is the status of an event.
+type EventStatus int
+
+// EventStatus types.
+const (
+	EventStatusUnspecified EventStatus = iota
+	EventStatusEnable
+	EventStatusDisable
+	EventStatusSlavesideDisable
+)
+
+// String implements fmt.Stringer interface, it returns the status shown in `information_schema.events`.
+func (s EventStatus) String() string {
+	switch s {
+	case EventStatusEnable:
+		return "ENABLED"
+	case EventStatusDisable:
+		return "DISABLED"
+	case EventStatusSlavesideDisable:
+		return "SLAVESIDE_DISABLED"
+	}
+	return ""
+}
+
+// Restore implements Node interface.
+func (s EventStatus) Restore(ctx *format.RestoreCtx) error {
+	switch s {
+	case EventStatusEnable:
+		ctx.WriteKeyWord("ENABLE")
+	case EventStatusDisable:
+		ctx.WriteKeyWord("DISABLE")
+	case EventStatusSlavesideDisable:
+		ctx.WriteKeyWord("DISABLE ON SLAVE")
+	default:
+		return errors.Errorf("invalid EventStatus: %d", s)
+	}
+	return nil
+}
+
+// EventSchedule is the schedule of an event, it's either `AT timestamp` or
+// `EVERY interval [STARTS timestamp] [ENDS timestamp]`.
+type EventSchedule struct {
+	// At is the execution time of a one-time event.
+	At ExprNode
+	// Every and Unit are the interval of a recurring event.
+	Every ExprNode
+	Unit  TimeUnitType
+	// Starts and Ends are the optional range of a recurring event.
+	Starts ExprNode
+	Ends   ExprNode
+}
+
+// Restore implements Node interface.
+func (n *EventSchedule) Restore(ctx *format.RestoreCtx) error {
+	if n.At != nil {
+		ctx.WriteKeyWord("AT ")
+		if err := n.At.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore EventSchedule.At")
+		}
+		return nil
+	}
+	ctx.WriteKeyWord("EVERY ")
+	if err := n.Every.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore EventSchedule.Every")
+	}
+	ctx.WritePlain(" ")
+	ctx.WriteKeyWord(n.Unit.String())
+	if n.Starts != nil {
+		ctx.WriteKeyWord(" STARTS ")
+		if err := n.Starts.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore EventSchedule.Starts")
+		}
+	}
+	if n.Ends != nil {
+		ctx.WriteKeyWord(" ENDS ")
+		if err := n.Ends.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore EventSchedule.Ends")
+		}
+	}
+	return nil
+}
+
+func (n *EventSchedule) accept(v Visitor) bool {
+	for _, expr := range []*ExprNode{&n.At, &n.Every, &n.Starts, &n.Ends} {
+		if *expr == nil {
+			continue
+		}
+		node, ok := (*expr).Accept(v)
+		if !ok {
+			return false
+		}
+		*expr = node.(ExprNode)
+	}
+	return true
+}
+
+func restoreEventDefiner(ctx *format.RestoreCtx, definer *auth.UserIdentity) {
+	ctx.WriteKeyWord("DEFINER")
+	ctx.WritePlain(" = ")
+	if definer.CurrentUser {
+		ctx.WriteKeyWord("current_user")
+	} else {
+		ctx.WriteName(definer.Username)
+		if definer.Hostname != "" {
+			ctx.WritePlain("@")
+			ctx.WriteName(definer.Hostname)
+		}
+	}
+	ctx.WritePlain(" ")
+}
+
+// CreateEventStmt is a statement to create an event.
+// See https://dev.mysql.com/doc/refman/8.0/en/create-event.html
+type CreateEventStmt struct {
+	stmtNode
+
+	IfNotExists  bool
+	Definer      *auth.UserIdentity
+	EventName    *TableName
+	Schedule     *EventSchedule
+	OnCompletion EventCompletion
+	Status       EventStatus
+	Comment      string
+	// Body is the statement executed by the event, its text is kept by `Body.Text()`.
+	Body StmtNode
+}
+
+// Restore implements Node interface.
+func (n *CreateEventStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("CREATE ")
+	restoreEventDefiner(ctx, n.Definer)
+	ctx.WriteKeyWord("EVENT ")
+	if n.IfNotExists {
+		ctx.WriteKeyWord("IF NOT EXISTS ")
+	}
+	if err := n.EventName.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore CreateEventStmt.EventName")
+	}
+	ctx.WriteKeyWord(" ON SCHEDULE ")
+	if err := n.Schedule.Restore(ctx); err != nil {
+		return err
+	}
+	if n.OnCompletion != EventCompletionUnspecified {
+		ctx.WritePlain(" ")
+		if err := n.OnCompletion.Restore(ctx); err != nil {
+			return err
+		}
+	}
+	if n.Status != EventStatusUnspecified {
+		ctx.WritePlain(" ")
+		if err := n.Status.Restore(ctx); err != nil {
+			return err
+		}
+	}
+	if n.Comment != "" {
+		ctx.WriteKeyWord(" COMMENT ")
+		ctx.WriteString(n.Comment)
+	}
+	ctx.WriteKeyWord(" DO ")
+	if err := n.Body.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore CreateEventStmt.Body")
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *CreateEventStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*CreateEventStmt)
+	node, ok := n.EventName.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.EventName = node.(*TableName)
+	if !n.Schedule.accept(v) {
+		return n, false
+	}
+	node, ok = n.Body.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Body = node.(StmtNode)
+	return v.Leave(n)
+}
+
+// AlterEventStmt is a statement to alter an event, the unspecified options are not changed.
+// See https://dev.mysql.com/doc/refman/8.0/en/alter-event.html
+type AlterEventStmt struct {
+	stmtNode
+
+	Definer      *auth.UserIdentity
+	EventName    *TableName
+	Schedule     *EventSchedule
+	OnCompletion EventCompletion
+	NewName      *TableName
+	Status       EventStatus
+	Comment      *string
+	Body         StmtNode
+}
+
+// Restore implements Node interface.
+func (n *AlterEventStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("ALTER ")
+	if n.Definer != nil {
+		restoreEventDefiner(ctx, n.Definer)
+	}
+	ctx.WriteKeyWord("EVENT ")
+	if err := n.EventName.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore AlterEventStmt.EventName")
+	}
+	if n.Schedule != nil {
+		ctx.WriteKeyWord(" ON SCHEDULE ")
+		if err := n.Schedule.Restore(ctx); err != nil {
+			return err
+		}
+	}
+	if n.OnCompletion != EventCompletionUnspecified {
+		ctx.WritePlain(" ")
+		if err := n.OnCompletion.Restore(ctx); err != nil {
+			return err
+		}
+	}
+	if n.NewName != nil {
+		ctx.WriteKeyWord(" RENAME TO ")
+		if err := n.NewName.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore AlterEventStmt.NewName")
+		}
+	}
+	if n.Status != EventStatusUnspecified {
+		ctx.WritePlain(" ")
+		if err := n.Status.Restore(ctx); err != nil {
+			return err
+		}
+	}
+	if n.Comment != nil {
+		ctx.WriteKeyWord(" COMMENT ")
+		ctx.WriteString(*n.Comment)
+	}
+	if n.Body != nil {
+		ctx.WriteKeyWord(" DO ")
+		if err := n.Body.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore AlterEventStmt.Body")
+		}
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *AlterEventStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*AlterEventStmt)
+	node, ok := n.EventName.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.EventName = node.(*TableName)
+	if n.Schedule != nil && !n.Schedule.accept(v) {
+		return n, false
+	}
+	if n.NewName != nil {
+		node, ok = n.NewName.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.NewName = node.(*TableName)
+	}
+	if n.Body != nil {
+		node, ok = n.Body.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Body = node.(StmtNode)
+	}
+	return v.Leave(n)
+}
+
+// DropEventStmt is a statement to drop an event.
+// See https://dev.mysql.com/doc/refman/8.0/en/drop-event.html
+type DropEventStmt struct {
+	stmtNode
+
+	IfExists  bool
+	EventName *TableName
+}
+
+// Restore implements Node interface.
+func (n *DropEventStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("DROP EVENT ")
+	if n.IfExists {
+		ctx.WriteKeyWord("IF EXISTS ")
+	}
+	if err := n.EventName.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore DropEventStmt.EventName")
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *DropEventStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*DropEventStmt)
+	node, ok := n.EventName.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.EventName = node.(*TableName)
+	return v.Leave(n)
+}
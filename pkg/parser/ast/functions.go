@@ -361,6 +361,40 @@ const (
 	VecFromText             = "vec_from_text"
 	VecAsText               = "vec_as_text"
 
+	// spatial functions
+	Point              = "point"
+	LineString         = "linestring"
+	Polygon            = "polygon"
+	MultiPoint         = "multipoint"
+	MultiLineString    = "multilinestring"
+	MultiPolygon       = "multipolygon"
+	GeomCollection     = "geomcollection"
+	GeometryCollection = "geometrycollection"
+	STGeomFromText     = "st_geomfromtext"
+	STGeometryFromText = "st_geometryfromtext"
+	STGeomFromWKB      = "st_geomfromwkb"
+	STGeometryFromWKB  = "st_geometryfromwkb"
+	STGeomFromGeoJSON  = "st_geomfromgeojson"
+	STAsText           = "st_astext"
+	STAsWKT            = "st_aswkt"
+	STAsBinary         = "st_asbinary"
+	STAsWKB            = "st_aswkb"
+	STAsGeoJSON        = "st_asgeojson"
+	STSRID             = "st_srid"
+	STGeometryType     = "st_geometrytype"
+	STIsEmpty          = "st_isempty"
+	STX                = "st_x"
+	STY                = "st_y"
+	STContains         = "st_contains"
+	STWithin           = "st_within"
+	STIntersects       = "st_intersects"
+	STDisjoint         = "st_disjoint"
+	STEquals           = "st_equals"
+	STDistance         = "st_distance"
+	STDistanceSphere   = "st_distance_sphere"
+	STArea             = "st_area"
+	STLength           = "st_length"
+
 	// FTS functions (tidb extension)
 	FTSMatchWord = "fts_match_word"
 
@@ -921,6 +955,8 @@ type WindowFuncExpr struct {
 	// FromLast indicates the calculation direction of this window function.
 	// MySQL only supports calculation from first, so we need to raise error if it is true.
 	FromLast bool
+	// Order is only used in `group_concat`.
+	Order *OrderByClause
 	// Spec is the specification of this window.
 	Spec WindowSpec
 }
@@ -929,7 +965,13 @@ type WindowFuncExpr struct {
 func (n *WindowFuncExpr) Restore(ctx *format.RestoreCtx) error {
 	ctx.WriteKeyWord(n.Name)
 	ctx.WritePlain("(")
-	for i, v := range n.Args {
+	args := n.Args
+	isGroupConcat := strings.ToLower(n.Name) == AggFuncGroupConcat
+	if isGroupConcat {
+		// The last arg of `group_concat` is the separator.
+		args = args[:len(args)-1]
+	}
+	for i, v := range args {
 		if i != 0 {
 			ctx.WritePlain(", ")
 		} else if n.Distinct {
@@ -939,6 +981,18 @@ func (n *WindowFuncExpr) Restore(ctx *format.RestoreCtx) error {
 			return errors.Annotatef(err, "An error occurred while restore WindowFuncExpr.Args[%d]", i)
 		}
 	}
+	if isGroupConcat {
+		if n.Order != nil {
+			ctx.WritePlain(" ")
+			if err := n.Order.Restore(ctx); err != nil {
+				return errors.Annotate(err, "An error occurred while restore WindowFuncExpr.Order")
+			}
+		}
+		ctx.WriteKeyWord(" SEPARATOR ")
+		if err := n.Args[len(n.Args)-1].Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore WindowFuncExpr.Args SEPARATOR")
+		}
+	}
 	ctx.WritePlain(")")
 	if n.FromLast {
 		ctx.WriteKeyWord(" FROM LAST")
@@ -973,6 +1027,13 @@ func (n *WindowFuncExpr) Accept(v Visitor) (Node, bool) {
 		}
 		n.Args[i] = node.(ExprNode)
 	}
+	if n.Order != nil {
+		node, ok := n.Order.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Order = node.(*OrderByClause)
+	}
 	node, ok := n.Spec.Accept(v)
 	if !ok {
 		return n, false
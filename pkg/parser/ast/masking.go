@@ -0,0 +1,185 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import (
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/parser/format"
+)
+
+var (
+	_ DDLNode = &CreateMaskingPolicyStmt{}
+	_ DDLNode = &DropMaskingPolicyStmt{}
+)
+
+// MaskingType is the type of the masking function of a masking policy.
+type MaskingType int
+
+// MaskingType types.
+const (
+	// MaskingRedact replaces every character of the value with '*'.
+	MaskingRedact MaskingType = iota + 1
+	// MaskingPartial keeps the prefix and the suffix of the value and replaces the other characters with '*'.
+	MaskingPartial
+	// MaskingHash replaces the value with its SHA-256 hash.
+	MaskingHash
+	// MaskingNull replaces the value with NULL.
+	MaskingNull
+	// MaskingDateTrunc truncates the date or time value to the unit.
+	MaskingDateTrunc
+)
+
+// String implements fmt.Stringer interface.
+func (t MaskingType) String() string {
+	switch t {
+	case MaskingRedact:
+		return "REDACT"
+	case MaskingPartial:
+		return "PARTIAL"
+	case MaskingHash:
+		return "HASH"
+	case MaskingNull:
+		return "NULL"
+	case MaskingDateTrunc:
+		return "DATE_TRUNC"
+	}
+	return ""
+}
+
+// NewMaskingOption creates a MaskingOption by the name of the masking function,
+// it returns nil if the name is unknown.
+func NewMaskingOption(name string) *MaskingOption {
+	for _, tp := range []MaskingType{MaskingRedact, MaskingPartial, MaskingHash, MaskingNull, MaskingDateTrunc} {
+		if strings.EqualFold(tp.String(), name) {
+			return &MaskingOption{Tp: tp}
+		}
+	}
+	return nil
+}
+
+// MaskingOption is the masking function of a masking policy.
+type MaskingOption struct {
+	Tp MaskingType
+	// KeepPrefix and KeepSuffix are the numbers of characters kept by MaskingPartial.
+	KeepPrefix uint64
+	KeepSuffix uint64
+	// Unit is the unit which MaskingDateTrunc truncates the value to.
+	Unit TimeUnitType
+}
+
+// Restore implements Node interface.
+func (n *MaskingOption) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord(n.Tp.String())
+	switch n.Tp {
+	case MaskingPartial:
+		ctx.WritePlainf("(%d, %d)", n.KeepPrefix, n.KeepSuffix)
+	case MaskingDateTrunc:
+		ctx.WritePlain("(")
+		ctx.WriteKeyWord(n.Unit.String())
+		ctx.WritePlain(")")
+	}
+	return nil
+}
+
+// CreateMaskingPolicyStmt is a statement to create a masking policy on a column.
+// The values of the column are masked in the query results for the users without
+// the UNMASKED privilege.
+//
+//	CREATE MASKING POLICY [IF NOT EXISTS] policy_name ON tbl_name (col_name)
+//	USING {REDACT | PARTIAL(prefix, suffix) | HASH | NULL | DATE_TRUNC(unit)}
+type CreateMaskingPolicyStmt struct {
+	ddlNode
+
+	IfNotExists bool
+	PolicyName  CIStr
+	Table       *TableName
+	Column      CIStr
+	Option      *MaskingOption
+}
+
+// Restore implements Node interface.
+func (n *CreateMaskingPolicyStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("CREATE MASKING POLICY ")
+	if n.IfNotExists {
+		ctx.WriteKeyWord("IF NOT EXISTS ")
+	}
+	ctx.WriteName(n.PolicyName.O)
+	ctx.WriteKeyWord(" ON ")
+	if err := n.Table.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore CreateMaskingPolicyStmt.Table")
+	}
+	ctx.WritePlain(" (")
+	ctx.WriteName(n.Column.O)
+	ctx.WritePlain(")")
+	ctx.WriteKeyWord(" USING ")
+	return errors.Annotate(n.Option.Restore(ctx), "An error occurred while restore CreateMaskingPolicyStmt.Option")
+}
+
+// Accept implements Node Accept interface.
+func (n *CreateMaskingPolicyStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*CreateMaskingPolicyStmt)
+	node, ok := n.Table.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Table = node.(*TableName)
+	return v.Leave(n)
+}
+
+// DropMaskingPolicyStmt is a statement to drop a masking policy of a table.
+//
+//	DROP MASKING POLICY [IF EXISTS] policy_name ON tbl_name
+type DropMaskingPolicyStmt struct {
+	ddlNode
+
+	IfExists   bool
+	PolicyName CIStr
+	Table      *TableName
+}
+
+// Restore implements Node interface.
+func (n *DropMaskingPolicyStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("DROP MASKING POLICY ")
+	if n.IfExists {
+		ctx.WriteKeyWord("IF EXISTS ")
+	}
+	ctx.WriteName(n.PolicyName.O)
+	ctx.WriteKeyWord(" ON ")
+	if err := n.Table.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore DropMaskingPolicyStmt.Table")
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *DropMaskingPolicyStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*DropMaskingPolicyStmt)
+	node, ok := n.Table.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Table = node.(*TableName)
+	return v.Leave(n)
+}
@@ -0,0 +1,152 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import (
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/parser/format"
+)
+
+var (
+	_ DDLNode  = &CreateMaterializedViewStmt{}
+	_ StmtNode = &RefreshMaterializedViewStmt{}
+)
+
+// MaterializedViewRefreshMethod is the way to refresh the data of a materialized view.
+type MaterializedViewRefreshMethod int
+
+// MaterializedViewRefreshMethod types.
+const (
+	// RefreshMethodDefault means the method is not specified, the one of the materialized view is used.
+	RefreshMethodDefault MaterializedViewRefreshMethod = iota
+	RefreshMethodComplete
+)
+
+// String implements fmt.Stringer interface.
+func (m MaterializedViewRefreshMethod) String() string {
+	switch m {
+	case RefreshMethodComplete:
+		return "COMPLETE"
+	}
+	return ""
+}
+
+// CreateMaterializedViewStmt is a statement to create a materialized view.
+// The result of the query is stored in a table, which is refreshed by REFRESH MATERIALIZED VIEW.
+//
+//	CREATE MATERIALIZED VIEW [IF NOT EXISTS] view_name [(column_list)]
+//	[REFRESH COMPLETE]
+//	AS select_statement
+type CreateMaterializedViewStmt struct {
+	ddlNode
+
+	IfNotExists   bool
+	ViewName      *TableName
+	Cols          []CIStr
+	RefreshMethod MaterializedViewRefreshMethod
+	Select        StmtNode
+
+	// Columns is filled by the planner with the columns of the query result.
+	Columns []*ColumnDef
+}
+
+// Restore implements Node interface.
+func (n *CreateMaterializedViewStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("CREATE MATERIALIZED VIEW ")
+	if n.IfNotExists {
+		ctx.WriteKeyWord("IF NOT EXISTS ")
+	}
+	if err := n.ViewName.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore CreateMaterializedViewStmt.ViewName")
+	}
+	for i, col := range n.Cols {
+		if i == 0 {
+			ctx.WritePlain(" (")
+		} else {
+			ctx.WritePlain(",")
+		}
+		ctx.WriteName(col.O)
+		if i == len(n.Cols)-1 {
+			ctx.WritePlain(")")
+		}
+	}
+	if n.RefreshMethod != RefreshMethodDefault {
+		ctx.WriteKeyWord(" REFRESH ")
+		ctx.WriteKeyWord(n.RefreshMethod.String())
+	}
+	ctx.WriteKeyWord(" AS ")
+	if err := n.Select.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore CreateMaterializedViewStmt.Select")
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *CreateMaterializedViewStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*CreateMaterializedViewStmt)
+	node, ok := n.ViewName.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.ViewName = node.(*TableName)
+	selnode, ok := n.Select.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Select = selnode.(StmtNode)
+	return v.Leave(n)
+}
+
+// RefreshMaterializedViewStmt is a statement to refresh the data of a materialized view.
+//
+//	REFRESH MATERIALIZED VIEW view_name [COMPLETE]
+type RefreshMaterializedViewStmt struct {
+	stmtNode
+
+	ViewName *TableName
+	Method   MaterializedViewRefreshMethod
+}
+
+// Restore implements Node interface.
+func (n *RefreshMaterializedViewStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("REFRESH MATERIALIZED VIEW ")
+	if err := n.ViewName.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore RefreshMaterializedViewStmt.ViewName")
+	}
+	if n.Method != RefreshMethodDefault {
+		ctx.WritePlain(" ")
+		ctx.WriteKeyWord(n.Method.String())
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *RefreshMaterializedViewStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*RefreshMaterializedViewStmt)
+	node, ok := n.ViewName.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.ViewName = node.(*TableName)
+	return v.Leave(n)
+}
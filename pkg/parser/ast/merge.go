@@ -0,0 +1,220 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import (
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/parser/format"
+)
+
+var (
+	_ DMLNode = &MergeStmt{}
+	_ Node    = &MergeClause{}
+)
+
+// MergeActionType is the action taken by a WHEN clause of the MERGE statement.
+type MergeActionType int
+
+// MergeActionType types.
+const (
+	MergeActionUpdate MergeActionType = iota
+	MergeActionDelete
+	MergeActionInsert
+)
+
+// MergeClause is a WHEN clause of the MERGE statement.
+//
+//	WHEN MATCHED [AND condition] THEN {UPDATE SET assignment_list | DELETE}
+//	WHEN NOT MATCHED [AND condition] THEN INSERT [(col_name, ...)] VALUES (expr, ...)
+type MergeClause struct {
+	node
+
+	// NotMatched is true if the clause applies to the source rows without a matched target row.
+	NotMatched bool
+	// Condition is the optional AND condition of the clause.
+	Condition ExprNode
+	Action    MergeActionType
+	// Assignments is the SET list of the UPDATE action.
+	Assignments []*Assignment
+	// Columns and Values are the column list and the value list of the INSERT action.
+	Columns []*ColumnName
+	Values  []ExprNode
+}
+
+// Restore implements Node interface.
+func (n *MergeClause) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("WHEN ")
+	if n.NotMatched {
+		ctx.WriteKeyWord("NOT ")
+	}
+	ctx.WriteKeyWord("MATCHED")
+	if n.Condition != nil {
+		ctx.WriteKeyWord(" AND ")
+		if err := n.Condition.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore MergeClause.Condition")
+		}
+	}
+	ctx.WriteKeyWord(" THEN ")
+	switch n.Action {
+	case MergeActionUpdate:
+		ctx.WriteKeyWord("UPDATE SET ")
+		for i, assignment := range n.Assignments {
+			if i != 0 {
+				ctx.WritePlain(",")
+			}
+			if err := assignment.Restore(ctx); err != nil {
+				return errors.Annotatef(err, "An error occurred while restore MergeClause.Assignments[%d]", i)
+			}
+		}
+	case MergeActionDelete:
+		ctx.WriteKeyWord("DELETE")
+	case MergeActionInsert:
+		ctx.WriteKeyWord("INSERT ")
+		if len(n.Columns) > 0 {
+			ctx.WritePlain("(")
+			for i, col := range n.Columns {
+				if i != 0 {
+					ctx.WritePlain(",")
+				}
+				if err := col.Restore(ctx); err != nil {
+					return errors.Annotatef(err, "An error occurred while restore MergeClause.Columns[%d]", i)
+				}
+			}
+			ctx.WritePlain(") ")
+		}
+		ctx.WriteKeyWord("VALUES ")
+		ctx.WritePlain("(")
+		for i, val := range n.Values {
+			if i != 0 {
+				ctx.WritePlain(",")
+			}
+			if err := val.Restore(ctx); err != nil {
+				return errors.Annotatef(err, "An error occurred while restore MergeClause.Values[%d]", i)
+			}
+		}
+		ctx.WritePlain(")")
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *MergeClause) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*MergeClause)
+	if n.Condition != nil {
+		node, ok := n.Condition.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Condition = node.(ExprNode)
+	}
+	for i, val := range n.Assignments {
+		node, ok := val.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Assignments[i] = node.(*Assignment)
+	}
+	for i, val := range n.Columns {
+		node, ok := val.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Columns[i] = node.(*ColumnName)
+	}
+	for i, val := range n.Values {
+		node, ok := val.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Values[i] = node.(ExprNode)
+	}
+	return v.Leave(n)
+}
+
+// MergeStmt is a statement to update, delete or insert the rows of the target table
+// according to the rows of the source table.
+// For each row of the source table, the first WHEN clause whose condition is satisfied is applied.
+//
+//	MERGE INTO tbl_name [[AS] alias]
+//	USING table_reference
+//	ON search_condition
+//	merge_when_clause [merge_when_clause] ...
+type MergeStmt struct {
+	dmlNode
+
+	Target  *TableSource
+	Source  *TableSource
+	On      ExprNode
+	Clauses []*MergeClause
+}
+
+// Restore implements Node interface.
+func (n *MergeStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("MERGE INTO ")
+	if err := n.Target.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore MergeStmt.Target")
+	}
+	ctx.WriteKeyWord(" USING ")
+	if err := n.Source.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore MergeStmt.Source")
+	}
+	ctx.WriteKeyWord(" ON ")
+	if err := n.On.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore MergeStmt.On")
+	}
+	for i, clause := range n.Clauses {
+		ctx.WritePlain(" ")
+		if err := clause.Restore(ctx); err != nil {
+			return errors.Annotatef(err, "An error occurred while restore MergeStmt.Clauses[%d]", i)
+		}
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *MergeStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*MergeStmt)
+	node, ok := n.Target.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Target = node.(*TableSource)
+	node, ok = n.Source.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Source = node.(*TableSource)
+	node, ok = n.On.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.On = node.(ExprNode)
+	for i, val := range n.Clauses {
+		node, ok = val.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Clauses[i] = node.(*MergeClause)
+	}
+	return v.Leave(n)
+}
@@ -1026,6 +1026,7 @@ const (
 	FlushHosts
 	FlushLogs
 	FlushClientErrorsSummary
+	FlushCardinalityFeedback
 )
 
 // LogType is the log type used in FLUSH statement.
@@ -1109,6 +1110,8 @@ func (n *FlushStmt) Restore(ctx *format.RestoreCtx) error {
 		ctx.WriteKeyWord(logType)
 	case FlushClientErrorsSummary:
 		ctx.WriteKeyWord("CLIENT_ERRORS_SUMMARY")
+	case FlushCardinalityFeedback:
+		ctx.WriteKeyWord("CARDINALITY_FEEDBACK")
 	default:
 		return errors.New("Unsupported type of FlushStmt")
 	}
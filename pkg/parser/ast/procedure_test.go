@@ -35,6 +35,7 @@ func TestProcedureVisitorCover(t *testing.T) {
 		&ast.ProcedureBlock{},
 		&ast.ProcedureInfo{ProcedureBody: &ast.ProcedureBlock{}},
 		&ast.DropProcedureStmt{},
+		&ast.ProcedureLoopStmt{},
 	}
 	for _, v := range stmts2 {
 		v.Accept(visitor{})
@@ -99,6 +100,9 @@ func TestProcedure(t *testing.T) {
 		`create procedure proc_2() begin labelname: while id < 10 do set id = id + 1; select 1; end while; end`,
 		`create procedure proc_2() begin labelname: while id < 10 do set id = id + 1; select 1; end while labelname; end`,
 		`create procedure proc_2(id int) begin labelname: REPEAT set id = id + 1; select 1; UNTIL id < 10 end REPEAT labelname; end`,
+		`create procedure proc_2(id int) begin LOOP set id = id + 1; select 1; end LOOP; end`,
+		`create procedure proc_2(id int) begin labelname: LOOP set id = id + 1; if id > 10 then leave labelname; end if; end LOOP labelname; end`,
+		`create procedure proc_2(inout id int) begin call proc_1(id); call test.proc_3(); end`,
 	}
 	for _, testcase := range testcases {
 		stmt, _, err := p.Parse(testcase, "", "")
@@ -217,6 +221,22 @@ func TestProcedureRestore(t *testing.T) {
 			"CREATE PROCEDURE `proc_2`( IN `id` INT(11)) BEGIN `labelname`: REPEAT SET @@SESSION.`id`=`id`+1;SELECT 1;UNTIL `id`<10 END REPEAT `labelname`; END",
 			"CREATE PROCEDURE `proc_2`( IN `id` INT(11)) BEGIN `labelname`: REPEAT SET @@SESSION.`id`=`id`+1;SELECT 1;UNTIL `id`<10 END REPEAT `labelname`; END",
 		},
+		{
+			"CREATE PROCEDURE `proc_2`( IN `id` INT(11)) BEGIN `labelname`: LOOP SET @@SESSION.`id`=`id`+1;IF `id`>10 THEN LEAVE `labelname`;END IF;END LOOP `labelname`; END",
+			"CREATE PROCEDURE `proc_2`( IN `id` INT(11)) BEGIN `labelname`: LOOP SET @@SESSION.`id`=`id`+1;IF `id`>10 THEN LEAVE `labelname`;END IF;END LOOP `labelname`; END",
+		},
+		{
+			"CREATE PROCEDURE `proc_2`( INOUT `id` INT(11)) BEGIN CALL `proc_1`(`id`);CALL `test`.`proc_3`(); END",
+			"CREATE PROCEDURE `proc_2`( INOUT `id` INT(11)) BEGIN CALL `proc_1`(`id`);CALL `test`.`proc_3`(); END",
+		},
+		{
+			"CREATE PROCEDURE `proc_2`() SQL SECURITY INVOKER BEGIN SELECT 1; END",
+			"CREATE PROCEDURE `proc_2`() SQL SECURITY INVOKER BEGIN SELECT 1; END",
+		},
+		{
+			"CREATE PROCEDURE `proc_2`() SQL SECURITY DEFINER BEGIN SELECT 1; END",
+			"CREATE PROCEDURE `proc_2`() SQL SECURITY DEFINER BEGIN SELECT 1; END",
+		},
 	}
 	extractNodeFunc := func(node ast.Node) ast.Node {
 		return node.(*ast.ProcedureInfo)
@@ -0,0 +1,197 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ast
+
+import (
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/parser/auth"
+	"github.com/pingcap/tidb/pkg/parser/format"
+)
+
+var (
+	_ DDLNode = &CreateRowPolicyStmt{}
+	_ DDLNode = &DropRowPolicyStmt{}
+)
+
+// RowPolicyCommand is the kind of statements which a row-level security policy applies to.
+type RowPolicyCommand int
+
+// RowPolicyCommand types.
+const (
+	RowPolicyAll RowPolicyCommand = iota
+	RowPolicySelect
+	RowPolicyInsert
+	RowPolicyUpdate
+	RowPolicyDelete
+)
+
+// String implements fmt.Stringer interface.
+func (c RowPolicyCommand) String() string {
+	switch c {
+	case RowPolicySelect:
+		return "SELECT"
+	case RowPolicyInsert:
+		return "INSERT"
+	case RowPolicyUpdate:
+		return "UPDATE"
+	case RowPolicyDelete:
+		return "DELETE"
+	}
+	return "ALL"
+}
+
+// CreateRowPolicyStmt is a statement to create a row-level security policy on a table.
+// The rows which don't satisfy the USING expression are invisible to the SELECT,
+// UPDATE and DELETE statements, and the rows inserted must satisfy the WITH CHECK
+// expression, or the USING expression if there is no WITH CHECK expression.
+//
+//	CREATE POLICY [IF NOT EXISTS] policy_name ON tbl_name
+//	[AS {PERMISSIVE | RESTRICTIVE}]
+//	[FOR {ALL | SELECT | INSERT | UPDATE | DELETE}]
+//	[TO role [, role] ...]
+//	[USING (expr)]
+//	[WITH CHECK (expr)]
+type CreateRowPolicyStmt struct {
+	ddlNode
+
+	IfNotExists bool
+	PolicyName  CIStr
+	Table       *TableName
+	// Restrictive policies are combined with AND, while permissive policies are combined with OR.
+	Restrictive bool
+	Command     RowPolicyCommand
+	// Roles are the users and roles which the policy applies to, empty means PUBLIC.
+	Roles     []*auth.RoleIdentity
+	Using     ExprNode
+	WithCheck ExprNode
+}
+
+// Restore implements Node interface.
+func (n *CreateRowPolicyStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("CREATE POLICY ")
+	if n.IfNotExists {
+		ctx.WriteKeyWord("IF NOT EXISTS ")
+	}
+	ctx.WriteName(n.PolicyName.O)
+	ctx.WriteKeyWord(" ON ")
+	if err := n.Table.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore CreateRowPolicyStmt.Table")
+	}
+	if n.Restrictive {
+		ctx.WriteKeyWord(" AS RESTRICTIVE")
+	} else {
+		ctx.WriteKeyWord(" AS PERMISSIVE")
+	}
+	ctx.WriteKeyWord(" FOR ")
+	ctx.WriteKeyWord(n.Command.String())
+	ctx.WriteKeyWord(" TO ")
+	if len(n.Roles) == 0 {
+		ctx.WriteKeyWord("PUBLIC")
+	}
+	for i, role := range n.Roles {
+		if i != 0 {
+			ctx.WritePlain(", ")
+		}
+		if err := role.Restore(ctx); err != nil {
+			return errors.Annotatef(err, "An error occurred while restore CreateRowPolicyStmt.Roles[%d]", i)
+		}
+	}
+	if n.Using != nil {
+		ctx.WriteKeyWord(" USING ")
+		ctx.WritePlain("(")
+		if err := n.Using.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore CreateRowPolicyStmt.Using")
+		}
+		ctx.WritePlain(")")
+	}
+	if n.WithCheck != nil {
+		ctx.WriteKeyWord(" WITH CHECK ")
+		ctx.WritePlain("(")
+		if err := n.WithCheck.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore CreateRowPolicyStmt.WithCheck")
+		}
+		ctx.WritePlain(")")
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *CreateRowPolicyStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*CreateRowPolicyStmt)
+	node, ok := n.Table.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Table = node.(*TableName)
+	if n.Using != nil {
+		node, ok = n.Using.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Using = node.(ExprNode)
+	}
+	if n.WithCheck != nil {
+		node, ok = n.WithCheck.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.WithCheck = node.(ExprNode)
+	}
+	return v.Leave(n)
+}
+
+// DropRowPolicyStmt is a statement to drop a row-level security policy of a table.
+//
+//	DROP POLICY [IF EXISTS] policy_name ON tbl_name
+type DropRowPolicyStmt struct {
+	ddlNode
+
+	IfExists   bool
+	PolicyName CIStr
+	Table      *TableName
+}
+
+// Restore implements Node interface.
+func (n *DropRowPolicyStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("DROP POLICY ")
+	if n.IfExists {
+		ctx.WriteKeyWord("IF EXISTS ")
+	}
+	ctx.WriteName(n.PolicyName.O)
+	ctx.WriteKeyWord(" ON ")
+	if err := n.Table.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore DropRowPolicyStmt.Table")
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *DropRowPolicyStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*DropRowPolicyStmt)
+	node, ok := n.Table.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Table = node.(*TableName)
+	return v.Leave(n)
+}
@@ -179,6 +179,7 @@ var tokenMap = map[string]int{
 	"AS":                             as,
 	"ASC":                            asc,
 	"ASCII":                          ascii,
+	"AT":                             at,
 	"APPLY":                          apply,
 	"ATTRIBUTE":                      attribute,
 	"ATTRIBUTES":                     attributes,
@@ -198,6 +199,7 @@ var tokenMap = map[string]int{
 	"BACKUP":                         backup,
 	"BACKUPS":                        backups,
 	"BDR":                            bdr,
+	"BEFORE":                         before,
 	"BEGIN":                          begin,
 	"BETWEEN":                        between,
 	"BERNOULLI":                      bernoulli,
@@ -231,6 +233,7 @@ var tokenMap = map[string]int{
 	"CANCEL":                         cancel,
 	"CAPTURE":                        capture,
 	"CARDINALITY":                    cardinality,
+	"CARDINALITY_FEEDBACK":           cardinalityFeedback,
 	"CASCADE":                        cascade,
 	"CASCADED":                       cascaded,
 	"CASE":                           caseKwd,
@@ -264,6 +267,8 @@ var tokenMap = map[string]int{
 	"COMMIT":                         commit,
 	"COMMITTED":                      committed,
 	"COMPACT":                        compact,
+	"COMPLETE":                       complete,
+	"COMPLETION":                     completion,
 	"COMPRESS":                       compress,
 	"COMPRESSED":                     compressed,
 	"COMPRESSION":                    compression,
@@ -359,13 +364,16 @@ var tokenMap = map[string]int{
 	"DUPLICATE":                      duplicate,
 	"DURATION":                       timeDuration,
 	"DYNAMIC":                        dynamic,
+	"EACH":                           each,
 	"ELSE":                           elseKwd,
 	"ELSEIF":                         elseIfKwd,
+	"EMPTY":                          emptyKwd,
 	"ENABLE":                         enable,
 	"ENABLED":                        enabled,
 	"ENCLOSED":                       enclosed,
 	"ENCRYPTION":                     encryption,
 	"END":                            end,
+	"ENDS":                           ends,
 	"END_TIME":                       endTime,
 	"ENFORCED":                       enforced,
 	"ENGINE":                         engine,
@@ -379,6 +387,7 @@ var tokenMap = map[string]int{
 	"ESCAPED":                        escaped,
 	"EVENT":                          event,
 	"EVENTS":                         events,
+	"EVERY":                          every,
 	"EVOLVE":                         evolve,
 	"EXACT":                          exact,
 	"EXEC_ELAPSED":                   execElapsed,
@@ -424,6 +433,9 @@ var tokenMap = map[string]int{
 	"GC_TTL":                         gcTTL,
 	"GENERAL":                        general,
 	"GENERATED":                      generated,
+	"GEOMCOLLECTION":                 geomCollection,
+	"GEOMETRY":                       geometry,
+	"GEOMETRYCOLLECTION":             geometryCollection,
 	"GET_FORMAT":                     getFormat,
 	"GLOBAL":                         global,
 	"GRANT":                          grant,
@@ -494,6 +506,7 @@ var tokenMap = map[string]int{
 	"JOIN":                           join,
 	"JSON_ARRAYAGG":                  jsonArrayagg,
 	"JSON_OBJECTAGG":                 jsonObjectAgg,
+	"JSON_TABLE":                     jsonTable,
 	"JSON":                           jsonType,
 	"KEY_BLOCK_SIZE":                 keyBlockSize,
 	"KEY":                            key,
@@ -503,6 +516,7 @@ var tokenMap = map[string]int{
 	"LANGUAGE":                       language,
 	"LAST_BACKUP":                    lastBackup,
 	"LAST":                           last,
+	"LATERAL":                        lateral,
 	"LASTVAL":                        lastval,
 	"LEADER":                         leader,
 	"LEADER_CONSTRAINTS":             leaderConstraints,
@@ -518,6 +532,7 @@ var tokenMap = map[string]int{
 	"LIMIT":                          limit,
 	"LINEAR":                         linear,
 	"LINES":                          lines,
+	"LINESTRING":                     lineString,
 	"LIST":                           list,
 	"LOAD":                           load,
 	"LOCAL":                          local,
@@ -531,8 +546,12 @@ var tokenMap = map[string]int{
 	"LONG":                           long,
 	"LONGBLOB":                       longblobType,
 	"LONGTEXT":                       longtextType,
+	"LOOP":                           loop,
 	"LOW_PRIORITY":                   lowPriority,
+	"MASKING":                        masking,
 	"MASTER":                         master,
+	"MATCHED":                        matched,
+	"MATERIALIZED":                   materialized,
 	"MATCH":                          match,
 	"MAX_CONNECTIONS_PER_HOUR":       maxConnectionsPerHour,
 	"MAX_IDXNUM":                     max_idxnum,
@@ -563,10 +582,14 @@ var tokenMap = map[string]int{
 	"MODE":                           mode,
 	"MODIFY":                         modify,
 	"MONTH":                          month,
+	"MULTILINESTRING":                multiLineString,
+	"MULTIPOINT":                     multiPoint,
+	"MULTIPOLYGON":                   multiPolygon,
 	"NAMES":                          names,
 	"NATIONAL":                       national,
 	"NATURAL":                        natural,
 	"NCHAR":                          ncharType,
+	"NESTED":                         nested,
 	"NEVER":                          never,
 	"NEXT_ROW_ID":                    next_row_id,
 	"NEXT":                           next,
@@ -608,6 +631,7 @@ var tokenMap = map[string]int{
 	"OPTIONAL":                       optional,
 	"OPTIONALLY":                     optionally,
 	"OR":                             or,
+	"ORDINALITY":                     ordinality,
 	"ORDER":                          order,
 	"OUT":                            out,
 	"OUTER":                          outer,
@@ -620,6 +644,7 @@ var tokenMap = map[string]int{
 	"PARTITIONING":                   partitioning,
 	"PARTITIONS":                     partitions,
 	"PASSWORD":                       password,
+	"PATH":                           path,
 	"PAUSE":                          pause,
 	"PERCENT":                        percent,
 	"PER_DB":                         per_db,
@@ -631,6 +656,7 @@ var tokenMap = map[string]int{
 	"PLUGINS":                        plugins,
 	"POINT":                          point,
 	"POLICY":                         policy,
+	"POLYGON":                        polygon,
 	"POSITION":                       position,
 	"PRE_SPLIT_REGIONS":              preSplitRegions,
 	"PRECEDING":                      preceding,
@@ -666,6 +692,7 @@ var tokenMap = map[string]int{
 	"RECURSIVE":                      recursive,
 	"REDUNDANT":                      redundant,
 	"REFERENCES":                     references,
+	"REFRESH":                        refresh,
 	"REGEXP":                         regexpKwd,
 	"REGION":                         region,
 	"REGIONS":                        regions,
@@ -774,9 +801,11 @@ var tokenMap = map[string]int{
 	"SQLEXCEPTION":                   sqlexception,
 	"SQLSTATE":                       sqlstate,
 	"SQLWARNING":                     sqlwarning,
+	"SRID":                           srid,
 	"SSL":                            ssl,
 	"STALENESS":                      staleness,
 	"START":                          start,
+	"STARTS":                         starts,
 	"START_TIME":                     startTime,
 	"START_TS":                       startTS,
 	"STARTING":                       starting,
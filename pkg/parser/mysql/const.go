@@ -225,6 +225,8 @@ const (
 	DefaultRoleTable = "default_roles"
 	// PasswordHistoryTable is the table in system db contains password history.
 	PasswordHistoryTable = "password_history"
+	// RoutinesTable is the table in system db contains stored routine definitions.
+	RoutinesTable = "routines"
 	// WorkloadSchema is the name of workload repository database.
 	WorkloadSchema = "workload_schema"
 )
@@ -449,6 +451,24 @@ func SetSQLMode(ori SQLMode, add SQLMode) SQLMode {
 	return ori | add
 }
 
+// String implements the fmt.Stringer interface, it returns the mode names separated by commas in the order of
+// the mode flags, which can be parsed back by GetSQLMode.
+func (m SQLMode) String() string {
+	names := make([]string, 0, 8)
+	for flag := ModeRealAsFloat; flag <= ModeAllowInvalidDates; flag <<= 1 {
+		if m&flag == 0 {
+			continue
+		}
+		for name, mode := range Str2SQLMode {
+			if mode == flag {
+				names = append(names, name)
+				break
+			}
+		}
+	}
+	return strings.Join(names, ",")
+}
+
 // consts for sql modes.
 // see https://dev.mysql.com/doc/internals/en/query-event.html#q-sql-mode-code
 const (
@@ -95,6 +95,16 @@ func TestSQLMode(t *testing.T) {
 	}
 }
 
+func TestSQLModeString(t *testing.T) {
+	require.Equal(t, "", SQLMode(ModeNone).String())
+	mode, err := GetSQLMode(FormatSQLModeStr("strict_trans_tables,only_full_group_by,no_engine_substitution"))
+	require.NoError(t, err)
+	require.Equal(t, "ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES,NO_ENGINE_SUBSTITUTION", mode.String())
+	parsed, err := GetSQLMode(mode.String())
+	require.NoError(t, err)
+	require.Equal(t, mode, parsed)
+}
+
 func TestVersionSeparator(t *testing.T) {
 	// DO NOT change the value of VersionSeparator.
 	require.Equal(t, "-TiDB-", VersionSeparator)
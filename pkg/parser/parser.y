@@ -179,11 +179,13 @@ import (
 	is                "IS"
 	iterate           "ITERATE"
 	join              "JOIN"
+	jsonTable         "JSON_TABLE"
 	key               "KEY"
 	keys              "KEYS"
 	kill              "KILL"
 	lag               "LAG"
 	lastValue         "LAST_VALUE"
+	lateral           "LATERAL"
 	lead              "LEAD"
 	leading           "LEADING"
 	leave             "LEAVE"
@@ -199,6 +201,7 @@ import (
 	long              "LONG"
 	longblobType      "LONGBLOB"
 	longtextType      "LONGTEXT"
+	loop              "LOOP"
 	lowPriority       "LOW_PRIORITY"
 	match             "MATCH"
 	maxValue          "MAXVALUE"
@@ -321,6 +324,7 @@ import (
 	any                        "ANY"
 	apply                      "APPLY"
 	ascii                      "ASCII"
+	at                         "AT"
 	attribute                  "ATTRIBUTE"
 	attributes                 "ATTRIBUTES"
 	autoIdCache                "AUTO_ID_CACHE"
@@ -333,6 +337,7 @@ import (
 	backup                     "BACKUP"
 	backups                    "BACKUPS"
 	bdr                        "BDR"
+	before                     "BEFORE"
 	begin                      "BEGIN"
 	bernoulli                  "BERNOULLI"
 	binding                    "BINDING"
@@ -348,6 +353,7 @@ import (
 	cache                      "CACHE"
 	calibrate                  "CALIBRATE"
 	capture                    "CAPTURE"
+	cardinalityFeedback        "CARDINALITY_FEEDBACK"
 	cascaded                   "CASCADED"
 	causal                     "CAUSAL"
 	chain                      "CHAIN"
@@ -371,6 +377,8 @@ import (
 	commit                     "COMMIT"
 	committed                  "COMMITTED"
 	compact                    "COMPACT"
+	complete                   "COMPLETE"
+	completion                 "COMPLETION"
 	compressed                 "COMPRESSED"
 	compression                "COMPRESSION"
 	compressionLevel           "COMPRESSION_LEVEL"
@@ -408,12 +416,15 @@ import (
 	do                         "DO"
 	duplicate                  "DUPLICATE"
 	dynamic                    "DYNAMIC"
+	each                       "EACH"
+	emptyKwd                   "EMPTY"
 	enable                     "ENABLE"
 	enabled                    "ENABLED"
 	encryption                 "ENCRYPTION"
 	encryptionKeyFile          "ENCRYPTION_KEYFILE"
 	encryptionMethod           "ENCRYPTION_METHOD"
 	end                        "END"
+	ends                       "ENDS"
 	enforced                   "ENFORCED"
 	engine                     "ENGINE"
 	engines                    "ENGINES"
@@ -424,6 +435,7 @@ import (
 	escape                     "ESCAPE"
 	event                      "EVENT"
 	events                     "EVENTS"
+	every                      "EVERY"
 	evolve                     "EVOLVE"
 	exchange                   "EXCHANGE"
 	exclusive                  "EXCLUSIVE"
@@ -445,6 +457,9 @@ import (
 	full                       "FULL"
 	function                   "FUNCTION"
 	general                    "GENERAL"
+	geomCollection             "GEOMCOLLECTION"
+	geometry                   "GEOMETRY"
+	geometryCollection         "GEOMETRYCOLLECTION"
 	global                     "GLOBAL"
 	grants                     "GRANTS"
 	handler                    "HANDLER"
@@ -479,13 +494,17 @@ import (
 	lastBackup                 "LAST_BACKUP"
 	less                       "LESS"
 	level                      "LEVEL"
+	lineString                 "LINESTRING"
 	list                       "LIST"
 	loadStats                  "LOAD_STATS"
 	local                      "LOCAL"
 	location                   "LOCATION"
 	locked                     "LOCKED"
 	logs                       "LOGS"
+	masking                    "MASKING"
 	master                     "MASTER"
+	matched                    "MATCHED"
+	materialized               "MATERIALIZED"
 	maxConnectionsPerHour      "MAX_CONNECTIONS_PER_HOUR"
 	max_idxnum                 "MAX_IDXNUM"
 	max_minutes                "MAX_MINUTES"
@@ -504,9 +523,13 @@ import (
 	mode                       "MODE"
 	modify                     "MODIFY"
 	month                      "MONTH"
+	multiLineString            "MULTILINESTRING"
+	multiPoint                 "MULTIPOINT"
+	multiPolygon               "MULTIPOLYGON"
 	names                      "NAMES"
 	national                   "NATIONAL"
 	ncharType                  "NCHAR"
+	nested                     "NESTED"
 	never                      "NEVER"
 	next                       "NEXT"
 	nextval                    "NEXTVAL"
@@ -531,6 +554,7 @@ import (
 	onDuplicate                "ON_DUPLICATE"
 	open                       "OPEN"
 	optional                   "OPTIONAL"
+	ordinality                 "ORDINALITY"
 	packKeys                   "PACK_KEYS"
 	pageSym                    "PAGE"
 	parser                     "PARSER"
@@ -539,6 +563,7 @@ import (
 	partitions                 "PARTITIONS"
 	password                   "PASSWORD"
 	passwordLockTime           "PASSWORD_LOCK_TIME"
+	path                       "PATH"
 	pause                      "PAUSE"
 	percent                    "PERCENT"
 	per_db                     "PER_DB"
@@ -547,6 +572,7 @@ import (
 	plugins                    "PLUGINS"
 	point                      "POINT"
 	policy                     "POLICY"
+	polygon                    "POLYGON"
 	preceding                  "PRECEDING"
 	prepare                    "PREPARE"
 	preserve                   "PRESERVE"
@@ -567,6 +593,7 @@ import (
 	recommend                  "RECOMMEND"
 	recover                    "RECOVER"
 	redundant                  "REDUNDANT"
+	refresh                    "REFRESH"
 	reload                     "RELOAD"
 	remove                     "REMOVE"
 	reorganize                 "REORGANIZE"
@@ -632,7 +659,9 @@ import (
 	sqlTsiSecond               "SQL_TSI_SECOND"
 	sqlTsiWeek                 "SQL_TSI_WEEK"
 	sqlTsiYear                 "SQL_TSI_YEAR"
+	srid                       "SRID"
 	start                      "START"
+	starts                     "STARTS"
 	statsAutoRecalc            "STATS_AUTO_RECALC"
 	statsColChoice             "STATS_COL_CHOICE"
 	statsColList               "STATS_COL_LIST"
@@ -969,146 +998,159 @@ import (
 	ProcedureCall                   "Procedure call with Identifier or identifier"
 
 %type	<statement>
-	AdminStmt                  "Check table statement or show ddl statement"
-	AlterDatabaseStmt          "Alter database statement"
-	AlterTableStmt             "Alter table statement"
-	AlterUserStmt              "Alter user statement"
-	AlterInstanceStmt          "Alter instance statement"
-	AlterRangeStmt             "Alter data range configuration statement"
-	AlterPolicyStmt            "Alter Placement Policy statement"
-	AlterResourceGroupStmt     "Alter Resource Group statement"
-	AlterSequenceStmt          "Alter sequence statement"
-	AnalyzeTableStmt           "Analyze table statement"
-	BeginTransactionStmt       "BEGIN TRANSACTION statement"
-	BinlogStmt                 "Binlog base64 statement"
-	BRIEStmt                   "BACKUP or RESTORE statement"
-	CalibrateResourceStmt      "CALIBRATE RESOURCE statement"
-	CommitStmt                 "COMMIT statement"
-	CreateTableStmt            "CREATE TABLE statement"
-	CreateViewStmt             "CREATE VIEW  statement"
-	CreateUserStmt             "CREATE User statement"
-	CreateRoleStmt             "CREATE Role statement"
-	CreateDatabaseStmt         "Create Database Statement"
-	CreateIndexStmt            "CREATE INDEX statement"
-	CreateBindingStmt          "CREATE BINDING statement"
-	CreatePolicyStmt           "CREATE PLACEMENT POLICY statement"
-	CreateProcedureStmt        "CREATE PROCEDURE statement"
-	AddQueryWatchStmt          "ADD QUERY WATCH statement"
-	CreateResourceGroupStmt    "CREATE RESOURCE GROUP statement"
-	CreateSequenceStmt         "CREATE SEQUENCE statement"
-	CreateStatisticsStmt       "CREATE STATISTICS statement"
-	DoStmt                     "Do statement"
-	DropDatabaseStmt           "DROP DATABASE statement"
-	DropIndexStmt              "DROP INDEX statement"
-	DropProcedureStmt          "DROP PROCEDURE statement"
-	DropQueryWatchStmt         "DROP QUERY WATCH statement"
-	DropResourceGroupStmt      "DROP RESOURCE GROUP statement"
-	DropStatisticsStmt         "DROP STATISTICS statement"
-	DropStatsStmt              "DROP STATS statement"
-	DropTableStmt              "DROP TABLE statement"
-	DropSequenceStmt           "DROP SEQUENCE statement"
-	DropUserStmt               "DROP USER"
-	DropRoleStmt               "DROP ROLE"
-	DropViewStmt               "DROP VIEW statement"
-	DropBindingStmt            "DROP BINDING  statement"
-	DropPolicyStmt             "DROP PLACEMENT POLICY statement"
-	DeallocateStmt             "Deallocate prepared statement"
-	DeleteFromStmt             "DELETE FROM statement"
-	DeleteWithoutUsingStmt     "Normal DELETE statement"
-	DeleteWithUsingStmt        "DELETE USING statement"
-	DistributeTableStmt        "Distribute table statement"
-	EmptyStmt                  "empty statement"
-	ExecuteStmt                "Execute statement"
-	ExplainStmt                "EXPLAIN statement"
-	ExplainableStmt            "explainable statement"
-	FlushStmt                  "Flush statement"
-	FlashbackTableStmt         "Flashback table statement"
-	FlashbackToTimestampStmt   "Flashback cluster statement"
-	FlashbackDatabaseStmt      "Flashback Database statement"
-	GrantStmt                  "Grant statement"
-	GrantProxyStmt             "Grant proxy statement"
-	GrantRoleStmt              "Grant role statement"
-	InsertIntoStmt             "INSERT INTO statement"
-	CallStmt                   "CALL statement"
-	ImportIntoStmt             "IMPORT INTO statement"
-	ImportFromSelectStmt       "SELECT statement of IMPORT INTO"
-	KillStmt                   "Kill statement"
-	LoadDataStmt               "Load data statement"
-	LoadStatsStmt              "Load statistic statement"
-	LockStatsStmt              "Lock statistic statement"
-	UnlockStatsStmt            "Unlock statistic statement"
-	LockTablesStmt             "Lock tables statement"
-	NonTransactionalDMLStmt    "Non-transactional DML statement"
-	OptimizeTableStmt          "OPTIMIZE statement"
-	PlanReplayerStmt           "Plan replayer statement"
-	PreparedStmt               "PreparedStmt"
-	ProcedureProcStmt          "The entrance of procedure statements which contains all kinds of statements in procedure"
-	ProcedureStatementStmt     "The normal statements in procedure, such as dml, select, set ..."
-	SelectStmt                 "SELECT statement"
-	SelectStmtWithClause       "common table expression SELECT statement"
-	RenameTableStmt            "rename table statement"
-	RenameUserStmt             "rename user statement"
-	ReplaceIntoStmt            "REPLACE INTO statement"
-	RecoverTableStmt           "recover table statement"
-	RevokeStmt                 "Revoke statement"
-	RevokeRoleStmt             "Revoke role statement"
-	RollbackStmt               "ROLLBACK statement"
-	ReleaseSavepointStmt       "RELEASE SAVEPOINT statement"
-	SavepointStmt              "SAVEPOINT statement"
-	SplitRegionStmt            "Split index region statement"
-	SetStmt                    "Set variable statement"
-	SetBindingStmt             "Set binding statement"
-	SetRoleStmt                "Set active role statement"
-	SetDefaultRoleStmt         "Set default statement for some user"
-	ShowStmt                   "Show engines/databases/tables/user/columns/warnings/status statement"
-	Statement                  "statement"
-	TraceStmt                  "TRACE statement"
-	TraceableStmt              "traceable statement"
-	TruncateTableStmt          "TRUNCATE TABLE statement"
-	UnlockTablesStmt           "Unlock tables statement"
-	UpdateStmt                 "UPDATE statement"
-	SetOprStmt                 "Union/Except/Intersect select statement"
-	SetOprStmtWithLimitOrderBy "Union/Except/Intersect select statement with limit and order by"
-	SetOprStmtWoutLimitOrderBy "Union/Except/Intersect select statement without limit and order by"
-	UseStmt                    "USE statement"
-	ShutdownStmt               "SHUTDOWN statement"
-	RestartStmt                "RESTART statement"
-	RecommendIndexStmt         "RECOMMEND INDEX statement"
-	CreateViewSelectOpt        "Select/Union/Except/Intersect statement in CREATE VIEW ... AS SELECT"
-	BindableStmt               "Statement that can be created binding on"
-	UpdateStmtNoWith           "Update statement without CTE clause"
-	HelpStmt                   "HELP statement"
-	ShardableStmt              "Shardable statement that can be used in non-transactional DMLs"
-	CancelImportStmt           "CANCEL IMPORT JOB statement"
-	TrafficStmt                "Traffic capture/replay statement"
-	ProcedureUnlabeledBlock    "The statement block without label in procedure"
-	ProcedureBlockContent      "The statement block in procedure expressed with 'Begin ... End'"
-	SimpleWhenThen             "Procedure case when then"
-	SearchWhenThen             "Procedure search when then"
-	ProcedureIfstmt            "The if statement in procedure, expressed by if ... elseif .. else ... end if"
-	procedurceElseIfs          "The else block in procedure, expressed by elseif or else or nil"
-	ProcedureIf                "The if block in procedure, expressed by expr then statement procedurceElseIfs"
-	ProcedureUnlabelLoopBlock  "The loop block without label in procedure "
-	ProcedureUnlabelLoopStmt   "The loop statement in procedure, expressed by repeat/do while/loop"
-	ProcedureCaseStmt          "Case statement in procedure, expressed by `case ... when.. then ..`"
-	ProcedureSimpleCase        "The simpe case statement in procedure, expressed by `case expr when expr then statement ... end case`"
-	ProcedureSearchedCase      "The searched case statement in procedure, expressed by `case when expr then statement ... end case`"
-	ProcedureCursorSelectStmt  "The select stmt can used in procedure cursor."
-	ProcedureOpenCur           "The open cursor statement in procedure, expressed by `open ...`"
-	ProcedureCloseCur          "The close cursor statement in procedure, expressed by `close ...`"
-	ProcedureFetchInto         "The fetch into statement in procedure, expressed by `fetch ... into ...`"
-	ProcedureHcond             "The handler value statement in procedure, expressed by condition_value"
-	ProcedurceCond             "The handler code statement in procedure, expressed by code error num or `sqlstate ...`"
-	ProcedureLabeledBlock      "The statement block with label in procedure"
-	ProcedurelabeledLoopStmt   "The loop block with label in procedure"
-	ProcedureIterate           "The iterate statement in procedure, expressed by `iterate ...`"
-	ProcedureLeave             "The leave statement in procedure, expressed by `leave ...`"
+	AdminStmt                   "Check table statement or show ddl statement"
+	AlterDatabaseStmt           "Alter database statement"
+	AlterEventStmt              "ALTER EVENT statement"
+	AlterTableStmt              "Alter table statement"
+	AlterUserStmt               "Alter user statement"
+	AlterInstanceStmt           "Alter instance statement"
+	AlterRangeStmt              "Alter data range configuration statement"
+	AlterPolicyStmt             "Alter Placement Policy statement"
+	AlterResourceGroupStmt      "Alter Resource Group statement"
+	AlterSequenceStmt           "Alter sequence statement"
+	AnalyzeTableStmt            "Analyze table statement"
+	BeginTransactionStmt        "BEGIN TRANSACTION statement"
+	BinlogStmt                  "Binlog base64 statement"
+	BRIEStmt                    "BACKUP or RESTORE statement"
+	CalibrateResourceStmt       "CALIBRATE RESOURCE statement"
+	CommitStmt                  "COMMIT statement"
+	CreateTableStmt             "CREATE TABLE statement"
+	CreateViewStmt              "CREATE VIEW  statement"
+	CreateUserStmt              "CREATE User statement"
+	CreateRoleStmt              "CREATE Role statement"
+	CreateDatabaseStmt          "Create Database Statement"
+	CreateEventStmt             "CREATE EVENT statement"
+	CreateIndexStmt             "CREATE INDEX statement"
+	CreateMaskingPolicyStmt     "CREATE MASKING POLICY statement"
+	CreateMaterializedViewStmt  "CREATE MATERIALIZED VIEW statement"
+	CreateRowPolicyStmt         "CREATE POLICY statement"
+	CreateBindingStmt           "CREATE BINDING statement"
+	CreatePolicyStmt            "CREATE PLACEMENT POLICY statement"
+	CreateProcedureStmt         "CREATE PROCEDURE statement"
+	CreateTriggerStmt           "CREATE TRIGGER statement"
+	AddQueryWatchStmt           "ADD QUERY WATCH statement"
+	CreateResourceGroupStmt     "CREATE RESOURCE GROUP statement"
+	CreateSequenceStmt          "CREATE SEQUENCE statement"
+	CreateStatisticsStmt        "CREATE STATISTICS statement"
+	DoStmt                      "Do statement"
+	DropDatabaseStmt            "DROP DATABASE statement"
+	DropEventStmt               "DROP EVENT statement"
+	DropIndexStmt               "DROP INDEX statement"
+	DropMaskingPolicyStmt       "DROP MASKING POLICY statement"
+	DropRowPolicyStmt           "DROP POLICY statement"
+	DropProcedureStmt           "DROP PROCEDURE statement"
+	DropTriggerStmt             "DROP TRIGGER statement"
+	DropQueryWatchStmt          "DROP QUERY WATCH statement"
+	DropResourceGroupStmt       "DROP RESOURCE GROUP statement"
+	DropStatisticsStmt          "DROP STATISTICS statement"
+	DropStatsStmt               "DROP STATS statement"
+	DropTableStmt               "DROP TABLE statement"
+	DropSequenceStmt            "DROP SEQUENCE statement"
+	DropUserStmt                "DROP USER"
+	DropRoleStmt                "DROP ROLE"
+	DropViewStmt                "DROP VIEW statement"
+	DropBindingStmt             "DROP BINDING  statement"
+	DropPolicyStmt              "DROP PLACEMENT POLICY statement"
+	DeallocateStmt              "Deallocate prepared statement"
+	DeleteFromStmt              "DELETE FROM statement"
+	DeleteWithoutUsingStmt      "Normal DELETE statement"
+	DeleteWithUsingStmt         "DELETE USING statement"
+	DistributeTableStmt         "Distribute table statement"
+	EmptyStmt                   "empty statement"
+	ExecuteStmt                 "Execute statement"
+	ExplainStmt                 "EXPLAIN statement"
+	ExplainableStmt             "explainable statement"
+	FlushStmt                   "Flush statement"
+	FlashbackTableStmt          "Flashback table statement"
+	FlashbackToTimestampStmt    "Flashback cluster statement"
+	FlashbackDatabaseStmt       "Flashback Database statement"
+	GrantStmt                   "Grant statement"
+	GrantProxyStmt              "Grant proxy statement"
+	GrantRoleStmt               "Grant role statement"
+	InsertIntoStmt              "INSERT INTO statement"
+	CallStmt                    "CALL statement"
+	ImportIntoStmt              "IMPORT INTO statement"
+	ImportFromSelectStmt        "SELECT statement of IMPORT INTO"
+	KillStmt                    "Kill statement"
+	LoadDataStmt                "Load data statement"
+	LoadStatsStmt               "Load statistic statement"
+	LockStatsStmt               "Lock statistic statement"
+	UnlockStatsStmt             "Unlock statistic statement"
+	LockTablesStmt              "Lock tables statement"
+	MergeStmt                   "MERGE statement"
+	RefreshMaterializedViewStmt "REFRESH MATERIALIZED VIEW statement"
+	NonTransactionalDMLStmt     "Non-transactional DML statement"
+	OptimizeTableStmt           "OPTIMIZE statement"
+	PlanReplayerStmt            "Plan replayer statement"
+	PreparedStmt                "PreparedStmt"
+	ProcedureProcStmt           "The entrance of procedure statements which contains all kinds of statements in procedure"
+	ProcedureStatementStmt      "The normal statements in procedure, such as dml, select, set ..."
+	SelectStmt                  "SELECT statement"
+	SelectStmtWithClause        "common table expression SELECT statement"
+	RenameTableStmt             "rename table statement"
+	RenameUserStmt              "rename user statement"
+	ReplaceIntoStmt             "REPLACE INTO statement"
+	RecoverTableStmt            "recover table statement"
+	RevokeStmt                  "Revoke statement"
+	RevokeRoleStmt              "Revoke role statement"
+	RollbackStmt                "ROLLBACK statement"
+	ReleaseSavepointStmt        "RELEASE SAVEPOINT statement"
+	SavepointStmt               "SAVEPOINT statement"
+	SplitRegionStmt             "Split index region statement"
+	SetStmt                     "Set variable statement"
+	SetBindingStmt              "Set binding statement"
+	SetRoleStmt                 "Set active role statement"
+	SetDefaultRoleStmt          "Set default statement for some user"
+	ShowStmt                    "Show engines/databases/tables/user/columns/warnings/status statement"
+	Statement                   "statement"
+	TraceStmt                   "TRACE statement"
+	TraceableStmt               "traceable statement"
+	TruncateTableStmt           "TRUNCATE TABLE statement"
+	UnlockTablesStmt            "Unlock tables statement"
+	UpdateStmt                  "UPDATE statement"
+	SetOprStmt                  "Union/Except/Intersect select statement"
+	SetOprStmtWithLimitOrderBy  "Union/Except/Intersect select statement with limit and order by"
+	SetOprStmtWoutLimitOrderBy  "Union/Except/Intersect select statement without limit and order by"
+	UseStmt                     "USE statement"
+	ShutdownStmt                "SHUTDOWN statement"
+	RestartStmt                 "RESTART statement"
+	RecommendIndexStmt          "RECOMMEND INDEX statement"
+	CreateViewSelectOpt         "Select/Union/Except/Intersect statement in CREATE VIEW ... AS SELECT"
+	BindableStmt                "Statement that can be created binding on"
+	UpdateStmtNoWith            "Update statement without CTE clause"
+	HelpStmt                    "HELP statement"
+	ShardableStmt               "Shardable statement that can be used in non-transactional DMLs"
+	CancelImportStmt            "CANCEL IMPORT JOB statement"
+	TrafficStmt                 "Traffic capture/replay statement"
+	ProcedureUnlabeledBlock     "The statement block without label in procedure"
+	ProcedureBlockContent       "The statement block in procedure expressed with 'Begin ... End'"
+	SimpleWhenThen              "Procedure case when then"
+	SearchWhenThen              "Procedure search when then"
+	ProcedureIfstmt             "The if statement in procedure, expressed by if ... elseif .. else ... end if"
+	procedurceElseIfs           "The else block in procedure, expressed by elseif or else or nil"
+	ProcedureIf                 "The if block in procedure, expressed by expr then statement procedurceElseIfs"
+	ProcedureUnlabelLoopBlock   "The loop block without label in procedure "
+	ProcedureUnlabelLoopStmt    "The loop statement in procedure, expressed by repeat/do while/loop"
+	ProcedureCaseStmt           "Case statement in procedure, expressed by `case ... when.. then ..`"
+	ProcedureSimpleCase         "The simpe case statement in procedure, expressed by `case expr when expr then statement ... end case`"
+	ProcedureSearchedCase       "The searched case statement in procedure, expressed by `case when expr then statement ... end case`"
+	ProcedureCursorSelectStmt   "The select stmt can used in procedure cursor."
+	ProcedureOpenCur            "The open cursor statement in procedure, expressed by `open ...`"
+	ProcedureCloseCur           "The close cursor statement in procedure, expressed by `close ...`"
+	ProcedureFetchInto          "The fetch into statement in procedure, expressed by `fetch ... into ...`"
+	ProcedureHcond              "The handler value statement in procedure, expressed by condition_value"
+	ProcedurceCond              "The handler code statement in procedure, expressed by code error num or `sqlstate ...`"
+	ProcedureLabeledBlock       "The statement block with label in procedure"
+	ProcedurelabeledLoopStmt    "The loop block with label in procedure"
+	ProcedureIterate            "The iterate statement in procedure, expressed by `iterate ...`"
+	ProcedureLeave              "The leave statement in procedure, expressed by `leave ...`"
 
 %type	<item>
 	AdminShowSlow                          "Admin Show Slow statement"
 	AdminStmtLimitOpt                      "Admin show ddl jobs limit option"
 	AllOrPartitionNameList                 "All or partition name list"
 	AlgorithmClause                        "Alter table algorithm"
+	AlterEventScheduleOpt                  "Alter event schedule option"
 	AlterJobOptionList                     "Alter job option list"
 	AlterJobOption                         "Alter job option"
 	AlterTableSpecSingleOpt                "Alter table single option"
@@ -1172,6 +1214,16 @@ import (
 	RequireClause                          "Encrypted connections options"
 	RequireClauseOpt                       "optional Encrypted connections options"
 	EqOpt                                  "= or empty"
+	EventBodyOpt                           "Event body option"
+	EventCommentOpt                        "Event comment option"
+	EventDefinerOpt                        "Event definer option"
+	EventEndsOpt                           "Event ends option"
+	EventOnCompletion                      "Event on completion"
+	EventOnCompletionOpt                   "Event on completion option"
+	EventRenameOpt                         "Event rename option"
+	EventSchedule                          "Event schedule"
+	EventStartsOpt                         "Event starts option"
+	EventStatusOpt                         "Event status option"
 	EscapedTableRef                        "escaped table reference"
 	ExpressionList                         "expression list"
 	ExtendedPriv                           "Extended privileges like LOAD FROM S3 or dynamic privileges"
@@ -1230,6 +1282,10 @@ import (
 	IntervalExpr                           "Interval expression"
 	JoinTable                              "join table"
 	JoinType                               "join type"
+	JSONTableColumn                        "json table column definition"
+	JSONTableColumnList                    "json table column definition list"
+	JSONTableOnEmptyOnErrorOpt             "json table column ON EMPTY and ON ERROR clauses"
+	JSONTableOnResponse                    "json table column ON EMPTY or ON ERROR action"
 	KillOrKillTiDB                         "Kill or Kill TiDB"
 	LocationLabelList                      "location label name list"
 	LikeTableWithOrWithoutParen            "LIKE table_name or ( LIKE table_name )"
@@ -1317,6 +1373,11 @@ import (
 	RoleSpec                               "Rolename and auth option"
 	RoleSpecList                           "Rolename and auth option list"
 	RowFormat                              "Row format option"
+	RowPolicyAsOpt                         "Row policy type, permissive or restrictive"
+	RowPolicyCheckOpt                      "Row policy WITH CHECK expression"
+	RowPolicyForOpt                        "Row policy command"
+	RowPolicyToOpt                         "Row policy role list"
+	RowPolicyUsingOpt                      "Row policy USING expression"
 	RowValue                               "Row value"
 	RowStmt                                "Row constructor"
 	SelectLockOpt                          "SELECT lock options"
@@ -1396,6 +1457,8 @@ import (
 	LockType                               "Table locks type"
 	TransactionChar                        "Transaction characteristic"
 	TransactionChars                       "Transaction characteristic list"
+	TriggerEvent                           "Trigger event, INSERT, UPDATE or DELETE"
+	TriggerTiming                          "Trigger timing, BEFORE or AFTER"
 	TrimDirection                          "Trim string direction"
 	SetOprOpt                              "Union/Except/Intersect Option(empty/ALL/DISTINCT)"
 	UpdateIndexElem                        "IndexName {GLOBAL|LOCAL}"
@@ -1475,6 +1538,8 @@ import (
 	BlobType                               "Blob types"
 	TextType                               "Text types"
 	DateAndTimeType                        "Date and Time types"
+	SpatialType                            "Spatial types"
+	GeometryType                           "Geometry type name"
 	OptFieldLen                            "Field length or empty"
 	FieldLen                               "Field length"
 	FieldOpts                              "Field type definition option list"
@@ -1496,6 +1561,12 @@ import (
 	EnforcedOrNot                          "{ENFORCED|NOT ENFORCED}"
 	EnforcedOrNotOpt                       "Optional {ENFORCED|NOT ENFORCED}"
 	EnforcedOrNotOrNotNullOpt              "{[ENFORCED|NOT ENFORCED|NOT NULL]}"
+	MaskingOption                          "Masking function of masking policy"
+	MaterializedViewRefreshMethod          "Refresh method of materialized view"
+	MaterializedViewRefreshOpt             "Optional REFRESH clause of CREATE MATERIALIZED VIEW"
+	MergeClause                            "WHEN clause of MERGE statement"
+	MergeClauseList                        "WHEN clause list of MERGE statement"
+	MergeConditionOpt                      "Optional AND condition of MERGE WHEN clause"
 	Match                                  "[MATCH FULL | MATCH PARTIAL | MATCH SIMPLE]"
 	MatchOpt                               "optional MATCH clause"
 	BRIETables                             "List of tables or databases for BRIE statements"
@@ -1533,6 +1604,7 @@ import (
 	OptionalShardColumn                    "Optional shard column"
 	SpOptInout                             "Optional procedure param type"
 	OptSpPdparams                          "Optional procedure param list"
+	ProcedureSQLSecurityOpt                "Optional procedure sql security"
 	SpPdparams                             "Procedure params"
 	SpPdparam                              "Procedure param"
 	ProcedureOptDefault                    "Optional procedure variable default value"
@@ -3782,6 +3854,10 @@ ColumnOption:
 			StrValue: $3,
 		}
 	}
+|	"SRID" LengthNum
+	{
+		$$ = &ast.ColumnOption{Tp: ast.ColumnOptionSRID, Expr: ast.NewValueExpr($2, "", "")}
+	}
 
 AutoRandomOpt:
 	{
@@ -5410,6 +5486,10 @@ DropViewStmt:
 	{
 		$$ = &ast.DropTableStmt{IfExists: true, Tables: $5.([]*ast.TableName), IsView: true}
 	}
+|	"DROP" "MATERIALIZED" "VIEW" IfExists TableNameList RestrictOrCascadeOpt
+	{
+		$$ = &ast.DropTableStmt{IfExists: $4.(bool), Tables: $5.([]*ast.TableName), IsMaterializedView: true}
+	}
 
 DropUserStmt:
 	"DROP" "USER" UsernameList
@@ -6858,6 +6938,7 @@ UnReservedKeyword:
 |	"ADD_COLUMNAR_REPLICA_ON_DEMAND"
 |	"ADVISE"
 |	"ASCII"
+|	"AT"
 |	"APPLY"
 |	"ATTRIBUTE"
 |	"ATTRIBUTES"
@@ -6872,6 +6953,7 @@ UnReservedKeyword:
 |	"ALWAYS"
 |	"AVG"
 |	"BDR"
+|	"BEFORE"
 |	"BEGIN"
 |	"BIT"
 |	"BOOL"
@@ -6889,6 +6971,7 @@ UnReservedKeyword:
 |	"SAN"
 |	"COMMIT"
 |	"COMPACT"
+|	"COMPLETION"
 |	"COMPRESSED"
 |	"CONSISTENCY"
 |	"CONSISTENT"
@@ -6901,8 +6984,10 @@ UnReservedKeyword:
 |	"DO"
 |	"DUPLICATE"
 |	"DYNAMIC"
+|	"EACH"
 |	"ENCRYPTION"
 |	"END"
+|	"ENDS"
 |	"ENFORCED"
 |	"ENGINE"
 |	"ENGINES"
@@ -6959,6 +7044,7 @@ UnReservedKeyword:
 |	"SHUTDOWN"
 |	"SNAPSHOT"
 |	"START"
+|	"STARTS"
 |	"STATUS"
 |	"OPEN"
 |	"POINT"
@@ -7033,6 +7119,7 @@ UnReservedKeyword:
 |	"BINDINGS"
 |	"MODIFY"
 |	"EVENTS"
+|	"EVERY"
 |	"PARTITIONS"
 |	"NONE"
 |	"NULLS"
@@ -7149,6 +7236,11 @@ UnReservedKeyword:
 |	"LOCATION"
 |	"LABELS"
 |	"LOGS"
+|	"MASKING"
+|	"MATCHED"
+|	"MATERIALIZED"
+|	"REFRESH"
+|	"COMPLETE"
 |	"HOSTS"
 |	"AGAINST"
 |	"EXPANSION"
@@ -7197,6 +7289,7 @@ UnReservedKeyword:
 |	"POLICY"
 |	"WAIT"
 |	"CLIENT_ERRORS_SUMMARY"
+|	"CARDINALITY_FEEDBACK"
 |	"BERNOULLI"
 |	"SYSTEM"
 |	"PERCENT"
@@ -7241,6 +7334,19 @@ UnReservedKeyword:
 |	"COMPRESSION_TYPE"
 |	"ENCRYPTION_METHOD"
 |	"ENCRYPTION_KEYFILE"
+|	"EMPTY"
+|	"NESTED"
+|	"ORDINALITY"
+|	"PATH"
+|	"GEOMCOLLECTION"
+|	"GEOMETRY"
+|	"GEOMETRYCOLLECTION"
+|	"LINESTRING"
+|	"MULTILINESTRING"
+|	"MULTIPOINT"
+|	"MULTIPOLYGON"
+|	"POLYGON"
+|	"SRID"
 
 TiDBKeyword:
 	"ADMIN"
@@ -8207,7 +8313,14 @@ FunctionNameConflict:
 |	"MINUTE"
 |	"MONTH"
 |	builtinNow
+|	"GEOMCOLLECTION"
+|	"GEOMETRYCOLLECTION"
+|	"LINESTRING"
+|	"MULTILINESTRING"
+|	"MULTIPOINT"
+|	"MULTIPOLYGON"
 |	"POINT"
+|	"POLYGON"
 |	"QUARTER"
 |	"REPEAT"
 |	"REPLACE"
@@ -8647,7 +8760,11 @@ SumExpr:
 		args := $4.([]ast.ExprNode)
 		args = append(args, $6.(ast.ExprNode))
 		if $8 != nil {
-			$$ = &ast.WindowFuncExpr{Name: $1, Args: args, Distinct: $3.(bool), Spec: *($8.(*ast.WindowSpec))}
+			wf := &ast.WindowFuncExpr{Name: $1, Args: args, Distinct: $3.(bool), Spec: *($8.(*ast.WindowSpec))}
+			if $5 != nil {
+				wf.Order = $5.(*ast.OrderByClause)
+			}
+			$$ = wf
 		} else {
 			agg := &ast.AggregateFuncExpr{F: $1, Args: args, Distinct: $3.(bool)}
 			if $5 != nil {
@@ -8778,6 +8895,12 @@ FunctionCallGeneric:
 			Args:   $3.([]ast.ExprNode),
 		}
 	}
+|	identifier '(' ExpressionListOpt ')' WindowingClause
+	{
+		// The aggregate functions that are not keywords, such as the ones registered by extensions,
+		// can be used as window functions too.
+		$$ = &ast.WindowFuncExpr{Name: strings.ToLower($1), Args: $3.([]ast.ExprNode), Spec: $5.(ast.WindowSpec)}
+	}
 |	Identifier '.' Identifier '(' ExpressionListOpt ')'
 	{
 		var tp ast.FuncCallExprType
@@ -10014,12 +10137,113 @@ TableFactor:
 		resultNode := $1.(*ast.SubqueryExpr).Query
 		$$ = &ast.TableSource{Source: resultNode, AsName: $2.(ast.CIStr)}
 	}
+|	"LATERAL" SubSelect TableAsName
+	{
+		resultNode := $2.(*ast.SubqueryExpr).Query
+		$$ = &ast.TableSource{Source: resultNode, AsName: $3.(ast.CIStr), Lateral: true}
+	}
 |	'(' TableRefs ')'
 	{
 		j := $2.(*ast.Join)
 		j.ExplicitParens = true
 		$$ = $2
 	}
+|	"JSON_TABLE" '(' Expression ',' stringLit "COLUMNS" '(' JSONTableColumnList ')' ')' TableAsName
+	{
+		jt := &ast.JSONTable{
+			Expr:    $3,
+			Path:    $5,
+			Columns: $8.([]*ast.JSONTableColumn),
+		}
+		$$ = &ast.TableSource{Source: jt, AsName: $11.(ast.CIStr)}
+	}
+
+JSONTableColumnList:
+	JSONTableColumn
+	{
+		$$ = []*ast.JSONTableColumn{$1.(*ast.JSONTableColumn)}
+	}
+|	JSONTableColumnList ',' JSONTableColumn
+	{
+		$$ = append($1.([]*ast.JSONTableColumn), $3.(*ast.JSONTableColumn))
+	}
+
+JSONTableColumn:
+	Identifier "FOR" "ORDINALITY"
+	{
+		$$ = &ast.JSONTableColumn{
+			Tp:   ast.JSONTableColumnOrdinality,
+			Name: ast.NewCIStr($1),
+		}
+	}
+|	Identifier Type "PATH" stringLit JSONTableOnEmptyOnErrorOpt
+	{
+		onResponses := $5.([]*ast.JSONTableOnResponse)
+		$$ = &ast.JSONTableColumn{
+			Tp:      ast.JSONTableColumnPath,
+			Name:    ast.NewCIStr($1),
+			Type:    $2.(*types.FieldType),
+			Path:    $4,
+			OnEmpty: onResponses[0],
+			OnError: onResponses[1],
+		}
+	}
+|	Identifier Type "EXISTS" "PATH" stringLit
+	{
+		$$ = &ast.JSONTableColumn{
+			Tp:   ast.JSONTableColumnExists,
+			Name: ast.NewCIStr($1),
+			Type: $2.(*types.FieldType),
+			Path: $5,
+		}
+	}
+|	"NESTED" "PATH" stringLit "COLUMNS" '(' JSONTableColumnList ')'
+	{
+		$$ = &ast.JSONTableColumn{
+			Tp:      ast.JSONTableColumnNested,
+			Path:    $3,
+			Columns: $6.([]*ast.JSONTableColumn),
+		}
+	}
+|	"NESTED" stringLit "COLUMNS" '(' JSONTableColumnList ')'
+	{
+		$$ = &ast.JSONTableColumn{
+			Tp:      ast.JSONTableColumnNested,
+			Path:    $2,
+			Columns: $5.([]*ast.JSONTableColumn),
+		}
+	}
+
+JSONTableOnEmptyOnErrorOpt:
+	{
+		$$ = []*ast.JSONTableOnResponse{nil, nil}
+	}
+|	JSONTableOnResponse "ON" "EMPTY"
+	{
+		$$ = []*ast.JSONTableOnResponse{$1.(*ast.JSONTableOnResponse), nil}
+	}
+|	JSONTableOnResponse "ON" "ERROR"
+	{
+		$$ = []*ast.JSONTableOnResponse{nil, $1.(*ast.JSONTableOnResponse)}
+	}
+|	JSONTableOnResponse "ON" "EMPTY" JSONTableOnResponse "ON" "ERROR"
+	{
+		$$ = []*ast.JSONTableOnResponse{$1.(*ast.JSONTableOnResponse), $4.(*ast.JSONTableOnResponse)}
+	}
+
+JSONTableOnResponse:
+	"NULL"
+	{
+		$$ = &ast.JSONTableOnResponse{Tp: ast.JSONTableOnResponseNull}
+	}
+|	"ERROR"
+	{
+		$$ = &ast.JSONTableOnResponse{Tp: ast.JSONTableOnResponseError}
+	}
+|	"DEFAULT" stringLit
+	{
+		$$ = &ast.JSONTableOnResponse{Tp: ast.JSONTableOnResponseDefault, Default: $2}
+	}
 
 PartitionNameListOpt:
 	/* empty */
@@ -12303,6 +12527,12 @@ FlushOption:
 			Tp: ast.FlushClientErrorsSummary,
 		}
 	}
+|	"CARDINALITY_FEEDBACK"
+	{
+		$$ = &ast.FlushStmt{
+			Tp: ast.FlushCardinalityFeedback,
+		}
+	}
 
 LogTypeOpt:
 	/* empty */
@@ -12364,6 +12594,7 @@ Statement:
 	EmptyStmt
 |	AdminStmt
 |	AlterDatabaseStmt
+|	AlterEventStmt
 |	AlterTableStmt
 |	AlterUserStmt
 |	AlterInstanceStmt
@@ -12382,7 +12613,11 @@ Statement:
 |	ExplainStmt
 |	CalibrateResourceStmt
 |	CreateDatabaseStmt
+|	CreateEventStmt
 |	CreateIndexStmt
+|	CreateMaskingPolicyStmt
+|	CreateMaterializedViewStmt
+|	CreateRowPolicyStmt
 |	CreateTableStmt
 |	CreateViewStmt
 |	CreateUserStmt
@@ -12390,6 +12625,7 @@ Statement:
 |	CreateBindingStmt
 |	CreatePolicyStmt
 |	CreateProcedureStmt
+|	CreateTriggerStmt
 |	CreateResourceGroupStmt
 |	AddQueryWatchStmt
 |	CreateSequenceStmt
@@ -12397,9 +12633,13 @@ Statement:
 |	DistributeTableStmt
 |	DoStmt
 |	DropDatabaseStmt
+|	DropEventStmt
 |	DropIndexStmt
+|	DropMaskingPolicyStmt
+|	DropRowPolicyStmt
 |	DropTableStmt
 |	DropProcedureStmt
+|	DropTriggerStmt
 |	DropPolicyStmt
 |	DropSequenceStmt
 |	DropViewStmt
@@ -12423,10 +12663,12 @@ Statement:
 |	KillStmt
 |	LoadDataStmt
 |	LoadStatsStmt
+|	MergeStmt
 |	LockStatsStmt
 |	UnlockStatsStmt
 |	PlanReplayerStmt
 |	PreparedStmt
+|	RefreshMaterializedViewStmt
 |	RollbackStmt
 |	RenameTableStmt
 |	RenameUserStmt
@@ -12506,6 +12748,7 @@ TraceableStmt:
 ExplainableStmt:
 	DeleteFromStmt
 |	UpdateStmt
+|	MergeStmt
 |	InsertIntoStmt
 |	ReplaceIntoStmt
 |	SetOprStmt
@@ -12986,6 +13229,7 @@ Type:
 	NumericType
 |	StringType
 |	DateAndTimeType
+|	SpatialType
 
 NumericType:
 	IntegerType OptFieldLen FieldOpts
@@ -13483,6 +13727,55 @@ OptCharsetWithOptBinary:
 		}
 	}
 
+SpatialType:
+	GeometryType
+	{
+		tp := types.NewFieldType(mysql.TypeGeometry)
+		tp.SetGeometryType($1.(byte))
+		tp.SetCharset(charset.CharsetBin)
+		tp.SetCollate(charset.CollationBin)
+		tp.AddFlag(mysql.BinaryFlag)
+		$$ = tp
+	}
+
+GeometryType:
+	"GEOMETRY"
+	{
+		$$ = mysql.GeometryTypeGeometry
+	}
+|	"POINT"
+	{
+		$$ = mysql.GeometryTypePoint
+	}
+|	"LINESTRING"
+	{
+		$$ = mysql.GeometryTypeLineString
+	}
+|	"POLYGON"
+	{
+		$$ = mysql.GeometryTypePolygon
+	}
+|	"MULTIPOINT"
+	{
+		$$ = mysql.GeometryTypeMultiPoint
+	}
+|	"MULTILINESTRING"
+	{
+		$$ = mysql.GeometryTypeMultiLineString
+	}
+|	"MULTIPOLYGON"
+	{
+		$$ = mysql.GeometryTypeMultiPolygon
+	}
+|	"GEOMETRYCOLLECTION"
+	{
+		$$ = mysql.GeometryTypeGeometryCollection
+	}
+|	"GEOMCOLLECTION"
+	{
+		$$ = mysql.GeometryTypeGeometryCollection
+	}
+
 DateAndTimeType:
 	"DATE"
 	{
@@ -13756,6 +14049,91 @@ UpdateStmtNoWith:
 		$$ = st
 	}
 
+/***********************************************************************************
+ * Merge Statement
+ *
+ * MERGE INTO tbl_name [[AS] alias]
+ * USING table_reference
+ * ON search_condition
+ * WHEN MATCHED [AND condition] THEN {UPDATE SET assignment_list | DELETE}
+ * WHEN NOT MATCHED [AND condition] THEN INSERT [(col_name, ...)] VALUES (expr, ...)
+ ***********************************************************************************/
+MergeStmt:
+	"MERGE" "INTO" TableName TableAsNameOpt "USING" TableFactor "ON" Expression MergeClauseList
+	{
+		$$ = &ast.MergeStmt{
+			Target:  &ast.TableSource{Source: $3.(*ast.TableName), AsName: $4.(ast.CIStr)},
+			Source:  $6.(*ast.TableSource),
+			On:      $8,
+			Clauses: $9.([]*ast.MergeClause),
+		}
+	}
+
+MergeClauseList:
+	MergeClause
+	{
+		$$ = []*ast.MergeClause{$1.(*ast.MergeClause)}
+	}
+|	MergeClauseList MergeClause
+	{
+		$$ = append($1.([]*ast.MergeClause), $2.(*ast.MergeClause))
+	}
+
+MergeClause:
+	"WHEN" "MATCHED" MergeConditionOpt "THEN" "UPDATE" "SET" AssignmentList
+	{
+		clause := &ast.MergeClause{
+			Action:      ast.MergeActionUpdate,
+			Assignments: $7.([]*ast.Assignment),
+		}
+		if $3 != nil {
+			clause.Condition = $3.(ast.ExprNode)
+		}
+		$$ = clause
+	}
+|	"WHEN" "MATCHED" MergeConditionOpt "THEN" "DELETE"
+	{
+		clause := &ast.MergeClause{Action: ast.MergeActionDelete}
+		if $3 != nil {
+			clause.Condition = $3.(ast.ExprNode)
+		}
+		$$ = clause
+	}
+|	"WHEN" "NOT" "MATCHED" MergeConditionOpt "THEN" "INSERT" ValueSym RowValue
+	{
+		clause := &ast.MergeClause{
+			NotMatched: true,
+			Action:     ast.MergeActionInsert,
+			Values:     $8.([]ast.ExprNode),
+		}
+		if $4 != nil {
+			clause.Condition = $4.(ast.ExprNode)
+		}
+		$$ = clause
+	}
+|	"WHEN" "NOT" "MATCHED" MergeConditionOpt "THEN" "INSERT" '(' ColumnNameListOpt ')' ValueSym RowValue
+	{
+		clause := &ast.MergeClause{
+			NotMatched: true,
+			Action:     ast.MergeActionInsert,
+			Columns:    $8.([]*ast.ColumnName),
+			Values:     $11.([]ast.ExprNode),
+		}
+		if $4 != nil {
+			clause.Condition = $4.(ast.ExprNode)
+		}
+		$$ = clause
+	}
+
+MergeConditionOpt:
+	{
+		$$ = nil
+	}
+|	"AND" Expression
+	{
+		$$ = $2
+	}
+
 UseStmt:
 	"USE" DBName
 	{
@@ -15533,6 +15911,198 @@ CreatePolicyStmt:
 		}
 	}
 
+/********************************************************************************************
+ *  CREATE MASKING POLICY [IF NOT EXISTS] policy_name ON tbl_name (col_name)
+ *  USING {REDACT | PARTIAL(prefix, suffix) | HASH | NULL | DATE_TRUNC(unit)}
+ ********************************************************************************************/
+CreateMaskingPolicyStmt:
+	"CREATE" "MASKING" "POLICY" IfNotExists Identifier "ON" TableName '(' Identifier ')' "USING" MaskingOption
+	{
+		$$ = &ast.CreateMaskingPolicyStmt{
+			IfNotExists: $4.(bool),
+			PolicyName:  ast.NewCIStr($5),
+			Table:       $7.(*ast.TableName),
+			Column:      ast.NewCIStr($9),
+			Option:      $12.(*ast.MaskingOption),
+		}
+	}
+
+MaskingOption:
+	"NULL"
+	{
+		$$ = &ast.MaskingOption{Tp: ast.MaskingNull}
+	}
+|	Identifier
+	{
+		x := ast.NewMaskingOption($1)
+		if x == nil || (x.Tp != ast.MaskingRedact && x.Tp != ast.MaskingHash) {
+			yylex.AppendError(yylex.Errorf("Unsupported masking function %s", $1))
+			return 1
+		}
+		$$ = x
+	}
+|	Identifier '(' LengthNum ',' LengthNum ')'
+	{
+		x := ast.NewMaskingOption($1)
+		if x == nil || x.Tp != ast.MaskingPartial {
+			yylex.AppendError(yylex.Errorf("Unsupported masking function %s", $1))
+			return 1
+		}
+		x.KeepPrefix = $3.(uint64)
+		x.KeepSuffix = $5.(uint64)
+		$$ = x
+	}
+|	Identifier '(' TimeUnit ')'
+	{
+		x := ast.NewMaskingOption($1)
+		if x == nil || x.Tp != ast.MaskingDateTrunc {
+			yylex.AppendError(yylex.Errorf("Unsupported masking function %s", $1))
+			return 1
+		}
+		x.Unit = $3.(ast.TimeUnitType)
+		$$ = x
+	}
+
+/********************************************************************************************
+ *  DROP MASKING POLICY [IF EXISTS] policy_name ON tbl_name
+ ********************************************************************************************/
+DropMaskingPolicyStmt:
+	"DROP" "MASKING" "POLICY" IfExists Identifier "ON" TableName
+	{
+		$$ = &ast.DropMaskingPolicyStmt{
+			IfExists:   $4.(bool),
+			PolicyName: ast.NewCIStr($5),
+			Table:      $7.(*ast.TableName),
+		}
+	}
+
+/********************************************************************************************
+ *  CREATE POLICY [IF NOT EXISTS] policy_name ON tbl_name
+ *  [AS {PERMISSIVE | RESTRICTIVE}]
+ *  [FOR {ALL | SELECT | INSERT | UPDATE | DELETE}]
+ *  [TO role [, role] ...]
+ *  [USING (expr)]
+ *  [WITH CHECK (expr)]
+ ********************************************************************************************/
+CreateRowPolicyStmt:
+	"CREATE" "POLICY" IfNotExists Identifier "ON" TableName RowPolicyAsOpt RowPolicyForOpt RowPolicyToOpt RowPolicyUsingOpt RowPolicyCheckOpt
+	{
+		if $10 == nil && $11 == nil {
+			yylex.AppendError(yylex.Errorf("USING or WITH CHECK expression must be specified for CREATE POLICY"))
+			return 1
+		}
+		stmt := &ast.CreateRowPolicyStmt{
+			IfNotExists: $3.(bool),
+			PolicyName:  ast.NewCIStr($4),
+			Table:       $6.(*ast.TableName),
+			Restrictive: $7.(bool),
+			Command:     $8.(ast.RowPolicyCommand),
+		}
+		if $9 != nil {
+			stmt.Roles = $9.([]*auth.RoleIdentity)
+		}
+		if $10 != nil {
+			stmt.Using = $10.(ast.ExprNode)
+		}
+		if $11 != nil {
+			stmt.WithCheck = $11.(ast.ExprNode)
+		}
+		$$ = stmt
+	}
+
+RowPolicyAsOpt:
+	{
+		$$ = false
+	}
+|	"AS" Identifier
+	{
+		switch strings.ToLower($2) {
+		case "permissive":
+			$$ = false
+		case "restrictive":
+			$$ = true
+		default:
+			yylex.AppendError(yylex.Errorf("Unsupported policy type %s", $2))
+			return 1
+		}
+	}
+
+RowPolicyForOpt:
+	{
+		$$ = ast.RowPolicyAll
+	}
+|	"FOR" "ALL"
+	{
+		$$ = ast.RowPolicyAll
+	}
+|	"FOR" "SELECT"
+	{
+		$$ = ast.RowPolicySelect
+	}
+|	"FOR" "INSERT"
+	{
+		$$ = ast.RowPolicyInsert
+	}
+|	"FOR" "UPDATE"
+	{
+		$$ = ast.RowPolicyUpdate
+	}
+|	"FOR" "DELETE"
+	{
+		$$ = ast.RowPolicyDelete
+	}
+
+RowPolicyToOpt:
+	{
+		$$ = nil
+	}
+|	"TO" RolenameList
+	{
+		roles := $2.([]*auth.RoleIdentity)
+		for _, role := range roles {
+			if strings.EqualFold(role.Username, "public") && role.Hostname == "%" {
+				roles = nil
+				break
+			}
+		}
+		if len(roles) == 0 {
+			$$ = nil
+		} else {
+			$$ = roles
+		}
+	}
+
+RowPolicyUsingOpt:
+	{
+		$$ = nil
+	}
+|	"USING" '(' Expression ')'
+	{
+		$$ = $3
+	}
+
+RowPolicyCheckOpt:
+	{
+		$$ = nil
+	}
+|	"WITH" "CHECK" '(' Expression ')'
+	{
+		$$ = $4
+	}
+
+/********************************************************************************************
+ *  DROP POLICY [IF EXISTS] policy_name ON tbl_name
+ ********************************************************************************************/
+DropRowPolicyStmt:
+	"DROP" "POLICY" IfExists Identifier "ON" TableName
+	{
+		$$ = &ast.DropRowPolicyStmt{
+			IfExists:   $3.(bool),
+			PolicyName: ast.NewCIStr($4),
+			Table:      $6.(*ast.TableName),
+		}
+	}
+
 AlterPolicyStmt:
 	"ALTER" "PLACEMENT" "POLICY" IfExists PolicyName PlacementOptionList
 	{
@@ -16125,6 +16695,8 @@ ProcedureStatementStmt:
 |	DeleteFromStmt
 |	AnalyzeTableStmt
 |	TruncateTableStmt
+|	CallStmt
+|	RefreshMaterializedViewStmt
 
 ProcedureCursorSelectStmt:
 	SelectStmt
@@ -16515,6 +17087,12 @@ ProcedureUnlabelLoopStmt:
 			Condition: $4.(ast.ExprNode),
 		}
 	}
+|	"LOOP" ProcedureProcStmt1s "END" "LOOP"
+	{
+		$$ = &ast.ProcedureLoopStmt{
+			Body: $2.([]ast.StmtNode),
+		}
+	}
 
 ProcedureLabeledBlock:
 	identifier ':' ProcedureBlockContent ProcedurceLabelOpt
@@ -16594,6 +17172,7 @@ ProcedureProcStmt:
  *	CREATE
  *  [DEFINER = user]
  *  PROCEDURE [IF NOT EXISTS] sp_name ([proc_parameter[,...]])
+ *  [SQL SECURITY { DEFINER | INVOKER }]
  *  routine_body
  *  proc_parameter:
  *  [ IN | OUT | INOUT ] param_name type
@@ -16605,26 +17184,44 @@ ProcedureProcStmt:
  *  Valid SQL routine statement
  ********************************************************************************************/
 CreateProcedureStmt:
-	"CREATE" "PROCEDURE" IfNotExists TableName '(' OptSpPdparams ')' ProcedureProcStmt
+	"CREATE" "PROCEDURE" IfNotExists TableName '(' OptSpPdparams ')' ProcedureSQLSecurityOpt ProcedureProcStmt
 	{
 		x := &ast.ProcedureInfo{
 			IfNotExists:    $3.(bool),
 			ProcedureName:  $4.(*ast.TableName),
 			ProcedureParam: $6.([]*ast.StoreParameter),
-			ProcedureBody:  $8,
+			ProcedureBody:  $9,
+		}
+		if $8 != nil {
+			x.Security = $8.(ast.ViewSecurity)
+			x.ExplicitSecurity = true
 		}
 		startOffset := parser.startOffset(&yyS[yypt])
-		originStmt := $8
+		originStmt := $9
 		originStmt.SetText(parser.lexer.client, strings.TrimSpace(parser.src[startOffset:parser.yylval.offset]))
-		startOffset = parser.startOffset(&yyS[yypt-3])
+		startOffset = parser.startOffset(&yyS[yypt-4])
 		if parser.src[startOffset] == '(' {
 			startOffset++
 		}
-		endOffset := parser.startOffset(&yyS[yypt-1])
+		endOffset := parser.startOffset(&yyS[yypt-2])
 		x.ProcedureParamStr = strings.TrimSpace(parser.src[startOffset:endOffset])
 		$$ = x
 	}
 
+ProcedureSQLSecurityOpt:
+	/* EMPTY */
+	{
+		$$ = nil
+	}
+|	"SQL" "SECURITY" "DEFINER"
+	{
+		$$ = ast.SecurityDefiner
+	}
+|	"SQL" "SECURITY" "INVOKER"
+	{
+		$$ = ast.SecurityInvoker
+	}
+
 /********************************************************************************************
 *  DROP PROCEDURE  [IF EXISTS] sp_name
 ********************************************************************************************/
@@ -16637,6 +17234,343 @@ DropProcedureStmt:
 		}
 	}
 
+/********************************************************************************************
+ *  CREATE TRIGGER [IF NOT EXISTS] trigger_name
+ *  {BEFORE | AFTER} {INSERT | UPDATE | DELETE}
+ *  ON tbl_name FOR EACH ROW
+ *  trigger_body
+ ********************************************************************************************/
+CreateTriggerStmt:
+	"CREATE" "TRIGGER" IfNotExists TableName TriggerTiming TriggerEvent "ON" TableName "FOR" "EACH" "ROW" ProcedureProcStmt
+	{
+		startOffset := parser.startOffset(&yyS[yypt])
+		body := $12
+		body.SetText(parser.lexer.client, strings.TrimSpace(parser.src[startOffset:parser.yylval.offset]))
+		$$ = &ast.CreateTriggerStmt{
+			IfNotExists: $3.(bool),
+			TriggerName: $4.(*ast.TableName),
+			Timing:      $5.(ast.TriggerTiming),
+			Event:       $6.(ast.TriggerEvent),
+			Table:       $8.(*ast.TableName),
+			Body:        body,
+		}
+	}
+
+TriggerTiming:
+	"BEFORE"
+	{
+		$$ = ast.TriggerBefore
+	}
+|	"AFTER"
+	{
+		$$ = ast.TriggerAfter
+	}
+
+TriggerEvent:
+	"INSERT"
+	{
+		$$ = ast.TriggerInsert
+	}
+|	"UPDATE"
+	{
+		$$ = ast.TriggerUpdate
+	}
+|	"DELETE"
+	{
+		$$ = ast.TriggerDelete
+	}
+
+/********************************************************************************************
+ *  DROP TRIGGER [IF EXISTS] [schema_name.]trigger_name
+ ********************************************************************************************/
+DropTriggerStmt:
+	"DROP" "TRIGGER" IfExists TableName
+	{
+		$$ = &ast.DropTriggerStmt{
+			IfExists:    $3.(bool),
+			TriggerName: $4.(*ast.TableName),
+		}
+	}
+
+/********************************************************************************************
+ *  CREATE MATERIALIZED VIEW [IF NOT EXISTS] view_name [(column_list)]
+ *  [REFRESH COMPLETE]
+ *  AS select_statement
+ ********************************************************************************************/
+CreateMaterializedViewStmt:
+	"CREATE" "MATERIALIZED" "VIEW" IfNotExists ViewName ViewFieldList MaterializedViewRefreshOpt "AS" CreateViewSelectOpt
+	{
+		x := &ast.CreateMaterializedViewStmt{
+			IfNotExists:   $4.(bool),
+			ViewName:      $5.(*ast.TableName),
+			RefreshMethod: $7.(ast.MaterializedViewRefreshMethod),
+			Select:        $9.(ast.StmtNode),
+		}
+		if $6 != nil {
+			x.Cols = $6.([]ast.CIStr)
+		}
+		$$ = x
+	}
+
+MaterializedViewRefreshOpt:
+	/* EMPTY */
+	{
+		$$ = ast.RefreshMethodDefault
+	}
+|	"REFRESH" MaterializedViewRefreshMethod
+	{
+		$$ = $2
+	}
+
+MaterializedViewRefreshMethod:
+	"COMPLETE"
+	{
+		$$ = ast.RefreshMethodComplete
+	}
+
+/********************************************************************************************
+ *  REFRESH MATERIALIZED VIEW view_name [COMPLETE]
+ ********************************************************************************************/
+RefreshMaterializedViewStmt:
+	"REFRESH" "MATERIALIZED" "VIEW" TableName
+	{
+		$$ = &ast.RefreshMaterializedViewStmt{ViewName: $4.(*ast.TableName)}
+	}
+|	"REFRESH" "MATERIALIZED" "VIEW" TableName MaterializedViewRefreshMethod
+	{
+		$$ = &ast.RefreshMaterializedViewStmt{
+			ViewName: $4.(*ast.TableName),
+			Method:   $5.(ast.MaterializedViewRefreshMethod),
+		}
+	}
+
+/********************************************************************************************
+ *
+ *  Create Event Statement
+ *
+ *  Example:
+ *	CREATE
+ *	[DEFINER = user]
+ *	EVENT [IF NOT EXISTS] event_name
+ *	ON SCHEDULE schedule
+ *	[ON COMPLETION [NOT] PRESERVE]
+ *	[ENABLE | DISABLE | DISABLE ON SLAVE]
+ *	[COMMENT 'string']
+ *	DO event_body;
+ *
+ *	schedule: {
+ *	AT timestamp [+ INTERVAL interval] ...
+ *	| EVERY interval
+ *	[STARTS timestamp [+ INTERVAL interval] ...]
+ *	[ENDS timestamp [+ INTERVAL interval] ...]
+ *	}
+ ********************************************************************************************/
+CreateEventStmt:
+	"CREATE" OrReplace ViewAlgorithm ViewDefiner "EVENT" IfNotExists TableName "ON" "SCHEDULE" EventSchedule EventOnCompletionOpt EventStatusOpt EventCommentOpt "DO" ProcedureStatementStmt
+	{
+		// `OrReplace` and `ViewAlgorithm` share the prefix with CREATE VIEW statement, they are not allowed here.
+		if $2.(bool) || $3.(ast.ViewAlgorithm) != ast.AlgorithmUndefined {
+			yylex.AppendError(yylex.Errorf("OR REPLACE and ALGORITHM are not supported in CREATE EVENT statement"))
+			return 1
+		}
+		startOffset := parser.startOffset(&yyS[yypt])
+		body := $15.(ast.StmtNode)
+		body.SetText(parser.lexer.client, strings.TrimSpace(parser.src[startOffset:parser.yylval.offset]))
+		x := &ast.CreateEventStmt{
+			IfNotExists:  $6.(bool),
+			Definer:      $4.(*auth.UserIdentity),
+			EventName:    $7.(*ast.TableName),
+			Schedule:     $10.(*ast.EventSchedule),
+			OnCompletion: $11.(ast.EventCompletion),
+			Status:       $12.(ast.EventStatus),
+			Body:         body,
+		}
+		if $13 != nil {
+			x.Comment = $13.(string)
+		}
+		$$ = x
+	}
+
+EventSchedule:
+	"AT" Expression
+	{
+		$$ = &ast.EventSchedule{At: $2}
+	}
+|	"EVERY" Expression TimeUnit EventStartsOpt EventEndsOpt
+	{
+		x := &ast.EventSchedule{
+			Every: $2,
+			Unit:  $3.(ast.TimeUnitType),
+		}
+		if $4 != nil {
+			x.Starts = $4.(ast.ExprNode)
+		}
+		if $5 != nil {
+			x.Ends = $5.(ast.ExprNode)
+		}
+		$$ = x
+	}
+
+EventStartsOpt:
+	{
+		$$ = nil
+	}
+|	"STARTS" Expression
+	{
+		$$ = $2
+	}
+
+EventEndsOpt:
+	{
+		$$ = nil
+	}
+|	"ENDS" Expression
+	{
+		$$ = $2
+	}
+
+EventOnCompletionOpt:
+	{
+		$$ = ast.EventCompletionUnspecified
+	}
+|	EventOnCompletion
+
+EventOnCompletion:
+	"ON" "COMPLETION" "PRESERVE"
+	{
+		$$ = ast.EventCompletionPreserve
+	}
+|	"ON" "COMPLETION" "NOT" "PRESERVE"
+	{
+		$$ = ast.EventCompletionNotPreserve
+	}
+
+EventStatusOpt:
+	{
+		$$ = ast.EventStatusUnspecified
+	}
+|	"ENABLE"
+	{
+		$$ = ast.EventStatusEnable
+	}
+|	"DISABLE"
+	{
+		$$ = ast.EventStatusDisable
+	}
+|	"DISABLE" "ON" "SLAVE"
+	{
+		$$ = ast.EventStatusSlavesideDisable
+	}
+
+EventCommentOpt:
+	{
+		$$ = nil
+	}
+|	"COMMENT" stringLit
+	{
+		$$ = $2
+	}
+
+/********************************************************************************************
+ *
+ *  Alter Event Statement
+ *
+ *  Example:
+ *	ALTER
+ *	[DEFINER = user]
+ *	EVENT event_name
+ *	[ON SCHEDULE schedule]
+ *	[ON COMPLETION [NOT] PRESERVE]
+ *	[RENAME TO new_event_name]
+ *	[ENABLE | DISABLE | DISABLE ON SLAVE]
+ *	[COMMENT 'string']
+ *	[DO event_body]
+ ********************************************************************************************/
+AlterEventStmt:
+	"ALTER" EventDefinerOpt "EVENT" TableName AlterEventScheduleOpt EventRenameOpt EventStatusOpt EventCommentOpt EventBodyOpt
+	{
+		x := $5.(*ast.AlterEventStmt)
+		x.EventName = $4.(*ast.TableName)
+		x.Status = $7.(ast.EventStatus)
+		if $2 != nil {
+			x.Definer = $2.(*auth.UserIdentity)
+		}
+		if $6 != nil {
+			x.NewName = $6.(*ast.TableName)
+		}
+		if $8 != nil {
+			comment := $8.(string)
+			x.Comment = &comment
+		}
+		if $9 != nil {
+			x.Body = $9.(ast.StmtNode)
+		}
+		if x.Definer == nil && x.Schedule == nil && x.OnCompletion == ast.EventCompletionUnspecified && x.NewName == nil &&
+			x.Status == ast.EventStatusUnspecified && x.Comment == nil && x.Body == nil {
+			yylex.AppendError(yylex.Errorf("ALTER EVENT statement should have at least one option"))
+			return 1
+		}
+		$$ = x
+	}
+
+EventDefinerOpt:
+	{
+		$$ = nil
+	}
+|	"DEFINER" "=" Username
+	{
+		$$ = $3
+	}
+
+AlterEventScheduleOpt:
+	{
+		$$ = &ast.AlterEventStmt{}
+	}
+|	"ON" "SCHEDULE" EventSchedule EventOnCompletionOpt
+	{
+		$$ = &ast.AlterEventStmt{
+			Schedule:     $3.(*ast.EventSchedule),
+			OnCompletion: $4.(ast.EventCompletion),
+		}
+	}
+|	EventOnCompletion
+	{
+		$$ = &ast.AlterEventStmt{OnCompletion: $1.(ast.EventCompletion)}
+	}
+
+EventRenameOpt:
+	{
+		$$ = nil
+	}
+|	"RENAME" "TO" TableName
+	{
+		$$ = $3
+	}
+
+EventBodyOpt:
+	{
+		$$ = nil
+	}
+|	"DO" ProcedureStatementStmt
+	{
+		startOffset := parser.startOffset(&yyS[yypt])
+		body := $2.(ast.StmtNode)
+		body.SetText(parser.lexer.client, strings.TrimSpace(parser.src[startOffset:parser.yylval.offset]))
+		$$ = body
+	}
+
+/********************************************************************************************
+ *  DROP EVENT [IF EXISTS] event_name
+ ********************************************************************************************/
+DropEventStmt:
+	"DROP" "EVENT" IfExists TableName
+	{
+		$$ = &ast.DropEventStmt{
+			IfExists:  $3.(bool),
+			EventName: $4.(*ast.TableName),
+		}
+	}
+
 /********************************************************************
  *
  * Calibrate Resource Statement
@@ -1456,6 +1456,7 @@ func TestDBAStmt(t *testing.T) {
 		{"flush general logs", true, "FLUSH GENERAL LOGS"},
 		{"flush slow logs", true, "FLUSH SLOW LOGS"},
 		{"flush client_errors_summary", true, "FLUSH CLIENT_ERRORS_SUMMARY"},
+		{"flush cardinality_feedback", true, "FLUSH CARDINALITY_FEEDBACK"},
 
 		// for call statement
 		{"call ", false, ""},
@@ -1999,6 +2000,13 @@ func TestBuiltin(t *testing.T) {
 		// repeat
 		{`SELECT REPEAT("a", 10);`, true, "SELECT REPEAT(_UTF8MB4'a', 10)"},
 
+		// for spatial functions
+		{"select point(1, 2)", true, "SELECT POINT(1, 2)"},
+		{"select linestring(point(0, 0), point(1, 1))", true, "SELECT LINESTRING(POINT(0, 0), POINT(1, 1))"},
+		{"select polygon(linestring(point(0, 0), point(1, 0), point(1, 1), point(0, 0)))", true, "SELECT POLYGON(LINESTRING(POINT(0, 0), POINT(1, 0), POINT(1, 1), POINT(0, 0)))"},
+		{"select multipoint(point(0, 0)), multilinestring(a), multipolygon(b), geometrycollection(c), geomcollection()", true, "SELECT MULTIPOINT(POINT(0, 0)),MULTILINESTRING(`a`),MULTIPOLYGON(`b`),GEOMETRYCOLLECTION(`c`),GEOMCOLLECTION()"},
+		{"select st_astext(st_geomfromtext('POINT(1 2)', 4326))", true, "SELECT ST_ASTEXT(ST_GEOMFROMTEXT(_UTF8MB4'POINT(1 2)', 4326))"},
+
 		// for miscellaneous functions
 		{`SELECT SLEEP(10);`, true, "SELECT SLEEP(10)"},
 		{`SELECT ANY_VALUE(@arg);`, true, "SELECT ANY_VALUE(@`arg`)"},
@@ -3015,6 +3023,16 @@ func TestDDL(t *testing.T) {
 		{"create table t (j json default (json_object('foo', 5, 'bar', 'barfoo')))", true, "CREATE TABLE `t` (`j` JSON DEFAULT (JSON_OBJECT(_UTF8MB4'foo', 5, _UTF8MB4'bar', _UTF8MB4'barfoo')))"},
 		{"create table t (j json default (json_array(1,2,3)))", true, "CREATE TABLE `t` (`j` JSON DEFAULT (JSON_ARRAY(1, 2, 3)))"},
 		{"create table t (j json default (json_quote('foobar')))", true, "CREATE TABLE `t` (`j` JSON DEFAULT (JSON_QUOTE(_UTF8MB4'foobar')))"},
+
+		// for spatial types
+		{"create table t (g geometry, p point, l linestring, pg polygon)", true, "CREATE TABLE `t` (`g` GEOMETRY,`p` POINT,`l` LINESTRING,`pg` POLYGON)"},
+		{"create table t (a multipoint, b multilinestring, c multipolygon, d geometrycollection, e geomcollection)", true, "CREATE TABLE `t` (`a` MULTIPOINT,`b` MULTILINESTRING,`c` MULTIPOLYGON,`d` GEOMCOLLECTION,`e` GEOMCOLLECTION)"},
+		{"create table t (p point not null srid 4326)", true, "CREATE TABLE `t` (`p` POINT NOT NULL SRID 4326)"},
+		{"create table t (g geometry srid 0 comment 'g')", true, "CREATE TABLE `t` (`g` GEOMETRY SRID 0 COMMENT 'g')"},
+		{"create table t (p point srid)", false, ""},
+		{"create table t (p point srid -1)", false, ""},
+		{"alter table t add column p point srid 4326", true, "ALTER TABLE `t` ADD COLUMN `p` POINT SRID 4326"},
+		{"create table t (geometry int, polygon int, srid int)", true, "CREATE TABLE `t` (`geometry` INT,`polygon` INT,`srid` INT)"},
 		{"create table t (c char(33) default (nonexistingfunc('foobar')))", true, "CREATE TABLE `t` (`c` CHAR(33) DEFAULT (NONEXISTINGFUNC(_UTF8MB4'foobar')))"},
 		{"create table t (c char(33) default 'foobar')", true, "CREATE TABLE `t` (`c` CHAR(33) DEFAULT _UTF8MB4'foobar')"},
 		{"create table t (c char(33) default ('foobar'))", true, "CREATE TABLE `t` (`c` CHAR(33) DEFAULT _UTF8MB4'foobar')"},
@@ -5958,6 +5976,163 @@ CREATE VIEW v2 AS SELECT 123123123123123;
 	require.Equal(t, nodes[1].(*ast.CreateViewStmt).Select.Text(), "SELECT 123123123123123")
 }
 
+func TestEvent(t *testing.T) {
+	table := []testCase{
+		{"create event e on schedule every 1 day do insert into t values (1)", true, "CREATE DEFINER = CURRENT_USER EVENT `e` ON SCHEDULE EVERY 1 DAY DO INSERT INTO `t` VALUES (1)"},
+		{"create definer = 'root'@'%' event if not exists test.e on schedule every '1:30' hour_minute starts '2024-01-01 00:00:00' ends '2025-01-01 00:00:00' + interval 1 day on completion preserve disable comment 'cleanup' do delete from t where a < now()",
+			true, "CREATE DEFINER = `root`@`%` EVENT IF NOT EXISTS `test`.`e` ON SCHEDULE EVERY _UTF8MB4'1:30' HOUR_MINUTE STARTS _UTF8MB4'2024-01-01 00:00:00' ENDS DATE_ADD(_UTF8MB4'2025-01-01 00:00:00', INTERVAL 1 DAY) ON COMPLETION PRESERVE DISABLE COMMENT 'cleanup' DO DELETE FROM `t` WHERE `a`<NOW()"},
+		{"create event e on schedule at current_timestamp + interval 1 hour on completion not preserve enable do call p()", true, "CREATE DEFINER = CURRENT_USER EVENT `e` ON SCHEDULE AT DATE_ADD(CURRENT_TIMESTAMP(), INTERVAL 1 HOUR) ON COMPLETION NOT PRESERVE ENABLE DO CALL `p`()"},
+		{"create event e on schedule every 1 minute disable on slave do analyze table t", true, "CREATE DEFINER = CURRENT_USER EVENT `e` ON SCHEDULE EVERY 1 MINUTE DISABLE ON SLAVE DO ANALYZE TABLE `t`"},
+		{"create or replace event e on schedule every 1 day do select 1", false, ""},
+		{"create event e on schedule every 1 day", false, ""},
+		{"create event e do select 1", false, ""},
+
+		{"alter event e disable", true, "ALTER EVENT `e` DISABLE"},
+		{"alter definer = current_user event test.e on schedule every 2 week on completion preserve rename to test.e1 enable comment '' do update t set a = a + 1", true, "ALTER DEFINER = CURRENT_USER EVENT `test`.`e` ON SCHEDULE EVERY 2 WEEK ON COMPLETION PRESERVE RENAME TO `test`.`e1` ENABLE COMMENT '' DO UPDATE `t` SET `a`=`a`+1"},
+		{"alter event e on schedule at '2024-01-01 00:00:00'", true, "ALTER EVENT `e` ON SCHEDULE AT _UTF8MB4'2024-01-01 00:00:00'"},
+		{"alter event e", false, ""},
+
+		{"drop event e", true, "DROP EVENT `e`"},
+		{"drop event if exists test.e", true, "DROP EVENT IF EXISTS `test`.`e`"},
+
+		// the keywords of events are not reserved
+		{"create table at (starts int, ends int, every int, completion int)", true, "CREATE TABLE `at` (`starts` INT,`ends` INT,`every` INT,`completion` INT)"},
+	}
+	RunTest(t, table, false)
+
+	p := parser.New()
+	stmt, _, err := p.Parse("create event e on schedule every 1 day do  insert into t values (1) ; ", "", "")
+	require.NoError(t, err)
+	require.Equal(t, "insert into t values (1)", stmt[0].(*ast.CreateEventStmt).Body.Text())
+	stmt, _, err = p.Parse("alter event e do select 1", "", "")
+	require.NoError(t, err)
+	require.Equal(t, "select 1", stmt[0].(*ast.AlterEventStmt).Body.Text())
+}
+
+func TestMaskingPolicy(t *testing.T) {
+	table := []testCase{
+		{"create masking policy p on t (c) using redact", true, "CREATE MASKING POLICY `p` ON `t` (`c`) USING REDACT"},
+		{"create masking policy if not exists p on test.t (c) using partial(2, 4)", true, "CREATE MASKING POLICY IF NOT EXISTS `p` ON `test`.`t` (`c`) USING PARTIAL(2, 4)"},
+		{"create masking policy p on t (c) using hash", true, "CREATE MASKING POLICY `p` ON `t` (`c`) USING HASH"},
+		{"create masking policy p on t (c) using null", true, "CREATE MASKING POLICY `p` ON `t` (`c`) USING NULL"},
+		{"create masking policy p on t (c) using date_trunc(month)", true, "CREATE MASKING POLICY `p` ON `t` (`c`) USING DATE_TRUNC(MONTH)"},
+		{"create masking policy p on t (c) using unknown", false, ""},
+		{"create masking policy p on t (c) using redact(1, 2)", false, ""},
+		{"create masking policy p on t (c) using partial", false, ""},
+		{"create masking policy p on t (c) using date_trunc(1, 2)", false, ""},
+		{"create masking policy p on t (c1, c2) using hash", false, ""},
+
+		{"drop masking policy p on t", true, "DROP MASKING POLICY `p` ON `t`"},
+		{"drop masking policy if exists p on test.t", true, "DROP MASKING POLICY IF EXISTS `p` ON `test`.`t`"},
+
+		// the keyword of masking policies is not reserved
+		{"create table masking (masking int)", true, "CREATE TABLE `masking` (`masking` INT)"},
+	}
+	RunTest(t, table, false)
+}
+
+func TestRowPolicy(t *testing.T) {
+	table := []testCase{
+		{"create policy p on t using (a > 1)", true, "CREATE POLICY `p` ON `t` AS PERMISSIVE FOR ALL TO PUBLIC USING (`a`>1)"},
+		{"create policy if not exists p on test.t as restrictive for select to u1, 'r1'@'%' using (owner = current_user())", true, "CREATE POLICY IF NOT EXISTS `p` ON `test`.`t` AS RESTRICTIVE FOR SELECT TO `u1`@`%`, `r1`@`%` USING (`owner`=CURRENT_USER())"},
+		{"create policy p on t as permissive for insert to public with check (a > 1)", true, "CREATE POLICY `p` ON `t` AS PERMISSIVE FOR INSERT TO PUBLIC WITH CHECK (`a`>1)"},
+		{"create policy p on t for update using (a > 1) with check (a < 10)", true, "CREATE POLICY `p` ON `t` AS PERMISSIVE FOR UPDATE TO PUBLIC USING (`a`>1) WITH CHECK (`a`<10)"},
+		{"create policy p on t for delete to u1@localhost using (a > 1)", true, "CREATE POLICY `p` ON `t` AS PERMISSIVE FOR DELETE TO `u1`@`localhost` USING (`a`>1)"},
+		{"create policy p on t", false, ""},
+		{"create policy p on t as unknown using (a > 1)", false, ""},
+		{"create policy p on t for replace using (a > 1)", false, ""},
+		{"create policy p on t using a > 1", false, ""},
+
+		{"drop policy p on t", true, "DROP POLICY `p` ON `t`"},
+		{"drop policy if exists p on test.t", true, "DROP POLICY IF EXISTS `p` ON `test`.`t`"},
+	}
+	RunTest(t, table, false)
+}
+
+func TestTrigger(t *testing.T) {
+	p := parser.New()
+	// The body of a trigger isn't traversed by the visitors, so compare the restored SQL instead of the AST.
+	createCases := []struct {
+		src     string
+		restore string
+	}{
+		{"create trigger tr before insert on t for each row set new.a = new.a + 1", "CREATE TRIGGER `tr` BEFORE INSERT ON `t` FOR EACH ROW SET @@SESSION.`new.a`=`new`.`a`+1"},
+		{"create trigger if not exists test.tr after update on test.t for each row begin insert into log values (old.a, new.a); update t2 set c = c + 1; end", "CREATE TRIGGER IF NOT EXISTS `test`.`tr` AFTER UPDATE ON `test`.`t` FOR EACH ROW BEGIN INSERT INTO `log` VALUES (`old`.`a`,`new`.`a`);UPDATE `t2` SET `c`=`c`+1; END"},
+		{"create trigger tr after delete on t for each row insert into log values (old.a)", "CREATE TRIGGER `tr` AFTER DELETE ON `t` FOR EACH ROW INSERT INTO `log` VALUES (`old`.`a`)"},
+	}
+	for _, c := range createCases {
+		stmt, err := p.ParseOneStmt(c.src, "", "")
+		require.NoError(t, err, c.src)
+		var sb strings.Builder
+		require.NoError(t, stmt.Restore(NewRestoreCtx(DefaultRestoreFlags, &sb)))
+		require.Equal(t, c.restore, sb.String())
+		_, err = p.ParseOneStmt(sb.String(), "", "")
+		require.NoError(t, err, sb.String())
+	}
+
+	// The text of the body is kept to store the trigger.
+	stmt, err := p.ParseOneStmt("create trigger tr before insert on t for each row begin set new.a = 1; end", "", "")
+	require.NoError(t, err)
+	trigger := stmt.(*ast.CreateTriggerStmt)
+	require.Equal(t, ast.TriggerBefore, trigger.Timing)
+	require.Equal(t, ast.TriggerInsert, trigger.Event)
+	require.Equal(t, "begin set new.a = 1; end", trigger.Body.Text())
+
+	table := []testCase{
+		{"create trigger tr before replace on t for each row set new.a = 1", false, ""},
+		{"create trigger tr before insert on t set new.a = 1", false, ""},
+		{"create trigger tr insert on t for each row set new.a = 1", false, ""},
+
+		{"drop trigger tr", true, "DROP TRIGGER `tr`"},
+		{"drop trigger if exists test.tr", true, "DROP TRIGGER IF EXISTS `test`.`tr`"},
+
+		{"select before, each from t", true, "SELECT `before`,`each` FROM `t`"},
+	}
+	RunTest(t, table, false)
+}
+
+func TestMerge(t *testing.T) {
+	table := []testCase{
+		{"merge into t using s on t.id = s.id when matched then update set t.v = s.v", true, "MERGE INTO `t` USING `s` ON `t`.`id`=`s`.`id` WHEN MATCHED THEN UPDATE SET `t`.`v`=`s`.`v`"},
+		{"merge into t using s on t.id = s.id when not matched then insert values (s.id, s.v)", true, "MERGE INTO `t` USING `s` ON `t`.`id`=`s`.`id` WHEN NOT MATCHED THEN INSERT VALUES (`s`.`id`,`s`.`v`)"},
+		{"merge into test.t as a using (select * from s) as b on a.id = b.id when matched and b.v is null then delete when matched then update set v = b.v, w = default when not matched and b.v > 0 then insert (id, v) values (b.id, b.v)", true, "MERGE INTO `test`.`t` AS `a` USING (SELECT * FROM `s`) AS `b` ON `a`.`id`=`b`.`id` WHEN MATCHED AND `b`.`v` IS NULL THEN DELETE WHEN MATCHED THEN UPDATE SET `v`=`b`.`v`,`w`=DEFAULT WHEN NOT MATCHED AND `b`.`v`>0 THEN INSERT (`id`,`v`) VALUES (`b`.`id`,`b`.`v`)"},
+		{"merge into t a using s b on a.id = b.id when not matched then insert value (b.id)", true, "MERGE INTO `t` AS `a` USING `s` AS `b` ON `a`.`id`=`b`.`id` WHEN NOT MATCHED THEN INSERT VALUES (`b`.`id`)"},
+		{"explain merge into t using s on t.id = s.id when matched then delete", true, "EXPLAIN FORMAT = 'row' MERGE INTO `t` USING `s` ON `t`.`id`=`s`.`id` WHEN MATCHED THEN DELETE"},
+		{"merge into t using s on t.id = s.id", false, ""},
+		{"merge into t using s when matched then delete", false, ""},
+		{"merge into t using s on t.id = s.id when matched then insert values (1)", false, ""},
+		{"merge into t using s on t.id = s.id when not matched then update set v = 1", false, ""},
+		{"merge into t using s on t.id = s.id when not matched then delete", false, ""},
+
+		// the keyword of merge statements is not reserved
+		{"create table matched (matched int)", true, "CREATE TABLE `matched` (`matched` INT)"},
+	}
+	RunTest(t, table, false)
+}
+
+func TestMaterializedView(t *testing.T) {
+	table := []testCase{
+		{"create materialized view mv as select a, count(*) from t group by a", true, "CREATE MATERIALIZED VIEW `mv` AS SELECT `a`,COUNT(1) FROM `t` GROUP BY `a`"},
+		{"create materialized view if not exists test.mv (a, cnt) refresh complete as select a, count(*) from t group by a", true, "CREATE MATERIALIZED VIEW IF NOT EXISTS `test`.`mv` (`a`,`cnt`) REFRESH COMPLETE AS SELECT `a`,COUNT(1) FROM `t` GROUP BY `a`"},
+		{"create materialized view mv refresh complete as select * from t1 join t2 on t1.a = t2.a", true, "CREATE MATERIALIZED VIEW `mv` REFRESH COMPLETE AS SELECT * FROM `t1` JOIN `t2` ON `t1`.`a`=`t2`.`a`"},
+		{"create materialized view mv refresh fast as select 1", false, ""},
+		{"create materialized view mv as (select 1) union (select 2)", true, "CREATE MATERIALIZED VIEW `mv` AS (SELECT 1) UNION (SELECT 2)"},
+		{"create materialized view mv as select 1 refresh complete", false, ""},
+		{"create or replace materialized view mv as select 1", false, ""},
+		{"refresh materialized view mv", true, "REFRESH MATERIALIZED VIEW `mv`"},
+		{"refresh materialized view test.mv complete", true, "REFRESH MATERIALIZED VIEW `test`.`mv` COMPLETE"},
+		{"refresh materialized view mv fast", false, ""},
+		{"refresh materialized view mv incremental", false, ""},
+		{"drop materialized view mv", true, "DROP MATERIALIZED VIEW `mv`"},
+		{"drop materialized view if exists mv1, test.mv2", true, "DROP MATERIALIZED VIEW IF EXISTS `mv1`, `test`.`mv2`"},
+		{"create event e on schedule every 1 minute do refresh materialized view mv", true, "CREATE DEFINER = CURRENT_USER EVENT `e` ON SCHEDULE EVERY 1 MINUTE DO REFRESH MATERIALIZED VIEW `mv`"},
+
+		// the keywords of materialized views are not reserved
+		{"create table refresh (complete int, fast int, materialized int)", true, "CREATE TABLE `refresh` (`complete` INT,`fast` INT,`materialized` INT)"},
+	}
+	RunTest(t, table, false)
+}
+
 func TestTimestampDiffUnit(t *testing.T) {
 	// Test case for timestampdiff unit.
 	// TimeUnit should be unified to upper case.
@@ -6200,6 +6375,42 @@ func TestAnalyze(t *testing.T) {
 	RunTest(t, table, false)
 }
 
+func TestJSONTable(t *testing.T) {
+	table := []testCase{
+		// positive test cases
+		{`select * from json_table('[{"a":1},{"a":2}]', '$[*]' columns (a int path '$.a')) as jt`, true, "SELECT * FROM JSON_TABLE(_UTF8MB4'[{\"a\":1},{\"a\":2}]', '$[*]' COLUMNS (`a` INT PATH '$.a')) AS `jt`"},
+		{`select * from json_table(@j, '$[*]' columns (id for ordinality, a varchar(10) path '$.a' default '"x"' on empty error on error, b int exists path '$.b')) jt`, true, "SELECT * FROM JSON_TABLE(@`j`, '$[*]' COLUMNS (`id` FOR ORDINALITY, `a` VARCHAR(10) PATH '$.a' DEFAULT '\"x\"' ON EMPTY ERROR ON ERROR, `b` INT EXISTS PATH '$.b')) AS `jt`"},
+		{`select * from json_table(@j, '$' columns (a json path '$.a' null on error, nested path '$.b[*]' columns (b int path '$', nested '$.c' columns (c int path '$')))) as jt`, true, "SELECT * FROM JSON_TABLE(@`j`, '$' COLUMNS (`a` JSON PATH '$.a' NULL ON ERROR, NESTED PATH '$.b[*]' COLUMNS (`b` INT PATH '$', NESTED PATH '$.c' COLUMNS (`c` INT PATH '$')))) AS `jt`"},
+		{`select t.id, jt.* from t join json_table(t.doc, '$[*]' columns (v int path '$')) as jt on true`, true, "SELECT `t`.`id`,`jt`.* FROM `t` JOIN JSON_TABLE(`t`.`doc`, '$[*]' COLUMNS (`v` INT PATH '$')) AS `jt` ON TRUE"},
+		{`select nested, path, ordinality, empty from t`, true, "SELECT `nested`,`path`,`ordinality`,`empty` FROM `t`"},
+
+		// negative test cases
+		{`select * from json_table(@j, '$' columns (a int path '$.a'))`, false, ""},
+		{`select * from json_table(@j, '$' columns ()) as jt`, false, ""},
+		{`select * from json_table(@j, '$' columns (a int)) as jt`, false, ""},
+		{`select * from json_table(@j, '$' columns (a int exists path '$.a' null on empty)) as jt`, false, ""},
+		{`select * from json_table(@j, '$' columns (a int path '$.a' null on error null on empty)) as jt`, false, ""},
+		{`select * from json_table(@j, @p columns (a int path '$.a')) as jt`, false, ""},
+	}
+	RunTest(t, table, false)
+}
+
+func TestLateralDerivedTable(t *testing.T) {
+	table := []testCase{
+		// positive test cases
+		{"select * from t, lateral (select * from t1 where t1.a = t.a limit 3) as d", true, "SELECT * FROM (`t`) JOIN LATERAL (SELECT * FROM `t1` WHERE `t1`.`a`=`t`.`a` LIMIT 3) AS `d`"},
+		{"select * from t left join lateral (select max(b) m from t1 where t1.a = t.a) d on true", true, "SELECT * FROM `t` LEFT JOIN LATERAL (SELECT MAX(`b`) AS `m` FROM `t1` WHERE `t1`.`a`=`t`.`a`) AS `d` ON TRUE"},
+		{"select * from t cross join lateral (select 1 union select t.a) as d", true, "SELECT * FROM `t` JOIN LATERAL (SELECT 1 UNION SELECT `t`.`a`) AS `d`"},
+		{"select `lateral` from t", true, "SELECT `lateral` FROM `t`"},
+
+		// negative test cases
+		{"select * from t, lateral (select 1)", false, ""},
+		{"select * from t, lateral t1", false, ""},
+		{"select lateral from t", false, ""},
+	}
+	RunTest(t, table, false)
+}
+
 func TestTableSample(t *testing.T) {
 	table := []testCase{
 		// positive test cases
@@ -6631,6 +6842,10 @@ func TestWindowFunctions(t *testing.T) {
 		{`SELECT COUNT(ALL profit) OVER() AS country_profit FROM sales;`, true, "SELECT COUNT(`profit`) OVER () AS `country_profit` FROM `sales`"},
 		{`SELECT COUNT(*) OVER() AS country_profit FROM sales;`, true, "SELECT COUNT(1) OVER () AS `country_profit` FROM `sales`"},
 		{`SELECT MAX(profit) OVER() AS country_profit FROM sales;`, true, "SELECT MAX(`profit`) OVER () AS `country_profit` FROM `sales`"},
+		{`SELECT GROUP_CONCAT(profit) OVER() FROM sales;`, true, "SELECT GROUP_CONCAT(`profit` SEPARATOR ',') OVER () FROM `sales`"},
+		{`SELECT GROUP_CONCAT(DISTINCT a, b ORDER BY b DESC SEPARATOR ';') OVER (PARTITION BY c ORDER BY d) FROM t;`, true, "SELECT GROUP_CONCAT(DISTINCT `a`, `b` ORDER BY `b` DESC SEPARATOR ';') OVER (PARTITION BY `c` ORDER BY `d`) FROM `t`"},
+		{`SELECT my_agg(profit, 0.5) OVER (PARTITION BY country ORDER BY year ROWS 1 PRECEDING) FROM sales;`, true, "SELECT MY_AGG(`profit`, 0.5) OVER (PARTITION BY `country` ORDER BY `year` ROWS BETWEEN 1 PRECEDING AND CURRENT ROW) FROM `sales`"},
+		{`SELECT my_agg(profit) OVER w FROM sales;`, true, "SELECT MY_AGG(`profit`) OVER `w` FROM `sales`"},
 		{`SELECT MIN(profit) OVER() AS country_profit FROM sales;`, true, "SELECT MIN(`profit`) OVER () AS `country_profit` FROM `sales`"},
 		{`SELECT SUM(profit) OVER() AS country_profit FROM sales;`, true, "SELECT SUM(`profit`) OVER () AS `country_profit` FROM `sales`"},
 		{`SELECT ROW_NUMBER() OVER(PARTITION BY country) AS row_num1 FROM sales;`, true, "SELECT ROW_NUMBER() OVER (PARTITION BY `country`) AS `row_num1` FROM `sales`"},
@@ -112,6 +112,22 @@ func TypeStr(tp byte) (r string) {
 	return type2Str[tp]
 }
 
+var geometryType2Str = map[byte]string{
+	mysql.GeometryTypeGeometry:           "geometry",
+	mysql.GeometryTypePoint:              "point",
+	mysql.GeometryTypeLineString:         "linestring",
+	mysql.GeometryTypePolygon:            "polygon",
+	mysql.GeometryTypeMultiPoint:         "multipoint",
+	mysql.GeometryTypeMultiLineString:    "multilinestring",
+	mysql.GeometryTypeMultiPolygon:       "multipolygon",
+	mysql.GeometryTypeGeometryCollection: "geomcollection",
+}
+
+// GeometryTypeToStr converts the subtype of the geometry type to a string.
+func GeometryTypeToStr(tp byte) string {
+	return geometryType2Str[tp]
+}
+
 // TypeToStr converts a field to a string.
 // It is used for converting Text to Blob,
 // or converting Char to Binary.
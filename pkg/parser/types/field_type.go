@@ -72,6 +72,8 @@ type FieldType struct {
 	elems            []string
 	elemsIsBinaryLit []bool
 	array            bool
+	// geometryType is the subtype of the geometry type, such as POINT or POLYGON.
+	geometryType byte
 	// Please keep in mind that jsonFieldType should be updated if you add a new field here.
 }
 
@@ -92,6 +94,7 @@ func (ft *FieldType) Hash64(h IHasher) {
 		h.HashBool(elem)
 	}
 	h.HashBool(ft.array)
+	h.HashByte(ft.geometryType)
 }
 
 // Equals implements the cascades/base.Hasher.<1th> interface.
@@ -112,7 +115,8 @@ func (ft *FieldType) Equals(other any) bool {
 		ft.decimal == ft2.decimal &&
 		ft.charset == ft2.charset &&
 		ft.collate == ft2.collate &&
-		ft.array == ft2.array
+		ft.array == ft2.array &&
+		ft.geometryType == ft2.geometryType
 	if !ok {
 		return false
 	}
@@ -318,6 +322,16 @@ func (ft *FieldType) ArrayType() *FieldType {
 	return clone
 }
 
+// GetGeometryType returns the subtype of the geometry type.
+func (ft *FieldType) GetGeometryType() byte {
+	return ft.geometryType
+}
+
+// SetGeometryType sets the subtype of the geometry type.
+func (ft *FieldType) SetGeometryType(tp byte) {
+	ft.geometryType = tp
+}
+
 // SetElemWithIsBinaryLit sets the element of the FieldType.
 func (ft *FieldType) SetElemWithIsBinaryLit(idx int, element string, isBinaryLit bool) {
 	ft.elems[idx] = element
@@ -442,6 +456,9 @@ func (ft *FieldType) Init(tp byte) {
 // This is used for showing column type in infoschema.
 func (ft *FieldType) CompactStr() string {
 	ts := TypeToStr(ft.GetType(), ft.charset)
+	if ft.GetType() == mysql.TypeGeometry {
+		ts = GeometryTypeToStr(ft.geometryType)
+	}
 	suffix := ""
 
 	defaultFlen, defaultDecimal := mysql.GetDefaultFieldLengthAndDecimal(ft.GetType())
@@ -531,7 +548,7 @@ func (ft *FieldType) String() string {
 	if mysql.HasZerofillFlag(ft.flag) {
 		strs = append(strs, "ZEROFILL")
 	}
-	if mysql.HasBinaryFlag(ft.flag) && ft.GetType() != mysql.TypeString {
+	if mysql.HasBinaryFlag(ft.flag) && ft.GetType() != mysql.TypeString && ft.GetType() != mysql.TypeGeometry {
 		strs = append(strs, "BINARY")
 	}
 
@@ -549,6 +566,10 @@ func (ft *FieldType) String() string {
 
 // Restore implements Node interface.
 func (ft *FieldType) Restore(ctx *format.RestoreCtx) error {
+	if ft.GetType() == mysql.TypeGeometry {
+		ctx.WriteKeyWord(GeometryTypeToStr(ft.geometryType))
+		return nil
+	}
 	ctx.WriteKeyWord(TypeToStr(ft.GetType(), ft.charset))
 
 	precision := UnspecifiedLength
@@ -722,6 +743,7 @@ type jsonFieldType struct {
 	Elems            []string
 	ElemsIsBinaryLit []bool
 	Array            bool
+	GeometryType     byte `json:",omitempty"`
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface.
@@ -738,6 +760,7 @@ func (ft *FieldType) UnmarshalJSON(data []byte) error {
 		ft.elems = r.Elems
 		ft.elemsIsBinaryLit = r.ElemsIsBinaryLit
 		ft.array = r.Array
+		ft.geometryType = r.GeometryType
 	}
 	return err
 }
@@ -754,6 +777,7 @@ func (ft *FieldType) MarshalJSON() ([]byte, error) {
 	r.Elems = ft.elems
 	r.ElemsIsBinaryLit = ft.elemsIsBinaryLit
 	r.Array = ft.array
+	r.GeometryType = ft.geometryType
 	return json.Marshal(r)
 }
 
@@ -0,0 +1,131 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cardinality
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/planner/planctx"
+	"github.com/pingcap/tidb/pkg/planner/util/debugtrace"
+	"github.com/pingcap/tidb/pkg/statistics"
+)
+
+// colGroupSelectivityFactor returns the factor to correct the selectivity of the used column nodes, which is
+// calculated under the independence assumption, by the column group statistics, i.e., the extended statistics
+// of cardinality and dependency types.
+// For example, for `city = 'X' and zip = 'Y'`, the selectivity is sel(city) * sel(zip) under the independence
+// assumption, which is usually far too small since `zip` almost determines `city`.
+//  1. With the cardinality(NDV) of (city, zip), the selectivity of the point ranges on the column group is
+//     estimated as min(sel(city), sel(zip), number of point combinations / NDV(city, zip)).
+//  2. With the dependency degree `d` of zip -> city, i.e., the fraction of rows whose `zip` determines `city`,
+//     the selectivity is d * min(sel(city), sel(zip)) + (1 - d) * sel(city) * sel(zip). The rows following the
+//     dependency match both predicates only if they match the more selective one, while the others are regarded
+//     as independent.
+//
+// Each column is adjusted by at most one column group statistics.
+func colGroupSelectivityFactor(sctx planctx.PlanContext, coll *statistics.HistColl, usedSets []*StatsNode) float64 {
+	if coll.ExtendedStats == nil || len(coll.ExtendedStats.Stats) == 0 {
+		return 1
+	}
+	colNodes := make(map[int64]*StatsNode, len(usedSets))
+	for _, set := range usedSets {
+		if (set.Tp == ColType || set.Tp == PkType) && !set.partCover {
+			colNodes[set.ID] = set
+		}
+	}
+	if len(colNodes) < 2 {
+		return 1
+	}
+	tc := sctx.GetSessionVars().StmtCtx.TypeCtx()
+	adjusted := make(map[int64]struct{}, len(colNodes))
+	factor := 1.0
+	// Stabilize the result.
+	names := slices.Sorted(maps.Keys(coll.ExtendedStats.Stats))
+	for _, name := range names {
+		item := coll.ExtendedStats.Stats[name]
+		if item.Tp != ast.StatsTypeCardinality && item.Tp != ast.StatsTypeDependency {
+			continue
+		}
+		uniqueIDs, ok := coll.ExtendedStatsUniqueIDs(item)
+		if !ok || len(uniqueIDs) < 2 {
+			continue
+		}
+		nodes := make([]*StatsNode, 0, len(uniqueIDs))
+		for _, id := range uniqueIDs {
+			node, ok := colNodes[id]
+			if !ok {
+				break
+			}
+			if _, ok := adjusted[id]; ok {
+				break
+			}
+			nodes = append(nodes, node)
+		}
+		if len(nodes) != len(uniqueIDs) {
+			continue
+		}
+		independentSel := 1.0
+		for _, node := range nodes {
+			independentSel *= node.Selectivity
+		}
+		if independentSel <= 0 {
+			continue
+		}
+		var groupSel float64
+		switch item.Tp {
+		case ast.StatsTypeCardinality:
+			if item.ScalarVals <= 0 {
+				continue
+			}
+			minSel, pointCombinations := 1.0, 1.0
+			allPoints := true
+			for _, node := range nodes {
+				minSel = min(minSel, node.Selectivity)
+				for _, ran := range node.Ranges {
+					if !ran.IsPointNonNullable(tc) {
+						allPoints = false
+						break
+					}
+				}
+				pointCombinations *= float64(len(node.Ranges))
+			}
+			if !allPoints {
+				continue
+			}
+			groupSel = min(minSel, pointCombinations/item.ScalarVals)
+		case ast.StatsTypeDependency:
+			degree := min(max(item.ScalarVals, 0), 1)
+			minSel := min(nodes[0].Selectivity, nodes[1].Selectivity)
+			groupSel = degree*minSel + (1-degree)*independentSel
+		}
+		if groupSel <= independentSel {
+			continue
+		}
+		factor *= groupSel / independentSel
+		for _, id := range uniqueIDs {
+			adjusted[id] = struct{}{}
+		}
+		if sctx.GetSessionVars().StmtCtx.EnableOptimizerDebugTrace {
+			debugtrace.RecordAnyValuesWithNames(sctx,
+				"Column group statistics", name,
+				"Independent selectivity", independentSel,
+				"Column group selectivity", groupSel,
+			)
+		}
+	}
+	return factor
+}
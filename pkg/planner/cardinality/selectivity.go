@@ -29,6 +29,7 @@ import (
 	"github.com/pingcap/tidb/pkg/planner/util/debugtrace"
 	"github.com/pingcap/tidb/pkg/planner/util/fixcontrol"
 	"github.com/pingcap/tidb/pkg/statistics"
+	"github.com/pingcap/tidb/pkg/statistics/feedback"
 	"github.com/pingcap/tidb/pkg/types"
 	"github.com/pingcap/tidb/pkg/util/chunk"
 	"github.com/pingcap/tidb/pkg/util/codec"
@@ -72,6 +73,13 @@ func Selectivity(
 	if coll.RealtimeCount == 0 || len(exprs) == 0 {
 		return 1, nil, nil
 	}
+	if ctx.GetSessionVars().EnableCardinalityFeedback {
+		defer func() {
+			if err == nil {
+				result = correctSelectivityByFeedback(ctx, coll, exprs, result)
+			}
+		}()
+	}
 	ret := 1.0
 	sc := ctx.GetSessionVars().StmtCtx
 	tableID := coll.PhysicalID
@@ -261,6 +269,9 @@ func Selectivity(
 			)
 		}
 	}
+	// The selectivity of the columns above is multiplied under the independence assumption,
+	// correct it if the columns are known to be correlated by the column group statistics.
+	ret *= colGroupSelectivityFactor(ctx, coll, usedSets)
 
 	notCoveredConstants := make(map[int]*expression.Constant)
 	notCoveredDNF := make(map[int]*expression.ScalarFunction)
@@ -458,6 +469,17 @@ OUTER:
 	return ret, nodes, nil
 }
 
+// correctSelectivityByFeedback corrects the selectivity by the cardinality feedback learned from the executed plans,
+// which is recorded until the table is analyzed again. Only the predicates with the same constants are corrected.
+func correctSelectivityByFeedback(ctx planctx.PlanContext, coll *statistics.HistColl, exprs []expression.Expression, selectivity float64) float64 {
+	key := feedback.Key{PhysicalID: coll.PhysicalID, Predicates: feedback.Predicates(ctx.GetExprCtx().GetEvalCtx(), exprs)}
+	factor, ok := feedback.Corrections.Factor(key)
+	if !ok {
+		return selectivity
+	}
+	return min(selectivity*factor, 1)
+}
+
 // CalcTotalSelectivityForMVIdxPath calculates the total selectivity for the given partial paths of an MV index merge path.
 // It corresponds with the meaning of AccessPath.CountAfterAccess, as used in buildPartialPathUp4MVIndex.
 // It uses the independence assumption to estimate the selectivity.
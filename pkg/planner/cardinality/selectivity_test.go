@@ -21,6 +21,7 @@ import (
 	"os"
 	"runtime/pprof"
 	"slices"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -41,6 +42,7 @@ import (
 	"github.com/pingcap/tidb/pkg/sessionctx/stmtctx"
 	"github.com/pingcap/tidb/pkg/sessionctx/vardef"
 	"github.com/pingcap/tidb/pkg/statistics"
+	"github.com/pingcap/tidb/pkg/statistics/feedback"
 	statstestutil "github.com/pingcap/tidb/pkg/statistics/handle/ddl/testutil"
 	"github.com/pingcap/tidb/pkg/testkit"
 	"github.com/pingcap/tidb/pkg/testkit/testdata"
@@ -1528,3 +1530,64 @@ func TestRiskEqSkewRatio(t *testing.T) {
 	require.Equal(t, float64(2), count)
 	testKit.MustExec("set @@session.tidb_opt_risk_eq_skew_ratio = 0")
 }
+
+func TestCardinalityFeedback(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	testKit := testkit.NewTestKit(t, store)
+	testKit.MustExec("use test")
+	testKit.MustExec("create table t(a int, b int)")
+	// a and b are fully correlated, the estimation by the independence assumption is 10 times smaller.
+	for i := 1; i <= 10; i++ {
+		for j := 0; j < 10; j++ {
+			testKit.MustExec(fmt.Sprintf("insert into t values (%d, %d)", i, i))
+		}
+	}
+	testKit.MustExec("analyze table t all columns with 0 topn")
+	h := dom.StatsHandle()
+	require.NoError(t, h.Update(context.Background(), dom.InfoSchema()))
+	testKit.MustExec("set @@tidb_enable_non_prepared_plan_cache = 0")
+	testKit.MustExec("set @@tidb_opt_enable_cardinality_feedback = 1")
+	testKit.MustExec("flush cardinality_feedback")
+
+	estRows := func() float64 {
+		rows := testKit.MustQuery("explain select * from t where a = 2 and b = 2").Rows()
+		est, err := strconv.ParseFloat(rows[0][1].(string), 64)
+		require.NoError(t, err)
+		return est
+	}
+	origEstRows := estRows()
+	require.Less(t, origEstRows, 5.0)
+
+	runFrequently := func() {
+		// the statement is regarded as frequently-run from the FrequentExecCount-th execution.
+		for i := 0; i <= feedback.FrequentExecCount; i++ {
+			testKit.MustQuery("select * from t where a = 1 and b = 1").Check(testkit.Rows(
+				"1 1", "1 1", "1 1", "1 1", "1 1", "1 1", "1 1", "1 1", "1 1", "1 1"))
+		}
+	}
+	runFrequently()
+	testKit.MustQuery("select table_schema, table_name, predicates, observations from information_schema.cardinality_feedback").Check(testkit.Rows(
+		"test t eq(test.t.a, 1), eq(test.t.b, 1) 2"))
+	// only the predicates with the same constants are corrected, the misestimate of one value says nothing about
+	// the others.
+	require.Equal(t, origEstRows, estRows())
+	rows := testKit.MustQuery("explain select * from t where b = 1 and a = 1").Rows()
+	corrected, err := strconv.ParseFloat(rows[0][1].(string), 64)
+	require.NoError(t, err)
+	require.InDelta(t, 10.0, corrected, 1)
+
+	testKit.MustExec("flush cardinality_feedback")
+	testKit.MustQuery("select * from information_schema.cardinality_feedback").Check(testkit.Rows())
+	require.Equal(t, origEstRows, estRows())
+
+	// the corrections learned with the old stats are dropped once the table is analyzed.
+	runFrequently()
+	testKit.MustQuery("select count(*) from information_schema.cardinality_feedback").Check(testkit.Rows("1"))
+	testKit.MustExec("analyze table t all columns with 0 topn")
+	require.NoError(t, h.Update(context.Background(), dom.InfoSchema()))
+	testKit.MustQuery("select count(*) from information_schema.cardinality_feedback").Check(testkit.Rows("0"))
+
+	testKit.MustExec("set @@tidb_opt_enable_cardinality_feedback = 0")
+	runFrequently()
+	testKit.MustQuery("select count(*) from information_schema.cardinality_feedback").Check(testkit.Rows("0"))
+}
@@ -26,6 +26,9 @@ import (
 )
 
 // Optimizer is a basic cascades search framework portal, driven by Context.
+// Only the exploration rules are driven here, the physical plans are built from
+// the explored memo by the physical optimization of pkg/planner/core, see
+// core.CascadesOptimize for the current coverage.
 type Optimizer struct {
 	logic corebase.LogicalPlan
 	ctx   cascadesctx.Context
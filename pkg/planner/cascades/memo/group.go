@@ -216,6 +216,16 @@ func (g *Group) ForEachGE(f func(ge *GroupExpression) bool) {
 	}
 }
 
+// ForEachParentGE traverse the parent group expressions referring to this group with f call on them each.
+func (g *Group) ForEachParentGE(f func(ge *GroupExpression) bool) {
+	next := true
+	g.hash2ParentGroupExpr.Each(func(_ unsafe.Pointer, val *GroupExpression) {
+		if next {
+			next = f(val)
+		}
+	})
+}
+
 // removeParentGEs remove the current Group's parent GE ref which is pointed to parent.
 func (g *Group) removeParentGEs(parent *GroupExpression) {
 	addr := unsafe.Pointer(parent)
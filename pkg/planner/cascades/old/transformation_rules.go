@@ -2556,7 +2556,11 @@ func (*MergeAdjacentWindow) Match(expr *memo.ExprIter) bool {
 		}
 	}
 	for _, funDesc := range curWinPlan.WindowFuncDescs {
-		for _, arg := range funDesc.Args {
+		exprs := funDesc.Args
+		for _, item := range funDesc.OrderByItems {
+			exprs = append(exprs[:len(exprs):len(exprs)], item.Expr)
+		}
+		for _, arg := range exprs {
 			cols := expression.ExtractColumns(arg)
 			for _, c := range cols {
 				if _, ok := nextWindowChildrenExistedCols[c.UniqueID]; !ok {
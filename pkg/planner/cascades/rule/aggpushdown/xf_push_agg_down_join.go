@@ -0,0 +1,227 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggpushdown
+
+import (
+	"github.com/pingcap/tidb/pkg/expression"
+	"github.com/pingcap/tidb/pkg/expression/aggregation"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/planner/cascades/memo"
+	"github.com/pingcap/tidb/pkg/planner/cascades/pattern"
+	"github.com/pingcap/tidb/pkg/planner/cascades/rule"
+	corebase "github.com/pingcap/tidb/pkg/planner/core/base"
+	"github.com/pingcap/tidb/pkg/planner/core/operator/logicalop"
+	"github.com/pingcap/tidb/pkg/types"
+)
+
+var _ rule.Rule = &XFPushAggDownJoin{}
+
+// XFPushAggDownJoin pushes the partial aggregation down to one side of the inner join, it transforms
+// `agg->join->(x, y)` into `agg->join->(agg->x, y)`. The partial aggregation groups the side by its columns in the
+// group-by items and the join conditions, so the rows of one partial group always join the same rows of the other
+// side, and the aggregation above merges the partial results in the final mode.
+//
+// It's applied when tidb_opt_agg_push_down is on. Unlike the aggregation pushdown of the normalization phase, whether
+// it pays is left to the cost model, and the join orders explored by join reorder rule let the aggregation be pushed
+// down to any connected part of a join group, rather than only the direct children of the join.
+type XFPushAggDownJoin struct {
+	*rule.BaseRule
+}
+
+// NewXFPushAggDownJoin creates a new XFPushAggDownJoin rule.
+func NewXFPushAggDownJoin() *XFPushAggDownJoin {
+	pa := pattern.NewPattern(pattern.OperandAggregation, pattern.EngineTiDBOnly)
+	pa.SetChildren(pattern.NewPattern(pattern.OperandJoin, pattern.EngineTiDBOnly))
+	return &XFPushAggDownJoin{
+		BaseRule: rule.NewBaseRule(rule.XFPushAggDownJoin, pa),
+	}
+}
+
+// ID implements the Rule interface.
+func (*XFPushAggDownJoin) ID() uint {
+	return uint(rule.XFPushAggDownJoin)
+}
+
+// PreCheck implements the Rule interface.
+func (*XFPushAggDownJoin) PreCheck(aggGE corebase.LogicalPlan) bool {
+	agg := aggGE.GetWrappedLogicalPlan().(*logicalop.LogicalAggregation)
+	if !agg.SCtx().GetSessionVars().AllowAggPushDown || agg.HasFlag(logicalop.AggGenFromXFPushAggDownJoinRuleFlag) ||
+		aggregation.IsAllFirstRow(agg.AggFuncs) {
+		return false
+	}
+	for _, fun := range agg.AggFuncs {
+		// the aggregation in the final mode has been split already.
+		if fun.Mode != aggregation.CompleteMode || !isDecomposable(fun) {
+			return false
+		}
+	}
+	join := aggGE.Children()[0].GetWrappedLogicalPlan().(*logicalop.LogicalJoin)
+	return join.JoinType == logicalop.InnerJoin
+}
+
+// isDecomposable checks whether the aggregate function F can be computed as F'(F(S_1), F(S_2), ...) on the partial
+// groups S_1, S_2, ..., where F' is F in the final mode.
+func isDecomposable(fun *aggregation.AggFuncDesc) bool {
+	if len(fun.OrderByItems) > 0 {
+		return false
+	}
+	switch fun.Name {
+	case ast.AggFuncMax, ast.AggFuncMin, ast.AggFuncFirstRow:
+		return true
+	case ast.AggFuncSum, ast.AggFuncCount:
+		return !fun.HasDistinct
+	default:
+		return false
+	}
+}
+
+// XForm implements the Rule interface.
+func (*XFPushAggDownJoin) XForm(aggGE corebase.LogicalPlan) ([]corebase.LogicalPlan, bool, error) {
+	agg := aggGE.GetWrappedLogicalPlan().(*logicalop.LogicalAggregation)
+	joinGE := aggGE.Children()[0].(*memo.GroupExpression)
+	join := joinGE.GetWrappedLogicalPlan().(*logicalop.LogicalJoin)
+	children := []*memo.GroupExpression{
+		joinGE.Inputs[0].GetLogicalExpressions().Front().Value.(*memo.GroupExpression),
+		joinGE.Inputs[1].GetLogicalExpressions().Front().Value.(*memo.GroupExpression),
+	}
+	res := make([]corebase.LogicalPlan, 0, 2)
+	for idx := range children {
+		newAgg, err := pushAggDown(agg, join, children, idx)
+		if err != nil {
+			return nil, false, err
+		}
+		if newAgg != nil {
+			res = append(res, newAgg)
+		}
+	}
+	return res, false, nil
+}
+
+// pushAggDown pushes the partial aggregation down to the idx-th child of the join. The aggregate functions referring
+// to the side or referring to no column are split into the partial ones and the final ones, while the others are
+// kept in the final aggregation, which should be insensitive to the duplicated rows.
+func pushAggDown(agg *logicalop.LogicalAggregation, join *logicalop.LogicalJoin, children []*memo.GroupExpression,
+	idx int) (corebase.LogicalPlan, error) {
+	side, other := children[idx].Schema(), children[1-idx].Schema()
+	pushed := make([]bool, len(agg.AggFuncs))
+	pushedFuncs := make([]*aggregation.AggFuncDesc, 0, len(agg.AggFuncs))
+	for i, fun := range agg.AggFuncs {
+		cols := expression.ExtractColumnsFromExpressions(nil, fun.Args, nil)
+		switch {
+		case side.ColumnsIndices(cols) != nil:
+			pushed[i] = true
+			pushedFuncs = append(pushedFuncs, fun)
+		case len(cols) > 0 && other.ColumnsIndices(cols) != nil:
+			// the rows of the other side are joined with less rows of the side after the partial aggregation.
+			if fun.Name == ast.AggFuncSum || fun.Name == ast.AggFuncCount {
+				return nil, nil
+			}
+		default:
+			return nil, nil
+		}
+	}
+	if aggregation.IsAllFirstRow(pushedFuncs) {
+		return nil, nil
+	}
+	// the columns of the side in the group-by items and the join conditions are the group-by columns of the partial
+	// aggregation, which are output by the first row functions with the same unique ids.
+	gbyCols := expression.NewSchema()
+	addGbyCols := func(cols []*expression.Column) {
+		for _, col := range cols {
+			if side.Contains(col) && !gbyCols.Contains(col) {
+				gbyCols.Append(col)
+			}
+		}
+	}
+	addGbyCols(expression.ExtractColumnsFromExpressions(nil, agg.GroupByItems, nil))
+	addGbyCols(expression.ExtractColumnsFromExpressions(nil, expression.ScalarFuncs2Exprs(join.EqualConditions), nil))
+	addGbyCols(expression.ExtractColumnsFromExpressions(nil, join.LeftConditions, nil))
+	addGbyCols(expression.ExtractColumnsFromExpressions(nil, join.RightConditions, nil))
+	addGbyCols(expression.ExtractColumnsFromExpressions(nil, join.OtherConditions, nil))
+	for _, key := range side.PKOrUK {
+		// the side is grouped by the key already.
+		if gbyCols.ColumnsIndices(key) != nil {
+			return nil, nil
+		}
+	}
+
+	sctx := agg.SCtx()
+	partialAgg := logicalop.LogicalAggregation{
+		AggFuncs:       make([]*aggregation.AggFuncDesc, 0, len(pushedFuncs)+gbyCols.Len()),
+		GroupByItems:   expression.Column2Exprs(gbyCols.Columns),
+		PreferAggType:  agg.PreferAggType,
+		PreferAggToCop: agg.PreferAggToCop,
+	}.Init(sctx, agg.QueryBlockOffset())
+	partialSchema := expression.NewSchema(make([]*expression.Column, 0, len(pushedFuncs)+gbyCols.Len())...)
+	finalAgg := logicalop.LogicalAggregation{
+		AggFuncs:       make([]*aggregation.AggFuncDesc, 0, len(agg.AggFuncs)),
+		GroupByItems:   agg.GroupByItems,
+		PreferAggType:  agg.PreferAggType,
+		PreferAggToCop: agg.PreferAggToCop,
+	}.Init(sctx, agg.QueryBlockOffset())
+	for i, fun := range agg.AggFuncs {
+		if !pushed[i] {
+			finalAgg.AggFuncs = append(finalAgg.AggFuncs, fun)
+			continue
+		}
+		partialAgg.AggFuncs = append(partialAgg.AggFuncs, fun.Clone())
+		col := &expression.Column{
+			UniqueID: sctx.GetSessionVars().AllocPlanColumnID(),
+			RetType:  fun.RetTp,
+		}
+		partialSchema.Append(col)
+		// the aggregate function in the memo can't be changed in place, which will change its hash64.
+		finalFun := fun.Clone()
+		finalFun.Args = []expression.Expression{col}
+		finalFun.Mode = aggregation.FinalMode
+		finalAgg.AggFuncs = append(finalAgg.AggFuncs, finalFun)
+	}
+	for _, col := range gbyCols.Columns {
+		firstRow, err := aggregation.NewAggFuncDesc(sctx.GetExprCtx(), ast.AggFuncFirstRow, []expression.Expression{col}, false)
+		if err != nil {
+			return nil, err
+		}
+		newCol := col.Clone().(*expression.Column)
+		newCol.RetType = firstRow.RetTp
+		partialAgg.AggFuncs = append(partialAgg.AggFuncs, firstRow)
+		partialSchema.Append(newCol)
+	}
+	if len(partialAgg.GroupByItems) == 0 {
+		// the aggregation without group-by items outputs one row on the empty input, group it by a constant instead.
+		partialAgg.GroupByItems = []expression.Expression{&expression.Constant{
+			Value:   types.NewDatum(0),
+			RetType: types.NewFieldType(mysql.TypeLong)}}
+	}
+	partialAgg.SetSchema(partialSchema)
+	partialAgg.SetFlag(logicalop.AggGenFromXFPushAggDownJoinRuleFlag)
+	partialAgg.SetChildren(children[idx])
+
+	// the join inside the memo can't be changed in place, which will change its hash64.
+	newJoin := join.Shallow()
+	newChildren := []corebase.LogicalPlan{children[0], children[1]}
+	newChildren[idx] = partialAgg
+	newJoin.SetSchema(expression.MergeSchema(newChildren[0].Schema(), newChildren[1].Schema()))
+	// the output names of the partial aggregation are not maintained, same as the aggregation pushdown of the
+	// normalization phase.
+	newJoin.SetOutputNames(nil)
+	// the join orders of the other side have been explored in its own group.
+	newJoin.SetFlag(logicalop.JoinOrderFixedFlag)
+	newJoin.SetChildren(newChildren...)
+	finalAgg.SetSchema(agg.Schema())
+	finalAgg.SetOutputNames(agg.OutputNames())
+	finalAgg.SetChildren(newJoin)
+	return finalAgg, nil
+}
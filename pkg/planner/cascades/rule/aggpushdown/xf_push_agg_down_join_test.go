@@ -0,0 +1,98 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggpushdown_test
+
+import (
+	"testing"
+
+	"github.com/pingcap/failpoint"
+	"github.com/pingcap/tidb/pkg/expression"
+	"github.com/pingcap/tidb/pkg/expression/aggregation"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/planner/cascades"
+	"github.com/pingcap/tidb/pkg/planner/cascades/memo"
+	"github.com/pingcap/tidb/pkg/planner/cascades/rule/aggpushdown"
+	"github.com/pingcap/tidb/pkg/planner/core/operator/logicalop"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestXFPushAggDownJoin(t *testing.T) {
+	require.NoError(t, failpoint.Enable("github.com/pingcap/tidb/pkg/planner/cascades/memo/MockPlanSkipMemoDeriveStats", `return(true)`))
+	defer func() {
+		require.NoError(t, failpoint.Disable("github.com/pingcap/tidb/pkg/planner/cascades/memo/MockPlanSkipMemoDeriveStats"))
+	}()
+
+	ctx := mock.NewContext()
+	ctx.GetSessionVars().AllowAggPushDown = true
+	tp := types.NewFieldType(mysql.TypeLonglong)
+	cols := make([]*expression.Column, 4)
+	for i := range cols {
+		cols[i] = &expression.Column{ID: int64(i + 1), UniqueID: int64(i + 1), RetType: tp}
+	}
+	// agg(sum(a2), firstrow(b2) group by b2) -> join(a1 = b1) -> (ds(a1, a2), ds(b1, b2))
+	dsA := logicalop.DataSource{}.Init(ctx, 0)
+	dsA.SetSchema(expression.NewSchema(cols[0], cols[1]))
+	dsB := logicalop.DataSource{}.Init(ctx, 0)
+	dsB.SetSchema(expression.NewSchema(cols[2], cols[3]))
+	join := logicalop.LogicalJoin{JoinType: logicalop.InnerJoin}.Init(ctx, 0)
+	join.SetSchema(expression.MergeSchema(dsA.Schema(), dsB.Schema()))
+	join.SetChildren(dsA, dsB)
+	eq := expression.NewFunctionInternal(ctx.GetExprCtx(), ast.EQ, types.NewFieldType(mysql.TypeTiny), cols[0], cols[2])
+	join.EqualConditions = []*expression.ScalarFunction{eq.(*expression.ScalarFunction)}
+	sum, err := aggregation.NewAggFuncDesc(ctx.GetExprCtx(), ast.AggFuncSum, []expression.Expression{cols[1]}, false)
+	require.Nil(t, err)
+	firstRow, err := aggregation.NewAggFuncDesc(ctx.GetExprCtx(), ast.AggFuncFirstRow, []expression.Expression{cols[3]}, false)
+	require.Nil(t, err)
+	agg := logicalop.LogicalAggregation{
+		AggFuncs:     []*aggregation.AggFuncDesc{sum, firstRow},
+		GroupByItems: []expression.Expression{cols[3]},
+	}.Init(ctx, 0)
+	agg.SetSchema(expression.NewSchema(
+		&expression.Column{UniqueID: 5, RetType: sum.RetTp},
+		&expression.Column{UniqueID: 6, RetType: firstRow.RetTp}))
+	agg.SetChildren(join)
+
+	cas, err := cascades.NewOptimizer(agg)
+	require.Nil(t, err)
+	defer cas.Destroy()
+	myRule := aggpushdown.NewXFPushAggDownJoin()
+	cas.SetRules([]uint{myRule.ID()})
+	require.Nil(t, cas.Execute())
+
+	// sum(a2) is pushed down to ds_a grouped by a1, while sum(a2) can't be pushed down to ds_b.
+	root := cas.GetMemo().GetRootGroup()
+	require.Equal(t, 2, root.GetLogicalExpressions().Len())
+	finalGE := root.GetLogicalExpressions().Back().Value.(*memo.GroupExpression)
+	finalAgg := finalGE.GetWrappedLogicalPlan().(*logicalop.LogicalAggregation)
+	require.Len(t, finalAgg.AggFuncs, 2)
+	require.Equal(t, aggregation.FinalMode, finalAgg.AggFuncs[0].Mode)
+	require.Equal(t, aggregation.CompleteMode, finalAgg.AggFuncs[1].Mode)
+	require.Equal(t, agg.Schema(), finalAgg.Schema())
+	newJoinGE := finalGE.Inputs[0].GetLogicalExpressions().Front().Value.(*memo.GroupExpression)
+	require.True(t, newJoinGE.GetWrappedLogicalPlan().(*logicalop.LogicalJoin).HasFlag(logicalop.JoinOrderFixedFlag))
+	partialGE := newJoinGE.Inputs[0].GetLogicalExpressions().Front().Value.(*memo.GroupExpression)
+	partialAgg := partialGE.GetWrappedLogicalPlan().(*logicalop.LogicalAggregation)
+	require.True(t, partialAgg.HasFlag(logicalop.AggGenFromXFPushAggDownJoinRuleFlag))
+	require.Len(t, partialAgg.GroupByItems, 1)
+	require.Equal(t, int64(1), partialAgg.GroupByItems[0].(*expression.Column).UniqueID)
+	require.Len(t, partialAgg.AggFuncs, 2)
+	require.Equal(t, ast.AggFuncSum, partialAgg.AggFuncs[0].Name)
+	require.Equal(t, ast.AggFuncFirstRow, partialAgg.AggFuncs[1].Name)
+	// the original aggregation is left unchanged.
+	require.Equal(t, aggregation.CompleteMode, agg.AggFuncs[0].Mode)
+}
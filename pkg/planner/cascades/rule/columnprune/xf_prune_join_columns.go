@@ -0,0 +1,143 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package columnprune
+
+import (
+	"github.com/pingcap/tidb/pkg/expression"
+	"github.com/pingcap/tidb/pkg/planner/cascades/memo"
+	"github.com/pingcap/tidb/pkg/planner/cascades/pattern"
+	"github.com/pingcap/tidb/pkg/planner/cascades/rule"
+	corebase "github.com/pingcap/tidb/pkg/planner/core/base"
+	"github.com/pingcap/tidb/pkg/planner/core/operator/logicalop"
+	"github.com/pingcap/tidb/pkg/types"
+)
+
+var _ rule.Rule = &XFPruneJoinColumns{}
+
+// XFPruneJoinColumns prunes the columns of the join children which are neither used by the projection above nor by
+// the join conditions, it transforms `proj->join->(x, y)` into `proj->join->(proj->x, proj->y)`. The join explored
+// from the memo outputs all the columns of its children, while some of them are only used by the conditions of the
+// joins below, the pruning projections narrow the rows of the join, and whether it pays is left to the cost model.
+type XFPruneJoinColumns struct {
+	*rule.BaseRule
+}
+
+// NewXFPruneJoinColumns creates a new XFPruneJoinColumns rule.
+func NewXFPruneJoinColumns() *XFPruneJoinColumns {
+	pa := pattern.NewPattern(pattern.OperandProjection, pattern.EngineTiDBOnly)
+	pa.SetChildren(pattern.NewPattern(pattern.OperandJoin, pattern.EngineTiDBOnly))
+	return &XFPruneJoinColumns{
+		BaseRule: rule.NewBaseRule(rule.XFPruneJoinColumns, pa),
+	}
+}
+
+// ID implements the Rule interface.
+func (*XFPruneJoinColumns) ID() uint {
+	return uint(rule.XFPruneJoinColumns)
+}
+
+// PreCheck implements the Rule interface.
+func (*XFPruneJoinColumns) PreCheck(projGE corebase.LogicalPlan) bool {
+	if projGE.GetWrappedLogicalPlan().(*logicalop.LogicalProjection).Proj4Expand {
+		return false
+	}
+	join := projGE.Children()[0].GetWrappedLogicalPlan().(*logicalop.LogicalJoin)
+	// the output columns of these joins are the columns of their children, and the default values of the outer
+	// join are bound to the columns of the inner side, the full schema is bound to the columns of the children.
+	switch join.JoinType {
+	case logicalop.InnerJoin, logicalop.LeftOuterJoin, logicalop.RightOuterJoin:
+		return join.FullSchema == nil && join.DefaultValues == nil
+	default:
+		return false
+	}
+}
+
+// XForm implements the Rule interface.
+func (*XFPruneJoinColumns) XForm(projGE corebase.LogicalPlan) ([]corebase.LogicalPlan, bool, error) {
+	proj := projGE.GetWrappedLogicalPlan().(*logicalop.LogicalProjection)
+	joinGE := projGE.Children()[0].(*memo.GroupExpression)
+	join := joinGE.GetWrappedLogicalPlan().(*logicalop.LogicalJoin)
+	used := expression.ExtractColumnsFromExpressions(nil, proj.Exprs, nil)
+	used = expression.ExtractColumnsFromExpressions(used, expression.ScalarFuncs2Exprs(join.EqualConditions), nil)
+	used = expression.ExtractColumnsFromExpressions(used, join.LeftConditions, nil)
+	used = expression.ExtractColumnsFromExpressions(used, join.RightConditions, nil)
+	used = expression.ExtractColumnsFromExpressions(used, join.OtherConditions, nil)
+	usedSet := make(map[int64]struct{}, len(used))
+	for _, col := range used {
+		usedSet[col.UniqueID] = struct{}{}
+	}
+	sctx := proj.SCtx()
+	pruned := false
+	kept := make(map[int64]struct{}, join.Schema().Len())
+	children := make([]corebase.LogicalPlan, 0, len(joinGE.Inputs))
+	for _, input := range joinGE.Inputs {
+		child := input.GetLogicalExpressions().Front().Value.(*memo.GroupExpression)
+		cols := make([]*expression.Column, 0, child.Schema().Len())
+		names := make(types.NameSlice, 0, child.Schema().Len())
+		for i, col := range child.Schema().Columns {
+			if _, ok := usedSet[col.UniqueID]; ok {
+				cols = append(cols, col)
+				if i < len(child.OutputNames()) {
+					names = append(names, child.OutputNames()[i])
+				}
+			}
+		}
+		if len(cols) == 0 {
+			// keep one column at least, the rows of the child are still needed by the join.
+			cols = append(cols, child.Schema().Columns[0])
+			if len(child.OutputNames()) > 0 {
+				names = append(names, child.OutputNames()[0])
+			}
+		}
+		for _, col := range cols {
+			kept[col.UniqueID] = struct{}{}
+		}
+		if len(cols) == child.Schema().Len() {
+			children = append(children, child)
+			continue
+		}
+		pruned = true
+		pruneProj := logicalop.LogicalProjection{Exprs: expression.Column2Exprs(cols)}.Init(sctx, proj.QueryBlockOffset())
+		pruneProj.SetSchema(expression.NewSchema(cols...))
+		pruneProj.SetOutputNames(names)
+		pruneProj.SetChildren(child)
+		children = append(children, pruneProj)
+	}
+	if !pruned {
+		return nil, false, nil
+	}
+	// the join inside the memo can't be changed in place, which will change its hash64.
+	newJoin := join.Shallow()
+	cols := make([]*expression.Column, 0, len(kept))
+	names := make(types.NameSlice, 0, len(kept))
+	for i, col := range join.Schema().Columns {
+		if _, ok := kept[col.UniqueID]; ok {
+			cols = append(cols, col)
+			if i < len(join.OutputNames()) {
+				names = append(names, join.OutputNames()[i])
+			}
+		}
+	}
+	newJoin.SetSchema(expression.NewSchema(cols...))
+	newJoin.SetOutputNames(names)
+	// the children are the pruning projections, there is nothing to reorder for join reorder rule.
+	newJoin.SetFlag(logicalop.JoinOrderFixedFlag)
+	newJoin.SetChildren(children...)
+	newProj := logicalop.LogicalProjection{Exprs: proj.Exprs, CalculateNoDelay: proj.CalculateNoDelay}.Init(sctx, proj.QueryBlockOffset())
+	newProj.SetSchema(proj.Schema())
+	newProj.SetOutputNames(proj.OutputNames())
+	newProj.SetChildren(newJoin)
+	return []corebase.LogicalPlan{newProj}, false, nil
+}
@@ -0,0 +1,84 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package columnprune_test
+
+import (
+	"testing"
+
+	"github.com/pingcap/failpoint"
+	"github.com/pingcap/tidb/pkg/expression"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/planner/cascades"
+	"github.com/pingcap/tidb/pkg/planner/cascades/memo"
+	"github.com/pingcap/tidb/pkg/planner/cascades/rule/columnprune"
+	"github.com/pingcap/tidb/pkg/planner/core/operator/logicalop"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestXFPruneJoinColumns(t *testing.T) {
+	require.NoError(t, failpoint.Enable("github.com/pingcap/tidb/pkg/planner/cascades/memo/MockPlanSkipMemoDeriveStats", `return(true)`))
+	defer func() {
+		require.NoError(t, failpoint.Disable("github.com/pingcap/tidb/pkg/planner/cascades/memo/MockPlanSkipMemoDeriveStats"))
+	}()
+
+	ctx := mock.NewContext()
+	tp := types.NewFieldType(mysql.TypeLonglong)
+	cols := make([]*expression.Column, 4)
+	for i := range cols {
+		cols[i] = &expression.Column{ID: int64(i + 1), UniqueID: int64(i + 1), RetType: tp}
+	}
+	// proj(a1) -> join(a1 = b1) -> (ds(a1, a2), ds(b1, b2))
+	dsA := logicalop.DataSource{}.Init(ctx, 0)
+	dsA.SetSchema(expression.NewSchema(cols[0], cols[1]))
+	dsB := logicalop.DataSource{}.Init(ctx, 0)
+	dsB.SetSchema(expression.NewSchema(cols[2], cols[3]))
+	join := logicalop.LogicalJoin{JoinType: logicalop.InnerJoin}.Init(ctx, 0)
+	join.SetSchema(expression.MergeSchema(dsA.Schema(), dsB.Schema()))
+	join.SetChildren(dsA, dsB)
+	eq := expression.NewFunctionInternal(ctx.GetExprCtx(), ast.EQ, types.NewFieldType(mysql.TypeTiny), cols[0], cols[2])
+	join.EqualConditions = []*expression.ScalarFunction{eq.(*expression.ScalarFunction)}
+	proj := logicalop.LogicalProjection{Exprs: []expression.Expression{cols[0]}}.Init(ctx, 0)
+	proj.SetSchema(expression.NewSchema(&expression.Column{UniqueID: 5, RetType: tp}))
+	proj.SetChildren(join)
+
+	cas, err := cascades.NewOptimizer(proj)
+	require.Nil(t, err)
+	defer cas.Destroy()
+	myRule := columnprune.NewXFPruneJoinColumns()
+	cas.SetRules([]uint{myRule.ID()})
+	require.Nil(t, cas.Execute())
+
+	// proj -> join -> (ds_a, ds_b) is transformed into proj -> join -> (proj(a1) -> ds_a, proj(b1) -> ds_b).
+	root := cas.GetMemo().GetRootGroup()
+	require.Equal(t, 2, root.GetLogicalExpressions().Len())
+	newProj := root.GetLogicalExpressions().Back().Value.(*memo.GroupExpression)
+	newJoinGE := newProj.Inputs[0].GetLogicalExpressions().Front().Value.(*memo.GroupExpression)
+	newJoin := newJoinGE.GetWrappedLogicalPlan().(*logicalop.LogicalJoin)
+	require.Equal(t, 2, newJoin.Schema().Len())
+	require.Equal(t, int64(1), newJoin.Schema().Columns[0].UniqueID)
+	require.Equal(t, int64(3), newJoin.Schema().Columns[1].UniqueID)
+	require.True(t, newJoin.HasFlag(logicalop.JoinOrderFixedFlag))
+	for i, id := range []int64{1, 3} {
+		childGE := newJoinGE.Inputs[i].GetLogicalExpressions().Front().Value.(*memo.GroupExpression)
+		childProj := childGE.GetWrappedLogicalPlan().(*logicalop.LogicalProjection)
+		require.Equal(t, 1, childProj.Schema().Len())
+		require.Equal(t, id, childProj.Schema().Columns[0].UniqueID)
+	}
+	// the original join is left unchanged.
+	require.Equal(t, 4, join.Schema().Len())
+}
@@ -0,0 +1,330 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package join
+
+import (
+	"math/bits"
+
+	"github.com/pingcap/tidb/pkg/expression"
+	"github.com/pingcap/tidb/pkg/planner/cascades/memo"
+	"github.com/pingcap/tidb/pkg/planner/cascades/pattern"
+	"github.com/pingcap/tidb/pkg/planner/cascades/rule"
+	corebase "github.com/pingcap/tidb/pkg/planner/core/base"
+	"github.com/pingcap/tidb/pkg/planner/core/operator/logicalop"
+	"github.com/pingcap/tidb/pkg/types"
+)
+
+var _ rule.Rule = &XFJoinReorder{}
+
+const (
+	// maxReorderLeaves is the max number of leaves a join group can have to be reordered, limited by the uint64 mask.
+	maxReorderLeaves = 63
+	// bushyReorderLeaves is the max number of leaves a join group can have to enumerate all the bushy splits, the
+	// bigger join group only splits one leaf out each time, which keeps the number of new group expressions linear.
+	// The sides of the bigger join group beyond this size keep their left-deep order and are not explored again, so
+	// the bigger join group brings O(n^2) new groups instead of all its connected sub-graphs.
+	bushyReorderLeaves = 8
+)
+
+// XFJoinReorder explores the join orders of a group of inner joins inside the memo. Taking the inner join tree rooted
+// from the current group expression as a join graph, it splits the leaves into two connected parts joined by at least
+// one condition, and builds a new join for each split. The two sides are built as left-deep trees in the original leaf
+// order, which are copied into their own groups and explored by this rule later on, so every connected sub-graph gets
+// one group, and the memo holds all the join orders without cartesian products.
+//
+// Unlike the greedy join reorder in the normalization phase, the join group size is not limited by tidb_opt_join_reorder_threshold.
+// The join group beyond bushyReorderLeaves is only explored from its root, and the sides split from it are not explored
+// again until they shrink to bushyReorderLeaves, which bounds the exploration of each join group.
+type XFJoinReorder struct {
+	*rule.BaseRule
+}
+
+// NewXFJoinReorder creates a new XFJoinReorder rule.
+func NewXFJoinReorder() *XFJoinReorder {
+	// the join tree is extracted from the memo in XForm, so a childless pattern is enough here.
+	pa := pattern.NewPattern(pattern.OperandJoin, pattern.EngineTiDBOnly)
+	return &XFJoinReorder{
+		BaseRule: rule.NewBaseRule(rule.XFJoinReorder, pa),
+	}
+}
+
+// ID implements the Rule interface.
+func (*XFJoinReorder) ID() uint {
+	return uint(rule.XFJoinReorder)
+}
+
+// PreCheck implements the Rule interface.
+func (*XFJoinReorder) PreCheck(joinGE corebase.LogicalPlan) bool {
+	join := joinGE.GetWrappedLogicalPlan().(*logicalop.LogicalJoin)
+	// the join generated from this rule with a different column order is always the child of a projection in its
+	// group, reorder it again will only generate the duplicated alternatives of the projection's group.
+	return !join.HasFlag(logicalop.JoinGenFromXFJoinReorderRuleFlag|logicalop.JoinOrderFixedFlag) &&
+		reorderable(join)
+}
+
+// isJoinGroupInput checks whether the group is the input of a reorderable join, whose join group covers this one.
+func isJoinGroupInput(g *memo.Group) bool {
+	res := false
+	g.ForEachParentGE(func(ge *memo.GroupExpression) bool {
+		join, ok := ge.GetWrappedLogicalPlan().(*logicalop.LogicalJoin)
+		res = ok && !join.HasFlag(logicalop.JoinGenFromXFJoinReorderRuleFlag|logicalop.JoinOrderFixedFlag) &&
+			reorderable(join)
+		return !res
+	})
+	return res
+}
+
+// reorderable checks whether the join can be freely reordered with its adjacent inner joins.
+func reorderable(join *logicalop.LogicalJoin) bool {
+	return join.JoinType == logicalop.InnerJoin && !join.StraightJoin && !join.PreferJoinOrder &&
+		join.PreferJoinType == 0 && join.LeftPreferJoinType == 0 && join.RightPreferJoinType == 0 &&
+		join.HintInfo == nil && join.FullSchema == nil && len(join.NAEQConditions) == 0 &&
+		len(join.LeftConditions) == 0 && len(join.RightConditions) == 0
+}
+
+// XForm implements the Rule interface.
+func (*XFJoinReorder) XForm(joinGE corebase.LogicalPlan) ([]corebase.LogicalPlan, bool, error) {
+	ge := joinGE.(*memo.GroupExpression)
+	g := newJoinGraph(ge)
+	if g == nil {
+		return nil, false, nil
+	}
+	big := len(g.leaves) > bushyReorderLeaves
+	if big && isJoinGroupInput(ge.GetGroup()) {
+		// the big join group is only explored from its root.
+		return nil, false, nil
+	}
+	all := uint64(1)<<len(g.leaves) - 1
+	res := make([]corebase.LogicalPlan, 0, 4)
+	g.forEachSplit(all, func(lSet, rSet uint64) {
+		join := g.newJoin(g.leftDeep(lSet), lSet, g.leftDeep(rSet), rSet)
+		if big {
+			join.SetFlag(logicalop.JoinOrderFixedFlag)
+		}
+		if join.Schema().Len() == g.schema.Len() && sameColumns(join.Schema().Columns, g.schema.Columns) {
+			res = append(res, join)
+			return
+		}
+		// the output columns of the new join are different from the group's, project them back.
+		join.SetFlag(logicalop.JoinGenFromXFJoinReorderRuleFlag)
+		proj := logicalop.LogicalProjection{Exprs: expression.Column2Exprs(g.schema.Columns)}.Init(g.sctx, g.offset)
+		proj.SetSchema(g.schema.Clone())
+		proj.SetOutputNames(g.names)
+		proj.SetChildren(join)
+		res = append(res, proj)
+	})
+	return res, false, nil
+}
+
+type joinCond struct {
+	expr expression.Expression
+	isEQ bool
+	mask uint64
+}
+
+// joinGraph is the inner join tree rooted from a group expression, whose leaves are the group expressions of the
+// non-reorderable input groups, in the DFS order of the join tree.
+type joinGraph struct {
+	sctx   corebase.PlanContext
+	offset int
+	schema *expression.Schema
+	names  types.NameSlice
+
+	leaves   []*memo.GroupExpression
+	conds    []joinCond
+	col2Leaf map[int64]int
+}
+
+func newJoinGraph(root *memo.GroupExpression) *joinGraph {
+	g := &joinGraph{
+		sctx:     root.SCtx(),
+		offset:   root.QueryBlockOffset(),
+		schema:   root.Schema(),
+		names:    root.OutputNames(),
+		col2Leaf: make(map[int64]int),
+	}
+	var extract func(ge *memo.GroupExpression)
+	extract = func(ge *memo.GroupExpression) {
+		join := ge.GetWrappedLogicalPlan().(*logicalop.LogicalJoin)
+		for _, input := range ge.Inputs {
+			if elem := input.GetFirstElem(pattern.OperandJoin); elem != nil {
+				child := elem.Value.(*memo.GroupExpression)
+				childJoin := child.GetWrappedLogicalPlan().(*logicalop.LogicalJoin)
+				if !childJoin.HasFlag(logicalop.JoinGenFromXFJoinReorderRuleFlag|logicalop.JoinOrderFixedFlag) &&
+					reorderable(childJoin) {
+					extract(child)
+					continue
+				}
+			}
+			leaf := input.GetLogicalExpressions().Front().Value.(*memo.GroupExpression)
+			for _, col := range leaf.Schema().Columns {
+				g.col2Leaf[col.UniqueID] = len(g.leaves)
+			}
+			g.leaves = append(g.leaves, leaf)
+		}
+		for _, cond := range join.EqualConditions {
+			g.conds = append(g.conds, joinCond{expr: cond, isEQ: true})
+		}
+		for _, cond := range join.OtherConditions {
+			g.conds = append(g.conds, joinCond{expr: cond})
+		}
+	}
+	extract(root)
+	if len(g.leaves) < 3 || len(g.leaves) > maxReorderLeaves {
+		return nil
+	}
+	for i := range g.conds {
+		for _, col := range expression.ExtractColumns(g.conds[i].expr) {
+			idx, ok := g.col2Leaf[col.UniqueID]
+			if !ok {
+				return nil
+			}
+			g.conds[i].mask |= 1 << idx
+		}
+		// the condition only referring to one leaf should have been pushed down already.
+		if bits.OnesCount64(g.conds[i].mask) < 2 {
+			return nil
+		}
+	}
+	for _, col := range g.schema.Columns {
+		if _, ok := g.col2Leaf[col.UniqueID]; !ok {
+			return nil
+		}
+	}
+	return g
+}
+
+// forEachSplit calls f for each split of the leaf set, both sides of which are connected and joined by conditions.
+// The lowest leaf is always put on the left side to avoid the symmetric splits, the join side is left to the
+// physical optimization.
+func (g *joinGraph) forEachSplit(set uint64, f func(lSet, rSet uint64)) {
+	lowest := set & -set
+	try := func(lSet uint64) {
+		rSet := set &^ lSet
+		if rSet != 0 && g.connected(lSet) && g.connected(rSet) && g.crossing(lSet, rSet) {
+			f(lSet, rSet)
+		}
+	}
+	if bits.OnesCount64(set) <= bushyReorderLeaves {
+		rest := set &^ lowest
+		// enumerate all the subsets of rest, and put them on the left side with the lowest leaf.
+		for sub := rest; ; sub = (sub - 1) & rest {
+			try(lowest | sub)
+			if sub == 0 {
+				break
+			}
+		}
+		return
+	}
+	try(lowest)
+	for rest := set &^ lowest; rest != 0; rest &= rest - 1 {
+		try(set &^ (rest & -rest))
+	}
+}
+
+// connected checks whether the leaves in the set are connected by the conditions inside the set.
+func (g *joinGraph) connected(set uint64) bool {
+	reached := set & -set
+	for {
+		next := reached
+		for _, cond := range g.conds {
+			if cond.mask&set == cond.mask && cond.mask&reached != 0 {
+				next |= cond.mask
+			}
+		}
+		if next == reached {
+			return reached == set
+		}
+		reached = next
+	}
+}
+
+// crossing checks whether there is a condition joining the two sets.
+func (g *joinGraph) crossing(lSet, rSet uint64) bool {
+	for _, cond := range g.conds {
+		if cond.mask&(lSet|rSet) == cond.mask && cond.mask&lSet != 0 && cond.mask&rSet != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// leftDeep builds the left-deep join tree of the leaves in the set, which joins the lowest leaf connected to the
+// joined ones each time. The tree is the same for the same set, so the sub-joins of the same set generated by
+// different splits share one group in the memo. The sub-joins beyond bushyReorderLeaves are marked to keep their order.
+func (g *joinGraph) leftDeep(set uint64) corebase.LogicalPlan {
+	idx := bits.TrailingZeros64(set)
+	var res corebase.LogicalPlan = g.leaves[idx]
+	resSet := uint64(1) << idx
+	for rest := set &^ resSet; rest != 0; rest &^= 1 << idx {
+		idx = bits.TrailingZeros64(rest)
+		for candidates := rest; candidates != 0; candidates &= candidates - 1 {
+			if g.crossing(resSet, candidates&-candidates) {
+				idx = bits.TrailingZeros64(candidates)
+				break
+			}
+		}
+		join := g.newJoin(res, resSet, g.leaves[idx], 1<<idx)
+		resSet |= 1 << idx
+		if bits.OnesCount64(resSet) > bushyReorderLeaves {
+			join.SetFlag(logicalop.JoinOrderFixedFlag)
+		}
+		res = join
+	}
+	return res
+}
+
+// newJoin builds the inner join of the two sides, with the conditions which are covered by the two sides together
+// and not covered by either side.
+func (g *joinGraph) newJoin(l corebase.LogicalPlan, lSet uint64, r corebase.LogicalPlan, rSet uint64) *logicalop.LogicalJoin {
+	join := logicalop.LogicalJoin{JoinType: logicalop.InnerJoin, Reordered: true}.Init(g.sctx, g.offset)
+	join.SetSchema(expression.MergeSchema(l.Schema(), r.Schema()))
+	names := make(types.NameSlice, 0, len(l.OutputNames())+len(r.OutputNames()))
+	names = append(names, l.OutputNames()...)
+	join.SetOutputNames(append(names, r.OutputNames()...))
+	join.SetChildren(l, r)
+	for _, cond := range g.conds {
+		if cond.mask&(lSet|rSet) != cond.mask || cond.mask&lSet == 0 || cond.mask&rSet == 0 {
+			continue
+		}
+		if !cond.isEQ {
+			join.OtherConditions = append(join.OtherConditions, cond.expr)
+			continue
+		}
+		eq := cond.expr.(*expression.ScalarFunction)
+		args := eq.GetArgs()
+		lCol, lOK := args[0].(*expression.Column)
+		rCol, rOK := args[1].(*expression.Column)
+		if !lOK || !rOK {
+			join.OtherConditions = append(join.OtherConditions, eq)
+			continue
+		}
+		if lSet&(1<<g.col2Leaf[lCol.UniqueID]) == 0 {
+			// the equal condition should be in the form of left column = right column.
+			eq = expression.NewFunctionInternal(g.sctx.GetExprCtx(), eq.FuncName.L, eq.GetStaticType(), rCol, lCol).(*expression.ScalarFunction)
+		}
+		join.EqualConditions = append(join.EqualConditions, eq)
+	}
+	return join
+}
+
+func sameColumns(a, b []*expression.Column) bool {
+	for i := range a {
+		if a[i].UniqueID != b[i].UniqueID {
+			return false
+		}
+	}
+	return true
+}
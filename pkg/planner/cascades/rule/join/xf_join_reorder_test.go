@@ -0,0 +1,153 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package join_test
+
+import (
+	"testing"
+
+	"github.com/pingcap/failpoint"
+	"github.com/pingcap/tidb/pkg/expression"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/planner/cascades"
+	"github.com/pingcap/tidb/pkg/planner/cascades/memo"
+	"github.com/pingcap/tidb/pkg/planner/cascades/rule/join"
+	corebase "github.com/pingcap/tidb/pkg/planner/core/base"
+	"github.com/pingcap/tidb/pkg/planner/core/operator/logicalop"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestXFJoinReorder(t *testing.T) {
+	require.NoError(t, failpoint.Enable("github.com/pingcap/tidb/pkg/planner/cascades/memo/MockPlanSkipMemoDeriveStats", `return(true)`))
+	defer func() {
+		require.NoError(t, failpoint.Disable("github.com/pingcap/tidb/pkg/planner/cascades/memo/MockPlanSkipMemoDeriveStats"))
+	}()
+
+	ctx := mock.NewContext()
+	newDS := func(id int64, name string) (*logicalop.DataSource, *expression.Column) {
+		col := &expression.Column{ID: id, UniqueID: id, RetType: types.NewFieldType(mysql.TypeLonglong)}
+		ds := logicalop.DataSource{}.Init(ctx, 0)
+		ds.SetSchema(expression.NewSchema(col))
+		ds.SetOutputNames(types.NameSlice{&types.FieldName{ColName: ast.NewCIStr(name)}})
+		return ds, col
+	}
+	dsA, a := newDS(1, "a")
+	dsB, b := newDS(2, "b")
+	dsC, c := newDS(3, "c")
+	eq := func(l, r *expression.Column) *expression.ScalarFunction {
+		return expression.NewFunctionInternal(ctx.GetExprCtx(), ast.EQ, types.NewFieldType(mysql.TypeTiny), l, r).(*expression.ScalarFunction)
+	}
+
+	// (a join b) join c on a = c and b = c, where a join b is a cartesian join.
+	ab := logicalop.LogicalJoin{JoinType: logicalop.InnerJoin}.Init(ctx, 0)
+	ab.SetSchema(expression.MergeSchema(dsA.Schema(), dsB.Schema()))
+	ab.SetOutputNames(append(append(types.NameSlice{}, dsA.OutputNames()...), dsB.OutputNames()...))
+	ab.SetChildren(dsA, dsB)
+	abc := logicalop.LogicalJoin{JoinType: logicalop.InnerJoin}.Init(ctx, 0)
+	abc.SetSchema(expression.MergeSchema(ab.Schema(), dsC.Schema()))
+	abc.SetOutputNames(append(append(types.NameSlice{}, ab.OutputNames()...), dsC.OutputNames()...))
+	abc.SetChildren(ab, dsC)
+	abc.EqualConditions = []*expression.ScalarFunction{eq(a, c), eq(b, c)}
+
+	cas, err := cascades.NewOptimizer(abc)
+	require.Nil(t, err)
+	defer cas.Destroy()
+	myRule := join.NewXFJoinReorder()
+	cas.SetRules([]uint{myRule.ID()})
+	require.Nil(t, cas.Execute())
+
+	var joins, projs []*memo.GroupExpression
+	cas.GetMemo().GetRootGroup().ForEachGE(func(ge *memo.GroupExpression) bool {
+		switch ge.GetWrappedLogicalPlan().(type) {
+		case *logicalop.LogicalJoin:
+			joins = append(joins, ge)
+		case *logicalop.LogicalProjection:
+			projs = append(projs, ge)
+		}
+		return true
+	})
+	// the original join and a join (b join c), the cartesian split {a, b} | {c} is not generated.
+	require.Len(t, joins, 2)
+	newJoinGE := joins[1]
+	require.Len(t, newJoinGE.GetWrappedLogicalPlan().(*logicalop.LogicalJoin).EqualConditions, 1)
+	bcGroup := newJoinGE.Inputs[1]
+	bc := bcGroup.GetLogicalExpressions().Front().Value.(*memo.GroupExpression)
+	require.Len(t, bc.GetWrappedLogicalPlan().(*logicalop.LogicalJoin).EqualConditions, 1)
+	require.Equal(t, int64(2), bc.Schema().Columns[0].UniqueID)
+	require.Equal(t, int64(3), bc.Schema().Columns[1].UniqueID)
+
+	// (a join c) join b outputs the columns as a, c, b, it's projected back as a, b, c.
+	require.Len(t, projs, 1)
+	proj := projs[0]
+	require.Equal(t, []int64{1, 2, 3}, []int64{proj.Schema().Columns[0].UniqueID, proj.Schema().Columns[1].UniqueID, proj.Schema().Columns[2].UniqueID})
+	flagged := proj.Inputs[0].GetLogicalExpressions().Front().Value.(*memo.GroupExpression)
+	flaggedJoin := flagged.GetWrappedLogicalPlan().(*logicalop.LogicalJoin)
+	require.True(t, flaggedJoin.HasFlag(logicalop.JoinGenFromXFJoinReorderRuleFlag))
+	require.Equal(t, []int64{1, 3, 2}, []int64{flagged.Schema().Columns[0].UniqueID, flagged.Schema().Columns[1].UniqueID, flagged.Schema().Columns[2].UniqueID})
+	// the equal condition b = c is turned into c = b, whose left column comes from the left side.
+	require.Len(t, flaggedJoin.EqualConditions, 1)
+	require.Equal(t, int64(3), flaggedJoin.EqualConditions[0].GetArgs()[0].(*expression.Column).UniqueID)
+}
+
+func TestXFJoinReorderBigJoinGroup(t *testing.T) {
+	require.NoError(t, failpoint.Enable("github.com/pingcap/tidb/pkg/planner/cascades/memo/MockPlanSkipMemoDeriveStats", `return(true)`))
+	defer func() {
+		require.NoError(t, failpoint.Disable("github.com/pingcap/tidb/pkg/planner/cascades/memo/MockPlanSkipMemoDeriveStats"))
+	}()
+
+	ctx := mock.NewContext()
+	// the left-deep join of 12 leaves, every two of which are joined by an equal condition, so all the 4095 leaf
+	// subsets are connected.
+	const n = 12
+	cols := make([]*expression.Column, 0, n)
+	var root *logicalop.LogicalJoin
+	var left corebase.LogicalPlan
+	for i := int64(1); i <= n; i++ {
+		col := &expression.Column{ID: i, UniqueID: i, RetType: types.NewFieldType(mysql.TypeLonglong)}
+		cols = append(cols, col)
+		ds := logicalop.DataSource{}.Init(ctx, 0)
+		ds.SetSchema(expression.NewSchema(col))
+		ds.SetOutputNames(types.NameSlice{&types.FieldName{ColName: ast.NewCIStr(col.String())}})
+		if left == nil {
+			left = ds
+			continue
+		}
+		root = logicalop.LogicalJoin{JoinType: logicalop.InnerJoin}.Init(ctx, 0)
+		root.SetSchema(expression.MergeSchema(left.Schema(), ds.Schema()))
+		root.SetOutputNames(append(append(types.NameSlice{}, left.OutputNames()...), ds.OutputNames()...))
+		root.SetChildren(left, ds)
+		left = root
+	}
+	for i := range cols {
+		for j := i + 1; j < len(cols); j++ {
+			eq := expression.NewFunctionInternal(ctx.GetExprCtx(), ast.EQ, types.NewFieldType(mysql.TypeTiny), cols[i], cols[j])
+			root.EqualConditions = append(root.EqualConditions, eq.(*expression.ScalarFunction))
+		}
+	}
+
+	cas, err := cascades.NewOptimizer(root)
+	require.Nil(t, err)
+	defer cas.Destroy()
+	myRule := join.NewXFJoinReorder()
+	cas.SetRules([]uint{myRule.ID()})
+	require.Nil(t, cas.Execute())
+
+	// the original join, and the alternatives splitting each leaf out from the root once.
+	require.Equal(t, n+1, cas.GetMemo().GetRootGroup().GetLogicalExpressions().Len())
+	// only the subsets of the first 9 leaves are fully explored, instead of all the connected subsets.
+	require.Less(t, cas.GetMemo().GetGroups().Len(), 1024)
+}
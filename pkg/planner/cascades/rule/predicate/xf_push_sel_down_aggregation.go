@@ -0,0 +1,95 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package predicate
+
+import (
+	"github.com/pingcap/tidb/pkg/expression"
+	"github.com/pingcap/tidb/pkg/planner/cascades/memo"
+	"github.com/pingcap/tidb/pkg/planner/cascades/pattern"
+	"github.com/pingcap/tidb/pkg/planner/cascades/rule"
+	corebase "github.com/pingcap/tidb/pkg/planner/core/base"
+	"github.com/pingcap/tidb/pkg/planner/core/operator/logicalop"
+)
+
+var _ rule.Rule = &XFPushSelDownAggregation{}
+
+// XFPushSelDownAggregation pushes the conditions only referring to the group-by columns down through the aggregation,
+// it transforms `sel->agg->x` into `agg->sel->x`, or `sel->agg->sel->x` when some conditions remain.
+type XFPushSelDownAggregation struct {
+	*rule.BaseRule
+}
+
+// NewXFPushSelDownAggregation creates a new XFPushSelDownAggregation rule.
+func NewXFPushSelDownAggregation() *XFPushSelDownAggregation {
+	pa := pattern.NewPattern(pattern.OperandSelection, pattern.EngineTiDBOnly)
+	pa.SetChildren(pattern.NewPattern(pattern.OperandAggregation, pattern.EngineTiDBOnly))
+	return &XFPushSelDownAggregation{
+		BaseRule: rule.NewBaseRule(rule.XFPushSelDownAggregation, pa),
+	}
+}
+
+// ID implements the Rule interface.
+func (*XFPushSelDownAggregation) ID() uint {
+	return uint(rule.XFPushSelDownAggregation)
+}
+
+// XForm implements the Rule interface.
+func (*XFPushSelDownAggregation) XForm(selGE corebase.LogicalPlan) ([]corebase.LogicalPlan, bool, error) {
+	sel := selGE.GetWrappedLogicalPlan().(*logicalop.LogicalSelection)
+	aggGE := selGE.Children()[0].(*memo.GroupExpression)
+	agg := aggGE.GetWrappedLogicalPlan().(*logicalop.LogicalAggregation)
+	groupByColumns := expression.NewSchema(agg.GetGroupByCols()...)
+	var pushed, remained []expression.Expression
+	for _, cond := range sel.Conditions {
+		switch cond.(type) {
+		case *expression.Constant:
+			// the constant false condition should be kept above the aggregation, since the aggregation without group-by
+			// items still outputs one row on the empty input.
+			pushed = append(pushed, cond)
+			remained = append(remained, cond)
+		case *expression.ScalarFunction:
+			cols := expression.ExtractColumns(cond)
+			if len(cols) > 0 && groupByColumns.ColumnsIndices(cols) != nil {
+				pushed = append(pushed, cond)
+			} else {
+				remained = append(remained, cond)
+			}
+		default:
+			remained = append(remained, cond)
+		}
+	}
+	if len(pushed) == 0 || len(remained) == len(sel.Conditions) {
+		return nil, false, nil
+	}
+	sctx := sel.SCtx()
+	child := aggGE.Inputs[0].GetLogicalExpressions().Front().Value.(*memo.GroupExpression)
+	newSel := logicalop.LogicalSelection{Conditions: pushed}.Init(sctx, sel.QueryBlockOffset())
+	newSel.SetChildren(child)
+	newAgg := logicalop.LogicalAggregation{
+		AggFuncs:       agg.AggFuncs,
+		GroupByItems:   agg.GroupByItems,
+		PreferAggType:  agg.PreferAggType,
+		PreferAggToCop: agg.PreferAggToCop,
+	}.Init(sctx, agg.QueryBlockOffset())
+	newAgg.SetSchema(agg.Schema())
+	newAgg.SetOutputNames(agg.OutputNames())
+	newAgg.SetChildren(newSel)
+	if len(remained) == 0 {
+		return []corebase.LogicalPlan{newAgg}, false, nil
+	}
+	topSel := logicalop.LogicalSelection{Conditions: remained}.Init(sctx, sel.QueryBlockOffset())
+	topSel.SetChildren(newAgg)
+	return []corebase.LogicalPlan{topSel}, false, nil
+}
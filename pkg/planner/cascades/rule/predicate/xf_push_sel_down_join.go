@@ -0,0 +1,108 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package predicate
+
+import (
+	"github.com/pingcap/tidb/pkg/expression"
+	"github.com/pingcap/tidb/pkg/planner/cascades/memo"
+	"github.com/pingcap/tidb/pkg/planner/cascades/pattern"
+	"github.com/pingcap/tidb/pkg/planner/cascades/rule"
+	corebase "github.com/pingcap/tidb/pkg/planner/core/base"
+	"github.com/pingcap/tidb/pkg/planner/core/operator/logicalop"
+)
+
+var _ rule.Rule = &XFPushSelDownJoin{}
+
+// XFPushSelDownJoin pushes the selection down through the inner join or semi join. The conditions of the selection
+// are merged into the join, and the ones only referring to one side are pushed down to that side, which transforms
+// `sel->join->(x, y)` into `join->(sel->x, sel->y)`. The outer joins are left to the predicate pushdown of the
+// normalization phase, since the conditions remained above them could be pushed again and again.
+type XFPushSelDownJoin struct {
+	*rule.BaseRule
+}
+
+// NewXFPushSelDownJoin creates a new XFPushSelDownJoin rule.
+func NewXFPushSelDownJoin() *XFPushSelDownJoin {
+	pa := pattern.NewPattern(pattern.OperandSelection, pattern.EngineTiDBOnly)
+	pa.SetChildren(pattern.NewPattern(pattern.OperandJoin, pattern.EngineTiDBOnly))
+	return &XFPushSelDownJoin{
+		BaseRule: rule.NewBaseRule(rule.XFPushSelDownJoin, pa),
+	}
+}
+
+// ID implements the Rule interface.
+func (*XFPushSelDownJoin) ID() uint {
+	return uint(rule.XFPushSelDownJoin)
+}
+
+// PreCheck implements the Rule interface.
+func (*XFPushSelDownJoin) PreCheck(selGE corebase.LogicalPlan) bool {
+	joinGE := selGE.Children()[0].(*memo.GroupExpression)
+	// the other joins in the group are the join orders of the same join group, which are explored again from the
+	// pushed down join by join reorder rule, so only the first join is taken here.
+	if joinGE.GetGroup().GetFirstElem(pattern.OperandJoin).Value.(*memo.GroupExpression) != joinGE {
+		return false
+	}
+	join := joinGE.GetWrappedLogicalPlan().(*logicalop.LogicalJoin)
+	return join.JoinType == logicalop.InnerJoin || join.JoinType == logicalop.SemiJoin
+}
+
+// XForm implements the Rule interface.
+func (*XFPushSelDownJoin) XForm(selGE corebase.LogicalPlan) ([]corebase.LogicalPlan, bool, error) {
+	sel := selGE.GetWrappedLogicalPlan().(*logicalop.LogicalSelection)
+	joinGE := selGE.Children()[0].(*memo.GroupExpression)
+	join := joinGE.GetWrappedLogicalPlan().(*logicalop.LogicalJoin)
+	exprCtx := sel.SCtx().GetExprCtx()
+	conds := make([]expression.Expression, 0, len(join.LeftConditions)+len(join.RightConditions)+
+		len(join.EqualConditions)+len(join.OtherConditions)+len(sel.Conditions))
+	conds = append(conds, join.LeftConditions...)
+	conds = append(conds, join.RightConditions...)
+	conds = append(conds, expression.ScalarFuncs2Exprs(join.EqualConditions)...)
+	conds = append(conds, join.OtherConditions...)
+	conds = append(conds, sel.Conditions...)
+	conds = expression.ExtractFiltersFromDNFs(exprCtx, conds)
+	conds = expression.PropagateConstant(exprCtx, conds)
+	// return the table dual when the conditions are constant false or null.
+	if dual := logicalop.Conds2TableDual(join, conds); dual != nil {
+		return []corebase.LogicalPlan{dual}, false, nil
+	}
+	lChild := joinGE.Inputs[0].GetLogicalExpressions().Front().Value.(*memo.GroupExpression)
+	rChild := joinGE.Inputs[1].GetLogicalExpressions().Front().Value.(*memo.GroupExpression)
+	// the join inside the memo can't be changed in place, which will change its hash64.
+	newJoin := join.Shallow()
+	eqConds, lConds, rConds, otherConds := newJoin.ExtractOnCondition(conds, lChild.Schema(), rChild.Schema(), true, true)
+	newJoin.LeftConditions = nil
+	newJoin.RightConditions = nil
+	newJoin.EqualConditions = eqConds
+	newJoin.OtherConditions = otherConds
+	if len(lConds) == 0 && len(rConds) == 0 && newJoin.Equals(join) {
+		// the selection is redundant, the join is already in the memo, which can't be copied into the group of
+		// the selection again.
+		return nil, false, nil
+	}
+	newJoin.SetChildren(addSelection(sel, lChild, lConds), addSelection(sel, rChild, rConds))
+	return []corebase.LogicalPlan{newJoin}, false, nil
+}
+
+// addSelection adds a selection with the pushed down conditions above the child if there are any.
+func addSelection(sel *logicalop.LogicalSelection, child *memo.GroupExpression, conds []expression.Expression) corebase.LogicalPlan {
+	conds = expression.RemoveDupExprs(conds)
+	if len(conds) == 0 {
+		return child
+	}
+	newSel := logicalop.LogicalSelection{Conditions: conds}.Init(sel.SCtx(), sel.QueryBlockOffset())
+	newSel.SetChildren(child)
+	return newSel
+}
@@ -0,0 +1,89 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package predicate
+
+import (
+	"slices"
+
+	"github.com/pingcap/tidb/pkg/expression"
+	"github.com/pingcap/tidb/pkg/planner/cascades/memo"
+	"github.com/pingcap/tidb/pkg/planner/cascades/pattern"
+	"github.com/pingcap/tidb/pkg/planner/cascades/rule"
+	corebase "github.com/pingcap/tidb/pkg/planner/core/base"
+	"github.com/pingcap/tidb/pkg/planner/core/operator/logicalop"
+)
+
+var _ rule.Rule = &XFPushSelDownProjection{}
+
+// XFPushSelDownProjection pushes the selection down through the projection, it transforms `sel->proj->x` into
+// `proj->sel->x`, or `sel->proj->sel->x` when some conditions can't be substituted by the projection expressions.
+type XFPushSelDownProjection struct {
+	*rule.BaseRule
+}
+
+// NewXFPushSelDownProjection creates a new XFPushSelDownProjection rule.
+func NewXFPushSelDownProjection() *XFPushSelDownProjection {
+	pa := pattern.NewPattern(pattern.OperandSelection, pattern.EngineTiDBOnly)
+	pa.SetChildren(pattern.NewPattern(pattern.OperandProjection, pattern.EngineTiDBOnly))
+	return &XFPushSelDownProjection{
+		BaseRule: rule.NewBaseRule(rule.XFPushSelDownProjection, pa),
+	}
+}
+
+// ID implements the Rule interface.
+func (*XFPushSelDownProjection) ID() uint {
+	return uint(rule.XFPushSelDownProjection)
+}
+
+// PreCheck implements the Rule interface.
+func (*XFPushSelDownProjection) PreCheck(selGE corebase.LogicalPlan) bool {
+	proj := selGE.Children()[0].GetWrappedLogicalPlan().(*logicalop.LogicalProjection)
+	// the projection assigning the user variables can't be evaluated on the filtered rows.
+	return !proj.Proj4Expand && !slices.ContainsFunc(proj.Exprs, expression.HasAssignSetVarFunc)
+}
+
+// XForm implements the Rule interface.
+func (*XFPushSelDownProjection) XForm(selGE corebase.LogicalPlan) ([]corebase.LogicalPlan, bool, error) {
+	sel := selGE.GetWrappedLogicalPlan().(*logicalop.LogicalSelection)
+	projGE := selGE.Children()[0].(*memo.GroupExpression)
+	proj := projGE.GetWrappedLogicalPlan().(*logicalop.LogicalProjection)
+	sctx := sel.SCtx()
+	canBePushed := make([]expression.Expression, 0, len(sel.Conditions))
+	canNotBePushed := make([]expression.Expression, 0, len(sel.Conditions))
+	for _, cond := range sel.Conditions {
+		substituted, hasFailed, newFilter := expression.ColumnSubstituteImpl(sctx.GetExprCtx(), cond, proj.Schema(), proj.Exprs, true)
+		if substituted && !hasFailed && !expression.HasGetSetVarFunc(newFilter) {
+			canBePushed = append(canBePushed, newFilter)
+		} else {
+			canNotBePushed = append(canNotBePushed, cond)
+		}
+	}
+	if len(canBePushed) == 0 {
+		return nil, false, nil
+	}
+	child := projGE.Inputs[0].GetLogicalExpressions().Front().Value.(*memo.GroupExpression)
+	newSel := logicalop.LogicalSelection{Conditions: canBePushed}.Init(sctx, sel.QueryBlockOffset())
+	newSel.SetChildren(child)
+	newProj := logicalop.LogicalProjection{Exprs: proj.Exprs, CalculateNoDelay: proj.CalculateNoDelay}.Init(sctx, proj.QueryBlockOffset())
+	newProj.SetSchema(proj.Schema())
+	newProj.SetOutputNames(proj.OutputNames())
+	newProj.SetChildren(newSel)
+	if len(canNotBePushed) == 0 {
+		return []corebase.LogicalPlan{newProj}, false, nil
+	}
+	topSel := logicalop.LogicalSelection{Conditions: canNotBePushed}.Init(sctx, sel.QueryBlockOffset())
+	topSel.SetChildren(newProj)
+	return []corebase.LogicalPlan{topSel}, false, nil
+}
@@ -0,0 +1,127 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package predicate_test
+
+import (
+	"testing"
+
+	"github.com/pingcap/failpoint"
+	"github.com/pingcap/tidb/pkg/expression"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/planner/cascades"
+	"github.com/pingcap/tidb/pkg/planner/cascades/memo"
+	"github.com/pingcap/tidb/pkg/planner/cascades/rule/predicate"
+	"github.com/pingcap/tidb/pkg/planner/core/operator/logicalop"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newDS(ctx *mock.Context, id int64, name string) (*logicalop.DataSource, *expression.Column) {
+	tp := types.NewFieldType(mysql.TypeLonglong)
+	tp.AddFlag(mysql.NotNullFlag)
+	col := &expression.Column{ID: id, UniqueID: id, RetType: tp}
+	ds := logicalop.DataSource{}.Init(ctx, 0)
+	ds.SetSchema(expression.NewSchema(col))
+	ds.SetOutputNames(types.NameSlice{&types.FieldName{ColName: ast.NewCIStr(name)}})
+	return ds, col
+}
+
+func gtOne(ctx *mock.Context, col *expression.Column) expression.Expression {
+	one := &expression.Constant{Value: types.NewIntDatum(1), RetType: types.NewFieldType(mysql.TypeLonglong)}
+	return expression.NewFunctionInternal(ctx.GetExprCtx(), ast.GT, types.NewFieldType(mysql.TypeTiny), col, one)
+}
+
+func TestXFPushSelDownProjection(t *testing.T) {
+	require.NoError(t, failpoint.Enable("github.com/pingcap/tidb/pkg/planner/cascades/memo/MockPlanSkipMemoDeriveStats", `return(true)`))
+	defer func() {
+		require.NoError(t, failpoint.Disable("github.com/pingcap/tidb/pkg/planner/cascades/memo/MockPlanSkipMemoDeriveStats"))
+	}()
+
+	ctx := mock.NewContext()
+	ds, a := newDS(ctx, 1, "a")
+	// sel(p > 1) -> proj(a as p) -> ds
+	p := &expression.Column{UniqueID: 2, RetType: a.RetType}
+	proj := logicalop.LogicalProjection{Exprs: []expression.Expression{a}}.Init(ctx, 0)
+	proj.SetSchema(expression.NewSchema(p))
+	proj.SetOutputNames(ds.OutputNames())
+	proj.SetChildren(ds)
+	sel := logicalop.LogicalSelection{Conditions: []expression.Expression{gtOne(ctx, p)}}.Init(ctx, 0)
+	sel.SetChildren(proj)
+
+	cas, err := cascades.NewOptimizer(sel)
+	require.Nil(t, err)
+	defer cas.Destroy()
+	myRule := predicate.NewXFPushSelDownProjection()
+	cas.SetRules([]uint{myRule.ID()})
+	require.Nil(t, cas.Execute())
+
+	// sel -> proj -> ds is transformed into proj -> sel(a > 1) -> ds.
+	root := cas.GetMemo().GetRootGroup()
+	require.Equal(t, 2, root.GetLogicalExpressions().Len())
+	newProj := root.GetLogicalExpressions().Back().Value.(*memo.GroupExpression)
+	require.IsType(t, &logicalop.LogicalProjection{}, newProj.GetWrappedLogicalPlan())
+	require.Equal(t, int64(2), newProj.Schema().Columns[0].UniqueID)
+	newSel := newProj.Inputs[0].GetLogicalExpressions().Front().Value.(*memo.GroupExpression)
+	conds := newSel.GetWrappedLogicalPlan().(*logicalop.LogicalSelection).Conditions
+	require.Len(t, conds, 1)
+	require.Equal(t, int64(1), expression.ExtractColumns(conds[0])[0].UniqueID)
+	require.IsType(t, &logicalop.DataSource{}, newSel.Inputs[0].GetLogicalExpressions().Front().Value.(*memo.GroupExpression).GetWrappedLogicalPlan())
+}
+
+func TestXFPushSelDownJoin(t *testing.T) {
+	require.NoError(t, failpoint.Enable("github.com/pingcap/tidb/pkg/planner/cascades/memo/MockPlanSkipMemoDeriveStats", `return(true)`))
+	defer func() {
+		require.NoError(t, failpoint.Disable("github.com/pingcap/tidb/pkg/planner/cascades/memo/MockPlanSkipMemoDeriveStats"))
+	}()
+
+	ctx := mock.NewContext()
+	dsA, a := newDS(ctx, 1, "a")
+	dsB, b := newDS(ctx, 2, "b")
+	// sel(a > 1) -> join(a = b) -> (ds_a, ds_b)
+	join := logicalop.LogicalJoin{JoinType: logicalop.InnerJoin}.Init(ctx, 0)
+	join.SetSchema(expression.MergeSchema(dsA.Schema(), dsB.Schema()))
+	join.SetOutputNames(append(append(types.NameSlice{}, dsA.OutputNames()...), dsB.OutputNames()...))
+	join.SetChildren(dsA, dsB)
+	eq := expression.NewFunctionInternal(ctx.GetExprCtx(), ast.EQ, types.NewFieldType(mysql.TypeTiny), a, b)
+	join.EqualConditions = []*expression.ScalarFunction{eq.(*expression.ScalarFunction)}
+	sel := logicalop.LogicalSelection{Conditions: []expression.Expression{gtOne(ctx, a)}}.Init(ctx, 0)
+	sel.SetChildren(join)
+
+	cas, err := cascades.NewOptimizer(sel)
+	require.Nil(t, err)
+	defer cas.Destroy()
+	myRule := predicate.NewXFPushSelDownJoin()
+	cas.SetRules([]uint{myRule.ID()})
+	require.Nil(t, cas.Execute())
+
+	// sel -> join is transformed into join -> (sel(a > 1) -> ds_a, sel(b > 1) -> ds_b), where b > 1 is propagated
+	// from a = b and a > 1.
+	root := cas.GetMemo().GetRootGroup()
+	require.Equal(t, 2, root.GetLogicalExpressions().Len())
+	newJoinGE := root.GetLogicalExpressions().Back().Value.(*memo.GroupExpression)
+	newJoin := newJoinGE.GetWrappedLogicalPlan().(*logicalop.LogicalJoin)
+	require.Len(t, newJoin.EqualConditions, 1)
+	require.Len(t, newJoin.OtherConditions, 0)
+	for i, id := range []int64{1, 2} {
+		childSel := newJoinGE.Inputs[i].GetLogicalExpressions().Front().Value.(*memo.GroupExpression)
+		conds := childSel.GetWrappedLogicalPlan().(*logicalop.LogicalSelection).Conditions
+		require.Len(t, conds, 1)
+		require.Equal(t, id, expression.ExtractColumns(conds[0])[0].UniqueID)
+	}
+	// the original join is left unchanged.
+	require.Len(t, join.EqualConditions, 1)
+}
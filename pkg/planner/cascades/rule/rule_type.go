@@ -41,6 +41,18 @@ const (
 	XFPullCorrPredFromAgg1
 	// XFPullCorrPredFromAgg2 try to pull correlated expression from agg<selection> from inner child of an apply.
 	XFPullCorrPredFromAgg2
+	// XFJoinReorder try to explore the join orders of the adjacent inner joins.
+	XFJoinReorder
+	// XFPushSelDownProjection try to push the selection down through the projection.
+	XFPushSelDownProjection
+	// XFPushSelDownAggregation try to push the selection on the group-by columns down through the aggregation.
+	XFPushSelDownAggregation
+	// XFPushSelDownJoin try to push the selection down through the inner join or semi join.
+	XFPushSelDownJoin
+	// XFPruneJoinColumns try to prune the columns of the join children which are not used by the projection above.
+	XFPruneJoinColumns
+	// XFPushAggDownJoin try to push the partial aggregation down to one side of the inner join.
+	XFPushAggDownJoin
 	// XFMaximumRuleLength is the maximum rule length.
 	XFMaximumRuleLength
 )
@@ -50,6 +62,18 @@ func (tp *Type) String() string {
 	switch *tp {
 	case XFJoinToApply:
 		return "join_to_apply"
+	case XFJoinReorder:
+		return "join_reorder"
+	case XFPushSelDownProjection:
+		return "push_selection_down_projection"
+	case XFPushSelDownAggregation:
+		return "push_selection_down_aggregation"
+	case XFPushSelDownJoin:
+		return "push_selection_down_join"
+	case XFPruneJoinColumns:
+		return "prune_join_columns"
+	case XFPushAggDownJoin:
+		return "push_aggregation_down_join"
 	default:
 		return "default_none"
 	}
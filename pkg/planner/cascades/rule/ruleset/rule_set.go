@@ -19,7 +19,11 @@ import (
 	"github.com/pingcap/tidb/pkg/planner/cascades/memo"
 	"github.com/pingcap/tidb/pkg/planner/cascades/pattern"
 	"github.com/pingcap/tidb/pkg/planner/cascades/rule"
+	"github.com/pingcap/tidb/pkg/planner/cascades/rule/aggpushdown"
 	"github.com/pingcap/tidb/pkg/planner/cascades/rule/apply/decorrelateapply"
+	"github.com/pingcap/tidb/pkg/planner/cascades/rule/columnprune"
+	"github.com/pingcap/tidb/pkg/planner/cascades/rule/join"
+	"github.com/pingcap/tidb/pkg/planner/cascades/rule/predicate"
 	"github.com/pingcap/tidb/pkg/planner/core/operator/logicalop"
 )
 
@@ -35,9 +39,19 @@ const (
 
 // DefaultRuleSets indicates the all rule set.
 var DefaultRuleSets = map[pattern.Operand]*OperandRules{
-	pattern.OperandApply: OperandApplyRules,
+	pattern.OperandApply:       OperandApplyRules,
+	pattern.OperandJoin:        OperandJoinRules,
+	pattern.OperandSelection:   OperandSelectionRules,
+	pattern.OperandProjection:  OperandProjectionRules,
+	pattern.OperandAggregation: OperandAggregationRules,
 }
 
+// ExpandingRuleMask indicates the rules which may bring new groups into the memo, they are masked out when the memo
+// has grown big enough.
+var ExpandingRuleMask = bitset.New(uint(rule.XFMaximumRuleLength)).Set(uint(rule.XFJoinReorder)).
+	Set(uint(rule.XFPushSelDownProjection)).Set(uint(rule.XFPushSelDownAggregation)).Set(uint(rule.XFPushSelDownJoin)).
+	Set(uint(rule.XFPruneJoinColumns)).Set(uint(rule.XFPushAggDownJoin))
+
 // OperandRules wrapper all the rules rooted from one specified operator.
 type OperandRules struct {
 	setMap  map[SetType][]rule.Rule
@@ -82,3 +96,37 @@ var OperandApplyRulesMap = map[SetType][]rule.Rule{
 var OperandApplyRulesList = []rule.Rule{
 	decorrelateapply.NewXFDeCorrelateSimpleApply(),
 }
+
+// OperandJoinRules is the rules rooted from a join operand.
+var OperandJoinRules = &OperandRules{nil, OperandJoinRulesList}
+
+// OperandJoinRulesList OperandJoinRules is the rules rooted from a join operand, organized as list.
+var OperandJoinRulesList = []rule.Rule{
+	join.NewXFJoinReorder(),
+}
+
+// OperandSelectionRules is the rules rooted from a selection operand.
+var OperandSelectionRules = &OperandRules{nil, OperandSelectionRulesList}
+
+// OperandSelectionRulesList OperandSelectionRules is the rules rooted from a selection operand, organized as list.
+var OperandSelectionRulesList = []rule.Rule{
+	predicate.NewXFPushSelDownProjection(),
+	predicate.NewXFPushSelDownAggregation(),
+	predicate.NewXFPushSelDownJoin(),
+}
+
+// OperandProjectionRules is the rules rooted from a projection operand.
+var OperandProjectionRules = &OperandRules{nil, OperandProjectionRulesList}
+
+// OperandProjectionRulesList OperandProjectionRules is the rules rooted from a projection operand, organized as list.
+var OperandProjectionRulesList = []rule.Rule{
+	columnprune.NewXFPruneJoinColumns(),
+}
+
+// OperandAggregationRules is the rules rooted from an aggregation operand.
+var OperandAggregationRules = &OperandRules{nil, OperandAggregationRulesList}
+
+// OperandAggregationRulesList OperandAggregationRules is the rules rooted from an aggregation operand, organized as list.
+var OperandAggregationRulesList = []rule.Rule{
+	aggpushdown.NewXFPushAggDownJoin(),
+}
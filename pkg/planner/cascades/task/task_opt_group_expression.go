@@ -26,6 +26,10 @@ import (
 
 var _ base.Task = &OptGroupTask{}
 
+// exploredGroupLimit is the max group number of the memo, beyond which the rules bringing new groups are not applied
+// anymore, and the exploration converges on the existing groups.
+const exploredGroupLimit = 4096
+
 // OptGroupExpressionTask is a wrapper of running logic of exploring group expression.
 type OptGroupExpressionTask struct {
 	BaseTask
@@ -67,7 +71,11 @@ func (ge *OptGroupExpressionTask) Desc(w util.StrBufferWriter) {
 func (ge *OptGroupExpressionTask) getValidRules() []rule.Rule {
 	operandRules := ruleset.DefaultRuleSets[pattern.GetOperand(ge.groupExpression.GetWrappedLogicalPlan())]
 	if operandRules != nil {
-		return operandRules.Filter(ge.groupExpression).Filter(ge.ctx.GetRuleMask())
+		mask := ge.ctx.GetRuleMask()
+		if ge.ctx.GetMemo().GetGroups().Len() >= exploredGroupLimit {
+			mask = mask.Difference(ruleset.ExpandingRuleMask)
+		}
+		return operandRules.Filter(ge.groupExpression).Filter(mask)
 	}
 	return nil
 }
@@ -83,7 +83,8 @@ type ShowNextRowID struct {
 	TableName *ast.TableName
 }
 
-// CheckTable is used for checking table data, built from the 'admin check table' statement.
+// CheckTable is used for checking table data, built from the 'admin check table' and
+// 'alter table ... check partition' statements.
 type CheckTable struct {
 	baseSchemaProducer
 
@@ -92,6 +93,9 @@ type CheckTable struct {
 	IndexInfos         []*model.IndexInfo
 	IndexLookUpReaders []*PhysicalIndexLookUpReader
 	CheckIndex         bool
+	// PartitionIDs is the partitions to check for "ALTER TABLE ... CHECK PARTITION", all the partitions are
+	// checked if it's empty.
+	PartitionIDs []int64
 }
 
 // RecoverIndex is used for backfilling corrupted index data.
@@ -436,6 +440,13 @@ type Insert struct {
 
 	FKChecks   []*FKCheck   `plan-cache-clone:"must-nil"`
 	FKCascades []*FKCascade `plan-cache-clone:"must-nil"`
+
+	// RowPolicyCheck checks the inserted rows by the row-level security policies.
+	RowPolicyCheck expression.Expression `plan-cache-clone:"must-nil"`
+	// RowPolicyUpdateUsing checks the existing rows updated by ON DUPLICATE KEY UPDATE.
+	RowPolicyUpdateUsing expression.Expression `plan-cache-clone:"must-nil"`
+	// RowPolicyDeleteUsing checks the existing rows removed by REPLACE.
+	RowPolicyDeleteUsing expression.Expression `plan-cache-clone:"must-nil"`
 }
 
 // MemoryUsage return the memory usage of Insert
@@ -568,6 +579,33 @@ func (p *Delete) MemoryUsage() (sum int64) {
 	return
 }
 
+// MergeClause is a WHEN clause of the Merge plan. One of Update, Delete and Insert
+// is set according to the action of the clause.
+type MergeClause struct {
+	NotMatched bool
+	// Condition is the AND condition of the clause, it's nil if the condition is not specified.
+	Condition expression.Expression
+
+	Update *Update
+	Delete *Delete
+	Insert *Insert
+	// Values is the value list of the INSERT action, it's evaluated on the rows of the SelectPlan.
+	Values []expression.Expression
+}
+
+// Merge represents a MERGE plan. The SelectPlan joins the source with the target table,
+// the columns of the target table are NULL if the source row doesn't match any target row.
+type Merge struct {
+	baseSchemaProducer
+
+	SelectPlan base.PhysicalPlan
+
+	// TblColPosInfo records the column position of the target table in the rows of the SelectPlan.
+	TblColPosInfo TblColPosInfo
+
+	Clauses []*MergeClause
+}
+
 // AnalyzeInfo is used to store the database name, table name and partition name of analyze task.
 type AnalyzeInfo struct {
 	DBName        string
@@ -732,6 +732,10 @@ func completePhysicalIndexJoin(physic *PhysicalIndexJoin, rt *RootTask, innerS,
 	// fill executing hashKeys, which containing inner/outer keys, and extracted EQ keys from otherConds if any.
 	physic.OuterHashKeys = outerHashKeys
 	physic.InnerHashKeys = innerHashKeys
+	// the full table scan reader is used to fall back to hash join when the outer order is not required.
+	if outerProp := physic.GetChildReqProps(1 - physic.InnerChildIdx); outerProp == nil || outerProp.IsSortItemEmpty() {
+		physic.FallbackInnerPlan = buildIndexJoinFallbackInnerPlan(rt.Plan(), info.FallbackReader)
+	}
 	// the logical EqualConditions is not used anymore in later phase.
 	physic.EqualConditions = nil
 	// clear rootTask's indexJoinInfo in case of pushing upward, because physical index join is indexJoinInfo's consumer.
@@ -739,6 +743,63 @@ func completePhysicalIndexJoin(physic *PhysicalIndexJoin, rt *RootTask, innerS,
 	return physic
 }
 
+// buildIndexJoinFallbackInnerPlan replaces the reader at the bottom of the index join's inner plan with the full table
+// scan reader. It returns nil if there is any operator which may change the rows read by the reader, like the
+// aggregation, or the reader can't be replaced since their schemas are different.
+func buildIndexJoinFallbackInnerPlan(innerPlan, fallbackReader base.PhysicalPlan) base.PhysicalPlan {
+	if fallbackReader == nil {
+		return nil
+	}
+	switch x := innerPlan.(type) {
+	case *PhysicalTableReader:
+		if !isPlainScanPlans(x.TablePlans) {
+			return nil
+		}
+	case *PhysicalIndexReader:
+		if !isPlainScanPlans(x.IndexPlans) {
+			return nil
+		}
+	case *PhysicalIndexLookUpReader:
+		if !isPlainScanPlans(x.IndexPlans) || !isPlainScanPlans(x.TablePlans) {
+			return nil
+		}
+	case *PhysicalProjection, *PhysicalSelection, *PhysicalUnionScan:
+		child := buildIndexJoinFallbackInnerPlan(innerPlan.Children()[0], fallbackReader)
+		if child == nil {
+			return nil
+		}
+		cloned, err := innerPlan.Clone(innerPlan.SCtx())
+		if err != nil {
+			return nil
+		}
+		cloned.SetChildren(child)
+		return cloned
+	default:
+		return nil
+	}
+	schema, fallbackSchema := innerPlan.Schema(), fallbackReader.Schema()
+	if schema.Len() != fallbackSchema.Len() {
+		return nil
+	}
+	for i, col := range schema.Columns {
+		if col.UniqueID != fallbackSchema.Columns[i].UniqueID {
+			return nil
+		}
+	}
+	return fallbackReader
+}
+
+func isPlainScanPlans(plans []base.PhysicalPlan) bool {
+	for _, p := range plans {
+		switch p.(type) {
+		case *PhysicalTableScan, *PhysicalIndexScan, *PhysicalSelection:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
 // When inner plan is TableReader, the parameter `ranges` will be nil. Because pk only have one column. So all of its range
 // is generated during execution time.
 func constructIndexJoin(
@@ -1203,7 +1264,7 @@ func buildDataSource2IndexScanByIndexJoinProp(
 	// here we don't need to construct physical index join here anymore, because we will encapsulate it bottom-up.
 	// chosenPath and lastColManager of indexJoinResult should be returned to the caller (seen by index join to keep
 	// index join aware of indexColLens and compareFilters).
-	completeIndexJoinFeedBackInfo(innerTask.(*CopTask), indexJoinResult, indexJoinResult.chosenRanges, keyOff2IdxOff)
+	completeIndexJoinFeedBackInfo(ds, prop, innerTask.(*CopTask), indexJoinResult, indexJoinResult.chosenRanges, keyOff2IdxOff)
 	return innerTask
 }
 
@@ -1273,7 +1334,7 @@ func buildDataSource2TableScanByIndexJoinProp(
 	// here we don't need to construct physical index join here anymore, because we will encapsulate it bottom-up.
 	// chosenPath and lastColManager of indexJoinResult should be returned to the caller (seen by index join to keep
 	// index join aware of indexColLens and compareFilters).
-	completeIndexJoinFeedBackInfo(innerTask.(*CopTask), indexJoinResult, ranges, keyOff2IdxOff)
+	completeIndexJoinFeedBackInfo(ds, prop, innerTask.(*CopTask), indexJoinResult, ranges, keyOff2IdxOff)
 	return innerTask
 }
 
@@ -1291,7 +1352,7 @@ func buildDataSource2TableScanByIndexJoinProp(
 // the indexJoinInfo will be filled back to the innerTask, passed upward to RootTask
 // once this copTask is converted to RootTask type, and finally end up usage in the
 // indexJoin's attach2Task with calling completePhysicalIndexJoin.
-func completeIndexJoinFeedBackInfo(innerTask *CopTask, indexJoinResult *indexJoinPathResult, ranges ranger.MutableRanges, keyOff2IdxOff []int) {
+func completeIndexJoinFeedBackInfo(ds *logicalop.DataSource, prop *property.PhysicalProperty, innerTask *CopTask, indexJoinResult *indexJoinPathResult, ranges ranger.MutableRanges, keyOff2IdxOff []int) {
 	info := innerTask.IndexJoinInfo
 	if info == nil {
 		info = &IndexJoinInfo{}
@@ -1304,6 +1365,9 @@ func completeIndexJoinFeedBackInfo(innerTask *CopTask, indexJoinResult *indexJoi
 	}
 	info.Ranges = ranges
 	info.KeyOff2IdxOff = keyOff2IdxOff
+	if fallbackTask := constructIndexJoinFallbackTask(ds, prop); fallbackTask != nil {
+		info.FallbackReader = fallbackTask.ConvertToRootTask(ds.SCtx()).Plan()
+	}
 	// fill it back to the bottom-up Task.
 	innerTask.IndexJoinInfo = info
 }
@@ -1389,6 +1453,7 @@ func buildIndexJoinInner2TableScan(
 	if innerTask2 != nil {
 		joins = append(joins, constructIndexMergeJoin(p, prop, outerIdx, innerTask2, ranges, keyOff2IdxOff, path, lastColMng)...)
 	}
+	attachIndexJoinFallbackInnerPlan(p, prop, wrapper, joins)
 	return joins
 }
 
@@ -1449,9 +1514,72 @@ func buildIndexJoinInner2IndexScan(
 			joins = append(joins, constructIndexMergeJoin(p, prop, outerIdx, innerTask2, indexJoinResult.chosenRanges, keyOff2IdxOff, indexJoinResult.chosenPath, indexJoinResult.lastColManager)...)
 		}
 	}
+	attachIndexJoinFallbackInnerPlan(p, prop, wrapper, joins)
 	return joins
 }
 
+// attachIndexJoinFallbackInnerPlan builds a full table scan on the inner side for the index joins, which is used by
+// the adaptive join executor to fall back to hash join when there are far more outer rows than estimated.
+// The index merge join is skipped since it requires the order of the inner rows, so does the index join whose outer
+// order is required by the parent.
+func attachIndexJoinFallbackInnerPlan(p *logicalop.LogicalJoin, prop *property.PhysicalProperty, wrapper *indexJoinInnerChildWrapper, joins []base.PhysicalPlan) {
+	if len(joins) == 0 {
+		return
+	}
+	copTask := constructIndexJoinFallbackTask(wrapper.ds, prop)
+	if copTask == nil {
+		return
+	}
+	innerTask := constructIndexJoinInnerSideTaskWithAggCheck(p, prop, copTask, wrapper.ds, nil, wrapper)
+	if innerTask == nil || innerTask.Invalid() {
+		return
+	}
+	for _, join := range joins {
+		switch x := join.(type) {
+		case *PhysicalIndexJoin:
+			x.FallbackInnerPlan = innerTask.Plan()
+		case *PhysicalIndexHashJoin:
+			x.FallbackInnerPlan = innerTask.Plan()
+		}
+	}
+}
+
+// constructIndexJoinFallbackTask builds a full table scan on the inner data source of the index join, which is used by
+// the adaptive join executor to fall back to hash join. It returns nil if the adaptive join is disabled or the order
+// of the index join is required.
+func constructIndexJoinFallbackTask(ds *logicalop.DataSource, prop *property.PhysicalProperty) *CopTask {
+	if !ds.SCtx().GetSessionVars().EnableAdaptiveJoin || !prop.IsSortItemEmpty() {
+		return nil
+	}
+	hasTiKVTablePath := false
+	for _, path := range ds.PossibleAccessPaths {
+		if path.IsTablePath() && path.StoreType == kv.TiKV {
+			hasTiKVTablePath = true
+			break
+		}
+	}
+	if !hasTiKVTablePath {
+		return nil
+	}
+	var ranges ranger.Ranges
+	if ds.TableInfo.IsCommonHandle {
+		ranges = ranger.FullRange()
+	} else {
+		isUnsigned := false
+		if ds.TableInfo.PKIsHandle {
+			if pkColInfo := ds.TableInfo.GetPkColInfo(); pkColInfo != nil {
+				isUnsigned = mysql.HasUnsignedFlag(pkColInfo.GetFlag())
+			}
+		}
+		ranges = ranger.FullIntRange(isUnsigned)
+	}
+	copTask := constructDS2TableScanTask(ds, ranges, "", false, false, ds.StatsInfo().RowCount)
+	if copTask == nil {
+		return nil
+	}
+	return copTask.(*CopTask)
+}
+
 // constructInnerTableScanTask is specially used to construct the inner plan for PhysicalIndexJoin.
 func constructInnerTableScanTask(
 	p *logicalop.LogicalJoin,
@@ -3120,6 +3248,11 @@ func tryToGetMppWindows(lw *logicalop.LogicalWindow, prop *property.PhysicalProp
 			return nil
 		}
 
+		if lw.Frame != nil && lw.Frame.Type == ast.Groups {
+			lw.SCtx().GetSessionVars().RaiseWarningWhenMPPEnforced(
+				"MPP mode may be blocked because window function frame GROUPS is not supported now.")
+			return nil
+		}
 		if lw.Frame != nil && lw.Frame.Type == ast.Ranges {
 			ctx := lw.SCtx().GetExprCtx()
 			if _, err := expression.ExpressionsToPBList(ctx.GetEvalCtx(), lw.Frame.Start.CalcFuncs, lw.SCtx().GetClient()); err != nil {
@@ -406,6 +406,17 @@ func (p *PhysicalSelection) ExplainInfo() string {
 	if p.TiFlashFineGrainedShuffleStreamCount > 0 {
 		exprStr += fmt.Sprintf(", stream_count: %d", p.TiFlashFineGrainedShuffleStreamCount)
 	}
+	for i, runtimeFilter := range p.runtimeFilterList {
+		if i == 0 {
+			if len(exprStr) > 0 {
+				exprStr += ", "
+			}
+			exprStr += "runtime filter:"
+		} else {
+			exprStr += ", "
+		}
+		exprStr += runtimeFilter.ExplainInfo(false)
+	}
 	return exprStr
 }
 
@@ -470,6 +481,16 @@ func (p *PhysicalTableDual) ExplainInfo() string {
 	return str.String()
 }
 
+// ExplainInfo implements Plan interface.
+func (p *PhysicalJSONTable) ExplainInfo() string {
+	var str strings.Builder
+	str.WriteString("json:")
+	str.WriteString(p.Expr.StringWithCtx(p.SCtx().GetExprCtx().GetEvalCtx(), perrors.RedactLogDisable))
+	str.WriteString(", path:")
+	str.WriteString(p.Root.Path.String())
+	return str.String()
+}
+
 // ExplainInfo implements Plan interface.
 func (p *PhysicalSort) ExplainInfo() string {
 	buffer := bytes.NewBufferString("")
@@ -907,9 +928,12 @@ func (p *PhysicalWindow) ExplainInfo() string {
 		if !isFirst {
 			buffer.WriteString(" ")
 		}
-		if p.Frame.Type == ast.Rows {
+		switch p.Frame.Type {
+		case ast.Rows:
 			buffer.WriteString("rows")
-		} else {
+		case ast.Groups:
+			buffer.WriteString("groups")
+		default:
 			buffer.WriteString("range")
 		}
 		buffer.WriteString(" between ")
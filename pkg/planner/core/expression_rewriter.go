@@ -2438,6 +2438,9 @@ func (er *expressionRewriter) clause() clauseCode {
 }
 
 func (er *expressionRewriter) toColumn(v *ast.ColumnName) {
+	if er.toProcedureVariable(v) {
+		return
+	}
 	idx, err := expression.FindFieldName(er.names, v)
 	if err != nil {
 		er.err = plannererrors.ErrAmbiguous.GenWithStackByArgs(v.Name, clauseMsg[fieldList])
@@ -2500,6 +2503,38 @@ func (er *expressionRewriter) toColumn(v *ast.ColumnName) {
 	er.err = plannererrors.ErrUnknownColumn.GenWithStackByArgs(v.String(), clauseMsg[planCtx.builder.curClause])
 }
 
+// toProcedureVariable rewrites an unqualified column name to the value of the stored procedure
+// parameter or local variable with the same name. Like MySQL, procedure variables take precedence
+// over columns. In the body of a trigger, NEW.col_name and OLD.col_name are rewritten to the
+// values of the row which activates the trigger.
+func (er *expressionRewriter) toProcedureVariable(v *ast.ColumnName) bool {
+	if v.Schema.L != "" {
+		return false
+	}
+	name := v.Name.L
+	switch v.Table.L {
+	case "":
+	case "new", "old":
+		name = v.Table.L + "." + name
+	default:
+		return false
+	}
+	evalCtx := er.sctx.GetEvalCtx()
+	if !evalCtx.GetOptionalPropSet().Contains(exprctx.OptPropSessionVars) {
+		return false
+	}
+	sessionVars, err := expropt.SessionVarsPropReader{}.GetSessionVars(evalCtx)
+	if err != nil || sessionVars.ProcedureCtx == nil {
+		return false
+	}
+	d, tp, ok := sessionVars.ProcedureCtx.GetVariable(name)
+	if !ok {
+		return false
+	}
+	er.ctxStackAppend(&expression.Constant{Value: d, RetType: tp}, &types.FieldName{ColName: v.Name})
+	return true
+}
+
 func findFieldNameFromNaturalUsingJoin(p base.LogicalPlan, v *ast.ColumnName) (col *expression.Column, name *types.FieldName, err error) {
 	switch x := p.(type) {
 	case *logicalop.LogicalLimit, *logicalop.LogicalSelection, *logicalop.LogicalTopN, *logicalop.LogicalSort, *logicalop.LogicalMaxOneRow:
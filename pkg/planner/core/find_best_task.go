@@ -149,6 +149,24 @@ func findBestTask4LogicalShowDDLJobs(lp base.LogicalPlan, prop *property.Physica
 	return rt, 1, nil
 }
 
+func findBestTask4LogicalJSONTable(lp base.LogicalPlan, prop *property.PhysicalProperty, planCounter *base.PlanCounterTp, opt *optimizetrace.PhysicalOptimizeOp) (base.Task, int64, error) {
+	if prop.IndexJoinProp != nil {
+		// even enforce hint can not work with this.
+		return base.InvalidTask, 0, nil
+	}
+	p := lp.(*logicalop.LogicalJSONTable)
+	if !prop.IsSortItemEmpty() || planCounter.Empty() {
+		return base.InvalidTask, 0, nil
+	}
+	jt := PhysicalJSONTable{Expr: p.Expr, Root: p.Root}.Init(p.SCtx(), p.StatsInfo(), p.QueryBlockOffset())
+	jt.SetSchema(p.Schema())
+	planCounter.Dec(1)
+	appendCandidate4PhysicalOptimizeOp(opt, p, jt, prop)
+	rt := &RootTask{}
+	rt.SetPlan(jt)
+	return rt, 1, nil
+}
+
 // rebuildChildTasks rebuilds the childTasks to make the clock_th combination.
 func rebuildChildTasks(p *logicalop.BaseLogicalPlan, childTasks *[]base.Task, pp base.PhysicalPlan, childCnts []int64, planCounter int64, ts uint64, opt *optimizetrace.PhysicalOptimizeOp) error {
 	// The taskMap of children nodes should be rolled back first.
@@ -686,6 +704,74 @@ END:
 	return bestTask, cntPlan, nil
 }
 
+// findBestTask4LogicalMemoGroup finds the best task among the alternatives of the memo group. The alternatives are
+// implemented by their own findBestTask as the implementation rules. The sort enforcer of the root task is added on
+// the group rather than on each alternative, the other enforcers are still added by the alternatives.
+func findBestTask4LogicalMemoGroup(lp base.LogicalPlan, prop *property.PhysicalProperty, planCounter *base.PlanCounterTp,
+	opt *optimizetrace.PhysicalOptimizeOp) (bestTask base.Task, cntPlan int64, err error) {
+	p := lp.(*logicalop.LogicalMemoGroup)
+	if prop == nil {
+		return nil, 1, nil
+	}
+	// the group may be referred by several parents, look up the task with this prop in the task map first.
+	bestTask = p.GetTask(prop)
+	if bestTask != nil {
+		planCounter.Dec(1)
+		return bestTask, 1, nil
+	}
+	if prop.CanAddEnforcer && prop.TaskTp == property.RootTaskType && !prop.IsSortItemEmpty() && prop.IndexJoinProp == nil {
+		return findBestTask4LogicalMemoGroupWithSort(p, prop, planCounter, opt)
+	}
+	for _, alternative := range p.Alternatives {
+		curTask, cnt, err := alternative.FindBestTask(prop, planCounter, opt)
+		if err != nil {
+			return nil, 0, err
+		}
+		cntPlan += cnt
+		if bestTask == nil {
+			bestTask = curTask
+			continue
+		}
+		if curIsBetter, err := compareTaskCost(curTask, bestTask, opt); err != nil {
+			return nil, 0, err
+		} else if curIsBetter {
+			bestTask = curTask
+		}
+	}
+	p.StoreTask(prop, bestTask)
+	return bestTask, cntPlan, nil
+}
+
+// findBestTask4LogicalMemoGroupWithSort compares the best task of the alternatives satisfying the required order by
+// themselves, with the sort enforcer over the best task of the group without the order. The latter is memorized in
+// the group, and shared with the parents requiring no order.
+func findBestTask4LogicalMemoGroupWithSort(p *logicalop.LogicalMemoGroup, prop *property.PhysicalProperty,
+	planCounter *base.PlanCounterTp, opt *optimizetrace.PhysicalOptimizeOp) (bestTask base.Task, cntPlan int64, err error) {
+	// CloneEssentialFields leaves CanAddEnforcer false.
+	fitProp := prop.CloneEssentialFields()
+	bestTask, cntPlan, err = findBestTask4LogicalMemoGroup(p, fitProp, planCounter, opt)
+	if err != nil {
+		return nil, 0, err
+	}
+	emptyProp := prop.CloneEssentialFields()
+	emptyProp.SortItems = []property.SortItem{}
+	emptyProp.SortItemsForPartition = []property.SortItem{}
+	emptyProp.ExpectedCnt = math.MaxFloat64
+	curTask, cnt, err := findBestTask4LogicalMemoGroup(p, emptyProp, planCounter, opt)
+	if err != nil {
+		return nil, 0, err
+	}
+	cntPlan += cnt
+	curTask = enforceProperty(prop, curTask, p.SCtx(), nil)
+	if curIsBetter, err := compareTaskCost(curTask, bestTask, opt); err != nil {
+		return nil, 0, err
+	} else if curIsBetter {
+		bestTask = curTask
+	}
+	p.StoreTask(prop, bestTask)
+	return bestTask, cntPlan, nil
+}
+
 func findBestTask4LogicalMemTable(lp base.LogicalPlan, prop *property.PhysicalProperty, planCounter *base.PlanCounterTp, opt *optimizetrace.PhysicalOptimizeOp) (t base.Task, cntPlan int64, err error) {
 	if prop.IndexJoinProp != nil {
 		// even enforce hint can not work with this.
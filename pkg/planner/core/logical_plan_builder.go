@@ -154,7 +154,11 @@ func (b *PlanBuilder) buildExpand(p base.LogicalPlan, gbyItems []expression.Expr
 	distinctGbyCols := make([]*expression.Column, 0, len(distinctGbyExprs))
 	for _, expr := range distinctGbyExprs {
 		// distinct group expr has been resolved in resolveGby.
-		proj.Exprs = append(proj.Exprs, expr)
+		projExpr, err := b.maskExpandedGbyExpr(expr)
+		if err != nil {
+			return nil, nil, err
+		}
+		proj.Exprs = append(proj.Exprs, projExpr)
 
 		// add the newly appended names.
 		var name *types.FieldName
@@ -170,7 +174,10 @@ func (b *PlanBuilder) buildExpand(p base.LogicalPlan, gbyItems []expression.Expr
 		col := &expression.Column{
 			UniqueID: b.ctx.GetSessionVars().AllocPlanColumnID(),
 			// clone it rather than using it directly,
-			RetType: expr.GetType(b.ctx.GetExprCtx().GetEvalCtx()).Clone(),
+			RetType: projExpr.GetType(b.ctx.GetExprCtx().GetEvalCtx()).Clone(),
+		}
+		if err := b.registerExpandedMaskedColumn(expr, col); err != nil {
+			return nil, nil, err
 		}
 
 		projSchema.Append(col)
@@ -291,6 +298,11 @@ func (b *PlanBuilder) buildAggregation(ctx context.Context, p base.LogicalPlan,
 				return nil, nil, err
 			}
 			p = np
+			// The aggregate functions may also expose the values of the masked columns.
+			newArg, err = b.maskColumns(newArg)
+			if err != nil {
+				return nil, nil, err
+			}
 			newArgList = append(newArgList, newArg)
 		}
 		newFunc, err := aggregation.NewAggFuncDesc(b.ctx.GetExprCtx(), aggFunc.F, newArgList, aggFunc.Distinct)
@@ -454,6 +466,10 @@ func (b *PlanBuilder) buildResultSetNode(ctx context.Context, node ast.ResultSet
 		case *ast.TableName:
 			p, err = b.buildDataSource(ctx, v, &x.AsName)
 			isTableName = true
+		case *ast.JSONTable:
+			p, err = b.buildJSONTable(ctx, v, x.AsName)
+			// JSON_TABLE is not a select block either.
+			isTableName = true
 		default:
 			err = plannererrors.ErrUnsupportedType.GenWithStackByArgs(v)
 		}
@@ -497,6 +513,102 @@ func (b *PlanBuilder) buildResultSetNode(ctx context.Context, node ast.ResultSet
 	}
 }
 
+// buildJSONTable builds the JSON_TABLE table function. The columns it produces are
+// laid out in the order they are defined, including the ones of NESTED PATHs.
+func (b *PlanBuilder) buildJSONTable(ctx context.Context, jt *ast.JSONTable, asName ast.CIStr) (base.LogicalPlan, error) {
+	b.handleHelper.pushMap(nil)
+	// The document is evaluated on a dual, so it can only refer to outer columns.
+	dual := logicalop.LogicalTableDual{RowCount: 1}.Init(b.ctx, b.getSelectOffset())
+	dual.SetSchema(expression.NewSchema())
+	expr, np, err := b.rewrite(ctx, jt.Expr, dual, nil, true)
+	if err != nil {
+		return nil, err
+	}
+	if np != dual {
+		return nil, plannererrors.ErrNotSupportedYet.GenWithStackByArgs("subquery in JSON_TABLE")
+	}
+	if expr.GetType(b.ctx.GetExprCtx().GetEvalCtx()).EvalType() != types.ETJson {
+		expr = expression.BuildCastFunction(b.ctx.GetExprCtx(), expr, types.NewFieldType(mysql.TypeJSON))
+	}
+
+	p := logicalop.LogicalJSONTable{Expr: expr}.Init(b.ctx, b.getSelectOffset())
+	schema := expression.NewSchema()
+	names := make([]*types.FieldName, 0, len(jt.Columns))
+	p.Root, err = b.buildJSONTablePath(jt.Path, jt.Columns, asName, schema, &names)
+	if err != nil {
+		return nil, err
+	}
+	p.SetSchema(schema)
+	p.SetOutputNames(names)
+	return p, nil
+}
+
+func (b *PlanBuilder) buildJSONTablePath(path string, cols []*ast.JSONTableColumn, asName ast.CIStr,
+	schema *expression.Schema, names *[]*types.FieldName) (*logicalop.JSONTablePath, error) {
+	pathExpr, err := types.ParseJSONPathExpr(path)
+	if err != nil {
+		return nil, err
+	}
+	result := &logicalop.JSONTablePath{Path: pathExpr}
+	for _, col := range cols {
+		if col.Tp == ast.JSONTableColumnNested {
+			nested, err := b.buildJSONTablePath(col.Path, col.Columns, asName, schema, names)
+			if err != nil {
+				return nil, err
+			}
+			result.Nested = append(result.Nested, nested)
+			continue
+		}
+		for _, name := range *names {
+			if name.ColName.L == col.Name.L {
+				return nil, plannererrors.ErrDupFieldName.GenWithStackByArgs(col.Name.O)
+			}
+		}
+		c := &logicalop.JSONTableColumn{
+			Tp:      col.Tp,
+			Name:    col.Name,
+			Offset:  schema.Len(),
+			OnEmpty: col.OnEmpty,
+			OnError: col.OnError,
+		}
+		if col.Tp == ast.JSONTableColumnOrdinality {
+			c.RetType = types.NewFieldType(mysql.TypeLonglong)
+			c.RetType.AddFlag(mysql.UnsignedFlag)
+		} else {
+			c.Path, err = types.ParseJSONPathExpr(col.Path)
+			if err != nil {
+				return nil, err
+			}
+			c.RetType = b.jsonTableColumnType(col.Type)
+		}
+		result.Columns = append(result.Columns, c)
+		schema.Append(&expression.Column{
+			RetType:  c.RetType,
+			UniqueID: b.ctx.GetSessionVars().AllocPlanColumnID(),
+		})
+		*names = append(*names, &types.FieldName{TblName: asName, ColName: col.Name, OrigColName: col.Name})
+	}
+	return result, nil
+}
+
+// jsonTableColumnType fills the unspecified length and charset of a JSON_TABLE column type.
+func (b *PlanBuilder) jsonTableColumnType(tp *types.FieldType) *types.FieldType {
+	tp = tp.Clone()
+	defaultFlen, defaultDecimal := mysql.GetDefaultFieldLengthAndDecimal(tp.GetType())
+	if tp.GetFlen() == types.UnspecifiedLength {
+		tp.SetFlen(defaultFlen)
+	}
+	if tp.GetDecimal() == types.UnspecifiedLength {
+		tp.SetDecimal(defaultDecimal)
+	}
+	if tp.EvalType() == types.ETString && tp.GetCharset() == "" {
+		chs, coll := b.ctx.GetSessionVars().GetCharsetInfo()
+		tp.SetCharset(chs)
+		tp.SetCollate(coll)
+	}
+	return tp
+}
+
 func setPreferredStoreType(ds *logicalop.DataSource, hintInfo *h.PlanHints) {
 	if hintInfo == nil {
 		return
@@ -570,7 +682,7 @@ func (b *PlanBuilder) buildJoin(ctx context.Context, joinNode *ast.Join) (base.L
 		return nil, err
 	}
 
-	rightPlan, err := b.buildResultSetNode(ctx, joinNode.Right, false)
+	rightPlan, dependent, err := b.buildJoinRightSide(ctx, joinNode, leftPlan)
 	if err != nil {
 		return nil, err
 	}
@@ -684,7 +796,7 @@ func (b *PlanBuilder) buildJoin(ctx context.Context, joinNode *ast.Join) (base.L
 		// possible decorrelate optimizations. The ON clause is actually treated as a WHERE clause now.
 		if joinPlan.JoinType == logicalop.InnerJoin {
 			sel := logicalop.LogicalSelection{Conditions: onCondition}.Init(b.ctx, b.getSelectOffset())
-			sel.SetChildren(joinPlan)
+			sel.SetChildren(b.joinOrApply(joinPlan, dependent))
 			return sel, nil
 		}
 		joinPlan.AttachOnConds(onCondition)
@@ -694,7 +806,58 @@ func (b *PlanBuilder) buildJoin(ctx context.Context, joinNode *ast.Join) (base.L
 		joinPlan.CartesianJoin = true
 	}
 
-	return joinPlan, nil
+	return b.joinOrApply(joinPlan, dependent), nil
+}
+
+// buildJoinRightSide builds the right side of a join. LATERAL derived tables and table
+// functions like JSON_TABLE may refer to the columns of the tables on their left, so they
+// are built with the left side as an outer schema. It returns true if the right side really depends on the left side,
+// in which case the join must be built as an Apply.
+func (b *PlanBuilder) buildJoinRightSide(ctx context.Context, joinNode *ast.Join, leftPlan base.LogicalPlan) (base.LogicalPlan, bool, error) {
+	// The inner side of RIGHT JOIN is the left side, so it can't be referred by the right side.
+	if joinNode.Tp == ast.RightJoin || !canReferToLeftSide(joinNode.Right) {
+		rightPlan, err := b.buildResultSetNode(ctx, joinNode.Right, false)
+		return rightPlan, false, err
+	}
+	b.outerSchemas = append(b.outerSchemas, leftPlan.Schema())
+	b.outerNames = append(b.outerNames, leftPlan.OutputNames())
+	defer func() {
+		b.outerSchemas = b.outerSchemas[0 : len(b.outerSchemas)-1]
+		b.outerNames = b.outerNames[0 : len(b.outerNames)-1]
+	}()
+	rightPlan, err := b.buildResultSetNode(ctx, joinNode.Right, false)
+	if err != nil {
+		return nil, false, err
+	}
+	dependent := len(coreusage.ExtractCorColumnsBySchema4LogicalPlan(rightPlan, leftPlan.Schema())) > 0
+	return rightPlan, dependent, nil
+}
+
+// canReferToLeftSide checks whether the node can refer to the columns of the tables
+// on its left in the FROM clause.
+func canReferToLeftSide(node ast.ResultSetNode) bool {
+	ts, ok := node.(*ast.TableSource)
+	if !ok {
+		return false
+	}
+	if ts.Lateral {
+		return true
+	}
+	_, ok = ts.Source.(*ast.JSONTable)
+	return ok
+}
+
+// joinOrApply converts the join to an Apply if its right side depends on its left side.
+func (b *PlanBuilder) joinOrApply(joinPlan *logicalop.LogicalJoin, dependent bool) base.LogicalPlan {
+	if !dependent {
+		return joinPlan
+	}
+	b.optFlag = b.optFlag | rule.FlagBuildKeyInfo | rule.FlagDecorrelate
+	setIsInApplyForCTE(joinPlan.Children()[1], joinPlan.Schema())
+	ap := &logicalop.LogicalApply{LogicalJoin: *joinPlan}
+	ap.SetTP(plancodec.TypeApply)
+	ap.SetSelf(ap)
+	return ap
 }
 
 // buildUsingClause eliminate the redundant columns and ordering columns based
@@ -1349,6 +1512,10 @@ func (b *PlanBuilder) buildProjection(ctx context.Context, p base.LogicalPlan, f
 		// the column inside aggregate (only sum(b) here) should be resolved to original source column,
 		// while for others, just use expanded columns if exists: a'+ 1, b', group(gid)
 		newExpr = b.replaceGroupingFunc(newExpr)
+		newExpr, err = b.maskColumns(newExpr)
+		if err != nil {
+			return nil, nil, 0, err
+		}
 
 		// For window functions in the order by clause, we will append an field for it.
 		// We need rewrite the window mapper here so order by clause could find the added field.
@@ -4681,6 +4848,9 @@ func (b *PlanBuilder) buildDataSource(ctx context.Context, tn *ast.TableName, as
 		schema.Append(newCol)
 		ds.TblCols = append(ds.TblCols, newCol)
 	}
+	if err := b.registerMaskedColumns(ds.Columns, ds.TblCols); err != nil {
+		return nil, err
+	}
 	// We append an extra handle column to the schema when the handle
 	// column is not the primary key of "ds".
 	if handleCols == nil {
@@ -4760,7 +4930,7 @@ func (b *PlanBuilder) buildDataSource(ctx context.Context, tn *ast.TableName, as
 
 	var result base.LogicalPlan = ds
 	dirty := tableHasDirtyContent(b.ctx, tableInfo)
-	if dirty || tableInfo.TempTableType == model.TempTableLocal || tableInfo.TableCacheStatusType == model.TableCacheStatusEnable {
+	if dirty || tableInfo.HasSessionTemporaryData() || tableInfo.TableCacheStatusType == model.TableCacheStatusEnable {
 		us := logicalop.LogicalUnionScan{HandleCols: handleCols}.Init(b.ctx, b.getSelectOffset())
 		us.SetChildren(ds)
 		if tableInfo.Partition != nil && b.optFlag&rule.FlagPartitionProcessor == 0 {
@@ -4779,7 +4949,7 @@ func (b *PlanBuilder) buildDataSource(ctx context.Context, tn *ast.TableName, as
 	}
 	sessionVars.StmtCtx.TblInfo2UnionScan[tableInfo] = dirty
 
-	return result, nil
+	return b.buildRowPolicyFilter(ctx, tn, tableInfo, result)
 }
 
 func (b *PlanBuilder) timeRangeForSummaryTable() util.QueryTimeRange {
@@ -4968,6 +5138,7 @@ func (b *PlanBuilder) BuildDataSourceFromView(ctx context.Context, dbName ast.CI
 	if err != nil {
 		return nil, err
 	}
+	selectNode.Accept(&extensionAggFuncConverter{})
 	originalVisitInfo := b.visitInfo
 	b.visitInfo = make([]visitInfo, 0)
 
@@ -5373,7 +5544,8 @@ func pruneAndBuildColPositionInfoForDelete(
 		tblInfo := tbl.Meta()
 		// If it's partitioned table, or has foreign keys, or is point get plan, we can't prune the columns, currently.
 		// nonPrunedSet will be nil if it's a point get or has foreign keys.
-		if tblInfo.GetPartitionInfo() != nil || hasFK || nonPruned == nil {
+		// The full row is also needed to delete the double written row when the primary key is being changed.
+		if tblInfo.GetPartitionInfo() != nil || hasFK || nonPruned == nil || tblInfo.PrimaryKeyChange != nil {
 			err = buildSingleTableColPosInfoForDelete(tbl, cols2PosInfo)
 			if err != nil {
 				return nil, nil, err
@@ -5516,6 +5688,7 @@ func (b *PlanBuilder) buildUpdate(ctx context.Context, update *ast.UpdateStmt) (
 		}
 	}
 
+	b.collectUpdateRowPolicyTargets(update)
 	p, err := b.buildResultSetNode(ctx, update.TableRefs.TableRefs, false)
 	if err != nil {
 		return nil, err
@@ -5728,7 +5901,7 @@ func (b *PlanBuilder) buildUpdateLists(ctx context.Context, tableList []*ast.Tab
 		for _, tl := range tableList {
 			tlW := b.resolveCtx.GetTableName(tl)
 			if (tl.Schema.L == "" || tl.Schema.L == name.DBName.L) && (tl.Name.L == name.TblName.L) {
-				if isCTE(tlW) || tlW.TableInfo.IsView() || tlW.TableInfo.IsSequence() {
+				if isCTE(tlW) || tlW.TableInfo.IsView() || tlW.TableInfo.IsSequence() || b.isReadOnlyMaterializedView(tlW.TableInfo) {
 					return nil, nil, false, plannererrors.ErrNonUpdatableTable.GenWithStackByArgs(name.TblName.O, "UPDATE")
 				}
 				foundListItem = true
@@ -5823,6 +5996,13 @@ func (b *PlanBuilder) buildUpdateLists(ctx context.Context, tableList []*ast.Tab
 			if err != nil {
 				return nil, nil, false, err
 			}
+			// The assigned values are visible to the user like the projected values,
+			// so the masked columns are masked, while the generated columns are
+			// computed from the original values below.
+			newExpr, err = b.maskColumns(newExpr)
+			if err != nil {
+				return nil, nil, false, err
+			}
 			dependentColumnsModified[col.UniqueID] = true
 		} else {
 			// rewrite with generation expression
@@ -5935,6 +6115,7 @@ func (b *PlanBuilder) buildDelete(ctx context.Context, ds *ast.DeleteStmt) (base
 		}
 	}
 
+	b.collectDeleteRowPolicyTargets(ds)
 	p, err := b.buildResultSetNode(ctx, ds.TableRefs.TableRefs, false)
 	if err != nil {
 		return nil, err
@@ -6030,6 +6211,9 @@ func (b *PlanBuilder) buildDelete(ctx context.Context, ds *ast.DeleteStmt) (base
 			if tableInfo.IsSequence() {
 				return nil, errors.Errorf("delete sequence %s is not supported now", tn.Name.O)
 			}
+			if b.isReadOnlyMaterializedView(tableInfo) {
+				return nil, plannererrors.ErrNonUpdatableTable.GenWithStackByArgs(tn.Name.O, "DELETE")
+			}
 			if sessionVars.User != nil {
 				authErr = plannererrors.ErrTableaccessDenied.FastGenByArgs("DELETE", sessionVars.User.AuthUsername, sessionVars.User.AuthHostname, tb.Name.L)
 			}
@@ -6050,6 +6234,9 @@ func (b *PlanBuilder) buildDelete(ctx context.Context, ds *ast.DeleteStmt) (base
 			if tblW.TableInfo.IsSequence() {
 				return nil, errors.Errorf("delete sequence %s is not supported now", v.Name.O)
 			}
+			if b.isReadOnlyMaterializedView(tblW.TableInfo) {
+				return nil, plannererrors.ErrNonUpdatableTable.GenWithStackByArgs(v.Name.O, "DELETE")
+			}
 			dbName := v.Schema.L
 			if dbName == "" {
 				dbName = b.ctx.GetSessionVars().CurrentDB
@@ -6223,6 +6410,11 @@ func (b *PlanBuilder) buildProjectionForWindow(ctx context.Context, p base.Logic
 			return nil, nil, nil, nil, err
 		}
 		p = np
+		// The window functions may also expose the values of the masked columns.
+		newArg, err = b.maskColumns(newArg)
+		if err != nil {
+			return nil, nil, nil, nil, err
+		}
 		switch newArg.(type) {
 		case *expression.Column, *expression.Constant:
 			newArgList = append(newArgList, newArg.Clone())
@@ -6242,6 +6434,29 @@ func (b *PlanBuilder) buildProjectionForWindow(ctx context.Context, p base.Logic
 	return proj, propertyItems[:lenPartition], propertyItems[lenPartition:], newArgList, nil
 }
 
+// resolveWindowFuncOrderBy returns the expressions of the ORDER BY clause of a
+// window `group_concat`, where the positions are replaced by the arguments they refer to.
+func (b *PlanBuilder) resolveWindowFuncOrderBy(windowFunc *ast.WindowFuncExpr) ([]ast.ExprNode, error) {
+	if windowFunc.Order == nil {
+		return nil, nil
+	}
+	resolver := &aggOrderByResolver{
+		ctx:  b.ctx,
+		args: windowFunc.Args[:len(windowFunc.Args)-1], // the last argument is SEPARATOR, remove it.
+	}
+	exprs := make([]ast.ExprNode, 0, len(windowFunc.Order.Items))
+	for _, byItem := range windowFunc.Order.Items {
+		resolver.exprDepth = 0
+		resolver.err = nil
+		retExpr, _ := byItem.Expr.Accept(resolver)
+		if resolver.err != nil {
+			return nil, errors.Trace(resolver.err)
+		}
+		exprs = append(exprs, retExpr.(ast.ExprNode))
+	}
+	return exprs, nil
+}
+
 func (b *PlanBuilder) buildArgs4WindowFunc(ctx context.Context, p base.LogicalPlan, args []ast.ExprNode, aggMap map[*ast.AggregateFuncExpr]int) ([]expression.Expression, error) {
 	b.optFlag |= rule.FlagEliminateProjection
 
@@ -6255,6 +6470,10 @@ func (b *PlanBuilder) buildArgs4WindowFunc(ctx context.Context, p base.LogicalPl
 			return nil, err
 		}
 		p = np
+		newArg, err = b.maskColumns(newArg)
+		if err != nil {
+			return nil, err
+		}
 		switch newArg.(type) {
 		case *expression.Column, *expression.Constant:
 			newArgList = append(newArgList, newArg.Clone())
@@ -6324,7 +6543,9 @@ func (b *PlanBuilder) buildWindowFunctionFrameBound(_ context.Context, spec *ast
 		return bound, nil
 	}
 
-	if frameType == ast.Rows {
+	// The offsets of GROUPS frames are counted in peer groups, just like the ones of
+	// ROWS frames are counted in rows.
+	if frameType == ast.Rows || frameType == ast.Groups {
 		if bound.Type == ast.CurrentRow {
 			return bound, nil
 		}
@@ -6428,9 +6649,6 @@ func (b *PlanBuilder) buildWindowFunctionFrame(ctx context.Context, spec *ast.Wi
 func (b *PlanBuilder) checkWindowFuncArgs(ctx context.Context, p base.LogicalPlan, windowFuncExprs []*ast.WindowFuncExpr, windowAggMap map[*ast.AggregateFuncExpr]int) error {
 	checker := &expression.ParamMarkerInPrepareChecker{}
 	for _, windowFuncExpr := range windowFuncExprs {
-		if strings.ToLower(windowFuncExpr.Name) == ast.AggFuncGroupConcat {
-			return plannererrors.ErrNotSupportedYet.GenWithStackByArgs("group_concat as window function")
-		}
 		args, err := b.buildArgs4WindowFunc(ctx, p, windowFuncExpr.Args, windowAggMap)
 		if err != nil {
 			return err
@@ -6519,6 +6737,11 @@ func (b *PlanBuilder) buildWindowFunctions(ctx context.Context, p base.LogicalPl
 		spec, funcs := window.spec, window.funcs
 		for _, windowFunc := range funcs {
 			args = append(args, windowFunc.Args...)
+			orderByExprs, err := b.resolveWindowFuncOrderBy(windowFunc)
+			if err != nil {
+				return nil, nil, err
+			}
+			args = append(args, orderByExprs...)
 		}
 		np, partitionBy, orderBy, args, err := b.buildProjectionForWindow(ctx, p, spec, args, aggMap)
 		if err != nil {
@@ -6566,6 +6789,15 @@ func (b *PlanBuilder) buildWindowFunctions(ctx context.Context, p base.LogicalPl
 				return nil, nil, plannererrors.ErrWrongArguments.GenWithStackByArgs(strings.ToLower(windowFunc.Name))
 			}
 			preArgs += len(windowFunc.Args)
+			if windowFunc.Order != nil {
+				for i, byItem := range windowFunc.Order.Items {
+					desc.OrderByItems = append(desc.OrderByItems, &util.ByItems{Expr: args[preArgs+i], Desc: byItem.Desc})
+				}
+				preArgs += len(windowFunc.Order.Items)
+			}
+			desc.HasDistinct = windowFunc.Distinct
+			desc.IgnoreNull = windowFunc.IgnoreNull
+			desc.FromLast = windowFunc.FromLast
 			desc.WrapCastForAggArgs(b.ctx.GetExprCtx())
 			descs = append(descs, desc)
 			windowMap[windowFunc] = schema.Len()
@@ -6587,15 +6819,6 @@ func (b *PlanBuilder) buildWindowFunctions(ctx context.Context, p base.LogicalPl
 // Because the grouped specification is different from them, we should especially check them before build window frame.
 func (b *PlanBuilder) checkOriginWindowFuncs(funcs []*ast.WindowFuncExpr, orderByItems []property.SortItem) error {
 	for _, f := range funcs {
-		if f.IgnoreNull {
-			return plannererrors.ErrNotSupportedYet.GenWithStackByArgs("IGNORE NULLS")
-		}
-		if f.Distinct {
-			return plannererrors.ErrNotSupportedYet.GenWithStackByArgs("<window function>(DISTINCT ..)")
-		}
-		if f.FromLast {
-			return plannererrors.ErrNotSupportedYet.GenWithStackByArgs("FROM LAST")
-		}
 		spec := &f.Spec
 		if f.Spec.Name.L != "" {
 			spec = b.windowSpecs[f.Spec.Name.L]
@@ -6612,9 +6835,6 @@ func (b *PlanBuilder) checkOriginWindowSpec(spec *ast.WindowSpec, orderByItems [
 	if spec.Frame == nil {
 		return nil
 	}
-	if spec.Frame.Type == ast.Groups {
-		return plannererrors.ErrNotSupportedYet.GenWithStackByArgs("GROUPS")
-	}
 	start, end := spec.Frame.Extent.Start, spec.Frame.Extent.End
 	if start.Type == ast.Following && start.UnBounded {
 		return plannererrors.ErrWindowFrameStartIllegal.GenWithStackByArgs(getWindowName(spec.Name.O))
@@ -6646,7 +6866,7 @@ func (b *PlanBuilder) checkOriginWindowFrameBound(bound *ast.FrameBound, spec *a
 	}
 
 	frameType := spec.Frame.Type
-	if frameType == ast.Rows {
+	if frameType == ast.Rows || frameType == ast.Groups {
 		if bound.Unit != ast.TimeUnitInvalid {
 			return plannererrors.ErrWindowRowsIntervalUse.GenWithStackByArgs(getWindowName(spec.Name.O))
 		}
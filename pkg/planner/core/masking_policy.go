@@ -0,0 +1,231 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/expression"
+	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/privilege"
+	"github.com/pingcap/tidb/pkg/types"
+)
+
+// needMasking checks whether the columns with masking policies should be masked
+// for the current user. The internal SQLs and the users with the UNMASKED
+// privilege see the original values.
+func (b *PlanBuilder) needMasking() bool {
+	sessionVars := b.ctx.GetSessionVars()
+	if sessionVars.InRestrictedSQL {
+		return false
+	}
+	pm := privilege.GetPrivilegeManager(b.ctx)
+	if pm == nil {
+		return false
+	}
+	return !pm.RequestDynamicVerification(sessionVars.ActiveRoles, "UNMASKED", false)
+}
+
+// registerMaskedColumns records the columns of the data source which should be
+// masked when they are projected, see maskColumns.
+func (b *PlanBuilder) registerMaskedColumns(columns []*model.ColumnInfo, cols []*expression.Column) error {
+	checked := false
+	for i, colInfo := range columns {
+		if colInfo.MaskingPolicy == nil {
+			continue
+		}
+		if !checked {
+			// The masked results depend on the privileges of the current user, the
+			// plan built for the user who sees the original values can't be cached either.
+			b.ctx.GetSessionVars().StmtCtx.SetSkipPlanCache("query has masked columns")
+			if !b.needMasking() {
+				return nil
+			}
+			checked = true
+		}
+		maskingExpr, err := buildMaskingExpr(b.ctx.GetExprCtx(), cols[i], colInfo.MaskingPolicy)
+		if err != nil {
+			return err
+		}
+		if b.maskedCols == nil {
+			b.maskedCols = expression.NewSchema()
+		}
+		b.maskedCols.Append(cols[i])
+		b.maskingExprs = append(b.maskingExprs, maskingExpr)
+	}
+	return nil
+}
+
+// maskColumns replaces the masked columns in the expression with the masking expressions.
+func (b *PlanBuilder) maskColumns(expr expression.Expression) (expression.Expression, error) {
+	if b.maskedCols == nil {
+		return expr, nil
+	}
+	return substituteMaskedColumns(b.ctx.GetExprCtx(), expr, b.maskedCols, b.maskingExprs)
+}
+
+// maskExpandedGbyExpr returns the expression projected by the Expand of ROLLUP
+// for the group-by expression. The masked value of an expression on the masked
+// columns can't be computed from its original value, so the expression is
+// grouped by its masked value.
+func (b *PlanBuilder) maskExpandedGbyExpr(expr expression.Expression) (expression.Expression, error) {
+	if _, ok := expr.(*expression.Column); ok {
+		return expr, nil
+	}
+	return b.maskColumns(expr)
+}
+
+// registerExpandedMaskedColumn records the column projected by the Expand of
+// ROLLUP for a masked column, which carries the original values of it.
+func (b *PlanBuilder) registerExpandedMaskedColumn(expr expression.Expression, expandedCol *expression.Column) error {
+	col, ok := expr.(*expression.Column)
+	if !ok || b.maskedCols == nil {
+		return nil
+	}
+	idx := b.maskedCols.ColumnIndex(col)
+	if idx == -1 {
+		return nil
+	}
+	maskingExpr, err := substituteMaskedColumns(b.ctx.GetExprCtx(), b.maskingExprs[idx], expression.NewSchema(col), []expression.Expression{expandedCol})
+	if err != nil {
+		return err
+	}
+	b.maskedCols.Append(expandedCol)
+	b.maskingExprs = append(b.maskingExprs, maskingExpr)
+	return nil
+}
+
+// substituteMaskedColumns is like expression.ColumnSubstitute, but it never falls
+// back to the original column, otherwise the original values may be leaked.
+func substituteMaskedColumns(ctx expression.BuildContext, expr expression.Expression, schema *expression.Schema, newExprs []expression.Expression) (expression.Expression, error) {
+	switch v := expr.(type) {
+	case *expression.Column:
+		if idx := schema.ColumnIndex(v); idx != -1 {
+			return newExprs[idx], nil
+		}
+	case *expression.ScalarFunction:
+		args := v.GetArgs()
+		var newArgs []expression.Expression
+		for i, arg := range args {
+			newArg, err := substituteMaskedColumns(ctx, arg, schema, newExprs)
+			if err != nil {
+				return nil, err
+			}
+			if newArg != arg && newArgs == nil {
+				newArgs = make([]expression.Expression, len(args))
+				copy(newArgs, args[:i])
+			}
+			if newArgs != nil {
+				newArgs[i] = newArg
+			}
+		}
+		if newArgs == nil {
+			return v, nil
+		}
+		if v.FuncName.L == ast.Cast {
+			return expression.BuildCastFunction(ctx, newArgs[0], v.RetType), nil
+		}
+		return expression.NewFunction(ctx, v.FuncName.L, v.RetType.Clone(), newArgs...)
+	}
+	return expr, nil
+}
+
+// buildMaskingExpr builds the expression which masks the column by the masking policy.
+func buildMaskingExpr(ctx expression.BuildContext, col *expression.Column, policy *model.MaskingPolicyInfo) (expression.Expression, error) {
+	newFunc := func(name string, args ...expression.Expression) (expression.Expression, error) {
+		return expression.NewFunction(ctx, name, types.NewFieldType(mysql.TypeUnspecified), args...)
+	}
+	strConst := func(s string) expression.Expression {
+		tp := types.NewFieldType(mysql.TypeVarString)
+		chs, coll := ctx.GetCharsetInfo()
+		tp.SetCharset(chs)
+		tp.SetCollate(coll)
+		tp.SetFlen(len(s))
+		return &expression.Constant{Value: types.NewStringDatum(s), RetType: tp}
+	}
+	redact := func() (expression.Expression, error) {
+		charLen, err := newFunc(ast.CharLength, col)
+		if err != nil {
+			return nil, err
+		}
+		return newFunc(ast.Repeat, strConst("*"), charLen)
+	}
+
+	switch policy.Type {
+	case ast.MaskingRedact:
+		return redact()
+	case ast.MaskingPartial:
+		// IF(CHAR_LENGTH(c) > p + s, CONCAT(LEFT(c, p), REPEAT('*', CHAR_LENGTH(c) - p - s), RIGHT(c, s)), REPEAT('*', CHAR_LENGTH(c)))
+		kept := int64(policy.KeepPrefix + policy.KeepSuffix)
+		charLen, err := newFunc(ast.CharLength, col)
+		if err != nil {
+			return nil, err
+		}
+		cond, err := newFunc(ast.GT, charLen, expression.NewInt64Const(kept))
+		if err != nil {
+			return nil, err
+		}
+		prefix, err := newFunc(ast.Left, col, expression.NewInt64Const(int64(policy.KeepPrefix)))
+		if err != nil {
+			return nil, err
+		}
+		maskedLen, err := newFunc(ast.Minus, charLen, expression.NewInt64Const(kept))
+		if err != nil {
+			return nil, err
+		}
+		stars, err := newFunc(ast.Repeat, strConst("*"), maskedLen)
+		if err != nil {
+			return nil, err
+		}
+		suffix, err := newFunc(ast.Right, col, expression.NewInt64Const(int64(policy.KeepSuffix)))
+		if err != nil {
+			return nil, err
+		}
+		partial, err := newFunc(ast.Concat, prefix, stars, suffix)
+		if err != nil {
+			return nil, err
+		}
+		redacted, err := redact()
+		if err != nil {
+			return nil, err
+		}
+		return newFunc(ast.If, cond, partial, redacted)
+	case ast.MaskingHash:
+		return newFunc(ast.SHA2, col, expression.NewInt64Const(256))
+	case ast.MaskingNull:
+		tp := col.RetType.Clone()
+		tp.DelFlag(mysql.NotNullFlag)
+		return expression.NewNullWithFieldType(tp), nil
+	case ast.MaskingDateTrunc:
+		var format string
+		switch policy.Unit {
+		case ast.TimeUnitYear:
+			format = "%Y-01-01"
+		case ast.TimeUnitMonth:
+			format = "%Y-%m-01"
+		default:
+			format = "%Y-%m-%d"
+		}
+		truncated, err := newFunc(ast.DateFormat, col, strConst(format))
+		if err != nil {
+			return nil, err
+		}
+		tp := col.RetType.Clone()
+		tp.DelFlag(mysql.NotNullFlag)
+		return expression.BuildCastFunction(ctx, truncated, tp), nil
+	}
+	return nil, errors.Errorf("unknown masking type %d", policy.Type)
+}
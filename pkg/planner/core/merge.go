@@ -0,0 +1,288 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/expression"
+	"github.com/pingcap/tidb/pkg/infoschema"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/planner/core/base"
+	"github.com/pingcap/tidb/pkg/planner/core/operator/logicalop"
+	"github.com/pingcap/tidb/pkg/planner/core/resolve"
+	"github.com/pingcap/tidb/pkg/planner/core/rule"
+	"github.com/pingcap/tidb/pkg/planner/util"
+	"github.com/pingcap/tidb/pkg/table"
+	"github.com/pingcap/tidb/pkg/util/dbterror/plannererrors"
+)
+
+// buildMerge builds the plan of the MERGE statement. The source is joined with the target table,
+// a left outer join is used if there are WHEN NOT MATCHED clauses so that the unmatched source rows
+// are kept. The rows of the join are written by the Update, Delete and Insert plans of the clauses.
+func (b *PlanBuilder) buildMerge(ctx context.Context, merge *ast.MergeStmt) (base.Plan, error) {
+	b.pushSelectOffset(0)
+	defer b.popSelectOffset()
+
+	b.inUpdateStmt = true
+	b.isForUpdateRead = true
+
+	tn, ok := merge.Target.Source.(*ast.TableName)
+	if !ok {
+		return nil, infoschema.ErrTableNotExists.FastGenByArgs()
+	}
+	tnW := b.resolveCtx.GetTableName(tn)
+	tblInfo := tnW.TableInfo
+	if isCTE(tnW) || tblInfo.IsView() || tblInfo.IsSequence() || b.isReadOnlyMaterializedView(tblInfo) {
+		return nil, plannererrors.ErrNonUpdatableTable.GenWithStackByArgs(tn.Name.O, "MERGE")
+	}
+	tbl, ok := b.is.TableByID(ctx, tblInfo.ID)
+	if !ok {
+		return nil, infoschema.ErrTableNotExists.FastGenByArgs(tnW.DBInfo.Name.O, tblInfo.Name.O)
+	}
+	dbName := tnW.DBInfo.Name.L
+	targetName := mergeTargetName(merge.Target, b.resolveCtx)
+	if srcName := merge.Source.AsName; srcName.L == "" {
+		if srcTn, ok := merge.Source.Source.(*ast.TableName); ok && srcTn.Name.L == targetName.Name.L {
+			return nil, plannererrors.ErrNonUniqTable.GenWithStackByArgs(targetName.Name.O)
+		}
+	} else if srcName.L == targetName.Name.L {
+		return nil, plannererrors.ErrNonUniqTable.GenWithStackByArgs(targetName.Name.O)
+	}
+
+	joinTp := ast.CrossJoin
+	for _, clause := range merge.Clauses {
+		if clause.NotMatched {
+			joinTp = ast.LeftJoin
+			break
+		}
+	}
+	// the UPDATE policies apply to the target table, and the SELECT policies apply to the source.
+	b.rowPolicyTargets = map[*ast.TableName]struct{}{tn: {}}
+	join := &ast.Join{Left: merge.Source, Right: merge.Target, Tp: joinTp, On: &ast.OnCondition{Expr: merge.On}}
+	p, err := b.buildResultSetNode(ctx, join, false)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range ExtractTableList(resolve.NewNodeWWithCtx(join, b.resolveCtx), false) {
+		db := t.Schema.L
+		if db == "" {
+			db = b.ctx.GetSessionVars().CurrentDB
+		}
+		if _, ok := b.nameMapCTE[t.Name.L]; !ok {
+			b.visitInfo = appendVisitInfo(b.visitInfo, mysql.SelectPriv, db, t.Name.L, "", nil)
+		}
+	}
+
+	// Add project to freeze the order of output columns.
+	proj := logicalop.LogicalProjection{Exprs: expression.Column2Exprs(p.Schema().Columns)}.Init(b.ctx, b.getSelectOffset())
+	proj.SetSchema(p.Schema().Clone())
+	proj.SetOutputNames(p.OutputNames())
+	proj.SetChildren(p)
+	p = proj
+
+	mergePlan := Merge{}.Init(b.ctx)
+	for _, clause := range merge.Clauses {
+		c := &MergeClause{NotMatched: clause.NotMatched}
+		if clause.Condition != nil {
+			c.Condition, p, err = b.rewrite(ctx, clause.Condition, p, nil, true)
+			if err != nil {
+				return nil, err
+			}
+		}
+		switch clause.Action {
+		case ast.MergeActionUpdate:
+			var list []*expression.Assignment
+			list, p, _, err = b.buildUpdateLists(ctx, []*ast.TableName{targetName}, mergeAssignments(clause.Assignments, targetName), p)
+			if err != nil {
+				return nil, err
+			}
+			c.Update = Update{OrderedList: list, VirtualAssignmentsOffset: len(clause.Assignments)}.Init(b.ctx)
+		case ast.MergeActionDelete:
+			c.Delete = Delete{}.Init(b.ctx)
+			var authErr error
+			if user := b.ctx.GetSessionVars().User; user != nil {
+				authErr = plannererrors.ErrTableaccessDenied.FastGenByArgs("DELETE", user.AuthUsername, user.AuthHostname, tblInfo.Name.L)
+			}
+			b.visitInfo = appendVisitInfo(b.visitInfo, mysql.DeletePriv, dbName, tblInfo.Name.L, "", authErr)
+		case ast.MergeActionInsert:
+			c.Insert, c.Values, p, err = b.buildMergeInsert(ctx, clause, tn, tbl, dbName, p)
+			if err != nil {
+				return nil, err
+			}
+		}
+		mergePlan.Clauses = append(mergePlan.Clauses, c)
+	}
+
+	mergePlan.names = p.OutputNames()
+	mergePlan.SelectPlan, _, err = DoOptimize(ctx, b.ctx, b.optFlag&^rule.FlagEliminateProjection, p)
+	if err != nil {
+		return nil, err
+	}
+	tblID2Handle, err := resolveIndicesForTblID2Handle(b.handleHelper.tailMap(), mergePlan.SelectPlan.Schema())
+	if err != nil {
+		return nil, err
+	}
+	tblID2Table := map[int64]table.Table{tblInfo.ID: tbl}
+	posInfos, err := buildColumns2HandleWithWrtiableColumns(mergePlan.names, map[int64][]util.HandleCols{tblInfo.ID: tblID2Handle[tblInfo.ID]}, tblID2Table)
+	if err != nil {
+		return nil, err
+	}
+	// The source may be the same table with the target, find the target by its name.
+	found := false
+	for _, info := range posInfos {
+		if mergePlan.names[info.Start].TblName.L == targetName.Name.L {
+			mergePlan.TblColPosInfo, found = info, true
+			break
+		}
+	}
+	if !found {
+		return nil, errors.Errorf("Couldn't get column information when do merge")
+	}
+
+	for _, c := range mergePlan.Clauses {
+		switch {
+		case c.Update != nil:
+			// The Update plan shares the SelectPlan with the Merge plan, it's only used to write the rows.
+			c.Update.SelectPlan = mergePlan.SelectPlan
+			c.Update.names = mergePlan.names
+			c.Update.TblColPosInfos = TblColPosInfoSlice{mergePlan.TblColPosInfo}
+			c.Update.tblID2Table = tblID2Table
+			if err = c.Update.buildOnUpdateFKTriggers(b.ctx, b.is, tblID2Table); err != nil {
+				return nil, err
+			}
+		case c.Delete != nil:
+			c.Delete.TblColPosInfos = TblColPosInfoSlice{mergePlan.TblColPosInfo}
+			if err = c.Delete.buildOnDeleteFKTriggers(b.ctx, b.is, tblID2Table); err != nil {
+				return nil, err
+			}
+		}
+	}
+	err = mergePlan.ResolveIndices()
+	return mergePlan, err
+}
+
+// mergeTargetName returns the name which is used to refer to the target table in the MERGE statement.
+func mergeTargetName(target *ast.TableSource, resolveCtx *resolve.Context) *ast.TableName {
+	tn := target.Source.(*ast.TableName)
+	if target.AsName.L == "" {
+		return tn
+	}
+	aliasName := *tn
+	aliasName.Name = target.AsName
+	aliasName.Schema = ast.NewCIStr("")
+	if tnW := resolveCtx.GetTableName(tn); tnW != nil {
+		resolveCtx.AddTableName(&resolve.TableNameW{
+			TableName: &aliasName,
+			DBInfo:    tnW.DBInfo,
+			TableInfo: tnW.TableInfo,
+		})
+	}
+	return &aliasName
+}
+
+// mergeAssignments qualifies the columns of the SET list with the target table, since only the
+// columns of the target table can be assigned by the WHEN MATCHED THEN UPDATE clause.
+func mergeAssignments(list []*ast.Assignment, target *ast.TableName) []*ast.Assignment {
+	assignments := make([]*ast.Assignment, 0, len(list))
+	for _, assign := range list {
+		if assign.Column.Table.L != "" {
+			assignments = append(assignments, assign)
+			continue
+		}
+		col := *assign.Column
+		col.Schema, col.Table = target.Schema, target.Name
+		assignments = append(assignments, &ast.Assignment{Column: &col, Expr: assign.Expr})
+	}
+	return assignments
+}
+
+// buildMergeInsert builds the Insert plan of the WHEN NOT MATCHED clause. The values are
+// rewritten on the join of the source and the target, the columns assigned with DEFAULT
+// are filled by the Insert executor.
+func (b *PlanBuilder) buildMergeInsert(
+	ctx context.Context, clause *ast.MergeClause, tn *ast.TableName, tbl table.Table, dbName string, p base.LogicalPlan,
+) (*Insert, []expression.Expression, base.LogicalPlan, error) {
+	tblInfo := tbl.Meta()
+	schema, names, err := expression.TableInfo2SchemaAndNames(b.ctx.GetExprCtx(), tn.Schema, tblInfo)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	insertPlan := Insert{
+		Table:         tbl,
+		tableSchema:   schema,
+		tableColNames: names,
+	}.Init(b.ctx)
+	affectedValuesCols, err := b.getAffectCols(&ast.InsertStmt{Columns: clause.Columns}, insertPlan)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if len(clause.Values) != len(affectedValuesCols) {
+		return nil, nil, nil, plannererrors.ErrWrongValueCountOnRow.GenWithStackByArgs(1)
+	}
+
+	values := make([]expression.Expression, 0, len(clause.Values))
+	for i, val := range clause.Values {
+		col := affectedValuesCols[i]
+		if col.Hidden {
+			return nil, nil, nil, plannererrors.ErrUnknownColumn.GenWithStackByArgs(col.Name, clauseMsg[fieldList])
+		}
+		if extractDefaultExpr(val) != nil {
+			continue
+		}
+		// Note: For INSERT, REPLACE, and UPDATE, if a generated column is inserted into, replaced, or updated explicitly, the only permitted value is DEFAULT.
+		if col.IsGenerated() {
+			return nil, nil, nil, plannererrors.ErrBadGeneratedColumn.GenWithStackByArgs(col.Name.O, tblInfo.Name.O)
+		}
+		var expr expression.Expression
+		expr, p, err = b.rewrite(ctx, val, p, nil, true)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		expr, err = b.maskColumns(expr)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		insertPlan.Columns = append(insertPlan.Columns, &ast.ColumnName{Name: col.Name})
+		values = append(values, expr)
+	}
+	insertPlan.RowLen = len(values)
+
+	user := b.ctx.GetSessionVars().User
+	var authErr error
+	if user != nil {
+		authErr = plannererrors.ErrTableaccessDenied.FastGenByArgs("INSERT", user.AuthUsername, user.AuthHostname, tblInfo.Name.L)
+	}
+	b.visitInfo = appendVisitInfo(b.visitInfo, mysql.InsertPriv, dbName, tblInfo.Name.L, "", authErr)
+
+	mockTablePlan := logicalop.LogicalTableDual{}.Init(b.ctx, b.getSelectOffset())
+	mockTablePlan.SetSchema(insertPlan.tableSchema)
+	mockTablePlan.SetOutputNames(insertPlan.tableColNames)
+	insertPlan.GenCols, err = b.resolveGeneratedColumns(ctx, tbl.Cols(), nil, mockTablePlan)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	insertPlan.RowPolicyCheck, err = b.buildRowPolicyCheck(tblInfo)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if err = insertPlan.ResolveIndices(); err != nil {
+		return nil, nil, nil, err
+	}
+	err = insertPlan.buildOnInsertFKTriggers(b.ctx, b.is, dbName)
+	return insertPlan, values, p, err
+}
@@ -39,6 +39,15 @@ var _ base.LogicalPlan = &BaseLogicalPlan{}
 const (
 	// ApplyGenFromXFDeCorrelateRuleFlag is the flag marked for this op apply is intermediary.
 	ApplyGenFromXFDeCorrelateRuleFlag uint64 = 1 << 0
+	// JoinGenFromXFJoinReorderRuleFlag is the flag marked for this op join is generated by join reorder rule, whose
+	// output columns are in a different order from the group it comes from.
+	JoinGenFromXFJoinReorderRuleFlag uint64 = 1 << 1
+	// JoinOrderFixedFlag is the flag marked for this op join is generated by the memo rules with a fixed join order,
+	// like the sides split from a big join group by join reorder rule, which is not explored by join reorder rule again.
+	JoinOrderFixedFlag uint64 = 1 << 2
+	// AggGenFromXFPushAggDownJoinRuleFlag is the flag marked for this op aggregation is the partial aggregation pushed
+	// down by push agg down join rule, which is not pushed down again.
+	AggGenFromXFPushAggDownJoinRuleFlag uint64 = 1 << 3
 )
 
 // BaseLogicalPlan is the common structure that used in logical plan.
@@ -0,0 +1,109 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logicalop
+
+import (
+	"github.com/pingcap/tidb/pkg/expression"
+	"github.com/pingcap/tidb/pkg/kv"
+	"github.com/pingcap/tidb/pkg/planner/core/base"
+	fd "github.com/pingcap/tidb/pkg/planner/funcdep"
+	"github.com/pingcap/tidb/pkg/planner/property"
+	"github.com/pingcap/tidb/pkg/planner/util/optimizetrace"
+	"github.com/pingcap/tidb/pkg/planner/util/utilfuncp"
+	"github.com/pingcap/tidb/pkg/util/plancodec"
+)
+
+// LogicalMemoGroup stands for a group of the cascades memo in the physical optimization phase. The logical plans of
+// the group expressions inside the group are its Alternatives, whose children are the LogicalMemoGroups of their
+// input groups. So the physical optimization works on the memo directly, rather than on every logical plan tree
+// iterated out from the memo, and the best task of each group is only found once for each required property.
+//
+// LogicalMemoGroup has no children, and it's referred by all the group expressions taking the group as input.
+type LogicalMemoGroup struct {
+	LogicalSchemaProducer
+
+	// Alternatives are the equivalent logical plans of the group, the first one comes from the original logical plan.
+	Alternatives []base.LogicalPlan
+
+	possibleProps [][]*expression.Column
+	propsPrepared bool
+}
+
+// Init initializes LogicalMemoGroup.
+func (p LogicalMemoGroup) Init(ctx base.PlanContext, offset int) *LogicalMemoGroup {
+	p.BaseLogicalPlan = NewBaseLogicalPlan(ctx, plancodec.TypeMemoGroup, &p, offset)
+	return &p
+}
+
+// *************************** start implementation of logicalPlan interface ***************************
+
+// FindBestTask implements the base.LogicalPlan.<3rd> interface.
+func (p *LogicalMemoGroup) FindBestTask(prop *property.PhysicalProperty, planCounter *base.PlanCounterTp,
+	opt *optimizetrace.PhysicalOptimizeOp) (base.Task, int64, error) {
+	return utilfuncp.FindBestTask4LogicalMemoGroup(p, prop, planCounter, opt)
+}
+
+// RecursiveDeriveStats implements base.LogicalPlan.<10th> interface.
+func (p *LogicalMemoGroup) RecursiveDeriveStats(colGroups [][]*expression.Column) (*property.StatsInfo, bool, error) {
+	// the group may be referred by several parents, derive the stats of its alternatives only once.
+	if p.StatsInfo() != nil {
+		return p.StatsInfo(), false, nil
+	}
+	for i, alternative := range p.Alternatives {
+		stats, _, err := alternative.RecursiveDeriveStats(colGroups)
+		if err != nil {
+			return nil, false, err
+		}
+		// the alternatives may be estimated slightly differently, take the original logical plan's as the group's.
+		if i == 0 {
+			p.SetStats(stats)
+		}
+	}
+	return p.StatsInfo(), true, nil
+}
+
+// DeriveStats implements base.LogicalPlan.<11th> interface.
+func (p *LogicalMemoGroup) DeriveStats(_ []*property.StatsInfo, _ *expression.Schema, _ []*expression.Schema, _ []bool) (*property.StatsInfo, bool, error) {
+	return p.RecursiveDeriveStats(nil)
+}
+
+// MaxOneRow implements the base.LogicalPlan.<16th> interface.
+func (p *LogicalMemoGroup) MaxOneRow() bool {
+	return p.Alternatives[0].MaxOneRow()
+}
+
+// CanPushToCop implements the base.LogicalPlan.<21st> interface.
+func (p *LogicalMemoGroup) CanPushToCop(storeTp kv.StoreType) bool {
+	return p.Alternatives[0].CanPushToCop(storeTp)
+}
+
+// ExtractFD implements the base.LogicalPlan.<22nd> interface.
+func (p *LogicalMemoGroup) ExtractFD() *fd.FDSet {
+	return p.Alternatives[0].ExtractFD()
+}
+
+// *************************** end implementation of logicalPlan interface ***************************
+
+// PreparePossibleProperties4Alternatives prepares the possible properties of all the alternatives by f, and returns
+// the union of them. It only prepares once, since the group may be referred by several parents.
+func (p *LogicalMemoGroup) PreparePossibleProperties4Alternatives(f func(base.LogicalPlan) [][]*expression.Column) [][]*expression.Column {
+	if !p.propsPrepared {
+		for _, alternative := range p.Alternatives {
+			p.possibleProps = append(p.possibleProps, f(alternative)...)
+		}
+		p.propsPrepared = true
+	}
+	return p.possibleProps
+}
@@ -162,6 +162,10 @@ func CanPushToCopImpl(lp base.LogicalPlan, storeTp kv.StoreType, considerDual bo
 	p := lp.GetBaseLogicalPlan().(*BaseLogicalPlan)
 	ret := true
 	for _, ch := range p.Children() {
+		if group, ok := ch.(*LogicalMemoGroup); ok {
+			// the alternatives of a memo group share the same operators to push down, check the original one.
+			ch = group.Alternatives[0]
+		}
 		switch c := ch.(type) {
 		case *DataSource:
 			validDs := false
@@ -38,6 +38,7 @@ import (
 	"github.com/pingcap/tidb/pkg/parser/auth"
 	"github.com/pingcap/tidb/pkg/parser/mysql"
 	"github.com/pingcap/tidb/pkg/planner/cascades"
+	"github.com/pingcap/tidb/pkg/planner/cascades/memo"
 	"github.com/pingcap/tidb/pkg/planner/core/base"
 	"github.com/pingcap/tidb/pkg/planner/core/operator/logicalop"
 	"github.com/pingcap/tidb/pkg/planner/core/operator/physicalop"
@@ -283,6 +284,13 @@ func doOptimize(ctx context.Context, sctx base.PlanContext, flag uint64, logic b
 }
 
 // CascadesOptimize includes: normalization, cascadesOptimize, and physicalOptimize.
+//
+// The normalization phase applies the rules which always pay the same way as VolcanoOptimize, then the memo explores
+// the alternatives left to the cost model: the predicate pushdown (XFPushSelDown*), the column pruning
+// (XFPruneJoinColumns) and the aggregation pushdown (XFPushAggDownJoin) over the join orders explored by
+// XFJoinReorder, and the apply decorrelation. physicalOptimize implements the alternatives of each memo group by their
+// own findBestTask, and the sort enforcer is added on the memo group, so the best task of a group under a required
+// order is compared with the sort over its best unordered task.
 func CascadesOptimize(ctx context.Context, sctx base.PlanContext, flag uint64, logic base.LogicalPlan) (base.LogicalPlan, base.PhysicalPlan, float64, error) {
 	sessVars := sctx.GetSessionVars()
 	flag = adjustOptimizationFlags(flag, logic)
@@ -303,28 +311,13 @@ func CascadesOptimize(ctx context.Context, sctx base.PlanContext, flag uint64, l
 	if err != nil {
 		return nil, nil, 0, err
 	}
-	var (
-		physical base.PhysicalPlan
-		cost     = math.MaxFloat64
-	)
-	// At current phase, cascades just iterate every logic plan out for feeding physicalOptimize.
-	// TODO: In the near future, physicalOptimize will be refactored as receiving *Group as param directly.
-	cas.GetMemo().NewIterator().Each(func(oneLogic base.LogicalPlan) bool {
-		planCounter := base.PlanCounterTp(sessVars.StmtCtx.StmtHints.ForceNthPlan)
-		if planCounter == 0 {
-			planCounter = -1
-		}
-		tmpPhysical, tmpCost, tmpErr := physicalOptimize(oneLogic, &planCounter)
-		if tmpErr != nil {
-			err = tmpErr
-			return false
-		}
-		if tmpCost < cost {
-			physical = tmpPhysical
-			cost = tmpCost
-		}
-		return true
-	})
+	planCounter := base.PlanCounterTp(sessVars.StmtCtx.StmtHints.ForceNthPlan)
+	if planCounter == 0 {
+		planCounter = -1
+	}
+	// physicalOptimize works on the memo groups directly, the alternatives of each group are implemented by their
+	// own findBestTask with the enforcers, and the best task of each group is memorized for each required property.
+	physical, cost, err := physicalOptimize(buildMemoGroupPlan(cas.GetMemo()), &planCounter)
 	if err != nil {
 		return nil, nil, 0, err
 	}
@@ -339,6 +332,39 @@ func CascadesOptimize(ctx context.Context, sctx base.PlanContext, flag uint64, l
 	return logic, finalPlan, cost, nil
 }
 
+// buildMemoGroupPlan converts the explored memo into a logical plan DAG for physicalOptimize. A group with several
+// group expressions is converted into a LogicalMemoGroup, while a group with only one is converted into the logical
+// plan of the group expression itself, so that the physical optimization can still see through it.
+func buildMemoGroupPlan(mm *memo.Memo) base.LogicalPlan {
+	group2Plan := make(map[memo.GroupID]base.LogicalPlan, mm.GetGroups().Len())
+	var build func(g *memo.Group) base.LogicalPlan
+	build = func(g *memo.Group) base.LogicalPlan {
+		if plan, ok := group2Plan[g.GetGroupID()]; ok {
+			return plan
+		}
+		alternatives := make([]base.LogicalPlan, 0, g.GetLogicalExpressions().Len())
+		g.ForEachGE(func(ge *memo.GroupExpression) bool {
+			children := make([]base.LogicalPlan, 0, len(ge.Inputs))
+			for _, input := range ge.Inputs {
+				children = append(children, build(input))
+			}
+			ge.LogicalPlan.SetChildren(children...)
+			alternatives = append(alternatives, ge.LogicalPlan)
+			return true
+		})
+		plan := alternatives[0]
+		if len(alternatives) > 1 {
+			group := logicalop.LogicalMemoGroup{Alternatives: alternatives}.Init(plan.SCtx(), plan.QueryBlockOffset())
+			group.SetSchema(plan.Schema())
+			group.SetOutputNames(plan.OutputNames())
+			plan = group
+		}
+		group2Plan[g.GetGroupID()] = plan
+		return plan
+	}
+	return build(mm.GetRootGroup())
+}
+
 // VolcanoOptimize includes: logicalOptimize, physicalOptimize
 func VolcanoOptimize(ctx context.Context, sctx base.PlanContext, flag uint64, logic base.LogicalPlan) (base.LogicalPlan, base.PhysicalPlan, float64, error) {
 	sessVars := sctx.GetSessionVars()
@@ -486,7 +512,8 @@ func postOptimize(ctx context.Context, sctx base.PlanContext, plan base.Physical
 }
 
 func generateRuntimeFilter(sctx base.PlanContext, plan base.PhysicalPlan) {
-	if !sctx.GetSessionVars().IsRuntimeFilterEnabled() || sctx.GetSessionVars().InRestrictedSQL {
+	sessVars := sctx.GetSessionVars()
+	if (!sessVars.IsRuntimeFilterEnabled() && !sessVars.EnableCopRuntimeFilter) || sessVars.InRestrictedSQL {
 		return
 	}
 	logutil.BgLogger().Debug("Start runtime filter generator")
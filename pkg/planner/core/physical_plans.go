@@ -63,6 +63,7 @@ var (
 	_ base.PhysicalPlan = &PhysicalTopN{}
 	_ base.PhysicalPlan = &PhysicalMaxOneRow{}
 	_ base.PhysicalPlan = &PhysicalTableDual{}
+	_ base.PhysicalPlan = &PhysicalJSONTable{}
 	_ base.PhysicalPlan = &PhysicalUnionAll{}
 	_ base.PhysicalPlan = &PhysicalSort{}
 	_ base.PhysicalPlan = &NominalSort{}
@@ -1503,6 +1504,29 @@ type PhysicalHashJoin struct {
 	runtimeFilterList []*RuntimeFilter `plan-cache-clone:"must-nil"` // plan with runtime filter is not cached
 }
 
+// SwapBuildSide returns a shallow copy of the inner hash join whose build side and probe side are swapped. The copy
+// shares the ID of the original one, and it's only used by the adaptive join executor to build the executor.
+func (p *PhysicalHashJoin) SwapBuildSide() *PhysicalHashJoin {
+	// the runtime filters are built from the build side, and the probe side waits for them before reading anything.
+	if p.JoinType != logicalop.InnerJoin || p.UseOuterToBuild || len(p.runtimeFilterList) > 0 {
+		return nil
+	}
+	swapped := *p
+	swapped.InnerChildIdx = 1 - p.InnerChildIdx
+	return &swapped
+}
+
+// CopRuntimeFilters returns the runtime filters pushed down into the TiKV readers on the probe side.
+func (p *PhysicalHashJoin) CopRuntimeFilters() []*RuntimeFilter {
+	var rfs []*RuntimeFilter
+	for _, rf := range p.runtimeFilterList {
+		if rf.targetReader != nil {
+			rfs = append(rfs, rf)
+		}
+	}
+	return rfs
+}
+
 // CanUseHashJoinV2 returns true if current join is supported by hash join v2
 func (p *PhysicalHashJoin) CanUseHashJoinV2() bool {
 	return canUseHashJoinV2(p.JoinType, p.LeftJoinKeys, p.IsNullEQ, p.LeftNAJoinKeys)
@@ -1665,6 +1689,10 @@ type PhysicalIndexJoin struct {
 	InnerHashKeys []*expression.Column
 	// EqualConditions stores the equal conditions for logical join's original EqualConditions.
 	EqualConditions []*expression.ScalarFunction `plan-cache-clone:"shallow"`
+	// FallbackInnerPlan reads the whole inner side without the ranges built from the outer rows. It's only set when
+	// `tidb_enable_adaptive_join` is on, the executor uses it to fall back to hash join when there are far more outer
+	// rows than estimated.
+	FallbackInnerPlan base.PhysicalPlan
 }
 
 // Clone implements op.PhysicalPlan interface.
@@ -1690,9 +1718,79 @@ func (p *PhysicalIndexJoin) Clone(newCtx base.PlanContext) (base.PhysicalPlan, e
 	cloned.CompareFilters = p.CompareFilters.cloneForPlanCache()
 	cloned.OuterHashKeys = util.CloneCols(p.OuterHashKeys)
 	cloned.InnerHashKeys = util.CloneCols(p.InnerHashKeys)
+	if p.FallbackInnerPlan != nil {
+		cloned.FallbackInnerPlan, err = p.FallbackInnerPlan.Clone(newCtx)
+		if err != nil {
+			return nil, err
+		}
+	}
 	return cloned, nil
 }
 
+// FallbackHashJoin builds the hash join which reads the inner side by FallbackInnerPlan. The adaptive join executor
+// switches to it when there are far more outer rows than estimated. The returned plan shares the ID of the index join,
+// and it's only used to build the executor, so it returns nil if the index join can't be replaced.
+func (p *PhysicalIndexJoin) FallbackHashJoin() (*PhysicalHashJoin, error) {
+	if p.FallbackInnerPlan == nil || len(p.OuterHashKeys) == 0 {
+		return nil, nil
+	}
+	// The join conditions have been resolved by the schema of the original inner plan.
+	innerSchema, fallbackSchema := p.Children()[p.InnerChildIdx].Schema(), p.FallbackInnerPlan.Schema()
+	if innerSchema.Len() != fallbackSchema.Len() {
+		return nil, nil
+	}
+	for i, col := range innerSchema.Columns {
+		if col.UniqueID != fallbackSchema.Columns[i].UniqueID {
+			return nil, nil
+		}
+	}
+	ctx := p.SCtx()
+	leftKeys := make([]*expression.Column, 0, len(p.OuterHashKeys))
+	rightKeys := make([]*expression.Column, 0, len(p.InnerHashKeys))
+	eqConds := make([]*expression.ScalarFunction, 0, len(p.OuterHashKeys))
+	for i := range p.OuterHashKeys {
+		lKey, rKey := p.OuterHashKeys[i], p.InnerHashKeys[i]
+		if p.InnerChildIdx == 0 {
+			lKey, rKey = rKey, lKey
+		}
+		eqCond, err := expression.NewFunction(ctx.GetExprCtx(), ast.EQ, types.NewFieldType(mysql.TypeTiny), lKey, rKey)
+		if err != nil {
+			return nil, err
+		}
+		sf, ok := eqCond.(*expression.ScalarFunction)
+		if !ok {
+			return nil, nil
+		}
+		leftKeys = append(leftKeys, lKey)
+		rightKeys = append(rightKeys, rKey)
+		eqConds = append(eqConds, sf)
+	}
+	baseJoin := basePhysicalJoin{
+		JoinType:        p.JoinType,
+		LeftConditions:  p.LeftConditions,
+		RightConditions: p.RightConditions,
+		OtherConditions: p.OtherConditions,
+		InnerChildIdx:   p.InnerChildIdx,
+		LeftJoinKeys:    leftKeys,
+		RightJoinKeys:   rightKeys,
+		IsNullEQ:        make([]bool, len(leftKeys)),
+		DefaultValues:   p.DefaultValues,
+	}
+	hashJoin := PhysicalHashJoin{
+		basePhysicalJoin: baseJoin,
+		EqualConditions:  eqConds,
+		Concurrency:      uint(ctx.GetSessionVars().HashJoinConcurrency()),
+	}.Init(ctx, p.StatsInfo(), p.QueryBlockOffset())
+	hashJoin.SetID(p.ID())
+	hashJoin.SetSchema(p.Schema())
+	if p.InnerChildIdx == 0 {
+		hashJoin.SetChildren(p.FallbackInnerPlan, p.Children()[1])
+	} else {
+		hashJoin.SetChildren(p.Children()[0], p.FallbackInnerPlan)
+	}
+	return hashJoin, nil
+}
+
 // MemoryUsage return the memory usage of PhysicalIndexJoin
 func (p *PhysicalIndexJoin) MemoryUsage() (sum int64) {
 	if p == nil {
@@ -1714,6 +1812,9 @@ func (p *PhysicalIndexJoin) MemoryUsage() (sum int64) {
 	for _, col := range p.InnerHashKeys {
 		sum += col.MemoryUsage()
 	}
+	if p.FallbackInnerPlan != nil {
+		sum += p.FallbackInnerPlan.MemoryUsage()
+	}
 	return
 }
 
@@ -2437,6 +2538,10 @@ type PhysicalSelection struct {
 	// True: Used for RuntimeFilter
 	// False: Only for normal conditions
 	// hasRFConditions bool
+
+	// runtimeFilterList is the runtime filters of the root hash join pushed into this selection in the coprocessor,
+	// their predicates are appended to Conditions at runtime.
+	runtimeFilterList []*RuntimeFilter `plan-cache-clone:"must-nil"` // plan with runtime filter is not cached
 }
 
 // Clone implements op.PhysicalPlan interface.
@@ -2449,6 +2554,9 @@ func (p *PhysicalSelection) Clone(newCtx base.PlanContext) (base.PhysicalPlan, e
 	}
 	cloned.BasePhysicalPlan = *base
 	cloned.Conditions = util.CloneExprs(p.Conditions)
+	for _, rf := range p.runtimeFilterList {
+		cloned.runtimeFilterList = append(cloned.runtimeFilterList, rf.Clone())
+	}
 	return cloned, nil
 }
 
@@ -2554,6 +2662,9 @@ func (p *PhysicalWindow) ExtractCorrelatedCols() []*expression.CorrelatedColumn
 		for _, arg := range windowFunc.Args {
 			corCols = append(corCols, expression.ExtractCorColumns(arg)...)
 		}
+		for _, item := range windowFunc.OrderByItems {
+			corCols = append(corCols, expression.ExtractCorColumns(item.Expr)...)
+		}
 	}
 	if p.Frame != nil {
 		if p.Frame.Start != nil {
@@ -2757,6 +2868,27 @@ func (p *PhysicalShowDDLJobs) MemoryUsage() (sum int64) {
 	return p.physicalSchemaProducer.MemoryUsage() + size.SizeOfInt64
 }
 
+// PhysicalJSONTable is the physical operator of JSON_TABLE.
+type PhysicalJSONTable struct {
+	physicalSchemaProducer
+
+	Expr expression.Expression
+	Root *logicalop.JSONTablePath
+}
+
+// MemoryUsage return the memory usage of PhysicalJSONTable
+func (p *PhysicalJSONTable) MemoryUsage() (sum int64) {
+	if p == nil {
+		return
+	}
+
+	sum = p.physicalSchemaProducer.MemoryUsage() + size.SizeOfInterface + size.SizeOfPointer
+	if p.Expr != nil {
+		sum += p.Expr.MemoryUsage()
+	}
+	return
+}
+
 // BuildMergeJoinPlan builds a PhysicalMergeJoin from the given fields. Currently, it is only used for test purpose.
 func BuildMergeJoinPlan(ctx base.PlanContext, joinType logicalop.JoinType, leftKeys, rightKeys []*expression.Column) *PhysicalMergeJoin {
 	baseJoin := basePhysicalJoin{
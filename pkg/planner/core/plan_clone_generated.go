@@ -94,6 +94,9 @@ func (op *PhysicalSelection) CloneForPlanCache(newCtx base.PlanContext) (base.Pl
 	}
 	cloned.BasePhysicalPlan = *basePlan
 	cloned.Conditions = cloneExpressionsForPlanCache(op.Conditions, nil)
+	if op.runtimeFilterList != nil {
+		return nil, false
+	}
 	return cloned, true
 }
 
@@ -327,6 +330,13 @@ func (op *PhysicalIndexJoin) CloneForPlanCache(newCtx base.PlanContext) (base.Pl
 	cloned.CompareFilters = op.CompareFilters.cloneForPlanCache()
 	cloned.OuterHashKeys = cloneColumnsForPlanCache(op.OuterHashKeys, nil)
 	cloned.InnerHashKeys = cloneColumnsForPlanCache(op.InnerHashKeys, nil)
+	if op.FallbackInnerPlan != nil {
+		FallbackInnerPlan, ok := op.FallbackInnerPlan.CloneForPlanCache(newCtx)
+		if !ok {
+			return nil, false
+		}
+		cloned.FallbackInnerPlan = FallbackInnerPlan.(base.PhysicalPlan)
+	}
 	return cloned, true
 }
 
@@ -480,6 +490,15 @@ func (op *Insert) CloneForPlanCache(newCtx base.PlanContext) (base.Plan, bool) {
 	if op.FKCascades != nil {
 		return nil, false
 	}
+	if op.RowPolicyCheck != nil {
+		return nil, false
+	}
+	if op.RowPolicyUpdateUsing != nil {
+		return nil, false
+	}
+	if op.RowPolicyDeleteUsing != nil {
+		return nil, false
+	}
 	return cloned, true
 }
 
@@ -226,6 +226,8 @@ type PlanBuilder struct {
 	windowSpecs  map[string]*ast.WindowSpec
 	inUpdateStmt bool
 	inDeleteStmt bool
+	// rowPolicyTargets are the tables written by the UPDATE, DELETE or MERGE statement, see buildRowPolicyFilter.
+	rowPolicyTargets map[*ast.TableName]struct{}
 	// inStraightJoin represents whether the current "SELECT" statement has
 	// "STRAIGHT_JOIN" option.
 	inStraightJoin bool
@@ -264,6 +266,11 @@ type PlanBuilder struct {
 	// correlatedAggMapper stores columns for correlated aggregates which should be evaluated in outer query.
 	correlatedAggMapper map[*ast.AggregateFuncExpr]*expression.CorrelatedColumn
 
+	// maskedCols stores the columns with masking policies which should be masked
+	// for the current user, maskingExprs are the corresponding masking expressions.
+	maskedCols   *expression.Schema
+	maskingExprs []expression.Expression
+
 	// isForUpdateRead should be true in either of the following situations
 	// 1. use `inside insert`, `update`, `delete` or `select for update` statement
 	// 2. isolation level is RC
@@ -541,6 +548,8 @@ func (b *PlanBuilder) Build(ctx context.Context, node *resolve.NodeW) (base.Plan
 		return b.buildSetOpr(ctx, x)
 	case *ast.UpdateStmt:
 		return b.buildUpdate(ctx, x)
+	case *ast.MergeStmt:
+		return b.buildMerge(ctx, x)
 	case *ast.ShowStmt:
 		return b.buildShow(ctx, x)
 	case *ast.DoStmt:
@@ -556,7 +565,9 @@ func (b *PlanBuilder) Build(ctx context.Context, node *resolve.NodeW) (base.Plan
 		*ast.GrantStmt, *ast.DropUserStmt, *ast.AlterUserStmt, *ast.AlterRangeStmt, *ast.RevokeStmt, *ast.KillStmt, *ast.DropStatsStmt,
 		*ast.GrantRoleStmt, *ast.RevokeRoleStmt, *ast.SetRoleStmt, *ast.SetDefaultRoleStmt, *ast.ShutdownStmt,
 		*ast.RenameUserStmt, *ast.NonTransactionalDMLStmt, *ast.SetSessionStatesStmt, *ast.SetResourceGroupStmt,
-		*ast.ImportIntoActionStmt, *ast.CalibrateResourceStmt, *ast.AddQueryWatchStmt, *ast.DropQueryWatchStmt, *ast.DropProcedureStmt:
+		*ast.ImportIntoActionStmt, *ast.CalibrateResourceStmt, *ast.AddQueryWatchStmt, *ast.DropQueryWatchStmt,
+		*ast.ProcedureInfo, *ast.DropProcedureStmt, *ast.CallStmt,
+		*ast.CreateEventStmt, *ast.AlterEventStmt, *ast.DropEventStmt, *ast.RefreshMaterializedViewStmt:
 		return b.buildSimple(ctx, node.Node.(ast.StmtNode))
 	case ast.DDLNode:
 		return b.buildDDL(ctx, x)
@@ -1772,7 +1783,9 @@ func tryGetPkHandleCol(tblInfo *model.TableInfo, allColSchema *expression.Schema
 	return nil, nil, false
 }
 
-func (b *PlanBuilder) buildPhysicalIndexLookUpReaders(ctx context.Context, dbName ast.CIStr, tbl table.Table, indices []table.Index) ([]base.Plan, []*model.IndexInfo, error) {
+// buildPhysicalIndexLookUpReaders builds the readers of the indices, the readers of the partitioned table are only
+// built for the given partitions if partitionIDs isn't empty.
+func (b *PlanBuilder) buildPhysicalIndexLookUpReaders(ctx context.Context, dbName ast.CIStr, tbl table.Table, indices []table.Index, partitionIDs []int64) ([]base.Plan, []*model.IndexInfo, error) {
 	tblInfo := tbl.Meta()
 	// get index information
 	indexInfos := make([]*model.IndexInfo, 0, len(tblInfo.Indices))
@@ -1820,6 +1833,9 @@ func (b *PlanBuilder) buildPhysicalIndexLookUpReaders(ctx context.Context, dbNam
 		// For partition tables except global index.
 		if pi := tbl.Meta().GetPartitionInfo(); pi != nil && !idxInfo.Global {
 			for _, def := range pi.Definitions {
+				if len(partitionIDs) > 0 && !slices.Contains(partitionIDs, def.ID) {
+					continue
+				}
 				t := tbl.(table.PartitionedTable).GetPartition(def.ID)
 				reader, err := b.buildPhysicalIndexLookUpReader(ctx, dbName, t, idxInfo)
 				if err != nil {
@@ -1874,9 +1890,9 @@ func (b *PlanBuilder) buildAdminCheckTable(ctx context.Context, as *ast.AdminStm
 			return nil, errors.Errorf("index %s state %s isn't public", as.Index, idx.Meta().State)
 		}
 		p.CheckIndex = true
-		readerPlans, indexInfos, err = b.buildPhysicalIndexLookUpReaders(ctx, tblName.Schema, tbl, []table.Index{idx})
+		readerPlans, indexInfos, err = b.buildPhysicalIndexLookUpReaders(ctx, tblName.Schema, tbl, []table.Index{idx}, nil)
 	} else {
-		readerPlans, indexInfos, err = b.buildPhysicalIndexLookUpReaders(ctx, tblName.Schema, tbl, tbl.Indices())
+		readerPlans, indexInfos, err = b.buildPhysicalIndexLookUpReaders(ctx, tblName.Schema, tbl, tbl.Indices(), nil)
 	}
 	if err != nil {
 		return nil, errors.Trace(err)
@@ -1890,6 +1906,83 @@ func (b *PlanBuilder) buildAdminCheckTable(ctx context.Context, as *ast.AdminStm
 	return p, nil
 }
 
+// buildCheckPartitions builds a plan for the "ALTER TABLE ... CHECK PARTITION ..." statement,
+// which checks the consistency of the data and indices of the partitions like "ADMIN CHECK TABLE".
+func (b *PlanBuilder) buildCheckPartitions(ctx context.Context, dbName ast.CIStr, v *ast.AlterTableStmt) (base.Plan, error) {
+	tbl, partitionIDs, err := b.getMaintainedPartitions(ctx, dbName, v)
+	if err != nil {
+		return nil, err
+	}
+	indices := make([]table.Index, 0, len(tbl.Indices()))
+	for _, idx := range tbl.Indices() {
+		// The global indices contain the rows of all the partitions, so they can't be checked against some partitions.
+		if !idx.Meta().Global {
+			indices = append(indices, idx)
+		}
+	}
+	readerPlans, indexInfos, err := b.buildPhysicalIndexLookUpReaders(ctx, dbName, tbl, indices, partitionIDs)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	readers := make([]*PhysicalIndexLookUpReader, 0, len(readerPlans))
+	for _, plan := range readerPlans {
+		readers = append(readers, plan.(*PhysicalIndexLookUpReader))
+	}
+	return &CheckTable{
+		DBName:             dbName.O,
+		Table:              tbl,
+		IndexInfos:         indexInfos,
+		IndexLookUpReaders: readers,
+		PartitionIDs:       partitionIDs,
+	}, nil
+}
+
+// buildOptimizePartitions builds a plan for the "ALTER TABLE ... OPTIMIZE PARTITION ..." statement,
+// which compacts the key ranges of the partitions in TiKV.
+func (b *PlanBuilder) buildOptimizePartitions(ctx context.Context, dbName ast.CIStr, v *ast.AlterTableStmt) (base.Plan, error) {
+	tbl, _, err := b.getMaintainedPartitions(ctx, dbName, v)
+	if err != nil {
+		return nil, err
+	}
+	return &CompactTable{
+		ReplicaKind:    ast.CompactReplicaKindTiKV,
+		TableInfo:      tbl.Meta(),
+		PartitionNames: v.Specs[0].PartitionNames,
+	}, nil
+}
+
+// getMaintainedPartitions returns the table and the IDs of the partitions specified by the partition maintenance
+// statement like "ALTER TABLE ... CHECK PARTITION {partition_names | ALL}".
+func (b *PlanBuilder) getMaintainedPartitions(ctx context.Context, dbName ast.CIStr, v *ast.AlterTableStmt) (table.Table, []int64, error) {
+	tbl, err := b.is.TableByName(ctx, dbName, v.Table.Name)
+	if err != nil {
+		return nil, nil, err
+	}
+	pi := tbl.Meta().GetPartitionInfo()
+	if pi == nil {
+		return nil, nil, errors.Trace(dbterror.ErrPartitionMgmtOnNonpartitioned)
+	}
+	spec := v.Specs[0]
+	if spec.OnAllPartitions {
+		ids := make([]int64, 0, len(pi.Definitions))
+		for _, def := range pi.Definitions {
+			ids = append(ids, def.ID)
+		}
+		return tbl, ids, nil
+	}
+	ids := make([]int64, 0, len(spec.PartitionNames))
+	for _, name := range spec.PartitionNames {
+		id := pi.GetPartitionIDByName(name.L)
+		if id == -1 {
+			return nil, nil, errors.Trace(table.ErrUnknownPartition.GenWithStackByArgs(name.O, tbl.Meta().Name.O))
+		}
+		if !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	return tbl, ids, nil
+}
+
 func (b *PlanBuilder) buildCheckIndexSchema(tn *ast.TableName, indexName string) (*expression.Schema, types.NameSlice, error) {
 	schema := expression.NewSchema()
 	var names types.NameSlice
@@ -3475,6 +3568,8 @@ func (b *PlanBuilder) buildShow(ctx context.Context, show *ast.ShowStmt) (base.P
 	isTempTableLocal := false
 	// It depends on ShowPredicateExtractor now
 	buildPattern := true
+	// patternColumn is the index of the column matched by the LIKE pattern.
+	patternColumn := 0
 
 	switch show.Tp {
 	case ast.ShowDatabases, ast.ShowVariables, ast.ShowTables, ast.ShowColumns, ast.ShowTableStatus, ast.ShowCollation:
@@ -3508,6 +3603,14 @@ func (b *PlanBuilder) buildShow(ctx context.Context, show *ast.ShowStmt) (base.P
 				b.visitInfo = appendVisitInfo(b.visitInfo, mysql.AllPrivMask&(^mysql.CreateTMPTablePriv), show.Table.Schema.L, show.Table.Name.L, "", err)
 			}
 		}
+	case ast.ShowEvents:
+		if p.DBName == "" {
+			return nil, plannererrors.ErrNoDB
+		}
+		dbName := strings.ToLower(p.DBName)
+		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.EventPriv, dbName, "", "", b.procedureAccessErr(dbName))
+		// The pattern of SHOW EVENTS matches the name of events like MySQL.
+		patternColumn = 1
 	case ast.ShowConfig:
 		privErr := plannererrors.ErrSpecificAccessDenied.GenWithStackByArgs("CONFIG")
 		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.ConfigPriv, "", "", "", privErr)
@@ -3583,7 +3686,7 @@ func (b *PlanBuilder) buildShow(ctx context.Context, show *ast.ShowStmt) (base.P
 	// If we have ShowPredicateExtractor, we do not buildSelection with Pattern
 	if show.Pattern != nil && buildPattern {
 		show.Pattern.Expr = &ast.ColumnNameExpr{
-			Name: &ast.ColumnName{Name: p.OutputNames()[0].ColName},
+			Name: &ast.ColumnName{Name: p.OutputNames()[patternColumn].ColName},
 		}
 		np, err = b.buildSelection(ctx, np, show.Pattern, nil)
 		if err != nil {
@@ -3751,17 +3854,96 @@ func (b *PlanBuilder) buildSimple(ctx context.Context, node ast.StmtNode) (base.
 			err := plannererrors.ErrSpecificAccessDenied.GenWithStackByArgs("SUPER or RESOURCE_GROUP_ADMIN or RESOURCE_GROUP_USER")
 			b.visitInfo = appendDynamicVisitInfo(b.visitInfo, []string{"RESOURCE_GROUP_ADMIN", "RESOURCE_GROUP_USER"}, false, err)
 		}
+	case *ast.ProcedureInfo:
+		dbName, err := b.procedureSchemaName(raw.ProcedureName)
+		if err != nil {
+			return nil, err
+		}
+		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.CreateRoutinePriv, dbName, "", "", b.procedureAccessErr(dbName))
 	case *ast.DropProcedureStmt:
-		procName := fmt.Sprintf("%s.%s", ast.NewCIStr(b.ctx.GetSessionVars().CurrentDB).O, raw.ProcedureName.Name.O)
-		err := plannererrors.ErrSpDoesNotExist.GenWithStackByArgs("PROCEDURE", procName)
-		if !raw.IfExists {
+		dbName, err := b.procedureSchemaName(raw.ProcedureName)
+		if err != nil {
+			return nil, err
+		}
+		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.AlterRoutinePriv, dbName, "", "", b.procedureAccessErr(dbName))
+	case *ast.CallStmt:
+		dbName, err := b.procedureSchemaName(&ast.TableName{Schema: raw.Procedure.Schema})
+		if err != nil {
+			return nil, err
+		}
+		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.ExecutePriv, dbName, "", "", b.procedureAccessErr(dbName))
+	case *ast.CreateEventStmt:
+		if err := b.appendEventVisitInfo(raw.EventName, raw.Definer); err != nil {
+			return nil, err
+		}
+	case *ast.AlterEventStmt:
+		if err := b.appendEventVisitInfo(raw.EventName, raw.Definer); err != nil {
+			return nil, err
+		}
+		if raw.NewName != nil {
+			if err := b.appendEventVisitInfo(raw.NewName, nil); err != nil {
+				return nil, err
+			}
+		}
+	case *ast.DropEventStmt:
+		if err := b.appendEventVisitInfo(raw.EventName, nil); err != nil {
 			return nil, err
 		}
-		b.ctx.GetSessionVars().StmtCtx.AppendNote(err)
+	case *ast.RefreshMaterializedViewStmt:
+		tnW := b.resolveCtx.GetTableName(raw.ViewName)
+		if tnW == nil || !tnW.TableInfo.IsMaterializedView() {
+			return nil, infoschema.ErrWrongObject.GenWithStackByArgs(raw.ViewName.Schema.O, raw.ViewName.Name.O, "MATERIALIZED VIEW")
+		}
+		// The rows of the materialized view are replaced by the result of its query.
+		for _, priv := range []mysql.PrivilegeType{mysql.DeletePriv, mysql.InsertPriv} {
+			var authErr error
+			if user := b.ctx.GetSessionVars().User; user != nil {
+				authErr = plannererrors.ErrTableaccessDenied.GenWithStackByArgs(strings.ToUpper(priv.String()), user.AuthUsername,
+					user.AuthHostname, raw.ViewName.Name.L)
+			}
+			b.visitInfo = appendVisitInfo(b.visitInfo, priv, raw.ViewName.Schema.L, raw.ViewName.Name.L, "", authErr)
+		}
 	}
 	return p, nil
 }
 
+// appendEventVisitInfo requires the EVENT privilege on the schema of the event, and the SUPER privilege
+// if the definer of the event is not the current user.
+func (b *PlanBuilder) appendEventVisitInfo(name *ast.TableName, definer *auth.UserIdentity) error {
+	dbName, err := b.procedureSchemaName(name)
+	if err != nil {
+		return err
+	}
+	b.visitInfo = appendVisitInfo(b.visitInfo, mysql.EventPriv, dbName, "", "", b.procedureAccessErr(dbName))
+	user := b.ctx.GetSessionVars().User
+	if definer != nil && !definer.CurrentUser && user != nil &&
+		(definer.Username != user.AuthUsername || definer.Hostname != user.AuthHostname) {
+		err = plannererrors.ErrSpecificAccessDenied.GenWithStackByArgs("SUPER")
+		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.SuperPriv, "", "", "", err)
+	}
+	return nil
+}
+
+// procedureSchemaName returns the lower-case schema of a stored procedure, which defaults to the current database.
+func (b *PlanBuilder) procedureSchemaName(name *ast.TableName) (string, error) {
+	if name.Schema.L != "" {
+		return name.Schema.L, nil
+	}
+	currentDB := b.ctx.GetSessionVars().CurrentDB
+	if currentDB == "" {
+		return "", plannererrors.ErrNoDB
+	}
+	return strings.ToLower(currentDB), nil
+}
+
+func (b *PlanBuilder) procedureAccessErr(dbName string) error {
+	user := b.ctx.GetSessionVars().User
+	if user == nil {
+		return nil
+	}
+	return plannererrors.ErrDBaccessDenied.GenWithStackByArgs(user.AuthUsername, user.AuthHostname, dbName)
+}
+
 func collectVisitInfoFromRevokeStmt(ctx context.Context, sctx base.PlanContext, vi []visitInfo, stmt *ast.RevokeStmt) ([]visitInfo, error) {
 	// To use REVOKE, you must have the GRANT OPTION privilege,
 	// and you must have the privileges that you are granting.
@@ -3985,6 +4167,13 @@ func (b *PlanBuilder) buildInsert(ctx context.Context, insert *ast.InsertStmt) (
 		}
 		return nil, err
 	}
+	if b.isReadOnlyMaterializedView(tableInfo) {
+		stmtName := "INSERT"
+		if insert.IsReplace {
+			stmtName = "REPLACE"
+		}
+		return nil, plannererrors.ErrNonUpdatableTable.GenWithStackByArgs(tableInfo.Name.O, stmtName)
+	}
 	// Build Schema with DBName otherwise ColumnRef with DBName cannot match any Column in Schema.
 	schema, names, err := expression.TableInfo2SchemaAndNames(b.ctx.GetExprCtx(), tn.Schema, tableInfo)
 	if err != nil {
@@ -4078,8 +4267,19 @@ func (b *PlanBuilder) buildInsert(ctx context.Context, insert *ast.InsertStmt) (
 	mockTablePlan.SetSchema(insertPlan.Schema4OnDuplicate)
 	mockTablePlan.SetOutputNames(insertPlan.names4OnDuplicate)
 
+	if len(insert.OnDuplicate) > 0 {
+		// The columns of the duplicate rows are read from the table, they are masked
+		// like the columns of the data source, see buildUpdateLists.
+		if err := b.registerMaskedColumns(tableInfo.Cols(), insertPlan.tableSchema.Columns); err != nil {
+			return nil, err
+		}
+	}
 	onDupColSet, err := insertPlan.resolveOnDuplicate(insert.OnDuplicate, tableInfo, func(node ast.ExprNode) (expression.Expression, error) {
-		return b.rewriteInsertOnDuplicateUpdate(ctx, node, mockTablePlan, insertPlan)
+		expr, err := b.rewriteInsertOnDuplicateUpdate(ctx, node, mockTablePlan, insertPlan)
+		if err != nil {
+			return nil, err
+		}
+		return b.maskColumns(expr)
 	})
 	if err != nil {
 		return nil, err
@@ -4093,6 +4293,23 @@ func (b *PlanBuilder) buildInsert(ctx context.Context, insert *ast.InsertStmt) (
 		return nil, err
 	}
 
+	insertPlan.RowPolicyCheck, err = b.buildRowPolicyCheck(tableInfo)
+	if err != nil {
+		return nil, err
+	}
+	if len(insert.OnDuplicate) > 0 {
+		insertPlan.RowPolicyUpdateUsing, err = b.buildRowPolicyUsing(tableInfo, ast.RowPolicyUpdate)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if insert.IsReplace {
+		insertPlan.RowPolicyDeleteUsing, err = b.buildRowPolicyUsing(tableInfo, ast.RowPolicyDelete)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	err = insertPlan.ResolveIndices()
 	if err != nil {
 		return nil, err
@@ -5162,6 +5379,16 @@ func (b *PlanBuilder) buildDDL(ctx context.Context, node ast.DDLNode) (base.Plan
 				}
 			}
 		}
+		// CHECK and OPTIMIZE PARTITION don't change the schema, so they are executed as normal statements
+		// instead of DDL jobs.
+		if len(v.Specs) == 1 {
+			switch v.Specs[0].Tp {
+			case ast.AlterTableCheckPartitions:
+				return b.buildCheckPartitions(ctx, ast.NewCIStr(dbName), v)
+			case ast.AlterTableOptimizePartition:
+				return b.buildOptimizePartitions(ctx, ast.NewCIStr(dbName), v)
+			}
+		}
 	case *ast.AlterSequenceStmt:
 		if b.ctx.GetSessionVars().User != nil {
 			authErr = plannererrors.ErrTableaccessDenied.GenWithStackByArgs("ALTER", b.ctx.GetSessionVars().User.AuthUsername,
@@ -5278,6 +5505,10 @@ func (b *PlanBuilder) buildDDL(ctx context.Context, node ast.DDLNode) (base.Plan
 			b.visitInfo = appendVisitInfo(b.visitInfo, mysql.SuperPriv, "",
 				"", "", err)
 		}
+	case *ast.CreateMaterializedViewStmt:
+		if err := b.buildCreateMaterializedView(ctx, v); err != nil {
+			return nil, err
+		}
 	case *ast.CreateSequenceStmt:
 		if b.ctx.GetSessionVars().User != nil {
 			authErr = plannererrors.ErrTableaccessDenied.GenWithStackByArgs("CREATE", b.ctx.GetSessionVars().User.AuthUsername,
@@ -5411,6 +5642,47 @@ func (b *PlanBuilder) buildDDL(ctx context.Context, node ast.DDLNode) (base.Plan
 	case *ast.CreateResourceGroupStmt, *ast.DropResourceGroupStmt, *ast.AlterResourceGroupStmt:
 		err := plannererrors.ErrSpecificAccessDenied.GenWithStackByArgs("SUPER or RESOURCE_GROUP_ADMIN")
 		b.visitInfo = appendDynamicVisitInfo(b.visitInfo, []string{"RESOURCE_GROUP_ADMIN"}, false, err)
+	case *ast.CreateMaskingPolicyStmt:
+		if b.ctx.GetSessionVars().User != nil {
+			authErr = plannererrors.ErrTableaccessDenied.GenWithStackByArgs("ALTER", b.ctx.GetSessionVars().User.AuthUsername,
+				b.ctx.GetSessionVars().User.AuthHostname, v.Table.Name.L)
+		}
+		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.AlterPriv, v.Table.Schema.L,
+			v.Table.Name.L, "", authErr)
+	case *ast.DropMaskingPolicyStmt:
+		if b.ctx.GetSessionVars().User != nil {
+			authErr = plannererrors.ErrTableaccessDenied.GenWithStackByArgs("ALTER", b.ctx.GetSessionVars().User.AuthUsername,
+				b.ctx.GetSessionVars().User.AuthHostname, v.Table.Name.L)
+		}
+		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.AlterPriv, v.Table.Schema.L,
+			v.Table.Name.L, "", authErr)
+	case *ast.CreateRowPolicyStmt:
+		if b.ctx.GetSessionVars().User != nil {
+			authErr = plannererrors.ErrTableaccessDenied.GenWithStackByArgs("ALTER", b.ctx.GetSessionVars().User.AuthUsername,
+				b.ctx.GetSessionVars().User.AuthHostname, v.Table.Name.L)
+		}
+		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.AlterPriv, v.Table.Schema.L,
+			v.Table.Name.L, "", authErr)
+	case *ast.DropRowPolicyStmt:
+		if b.ctx.GetSessionVars().User != nil {
+			authErr = plannererrors.ErrTableaccessDenied.GenWithStackByArgs("ALTER", b.ctx.GetSessionVars().User.AuthUsername,
+				b.ctx.GetSessionVars().User.AuthHostname, v.Table.Name.L)
+		}
+		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.AlterPriv, v.Table.Schema.L,
+			v.Table.Name.L, "", authErr)
+	case *ast.CreateTriggerStmt:
+		if b.ctx.GetSessionVars().User != nil {
+			authErr = plannererrors.ErrTableaccessDenied.GenWithStackByArgs("TRIGGER", b.ctx.GetSessionVars().User.AuthUsername,
+				b.ctx.GetSessionVars().User.AuthHostname, v.Table.Name.L)
+		}
+		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.TriggerPriv, v.Table.Schema.L,
+			v.Table.Name.L, "", authErr)
+	case *ast.DropTriggerStmt:
+		dbName, err := b.procedureSchemaName(v.TriggerName)
+		if err != nil {
+			return nil, err
+		}
+		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.TriggerPriv, dbName, "", "", b.procedureAccessErr(dbName))
 	case *ast.OptimizeTableStmt:
 		return nil, dbterror.ErrGeneralUnsupportedDDL.GenWithStack("OPTIMIZE TABLE is not supported")
 	}
@@ -5955,6 +6227,91 @@ func adjustOverlongViewColname(plan base.LogicalPlan) {
 	}
 }
 
+// isReadOnlyMaterializedView checks whether the table is a materialized view which can't be modified by the statement.
+// The rows of a materialized view are only changed by REFRESH MATERIALIZED VIEW, which runs in an internal session.
+func (b *PlanBuilder) isReadOnlyMaterializedView(tblInfo *model.TableInfo) bool {
+	return tblInfo.IsMaterializedView() && !b.ctx.GetSessionVars().InRestrictedSQL
+}
+
+// buildCreateMaterializedView fills the columns of the materialized view by the result of its query.
+func (b *PlanBuilder) buildCreateMaterializedView(ctx context.Context, v *ast.CreateMaterializedViewStmt) error {
+	if err := checkForUserVariables(v.Select); err != nil {
+		return err
+	}
+	// Like CREATE VIEW, the wildcards in the query are expanded, so the query stored in the
+	// materialized view always produces the same columns even if the tables are altered.
+	b.isCreateView = true
+	b.capFlag |= canExpandAST
+	defer func() {
+		b.capFlag &= ^canExpandAST
+		b.isCreateView = false
+	}()
+	if stmt := findStmtAsViewSchema(v.Select); stmt != nil {
+		stmt.AsViewSchema = true
+	}
+
+	nodeW := resolve.NewNodeWWithCtx(v.Select, b.resolveCtx)
+	plan, err := b.Build(ctx, nodeW)
+	if err != nil {
+		return err
+	}
+	schema := plan.Schema()
+	if v.Cols == nil {
+		adjustOverlongViewColname(plan.(base.LogicalPlan))
+		v.Cols = make([]ast.CIStr, len(schema.Columns))
+		for i, name := range plan.OutputNames() {
+			v.Cols[i] = name.ColName
+		}
+	}
+	if len(v.Cols) != schema.Len() {
+		return dbterror.ErrViewWrongList
+	}
+	v.Columns = make([]*ast.ColumnDef, 0, schema.Len())
+	for i, col := range schema.Columns {
+		v.Columns = append(v.Columns, &ast.ColumnDef{
+			Name: &ast.ColumnName{Name: v.Cols[i]},
+			Tp:   materializedViewColumnType(col.RetType),
+		})
+	}
+
+	var authErr error
+	if user := b.ctx.GetSessionVars().User; user != nil {
+		authErr = plannererrors.ErrTableaccessDenied.GenWithStackByArgs("CREATE", user.AuthUsername,
+			user.AuthHostname, v.ViewName.Name.L)
+	}
+	b.visitInfo = appendVisitInfo(b.visitInfo, mysql.CreatePriv, v.ViewName.Schema.L,
+		v.ViewName.Name.L, "", authErr)
+	return nil
+}
+
+// materializedViewColumnType returns the type of the column which stores the result of an expression.
+func materializedViewColumnType(retType *types.FieldType) *types.FieldType {
+	if retType.GetType() == mysql.TypeNull {
+		// Like MySQL, the column of NULL is BINARY(0).
+		tp := types.NewFieldType(mysql.TypeString)
+		tp.SetFlen(0)
+		tp.SetCharset(charset.CharsetBin)
+		tp.SetCollate(charset.CollationBin)
+		tp.AddFlag(mysql.BinaryFlag)
+		return tp
+	}
+	tp := retType.Clone()
+	// Only the flags describing the values are kept, the keys and NOT NULL of the tables don't apply to the result.
+	tp.SetFlag(tp.GetFlag() & (mysql.UnsignedFlag | mysql.BinaryFlag | mysql.ZerofillFlag))
+	if types.IsTypeVarchar(tp.GetType()) {
+		maxLen := 1
+		if cs, err := charset.GetCharsetInfo(tp.GetCharset()); err == nil {
+			maxLen = cs.Maxlen
+		}
+		// The length of the expression may be unknown or too long for VARCHAR, use LONGTEXT instead.
+		if tp.GetFlen() == types.UnspecifiedLength || tp.GetFlen()*maxLen > mysql.MaxFieldVarCharLength {
+			tp.SetType(mysql.TypeLongBlob)
+			tp.SetFlen(types.UnspecifiedLength)
+		}
+	}
+	return tp
+}
+
 // findStmtAsViewSchema finds the first SelectStmt as the schema for the view
 func findStmtAsViewSchema(stmt ast.Node) *ast.SelectStmt {
 	switch x := stmt.(type) {
@@ -1326,9 +1326,14 @@ func tryWhereIn2BatchPointGet(ctx base.PlanContext, selStmt *ast.SelectStmt, res
 	if len(tblName.PartitionNames) > 0 {
 		return nil
 	}
+	// The row policies are handled by the selection, see buildRowPolicyFilter.
+	if len(tbl.RowPolicies) > 0 {
+		return nil
+	}
 
 	for _, col := range tbl.Columns {
-		if col.IsGenerated() || col.State != model.StatePublic {
+		// The masked columns are handled by the projection, see maskColumns.
+		if col.IsGenerated() || col.State != model.StatePublic || col.MaskingPolicy != nil {
 			return nil
 		}
 	}
@@ -1434,6 +1439,10 @@ func tryPointGetPlan(ctx base.PlanContext, selStmt *ast.SelectStmt, resolveCtx *
 		return nil
 	}
 	tbl := tnW.TableInfo
+	// The row policies are handled by the selection, see buildRowPolicyFilter.
+	if len(tbl.RowPolicies) > 0 {
+		return nil
+	}
 
 	var pkColOffset int
 	for i, col := range tbl.Columns {
@@ -1445,6 +1454,10 @@ func tryPointGetPlan(ctx base.PlanContext, selStmt *ast.SelectStmt, resolveCtx *
 		if col.State != model.StatePublic {
 			return nil
 		}
+		// The masked columns are handled by the projection, see maskColumns.
+		if col.MaskingPolicy != nil {
+			return nil
+		}
 		if mysql.HasPriKeyFlag(col.GetFlag()) {
 			pkColOffset = i
 		}
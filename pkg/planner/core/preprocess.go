@@ -25,6 +25,7 @@ import (
 	"github.com/pingcap/tidb/pkg/bindinfo"
 	"github.com/pingcap/tidb/pkg/domain"
 	"github.com/pingcap/tidb/pkg/expression"
+	"github.com/pingcap/tidb/pkg/expression/aggregation"
 	"github.com/pingcap/tidb/pkg/infoschema"
 	"github.com/pingcap/tidb/pkg/kv"
 	"github.com/pingcap/tidb/pkg/meta/autoid"
@@ -287,7 +288,11 @@ func (p *preprocessor) Enter(in ast.Node) (out ast.Node, skipChildren bool) {
 		p.stmtTp = TypeCreate
 		p.flag |= inCreateOrDropTable
 		p.checkCreateViewGrammar(node)
-		p.checkCreateViewWithSelectGrammar(node)
+		p.checkCreateViewWithSelectGrammar(node.Select)
+	case *ast.CreateMaterializedViewStmt:
+		p.stmtTp = TypeCreate
+		p.flag |= inCreateOrDropTable
+		p.checkCreateMaterializedViewGrammar(node)
 	case *ast.DropTableStmt:
 		p.flag |= inCreateOrDropTable
 		p.stmtTp = TypeDrop
@@ -357,6 +362,19 @@ func (p *preprocessor) Enter(in ast.Node) (out ast.Node, skipChildren bool) {
 		// So skip check table name here, otherwise, recover table [table_name] syntax will return
 		// table not exists error. But recover table statement is use to recover the dropped table. So skip children here.
 		return in, true
+	case *ast.ProcedureInfo, *ast.DropProcedureStmt, *ast.CallStmt:
+		// The statements in a stored procedure are checked when they are executed by CALL,
+		// and the tables they refer to don't need to exist when the procedure is created.
+		return in, true
+	case *ast.CreateEventStmt, *ast.AlterEventStmt, *ast.DropEventStmt:
+		// Like stored procedures, the body of an event is checked when it's executed.
+		return in, true
+	case *ast.CreateTriggerStmt:
+		p.stmtTp = TypeCreate
+		// The table of a trigger is in the schema of the trigger by default.
+		if node.Table.Schema.L == "" {
+			node.Table.Schema = node.TriggerName.Schema
+		}
 	case *ast.FlashBackTableStmt:
 		if len(node.NewName) > 0 {
 			p.checkFlashbackTableGrammar(node)
@@ -619,7 +637,7 @@ func (p *preprocessor) Leave(in ast.Node) (out ast.Node, ok bool) {
 		p.flag &= ^inCreateOrDropTable
 		p.checkAutoIncrement(x)
 		p.checkContainDotColumn(x)
-	case *ast.CreateViewStmt:
+	case *ast.CreateViewStmt, *ast.CreateMaterializedViewStmt:
 		p.flag &= ^inCreateOrDropTable
 	case *ast.DropTableStmt, *ast.AlterTableStmt, *ast.RenameTableStmt:
 		p.flag &= ^inCreateOrDropTable
@@ -679,6 +697,10 @@ func (p *preprocessor) Leave(in ast.Node) (out ast.Node, ok bool) {
 		if x.FnName.L == ast.NextVal || x.FnName.L == ast.LastVal || x.FnName.L == ast.SetVal {
 			p.flag &= ^inSequenceFunction
 		}
+
+		if aggFunc := convertExtensionAggFunc(x); aggFunc != nil {
+			return aggFunc, p.err == nil
+		}
 	case *ast.RepairTableStmt:
 		p.flag &= ^inRepairTable
 	case *ast.CreateSequenceStmt:
@@ -717,6 +739,32 @@ func (p *preprocessor) Leave(in ast.Node) (out ast.Node, ok bool) {
 	return in, p.err == nil
 }
 
+// convertExtensionAggFunc converts the call of an aggregate function registered by extensions, which is
+// parsed as a normal function call, to an aggregate function. It returns nil if it's not such a call.
+func convertExtensionAggFunc(x *ast.FuncCallExpr) *ast.AggregateFuncExpr {
+	if x.Schema.L != "" || !aggregation.IsExtensionAggFunc(x.FnName.L) {
+		return nil
+	}
+	return &ast.AggregateFuncExpr{F: x.FnName.L, Args: x.Args}
+}
+
+// extensionAggFuncConverter converts the calls of the aggregate functions registered by extensions
+// for the statements that are not preprocessed, such as the definitions of views.
+type extensionAggFuncConverter struct{}
+
+func (*extensionAggFuncConverter) Enter(in ast.Node) (ast.Node, bool) {
+	return in, false
+}
+
+func (*extensionAggFuncConverter) Leave(in ast.Node) (ast.Node, bool) {
+	if x, ok := in.(*ast.FuncCallExpr); ok {
+		if aggFunc := convertExtensionAggFunc(x); aggFunc != nil {
+			return aggFunc, true
+		}
+	}
+	return in, true
+}
+
 func checkAutoIncrementOp(colDef *ast.ColumnDef, index int) (bool, error) {
 	var hasAutoIncrement bool
 
@@ -1036,8 +1084,23 @@ func (p *preprocessor) checkCreateViewWithSelect(stmt ast.Node) {
 	}
 }
 
-func (p *preprocessor) checkCreateViewWithSelectGrammar(stmt *ast.CreateViewStmt) {
-	switch stmt := stmt.Select.(type) {
+func (p *preprocessor) checkCreateMaterializedViewGrammar(stmt *ast.CreateMaterializedViewStmt) {
+	vName := stmt.ViewName.Name.String()
+	if util.IsInCorrectIdentifierName(vName) {
+		p.err = dbterror.ErrWrongTableName.GenWithStackByArgs(vName)
+		return
+	}
+	for _, col := range stmt.Cols {
+		if util.IsInCorrectIdentifierName(col.String()) {
+			p.err = dbterror.ErrWrongColumnName.GenWithStackByArgs(col)
+			return
+		}
+	}
+	p.checkCreateViewWithSelectGrammar(stmt.Select)
+}
+
+func (p *preprocessor) checkCreateViewWithSelectGrammar(sel ast.StmtNode) {
+	switch stmt := sel.(type) {
 	case *ast.SelectStmt:
 		p.checkCreateViewWithSelect(stmt)
 	case *ast.SetOprStmt:
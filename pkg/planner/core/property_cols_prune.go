@@ -17,11 +17,15 @@ package core
 import (
 	"github.com/pingcap/tidb/pkg/expression"
 	"github.com/pingcap/tidb/pkg/planner/core/base"
+	"github.com/pingcap/tidb/pkg/planner/core/operator/logicalop"
 )
 
 // preparePossibleProperties traverses the plan tree by a post-order method,
 // recursively calls base.LogicalPlan PreparePossibleProperties interface.
 func preparePossibleProperties(lp base.LogicalPlan) [][]*expression.Column {
+	if group, ok := lp.(*logicalop.LogicalMemoGroup); ok {
+		return group.PreparePossibleProperties4Alternatives(preparePossibleProperties)
+	}
 	childrenProperties := make([][][]*expression.Column, 0, len(lp.Children()))
 	for _, child := range lp.Children() {
 		childrenProperties = append(childrenProperties, preparePossibleProperties(child))
@@ -322,6 +322,12 @@ func (p *PhysicalIndexJoin) ResolveIndices() (err error) {
 		}
 		p.OuterHashKeys[i], p.InnerHashKeys[i] = outerKey.(*expression.Column), innerKey.(*expression.Column)
 	}
+	if p.FallbackInnerPlan != nil {
+		err = p.FallbackInnerPlan.ResolveIndices()
+		if err != nil {
+			return err
+		}
+	}
 
 	colsNeedResolving := p.schema.Len()
 	// The last output column of this two join is the generated column to indicate whether the row is matched or not.
@@ -665,6 +671,12 @@ func (p *PhysicalWindow) ResolveIndices() (err error) {
 				return err
 			}
 		}
+		for _, item := range desc.OrderByItems {
+			item.Expr, err = item.Expr.ResolveIndices(p.Children()[0].Schema())
+			if err != nil {
+				return err
+			}
+		}
 	}
 	if p.Frame != nil {
 		for i := range p.Frame.Start.CalcFuncs {
@@ -858,6 +870,35 @@ func (p *Update) ResolveIndices() (err error) {
 	return
 }
 
+// ResolveIndices implements Plan interface.
+func (p *Merge) ResolveIndices() (err error) {
+	err = p.baseSchemaProducer.ResolveIndices()
+	if err != nil {
+		return err
+	}
+	schema := p.SelectPlan.Schema()
+	for _, clause := range p.Clauses {
+		if clause.Condition != nil {
+			clause.Condition, err = clause.Condition.ResolveIndices(schema)
+			if err != nil {
+				return err
+			}
+		}
+		for i, val := range clause.Values {
+			clause.Values[i], err = val.ResolveIndices(schema)
+			if err != nil {
+				return err
+			}
+		}
+		if clause.Update != nil {
+			if err = clause.Update.ResolveIndices(); err != nil {
+				return err
+			}
+		}
+	}
+	return
+}
+
 // ResolveIndices implements Plan interface.
 func (p *PhysicalLock) ResolveIndices() (err error) {
 	err = p.BasePhysicalPlan.ResolveIndices()
@@ -0,0 +1,225 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+
+	"github.com/pingcap/tidb/pkg/expression"
+	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/planner/core/base"
+	"github.com/pingcap/tidb/pkg/planner/core/operator/logicalop"
+	"github.com/pingcap/tidb/pkg/privilege"
+	"github.com/pingcap/tidb/pkg/util/generatedexpr"
+)
+
+// applicableRowPolicies returns the row-level security policies of the table which
+// apply to the command and the current user. The internal SQLs and the users with
+// the BYPASS_RLS privilege are not restricted, ok is false for them.
+func (b *PlanBuilder) applicableRowPolicies(tblInfo *model.TableInfo, cmd ast.RowPolicyCommand) (policies []*model.RowPolicyInfo, ok bool) {
+	if len(tblInfo.RowPolicies) == 0 {
+		return nil, false
+	}
+	sessionVars := b.ctx.GetSessionVars()
+	// The visible rows depend on the current user, the plan built for the user who
+	// bypasses the policies can't be cached either.
+	sessionVars.StmtCtx.SetSkipPlanCache("table has row-level security policies")
+	if sessionVars.InRestrictedSQL {
+		return nil, false
+	}
+	pm := privilege.GetPrivilegeManager(b.ctx)
+	if pm == nil || pm.RequestDynamicVerification(sessionVars.ActiveRoles, "BYPASS_RLS", false) {
+		return nil, false
+	}
+	for _, p := range tblInfo.RowPolicies {
+		if !p.AppliesTo(cmd) {
+			continue
+		}
+		if len(p.Roles) > 0 && !pm.MatchRoles(sessionVars.ActiveRoles, p.Roles) {
+			continue
+		}
+		policies = append(policies, p)
+	}
+	return policies, true
+}
+
+// composeRowPolicies combines the expressions of the policies. The permissive
+// policies are combined with OR, and the result is combined with the restrictive
+// policies with AND. No row is allowed if there is no permissive policy.
+func composeRowPolicies(ctx expression.BuildContext, policies []*model.RowPolicyInfo, build func(*model.RowPolicyInfo) (expression.Expression, error)) (expression.Expression, error) {
+	var permissive, restrictive []expression.Expression
+	for _, p := range policies {
+		expr, err := build(p)
+		if err != nil {
+			return nil, err
+		}
+		if p.Restrictive {
+			restrictive = append(restrictive, expr)
+		} else {
+			permissive = append(permissive, expr)
+		}
+	}
+	if len(permissive) == 0 {
+		return expression.NewZero(), nil
+	}
+	return expression.ComposeCNFCondition(ctx, append(restrictive, expression.ComposeDNFCondition(ctx, permissive...))...), nil
+}
+
+// rowPolicyUsingExpr returns the expression which filters the visible rows,
+// the WITH CHECK expression is used if the policy has no USING expression.
+func rowPolicyUsingExpr(p *model.RowPolicyInfo) string {
+	if p.UsingExpr != "" {
+		return p.UsingExpr
+	}
+	return p.CheckExpr
+}
+
+// rowPolicyCheckExpr returns the expression which checks the written rows,
+// the USING expression is used if the policy has no WITH CHECK expression.
+func rowPolicyCheckExpr(p *model.RowPolicyInfo) string {
+	if p.CheckExpr != "" {
+		return p.CheckExpr
+	}
+	return p.UsingExpr
+}
+
+// collectRowPolicyTargets records the tables in the FROM clause of UPDATE or
+// DELETE which are written by the statement. The UPDATE or DELETE policies apply
+// to them, while the SELECT policies apply to the other tables read by the statement.
+func (b *PlanBuilder) collectRowPolicyTargets(node ast.ResultSetNode, isTarget func(name ast.CIStr, dbName string, tblInfo *model.TableInfo) bool) {
+	switch x := node.(type) {
+	case *ast.Join:
+		b.collectRowPolicyTargets(x.Left, isTarget)
+		if x.Right != nil {
+			b.collectRowPolicyTargets(x.Right, isTarget)
+		}
+	case *ast.TableSource:
+		tn, ok := x.Source.(*ast.TableName)
+		if !ok {
+			return
+		}
+		tnW := b.resolveCtx.GetTableName(tn)
+		if tnW == nil || tnW.DBInfo == nil || tnW.TableInfo == nil {
+			return
+		}
+		name := tn.Name
+		if x.AsName.L != "" {
+			name = x.AsName
+		}
+		if !isTarget(name, tnW.DBInfo.Name.L, tnW.TableInfo) {
+			return
+		}
+		if b.rowPolicyTargets == nil {
+			b.rowPolicyTargets = make(map[*ast.TableName]struct{})
+		}
+		b.rowPolicyTargets[tn] = struct{}{}
+	}
+}
+
+// collectUpdateRowPolicyTargets records the tables whose columns are assigned by the UPDATE statement.
+func (b *PlanBuilder) collectUpdateRowPolicyTargets(update *ast.UpdateStmt) {
+	b.collectRowPolicyTargets(update.TableRefs.TableRefs, func(name ast.CIStr, dbName string, tblInfo *model.TableInfo) bool {
+		if !update.MultipleTable {
+			return true
+		}
+		for _, assign := range update.List {
+			col := assign.Column
+			if col.Table.L == "" {
+				// the unqualified column can only belong to one of the tables.
+				if model.FindColumnInfo(tblInfo.Columns, col.Name.L) != nil {
+					return true
+				}
+				continue
+			}
+			if col.Table.L == name.L && (col.Schema.L == "" || col.Schema.L == dbName) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// collectDeleteRowPolicyTargets records the tables whose rows are deleted by the DELETE statement.
+func (b *PlanBuilder) collectDeleteRowPolicyTargets(del *ast.DeleteStmt) {
+	b.collectRowPolicyTargets(del.TableRefs.TableRefs, func(name ast.CIStr, dbName string, _ *model.TableInfo) bool {
+		if !del.IsMultiTable {
+			return true
+		}
+		for _, tn := range del.Tables.Tables {
+			if tn.Name.L == name.L && (tn.Schema.L == "" || tn.Schema.L == dbName) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// buildRowPolicyFilter adds a selection above the data source to filter out the
+// rows which are invisible to the current user by the row-level security policies.
+func (b *PlanBuilder) buildRowPolicyFilter(ctx context.Context, tn *ast.TableName, tblInfo *model.TableInfo, p base.LogicalPlan) (base.LogicalPlan, error) {
+	cmd := ast.RowPolicySelect
+	if _, ok := b.rowPolicyTargets[tn]; ok {
+		if b.inDeleteStmt {
+			cmd = ast.RowPolicyDelete
+		} else {
+			cmd = ast.RowPolicyUpdate
+		}
+	}
+	policies, ok := b.applicableRowPolicies(tblInfo, cmd)
+	if !ok {
+		return p, nil
+	}
+	cond, err := composeRowPolicies(b.ctx.GetExprCtx(), policies, func(policy *model.RowPolicyInfo) (expression.Expression, error) {
+		node, err := generatedexpr.ParseExpression(rowPolicyUsingExpr(policy))
+		if err != nil {
+			return nil, err
+		}
+		expr, _, err := b.rewrite(ctx, node, p, nil, true)
+		return expr, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	sel := logicalop.LogicalSelection{Conditions: expression.SplitCNFItems(cond)}.Init(b.ctx, b.getSelectOffset())
+	sel.SetChildren(p)
+	return sel, nil
+}
+
+// buildRowPolicyCheck builds the expression to check the rows inserted into the table,
+// it returns nil if the rows are not restricted.
+func (b *PlanBuilder) buildRowPolicyCheck(tblInfo *model.TableInfo) (expression.Expression, error) {
+	return b.buildRowPolicyExpr(tblInfo, ast.RowPolicyInsert, rowPolicyCheckExpr)
+}
+
+// buildRowPolicyUsing builds the expression to check the existing rows of the table which
+// are updated or deleted by the INSERT statement, i.e. the row updated by ON DUPLICATE KEY
+// UPDATE and the row removed by REPLACE. It returns nil if the rows are not restricted.
+func (b *PlanBuilder) buildRowPolicyUsing(tblInfo *model.TableInfo, cmd ast.RowPolicyCommand) (expression.Expression, error) {
+	return b.buildRowPolicyExpr(tblInfo, cmd, rowPolicyUsingExpr)
+}
+
+// buildRowPolicyExpr composes the expressions of the policies which apply to the command,
+// the expressions are evaluated on the rows of the table.
+func (b *PlanBuilder) buildRowPolicyExpr(tblInfo *model.TableInfo, cmd ast.RowPolicyCommand, exprOf func(*model.RowPolicyInfo) string) (expression.Expression, error) {
+	policies, ok := b.applicableRowPolicies(tblInfo, cmd)
+	if !ok {
+		return nil, nil
+	}
+	exprCtx := b.ctx.GetExprCtx()
+	return composeRowPolicies(exprCtx, policies, func(policy *model.RowPolicyInfo) (expression.Expression, error) {
+		return expression.ParseSimpleExpr(exprCtx, exprOf(policy), expression.WithTableInfo("", tblInfo))
+	})
+}
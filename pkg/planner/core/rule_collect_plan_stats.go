@@ -21,14 +21,18 @@ import (
 
 	"github.com/pingcap/failpoint"
 	"github.com/pingcap/tidb/pkg/domain"
+	"github.com/pingcap/tidb/pkg/expression"
 	"github.com/pingcap/tidb/pkg/infoschema"
 	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/parser/ast"
 	"github.com/pingcap/tidb/pkg/planner/core/base"
+	"github.com/pingcap/tidb/pkg/planner/core/operator/logicalop"
 	"github.com/pingcap/tidb/pkg/planner/planctx"
 	"github.com/pingcap/tidb/pkg/planner/util/optimizetrace"
 	"github.com/pingcap/tidb/pkg/sessionctx/vardef"
 	"github.com/pingcap/tidb/pkg/statistics"
 	"github.com/pingcap/tidb/pkg/statistics/asyncload"
+	"github.com/pingcap/tidb/pkg/util/filter"
 	"github.com/pingcap/tidb/pkg/util/intset"
 	"github.com/pingcap/tidb/pkg/util/logutil"
 	"go.uber.org/zap"
@@ -52,6 +56,9 @@ func (c *CollectPredicateColumnsPoint) Optimize(_ context.Context, plan base.Log
 	if len(predicateColumns) > 0 {
 		plan.SCtx().UpdateColStatsUsage(maps.Keys(predicateColumns))
 	}
+	if plan.SCtx().GetSessionVars().EnableExtendedStats {
+		recordPredicateColumnGroups(plan.SCtx(), plan)
+	}
 
 	// Prepare the table metadata to avoid repeatedly fetching from the infoSchema below, and trigger extra sync/async
 	// stats loading for the determinate mode.
@@ -96,6 +103,70 @@ func (c *CollectPredicateColumnsPoint) Optimize(_ context.Context, plan base.Log
 	return plan, planChanged, nil
 }
 
+// recordPredicateColumnGroups records the columns used together in the equal conditions of each DataSource,
+// so that the cardinality extended stats of them can be registered and maintained by analyze automatically.
+func recordPredicateColumnGroups(sctx planctx.PlanContext, plan base.LogicalPlan) {
+	statsHandle := domain.GetDomain(sctx).StatsHandle()
+	if statsHandle == nil {
+		return
+	}
+	var walk func(p base.LogicalPlan)
+	walk = func(p base.LogicalPlan) {
+		for _, child := range p.Children() {
+			walk(child)
+		}
+		ds, ok := p.(*logicalop.DataSource)
+		if !ok || filter.IsSystemSchema(ds.DBName.L) || ds.TableInfo.GetPartitionInfo() != nil ||
+			ds.TableInfo.TempTableType != model.TempTableNone {
+			return
+		}
+		colIDs := make([]int64, 0, len(ds.PushedDownConds))
+		for _, cond := range ds.PushedDownConds {
+			if col := extractEqualConstantColumn(cond); col != nil && col.ID > 0 && col.VirtualExpr == nil {
+				colIDs = append(colIDs, col.ID)
+			}
+		}
+		if len(colIDs) >= 2 {
+			statsHandle.RecordPredicateColumnGroup(ds.TableInfo.ID, colIDs)
+		}
+	}
+	walk(plan)
+}
+
+// extractEqualConstantColumn returns the column if the condition is like `col = constant` or `col in (constants...)`.
+func extractEqualConstantColumn(cond expression.Expression) *expression.Column {
+	sf, ok := cond.(*expression.ScalarFunction)
+	if !ok {
+		return nil
+	}
+	args := sf.GetArgs()
+	switch sf.FuncName.L {
+	case ast.EQ:
+		if col, ok := args[0].(*expression.Column); ok {
+			if _, ok := args[1].(*expression.Constant); ok {
+				return col
+			}
+		}
+		if col, ok := args[1].(*expression.Column); ok {
+			if _, ok := args[0].(*expression.Constant); ok {
+				return col
+			}
+		}
+	case ast.In:
+		col, ok := args[0].(*expression.Column)
+		if !ok {
+			return nil
+		}
+		for _, arg := range args[1:] {
+			if _, ok := arg.(*expression.Constant); !ok {
+				return nil
+			}
+		}
+		return col
+	}
+	return nil
+}
+
 // markAtLeastOneFullStatsLoadForEachTable marks at least one full stats load for each table.
 // It should be called after we use c.predicateCols to update the usage of predicate columns.
 func (*CollectPredicateColumnsPoint) markAtLeastOneFullStatsLoadForEachTable(
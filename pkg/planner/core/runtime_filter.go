@@ -61,6 +61,10 @@ type RuntimeFilterMode = variable.RuntimeFilterMode
 // The reason why it is designed as a list is because:
 // Reserve an interface for subsequent multiple join equivalent expressions corresponding to one rf.
 // (Since IN supports multiple columns, this construction can be more efficient at the execution level)
+//
+// For the hash join executed in TiDB, the runtime filter is built by the join executor, and its target is the TiKV
+// reader on the probe side instead of a table scan. The filter is pushed down into the coprocessor requests of the
+// reader as the predicates of the selection on the scan, see generateCopRuntimeFilter.
 type RuntimeFilter struct {
 	// runtime filter id, unique in one query plan
 	id             int
@@ -71,6 +75,8 @@ type RuntimeFilter struct {
 	// The following properties need to be set after assigning a scan node to RF
 	rfMode     RuntimeFilterMode
 	targetNode *PhysicalTableScan
+	// targetReader is the TiKV reader the filter of a root hash join is pushed into.
+	targetReader base.PhysicalPlan
 	// The plan id will be set when runtime filter clone()
 	// It is only used for runtime filter pb
 	buildNodeID  int
@@ -117,6 +123,46 @@ func (rf *RuntimeFilter) assign(targetNode *PhysicalTableScan, targetExpr *expre
 		zap.String("RuntimeFilter", rf.String()))
 }
 
+func (rf *RuntimeFilter) assignToCopReader(reader base.PhysicalPlan, copSel *PhysicalSelection, targetExpr *expression.Column) {
+	rf.rfMode = variable.RFLocal
+	rf.targetReader = reader
+	rf.targetExprList = append(rf.targetExprList, targetExpr)
+	rf.buildNode.runtimeFilterList = append(rf.buildNode.runtimeFilterList, rf)
+	copSel.runtimeFilterList = append(copSel.runtimeFilterList, rf)
+	logutil.BgLogger().Debug("Assign RF to coprocessor reader",
+		zap.String("RuntimeFilter", rf.String()))
+}
+
+// ID returns the id of the runtime filter, which is unique in one query plan.
+func (rf *RuntimeFilter) ID() int {
+	return rf.id
+}
+
+// Type returns the type of the runtime filter.
+func (rf *RuntimeFilter) Type() RuntimeFilterType {
+	return rf.rfType
+}
+
+// SrcColumn returns the join key of the build side the filter is built from.
+func (rf *RuntimeFilter) SrcColumn() *expression.Column {
+	return rf.srcExprList[0]
+}
+
+// TargetColumn returns the column the filter applies on, for the filter pushed into a TiKV reader, its index is
+// resolved against the schema of the scan in the coprocessor.
+func (rf *RuntimeFilter) TargetColumn() *expression.Column {
+	return rf.targetExprList[0]
+}
+
+// TargetReaderID returns the plan id of the TiKV reader the filter is pushed into, or 0 if the filter is not pushed
+// into a TiKV reader.
+func (rf *RuntimeFilter) TargetReaderID() int {
+	if rf.targetReader == nil {
+		return 0
+	}
+	return rf.targetReader.ID()
+}
+
 // ExplainInfo explain info of runtime filter
 func (rf *RuntimeFilter) ExplainInfo(isBuildNode bool) string {
 	var builder strings.Builder
@@ -147,10 +193,12 @@ func (rf *RuntimeFilter) String() string {
 	builder.WriteString(", ")
 	fmt.Fprintf(&builder, "buildNodeID=%d", rf.buildNode.ID())
 	builder.WriteString(", ")
-	if rf.targetNode == nil {
-		fmt.Fprintf(&builder, "targetNodeID=nil")
-	} else {
+	if rf.targetNode != nil {
 		fmt.Fprintf(&builder, "targetNodeID=%d", rf.targetNode.ID())
+	} else if rf.targetReader != nil {
+		fmt.Fprintf(&builder, "targetReaderID=%d", rf.targetReader.ID())
+	} else {
+		fmt.Fprintf(&builder, "targetNodeID=nil")
 	}
 	builder.WriteString(", ")
 	fmt.Fprintf(&builder, "srcColumn=")
@@ -190,6 +238,7 @@ func (rf *RuntimeFilter) Clone() *RuntimeFilter {
 	} else {
 		cloned.targetNodeID = rf.targetNode.ID()
 	}
+	cloned.targetReader = rf.targetReader
 
 	for _, srcExpr := range rf.srcExprList {
 		cloned.srcExprList = append(cloned.srcExprList, srcExpr.Clone().(*expression.Column))
@@ -22,7 +22,9 @@ import (
 	"github.com/pingcap/tidb/pkg/parser/mysql"
 	"github.com/pingcap/tidb/pkg/planner/core/base"
 	"github.com/pingcap/tidb/pkg/planner/core/operator/logicalop"
+	plannerutil "github.com/pingcap/tidb/pkg/planner/util"
 	"github.com/pingcap/tidb/pkg/sessionctx/variable"
+	"github.com/pingcap/tidb/pkg/types"
 	"github.com/pingcap/tidb/pkg/util"
 	"github.com/pingcap/tidb/pkg/util/logutil"
 	"go.uber.org/zap"
@@ -61,7 +63,11 @@ PhysicalPlanTree:
 func (generator *RuntimeFilterGenerator) GenerateRuntimeFilter(plan base.PhysicalPlan) {
 	switch physicalPlan := plan.(type) {
 	case *PhysicalHashJoin:
-		generator.generateRuntimeFilterInterval(physicalPlan)
+		if physicalPlan.storeTp == kv.TiFlash {
+			generator.generateRuntimeFilterInterval(physicalPlan)
+		} else {
+			generator.generateCopRuntimeFilter(physicalPlan)
+		}
 	case *PhysicalTableScan:
 		generator.assignRuntimeFilter(physicalPlan)
 	case *PhysicalTableReader:
@@ -82,7 +88,7 @@ func (generator *RuntimeFilterGenerator) GenerateRuntimeFilter(plan base.Physica
 
 func (generator *RuntimeFilterGenerator) generateRuntimeFilterInterval(hashJoinPlan *PhysicalHashJoin) {
 	// precondition: the storage type of hash join must be TiFlash
-	if hashJoinPlan.storeTp != kv.TiFlash {
+	if hashJoinPlan.storeTp != kv.TiFlash || !hashJoinPlan.SCtx().GetSessionVars().IsRuntimeFilterEnabled() {
 		return
 	}
 	// check hash join pattern
@@ -106,6 +112,134 @@ func (generator *RuntimeFilterGenerator) generateRuntimeFilterInterval(hashJoinP
 	}
 }
 
+// generateCopRuntimeFilter plans the runtime filters of the hash join executed in TiDB. The filters are built from the
+// join keys of the build side by the join executor, and pushed down into the coprocessor requests of the TiKV reader
+// on the probe side, so the probe rows which can't be joined are filtered out in TiKV instead of being sent to TiDB.
+// For example:
+/*
+PhysicalPlanTree:
+      HashJoin(t1.k1=t2.k1, runtime filter:0[IN] <- t2.k1)
+       /                     \
+ TableReader(t1)          TableReader(t2)
+       |
+ Selection(runtime filter:0[IN] -> t1.k1)
+       |
+ TableFullScan(t1)
+*/
+// The probe side must be the reader itself or the selections on it, so every probe row is read by the reader and no
+// operator like limit can change the rows read. The selection in the coprocessor is added if there isn't one, whose
+// conditions are filled at runtime, and it's removed from the coprocessor requests if no filter is built.
+func (generator *RuntimeFilterGenerator) generateCopRuntimeFilter(hashJoinPlan *PhysicalHashJoin) {
+	if !hashJoinPlan.SCtx().GetSessionVars().EnableCopRuntimeFilter || len(hashJoinPlan.NAEqualConditions) > 0 ||
+		!generator.matchRFJoinType(hashJoinPlan) {
+		return
+	}
+	rightIsBuildSide := hashJoinPlan.RightIsBuildSide()
+	probeSide := hashJoinPlan.Children()[0]
+	if !rightIsBuildSide {
+		probeSide = hashJoinPlan.Children()[1]
+	}
+	for {
+		sel, ok := probeSide.(*PhysicalSelection)
+		if !ok {
+			break
+		}
+		probeSide = sel.Children()[0]
+	}
+	scan, copSel := copRuntimeFilterTarget(probeSide)
+	if scan == nil {
+		return
+	}
+	sctx := hashJoinPlan.SCtx()
+	ectx := sctx.GetExprCtx().GetEvalCtx()
+	for _, eqPredicate := range hashJoinPlan.EqualConditions {
+		if !generator.matchEQPredicate(ectx, eqPredicate, rightIsBuildSide) {
+			continue
+		}
+		srcColumn, probeColumn := eqPredicate.GetArgs()[1].(*expression.Column), eqPredicate.GetArgs()[0].(*expression.Column)
+		if !rightIsBuildSide {
+			srcColumn, probeColumn = probeColumn, srcColumn
+		}
+		idx := scan.Schema().ColumnIndex(probeColumn)
+		if idx < 0 {
+			continue
+		}
+		targetColumn := scan.Schema().Columns[idx].Clone().(*expression.Column)
+		targetColumn.Index = idx
+		if targetColumn.VirtualExpr != nil || !matchCopRFColumnType(srcColumn.GetStaticType(), targetColumn.GetStaticType()) ||
+			!expression.CanExprsPushDown(plannerutil.GetPushDownCtx(sctx), []expression.Expression{targetColumn}, kv.TiKV) {
+			continue
+		}
+		if copSel == nil {
+			copSel = PhysicalSelection{}.Init(sctx, scan.StatsInfo(), scan.QueryBlockOffset())
+			copSel.SetChildren(scan)
+			switch reader := probeSide.(type) {
+			case *PhysicalTableReader:
+				reader.SetChildren(copSel)
+			case *PhysicalIndexReader:
+				reader.indexPlan = copSel
+				reader.IndexPlans = flattenPushDownPlan(copSel)
+			}
+		}
+		newRFList, _ := NewRuntimeFilter(generator.rfIDGenerator, eqPredicate, hashJoinPlan)
+		for _, rf := range newRFList {
+			rf.assignToCopReader(probeSide, copSel, targetColumn)
+		}
+	}
+}
+
+// copRuntimeFilterTarget returns the scan and the selection on it in the coprocessor of the TiKV reader, if the reader
+// only scans and filters the rows, the pushed down limit or aggregation changes the rows read by the reader.
+func copRuntimeFilterTarget(p base.PhysicalPlan) (scan base.PhysicalPlan, copSel *PhysicalSelection) {
+	var copPlans []base.PhysicalPlan
+	switch reader := p.(type) {
+	case *PhysicalTableReader:
+		if reader.StoreType != kv.TiKV || reader.ReadReqType != Cop {
+			return nil, nil
+		}
+		copPlans = reader.TablePlans
+	case *PhysicalIndexReader:
+		copPlans = reader.IndexPlans
+	default:
+		return nil, nil
+	}
+	switch len(copPlans) {
+	case 1:
+	case 2:
+		sel, ok := copPlans[1].(*PhysicalSelection)
+		if !ok {
+			return nil, nil
+		}
+		copSel = sel
+	default:
+		return nil, nil
+	}
+	switch copPlans[0].(type) {
+	case *PhysicalTableScan, *PhysicalIndexScan:
+		return copPlans[0], copSel
+	}
+	return nil, nil
+}
+
+// matchCopRFColumnType checks whether the values of the build key can be compared with the probe key as they are, the
+// filter is built from the build key values directly.
+func matchCopRFColumnType(srcType, targetType *types.FieldType) bool {
+	if srcType.EvalType() != targetType.EvalType() {
+		return false
+	}
+	switch srcType.EvalType() {
+	case types.ETInt:
+		return mysql.HasUnsignedFlag(srcType.GetFlag()) == mysql.HasUnsignedFlag(targetType.GetFlag())
+	case types.ETString:
+		return srcType.GetCollate() == targetType.GetCollate()
+	case types.ETDatetime, types.ETTimestamp:
+		return srcType.GetType() == targetType.GetType()
+	case types.ETDecimal, types.ETReal, types.ETDuration:
+		return true
+	}
+	return false
+}
+
 func (generator *RuntimeFilterGenerator) assignRuntimeFilter(physicalTableScan *PhysicalTableScan) {
 	// match rf for current scan node
 	cacheBuildNodeIDToRFMode := map[int]RuntimeFilterMode{}
@@ -16,6 +16,7 @@ package core
 
 import (
 	"fmt"
+	"maps"
 	"math"
 	"slices"
 	"strconv"
@@ -483,6 +484,45 @@ func getGroupNDVs(ds *logicalop.DataSource) []property.GroupNDV {
 		}
 		return false
 	})
+	return appendExtendedStatsGroupNDVs(tbl, colGroups, ndvs)
+}
+
+// appendExtendedStatsGroupNDVs appends the NDVs of the column groups that exactly match the
+// cardinality extended statistics, unless the column group has been matched by an index.
+func appendExtendedStatsGroupNDVs(tbl *statistics.HistColl, colGroups [][]*expression.Column, ndvs []property.GroupNDV) []property.GroupNDV {
+	if tbl.ExtendedStats == nil {
+		return ndvs
+	}
+	names := slices.Sorted(maps.Keys(tbl.ExtendedStats.Stats))
+	for _, name := range names {
+		item := tbl.ExtendedStats.Stats[name]
+		if item.Tp != ast.StatsTypeCardinality || item.ScalarVals <= 0 {
+			continue
+		}
+		cols, ok := tbl.ExtendedStatsUniqueIDs(item)
+		if !ok {
+			continue
+		}
+		slices.Sort(cols)
+		matched := slices.ContainsFunc(ndvs, func(ndv property.GroupNDV) bool {
+			return slices.Equal(ndv.Cols, cols)
+		})
+		if matched {
+			continue
+		}
+		for _, g := range colGroups {
+			// Both slices are sorted according to UniqueID.
+			if slices.EqualFunc(g, cols, func(col *expression.Column, id int64) bool {
+				return col.UniqueID == id
+			}) {
+				ndvs = append(ndvs, property.GroupNDV{
+					Cols: cols,
+					NDV:  item.ScalarVals,
+				})
+				break
+			}
+		}
+	}
 	return ndvs
 }
 
@@ -520,6 +560,8 @@ func initStats(ds *logicalop.DataSource) {
 	}
 	if ds.StatisticTable.Pseudo {
 		tableStats.StatsVersion = statistics.PseudoVersion
+	} else if ds.SCtx().GetSessionVars().EnableExtendedStats {
+		tableStats.HistColl.ExtendedStats = ds.StatisticTable.ExtendedStats
 	}
 
 	statsRecord := ds.SCtx().GetSessionVars().StmtCtx.GetUsedStatsInfo(true)
@@ -2992,4 +2992,7 @@ type IndexJoinInfo struct {
 	KeyOff2IdxOff  []int
 	Ranges         ranger.MutableRanges
 	CompareFilters *ColWithCmpFuncManager
+	// FallbackReader is the reader of the full table scan, which is used by the adaptive join executor to fall
+	// back to hash join. It's only set when `tidb_enable_adaptive_join` is on.
+	FallbackReader base.PhysicalPlan
 }
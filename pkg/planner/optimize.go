@@ -80,6 +80,7 @@ func getPlanFromNonPreparedPlanCache(ctx context.Context, sctx sessionctx.Contex
 		isExplain || // explain external
 		!sctx.GetSessionVars().DisableTxnAutoRetry || // txn-auto-retry
 		sctx.GetSessionVars().InMultiStmts || // in multi-stmt
+		sctx.GetSessionVars().ProcedureCtx != nil || // in stored procedure, procedure variables are folded into plans
 		(stmtCtx.InExplainStmt && stmtCtx.ExplainFormat != types.ExplainFormatPlanCache) { // in explain internal
 		return nil, nil, false, nil
 	}
@@ -420,6 +421,40 @@ func OptimizeForForeignKeyCascade(ctx context.Context, sctx planctx.PlanContext,
 	return p, nil
 }
 
+// OptimizeForTrigger does optimization and creates a Plan for a statement in the body of a trigger.
+// The statement is executed as a part of the statement activating the trigger, so like
+// OptimizeForForeignKeyCascade, the plan IDs aren't reset and the bindings aren't used.
+// The privileges of the statement are checked against the current user, which is the definer of the trigger while
+// the trigger is executing.
+func OptimizeForTrigger(ctx context.Context, sctx planctx.PlanContext, node *resolve.NodeW, is infoschema.InfoSchema) (base.Plan, types.NameSlice, error) {
+	hintProcessor := hint.NewQBHintHandler(sctx.GetSessionVars().StmtCtx)
+	node.Node.Accept(hintProcessor)
+	builder := planBuilderPool.Get().(*core.PlanBuilder)
+	defer planBuilderPool.Put(builder.ResetForReuse())
+	builder.Init(sctx, is, hintProcessor)
+	p, err := builder.Build(ctx, node)
+	if err != nil {
+		return nil, nil, err
+	}
+	if pm := privilege.GetPrivilegeManager(sctx); pm != nil {
+		visitInfo := core.VisitInfo4PrivCheck(ctx, is, node.Node, builder.GetVisitInfo())
+		if err := core.CheckPrivilege(sctx.GetSessionVars().ActiveRoles, pm, visitInfo); err != nil {
+			return nil, nil, err
+		}
+	}
+	if err := core.CheckTableLock(sctx, is, builder.GetVisitInfo()); err != nil {
+		return nil, nil, err
+	}
+	names := p.OutputNames()
+	logic, isLogicalPlan := p.(base.LogicalPlan)
+	if !isLogicalPlan {
+		return p, names, nil
+	}
+	core.RecheckCTE(logic)
+	finalPlan, _, err := core.DoOptimize(ctx, sctx, builder.GetOptFlag(), logic)
+	return finalPlan, names, err
+}
+
 func allowInReadOnlyMode(sctx planctx.PlanContext, node ast.Node) (bool, error) {
 	pm := privilege.GetPrivilegeManager(sctx)
 	if pm == nil {
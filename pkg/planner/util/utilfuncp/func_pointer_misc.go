@@ -52,6 +52,10 @@ var FindBestTask4LogicalMemTable func(lp base.LogicalPlan, prop *property.Physic
 var FindBestTask4LogicalShow func(lp base.LogicalPlan, prop *property.PhysicalProperty, planCounter *base.PlanCounterTp,
 	_ *optimizetrace.PhysicalOptimizeOp) (base.Task, int64, error)
 
+// FindBestTask4LogicalJSONTable will be called by LogicalJSONTable in logicalOp pkg.
+var FindBestTask4LogicalJSONTable func(lp base.LogicalPlan, prop *property.PhysicalProperty, planCounter *base.PlanCounterTp,
+	_ *optimizetrace.PhysicalOptimizeOp) (base.Task, int64, error)
+
 // FindBestTask4LogicalShowDDLJobs will be called by LogicalShowDDLJobs in logicalOp pkg.
 var FindBestTask4LogicalShowDDLJobs func(lp base.LogicalPlan, prop *property.PhysicalProperty,
 	planCounter *base.PlanCounterTp, _ *optimizetrace.PhysicalOptimizeOp) (base.Task, int64, error)
@@ -68,6 +72,10 @@ var FindBestTask4LogicalTableDual func(lp base.LogicalPlan, prop *property.Physi
 var FindBestTask4LogicalDataSource func(lp base.LogicalPlan, prop *property.PhysicalProperty,
 	planCounter *base.PlanCounterTp, opt *optimizetrace.PhysicalOptimizeOp) (t base.Task, cntPlan int64, err error)
 
+// FindBestTask4LogicalMemoGroup will be called by LogicalMemoGroup in logicalOp pkg.
+var FindBestTask4LogicalMemoGroup func(lp base.LogicalPlan, prop *property.PhysicalProperty,
+	planCounter *base.PlanCounterTp, opt *optimizetrace.PhysicalOptimizeOp) (t base.Task, cntPlan int64, err error)
+
 // ExhaustPhysicalPlans4LogicalSequence will be called by LogicalSequence in logicalOp pkg.
 var ExhaustPhysicalPlans4LogicalSequence func(lp base.LogicalPlan, prop *property.PhysicalProperty) (
 	[]base.PhysicalPlan, bool, error)
@@ -111,6 +111,9 @@ type Manager interface {
 	// GetAllRoles return all roles of user.
 	GetAllRoles(user, host string) []*auth.RoleIdentity
 
+	// MatchRoles returns true if the current user or one of its effective roles is in roles.
+	MatchRoles(activeRoles []*auth.RoleIdentity, roles []*auth.RoleIdentity) bool
+
 	// IsDynamicPrivilege returns if a privilege is in the list of privileges.
 	IsDynamicPrivilege(privNameInUpper string) bool
 
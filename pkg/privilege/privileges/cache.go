@@ -107,6 +107,8 @@ type MetadataInfo struct {
 type UserAttributesInfo struct {
 	MetadataInfo
 	PasswordLocking
+	// Restrictions are the partial revokes of the global privileges.
+	Restrictions Restrictions
 }
 
 // UserRecord is used to represent a user record in privilege cache.
@@ -1043,6 +1045,9 @@ func (p *MySQLPrivilege) decodeUserTableRow(userList map[string]struct{}) func(c
 				value.FailedLoginCount = passwordLocking.FailedLoginCount
 				value.AutoLockedLastChanged = passwordLocking.AutoLockedLastChanged
 				value.AutoAccountLocked = passwordLocking.AutoAccountLocked
+				if err := value.Restrictions.ParseJSON(bj); err != nil {
+					return err
+				}
 			case f.ColumnAsName.L == "password_expired":
 				if row.GetEnum(i).String() == "Y" {
 					value.PasswordExpired = true
@@ -1590,7 +1595,7 @@ func (p *MySQLPrivilege) RequestVerification(activeRoles []*auth.RoleIdentity, u
 	for _, r := range roleList {
 		userRecord := p.matchUser(r.Username, r.Hostname)
 		if userRecord != nil {
-			userPriv |= userRecord.Privileges
+			userPriv |= userRecord.Privileges &^ userRecord.Restrictions.Get(db)
 		}
 	}
 	if userPriv&priv > 0 {
@@ -1637,11 +1642,12 @@ func (p *MySQLPrivilege) RequestVerification(activeRoles []*auth.RoleIdentity, u
 // DBIsVisible checks whether the user can see the db.
 func (p *MySQLPrivilege) DBIsVisible(user, host, db string) bool {
 	if record := p.matchUser(user, host); record != nil {
-		if record.Privileges&globalDBVisible > 0 {
+		privs := record.Privileges &^ record.Restrictions.Get(db)
+		if privs&globalDBVisible > 0 {
 			return true
 		}
 		// For metrics_schema, `PROCESS` can also work.
-		if record.Privileges&mysql.ProcessPriv > 0 && strings.EqualFold(db, util.MetricSchemaName.O) {
+		if privs&mysql.ProcessPriv > 0 && strings.EqualFold(db, util.MetricSchemaName.O) {
 			return true
 		}
 	}
@@ -1691,6 +1697,7 @@ func (p *MySQLPrivilege) showGrants(ctx sessionctx.Context, user, host string, r
 	allRoles := p.FindAllUserEffectiveRoles(user, host, roles)
 	// Show global grants.
 	var currentPriv mysql.PrivilegeType
+	var restrictions Restrictions
 	var userExists = false
 	// Check whether user exists.
 	if userList, ok := p.user.Get(itemUser{username: user}); ok {
@@ -1699,6 +1706,7 @@ func (p *MySQLPrivilege) showGrants(ctx sessionctx.Context, user, host string, r
 				userExists = true
 				hasGlobalGrant = true
 				currentPriv |= record.Privileges
+				restrictions = record.Restrictions
 				break
 			}
 		}
@@ -1908,6 +1916,15 @@ func (p *MySQLPrivilege) showGrants(ctx sessionctx.Context, user, host string, r
 		s := fmt.Sprintf("GRANT %s ON *.* TO '%s'@'%s' WITH GRANT OPTION", strings.Join(grantableDynamicPrivs, ","), user, host)
 		gs = append(gs, s)
 	}
+
+	// Show partial revokes of the global privileges.
+	sortFromIdx = len(gs)
+	for _, restriction := range restrictions {
+		dbName := stringutil.Escape(restriction.Database, sqlMode)
+		s := fmt.Sprintf(`REVOKE %s ON %s.* FROM '%s'@'%s'`, restrictionPrivToString(restriction.Privileges), dbName, user, host)
+		gs = append(gs, s)
+	}
+	slices.Sort(gs[sortFromIdx:])
 	return gs
 }
 
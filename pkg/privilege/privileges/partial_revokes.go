@@ -0,0 +1,164 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package privileges
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/types"
+)
+
+// restrictablePrivs are the global privileges which can be revoked on a database
+// when partial_revokes is ON.
+var (
+	restrictablePrivs    = append(slices.Clone(mysql.AllDBPrivs), mysql.GrantPriv)
+	restrictablePrivMask = computePrivMask(restrictablePrivs)
+)
+
+// Restriction is an element of User_attributes->>"$.Restrictions".
+// It records the global privileges which are revoked on a database.
+type Restriction struct {
+	Database   string
+	Privileges mysql.PrivilegeType
+}
+
+// Restrictions is the User_attributes->>"$.Restrictions".
+// It has the same format as MySQL, for example:
+// {"Restrictions": [{"Database": "db1", "Privileges": ["SELECT", "INSERT"]}]}
+type Restrictions []Restriction
+
+type restrictionJSON struct {
+	Database   string   `json:"Database"`
+	Privileges []string `json:"Privileges"`
+}
+
+// ParseJSON parses the restrictions from the User_attributes.
+func (r *Restrictions) ParseJSON(userAttributes types.BinaryJSON) error {
+	*r = nil
+	pathExpr, err := types.ParseJSONPathExpr("$.Restrictions")
+	if err != nil {
+		return err
+	}
+	restrictionsBJ, found := userAttributes.Extract([]types.JSONPathExpression{pathExpr})
+	if !found || restrictionsBJ.TypeCode != types.JSONTypeCodeArray {
+		return nil
+	}
+	data, err := restrictionsBJ.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	var items []restrictionJSON
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	for _, item := range items {
+		var privs mysql.PrivilegeType
+		for _, name := range item.Privileges {
+			priv, ok := restrictionPrivFromName(name)
+			if !ok {
+				return errInvalidPrivilegeType.GenWithStack(name)
+			}
+			privs |= priv
+		}
+		*r = r.Revoke(item.Database, privs)
+	}
+	return nil
+}
+
+// BuildJSON builds the restrictions as a patch of the User_attributes, the
+// restrictions are removed from the User_attributes if there is none.
+func (r Restrictions) BuildJSON() string {
+	items := make([]restrictionJSON, 0, len(r))
+	for _, restriction := range r {
+		item := restrictionJSON{Database: restriction.Database}
+		for _, priv := range restrictablePrivs {
+			if restriction.Privileges&priv > 0 {
+				item.Privileges = append(item.Privileges, strings.ToUpper(mysql.Priv2Str[priv]))
+			}
+		}
+		items = append(items, item)
+	}
+	attributes := map[string]any{"Restrictions": nil}
+	if len(items) > 0 {
+		attributes["Restrictions"] = items
+	}
+	data, err := json.Marshal(attributes)
+	if err != nil {
+		// It's impossible to fail on marshaling strings.
+		panic(err)
+	}
+	return string(data)
+}
+
+// Get returns the privileges revoked on the database.
+func (r Restrictions) Get(db string) mysql.PrivilegeType {
+	if db == "" {
+		return 0
+	}
+	for _, restriction := range r {
+		if strings.EqualFold(restriction.Database, db) {
+			return restriction.Privileges
+		}
+	}
+	return 0
+}
+
+// Revoke returns the restrictions with privs revoked on the database.
+func (r Restrictions) Revoke(db string, privs mysql.PrivilegeType) Restrictions {
+	privs &= restrictablePrivMask
+	if privs == 0 {
+		return r
+	}
+	res := slices.Clone(r)
+	for i := range res {
+		if strings.EqualFold(res[i].Database, db) {
+			res[i].Privileges |= privs
+			return res
+		}
+	}
+	return append(res, Restriction{Database: db, Privileges: privs})
+}
+
+// Grant returns the restrictions with privs granted on the database again.
+// An empty db means the privileges are granted or revoked globally, so they
+// are removed from all the restrictions.
+func (r Restrictions) Grant(db string, privs mysql.PrivilegeType) Restrictions {
+	res := make(Restrictions, 0, len(r))
+	for _, restriction := range r {
+		if db == "" || strings.EqualFold(restriction.Database, db) {
+			restriction.Privileges &^= privs
+		}
+		if restriction.Privileges != 0 {
+			res = append(res, restriction)
+		}
+	}
+	return res
+}
+
+func restrictionPrivFromName(name string) (mysql.PrivilegeType, bool) {
+	for _, priv := range restrictablePrivs {
+		if strings.EqualFold(mysql.Priv2Str[priv], name) {
+			return priv, true
+		}
+	}
+	return 0, false
+}
+
+func restrictionPrivToString(privs mysql.PrivilegeType) string {
+	return PrivToString(privs, restrictablePrivs, mysql.Priv2Str)
+}
@@ -74,6 +74,8 @@ var dynamicPrivs = []string{
 	"RESOURCE_GROUP_USER",             // Can change the resource group of current session.
 	"TRAFFIC_CAPTURE_ADMIN",           // Can capture traffic
 	"TRAFFIC_REPLAY_ADMIN",            // Can replay traffic
+	"UNMASKED",                        // Can see the original values of the columns with masking policies
+	"BYPASS_RLS",                      // Bypass the row-level security policies
 }
 var dynamicPrivLock sync.Mutex
 var defaultTokenLife = 15 * time.Minute
@@ -977,6 +979,28 @@ func (p *UserPrivileges) GetAllRoles(user, host string) []*auth.RoleIdentity {
 	return mysqlPrivilege.getAllRoles(user, host)
 }
 
+// MatchRoles implements the Manager interface.
+func (p *UserPrivileges) MatchRoles(activeRoles []*auth.RoleIdentity, roles []*auth.RoleIdentity) bool {
+	if SkipWithGrant {
+		return true
+	}
+	if p.user == "" && p.host == "" {
+		return true
+	}
+
+	mysqlPriv := p.Handle.Get()
+	candidates := mysqlPriv.FindAllUserEffectiveRoles(p.user, p.host, activeRoles)
+	candidates = append(candidates, &auth.RoleIdentity{Username: p.user, Hostname: p.host})
+	for _, role := range roles {
+		for _, candidate := range candidates {
+			if role.Username == candidate.Username && strings.EqualFold(role.Hostname, candidate.Hostname) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // IsDynamicPrivilege returns true if the DYNAMIC privilege is built-in or has been registered by a plugin
 func (p *UserPrivileges) IsDynamicPrivilege(privName string) bool {
 	privNameInUpper := strings.ToUpper(privName)
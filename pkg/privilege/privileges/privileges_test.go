@@ -2197,3 +2197,64 @@ func TestSQLVariableAccelerateUserCreationUpdate(t *testing.T) {
 	priv = handle.Get()
 	require.True(t, priv.RequestVerification(nil, "bbb", "%", "test", "", "", mysql.SelectPriv))
 }
+
+func TestPartialRevokes(t *testing.T) {
+	store := createStoreAndPrepareDB(t)
+
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("CREATE DATABASE payroll")
+	tk.MustExec("CREATE TABLE payroll.salary (id int)")
+	tk.MustExec("CREATE USER 'ops'@'localhost'")
+	tk.MustExec("GRANT SELECT, INSERT ON *.* TO 'ops'@'localhost'")
+
+	// The global privileges can't be revoked on a database if partial_revokes is OFF.
+	tk.MustQuery("SELECT @@global.partial_revokes").Check(testkit.Rows("0"))
+	tk.MustGetErrMsg("REVOKE SELECT ON payroll.* FROM 'ops'@'localhost'", "There is no such grant defined for user 'ops' on host 'localhost' on database payroll")
+
+	tk.MustExec("SET GLOBAL partial_revokes = ON")
+	defer tk.MustExec("SET GLOBAL partial_revokes = DEFAULT")
+	tk.MustGetErrMsg("REVOKE UPDATE ON payroll.* FROM 'ops'@'localhost'", "There is no such grant defined for user 'ops' on host 'localhost' on database payroll")
+	tk.MustExec("REVOKE SELECT, INSERT ON payroll.* FROM 'ops'@'localhost'")
+	tk.MustQuery("SHOW GRANTS FOR 'ops'@'localhost'").Check(testkit.Rows(
+		"GRANT SELECT,INSERT ON *.* TO 'ops'@'localhost'",
+		"REVOKE SELECT,INSERT ON `payroll`.* FROM 'ops'@'localhost'",
+	))
+	tk.MustQuery("SELECT user_attributes->>'$.Restrictions' FROM mysql.user WHERE user = 'ops'").Check(testkit.Rows(
+		`[{"Database": "payroll", "Privileges": ["SELECT", "INSERT"]}]`,
+	))
+
+	opsTk := testkit.NewTestKit(t, store)
+	require.NoError(t, opsTk.Session().Auth(&auth.UserIdentity{Username: "ops", Hostname: "localhost"}, nil, nil, nil))
+	opsTk.MustQuery("SELECT * FROM test.test").Check(testkit.Rows())
+	err := opsTk.ExecToErr("SELECT * FROM payroll.salary")
+	require.True(t, terror.ErrorEqual(err, plannererrors.ErrTableaccessDenied))
+	err = opsTk.ExecToErr("INSERT INTO payroll.salary VALUES (1)")
+	require.True(t, terror.ErrorEqual(err, plannererrors.ErrTableaccessDenied))
+	opsTk.MustQuery("SHOW DATABASES LIKE 'payroll'").Check(testkit.Rows())
+
+	// Granting the privilege on the database lifts the partial revoke.
+	tk.MustExec("GRANT SELECT ON payroll.* TO 'ops'@'localhost'")
+	tk.MustQuery("SHOW GRANTS FOR 'ops'@'localhost'").Check(testkit.Rows(
+		"GRANT SELECT,INSERT ON *.* TO 'ops'@'localhost'",
+		"GRANT SELECT ON `payroll`.* TO 'ops'@'localhost'",
+		"REVOKE INSERT ON `payroll`.* FROM 'ops'@'localhost'",
+	))
+	opsTk.MustQuery("SELECT * FROM payroll.salary").Check(testkit.Rows())
+	err = opsTk.ExecToErr("INSERT INTO payroll.salary VALUES (1)")
+	require.True(t, terror.ErrorEqual(err, plannererrors.ErrTableaccessDenied))
+
+	// Revoking the privilege globally removes the partial revoke.
+	tk.MustExec("REVOKE INSERT ON *.* FROM 'ops'@'localhost'")
+	tk.MustQuery("SHOW GRANTS FOR 'ops'@'localhost'").Check(testkit.Rows(
+		"GRANT SELECT ON *.* TO 'ops'@'localhost'",
+		"GRANT SELECT ON `payroll`.* TO 'ops'@'localhost'",
+	))
+	tk.MustQuery("SELECT user_attributes->>'$.Restrictions' FROM mysql.user WHERE user = 'ops'").Check(testkit.Rows("<nil>"))
+
+	// The partial revokes are still honored after partial_revokes is turned OFF.
+	tk.MustExec("REVOKE SELECT ON test.* FROM 'ops'@'localhost'")
+	tk.MustExec("SET GLOBAL partial_revokes = OFF")
+	err = opsTk.ExecToErr("SELECT * FROM test.test")
+	require.True(t, terror.ErrorEqual(err, plannererrors.ErrTableaccessDenied))
+	require.False(t, privilege.GetPrivilegeManager(opsTk.Session()).RequestVerification(nil, "test", "test", "", mysql.SelectPriv))
+}
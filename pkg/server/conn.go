@@ -105,6 +105,7 @@ import (
 	"github.com/pingcap/tidb/pkg/util/hack"
 	"github.com/pingcap/tidb/pkg/util/intest"
 	"github.com/pingcap/tidb/pkg/util/logutil"
+	"github.com/pingcap/tidb/pkg/util/sqlexec"
 	tlsutil "github.com/pingcap/tidb/pkg/util/tls"
 	"github.com/pingcap/tidb/pkg/util/topsql"
 	topsqlstate "github.com/pingcap/tidb/pkg/util/topsql/state"
@@ -2034,6 +2035,8 @@ func (cc *clientConn) handleStmt(
 
 	rs, err := cc.ctx.ExecuteStmt(ctx, stmt)
 	reg.End()
+	procedureResultSets := cc.takeProcedureResultSets()
+	defer closeProcedureResultSets(procedureResultSets)
 	// - If rs is not nil, the statement tracker detachment from session tracker
 	//   is done in the `rs.Close` in most cases.
 	// - If the rs is nil and err is not nil, the detachment will be done in
@@ -2085,6 +2088,9 @@ func (cc *clientConn) handleStmt(
 		return false, nil
 	}
 
+	if err := cc.writeProcedureResultSets(ctx, procedureResultSets, false, status); err != nil {
+		return false, err
+	}
 	handled, err := cc.handleFileTransInConn(ctx, status)
 	if handled {
 		if execStmt := cc.ctx.Value(session.ExecStmtVarKey); execStmt != nil {
@@ -2095,6 +2101,37 @@ func (cc *clientConn) handleStmt(
 	return false, err
 }
 
+// takeProcedureResultSets returns the result sets returned by the procedure called by the last statement,
+// they are removed from the session so that they are never sent with the result of another statement.
+func (cc *clientConn) takeProcedureResultSets() []sqlexec.RecordSet {
+	resultSets := cc.ctx.Value(executor.ProcedureResultSetsVarKey)
+	if resultSets == nil {
+		return nil
+	}
+	cc.ctx.SetValue(executor.ProcedureResultSetsVarKey, nil)
+	//nolint:forcetypeassert
+	return resultSets.([]sqlexec.RecordSet)
+}
+
+// closeProcedureResultSets closes the result sets returned by a procedure to release the buffered rows,
+// whether they are written or not.
+func closeProcedureResultSets(resultSets []sqlexec.RecordSet) {
+	for _, rs := range resultSets {
+		terror.Log(rs.Close())
+	}
+}
+
+// writeProcedureResultSets writes the result sets returned by a procedure. Like MySQL, they are followed by
+// the result of CALL, so SERVER_MORE_RESULTS_EXISTS is always set.
+func (cc *clientConn) writeProcedureResultSets(ctx context.Context, resultSets []sqlexec.RecordSet, binary bool, status uint16) error {
+	for _, rs := range resultSets {
+		if _, err := cc.writeResultSet(ctx, resultset.New(rs, nil), binary, status|mysql.ServerMoreResultsExists, 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Preprocess LOAD DATA. Load data from a local file requires reading from the connection.
 // The function pass a builder to build the connection reader to the context,
 // which will be used in LoadDataExec.
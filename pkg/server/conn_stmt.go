@@ -317,6 +317,8 @@ func (cc *clientConn) executePreparedStmtAndWriteResult(ctx context.Context, stm
 	}
 	execStmt.SetText(charset.EncodingUTF8Impl, sql)
 	rs, err := (&cc.ctx).ExecuteStmt(ctx, execStmt)
+	procedureResultSets := cc.takeProcedureResultSets()
+	defer closeProcedureResultSets(procedureResultSets)
 	var lazy bool
 	if rs != nil {
 		defer func() {
@@ -339,6 +341,9 @@ func (cc *clientConn) executePreparedStmtAndWriteResult(ctx context.Context, stm
 		if useCursor {
 			vars.SetStatusFlag(mysql.ServerStatusCursorExists, false)
 		}
+		if err := cc.writeProcedureResultSets(ctx, procedureResultSets, true, cc.ctx.Status()); err != nil {
+			return false, err
+		}
 		return false, cc.writeOK(ctx)
 	}
 	if planCacheStmt, ok := prepStmt.(*plannercore.PlanCacheStmt); ok {
@@ -788,6 +788,41 @@ const (
 		value json NOT NULL,
 		index idx_version_category_type (version, category, type),
 		index idx_table_id (table_id));`
+
+	// CreateRoutinesTable is a table to store the definitions of stored procedures.
+	CreateRoutinesTable = `CREATE TABLE IF NOT EXISTS mysql.routines (
+		route_schema varchar(64) NOT NULL,
+		name varchar(64) NOT NULL,
+		type enum('FUNCTION','PROCEDURE') NOT NULL,
+		definition longtext NOT NULL,
+		parameter_str blob,
+		security_type enum('DEFINER','INVOKER') NOT NULL DEFAULT 'DEFINER',
+		definer varchar(288) NOT NULL,
+		sql_mode varchar(1024) NOT NULL DEFAULT '',
+		character_set_client varchar(32),
+		connection_collation varchar(32),
+		schema_collation varchar(32),
+		created timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		last_altered timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+		comment text,
+		PRIMARY KEY(route_schema, name, type));`
+
+	// CreateEventHistoryTable is a table to store the execution history of events.
+	CreateEventHistoryTable = `CREATE TABLE IF NOT EXISTS mysql.tidb_event_history (
+		event_id varchar(64) PRIMARY KEY,
+		event_schema varchar(64) NOT NULL,
+		event_name varchar(64) NOT NULL,
+		definer varchar(288) NOT NULL,
+		node_id varchar(64) NOT NULL,
+		scheduled_time timestamp NULL DEFAULT NULL,
+		start_time timestamp NOT NULL,
+		end_time timestamp NULL DEFAULT NULL,
+		state enum('running','succeeded','failed') NOT NULL,
+		affected_rows bigint(64) unsigned NOT NULL DEFAULT 0,
+		error_message text,
+		key(event_schema, event_name, start_time),
+		key(start_time)
+	);`
 )
 
 // CreateTimers is a table to store all timers for tidb
@@ -1284,11 +1319,19 @@ const (
 	// version 247
 	// Add last_stats_histograms_version to mysql.stats_meta.
 	version247 = 247
+
+	// version 248
+	// Add mysql.routines to store stored procedures.
+	version248 = 248
+
+	// version 249
+	// Add mysql.tidb_event_history to store the execution history of events.
+	version249 = 249
 )
 
 // currentBootstrapVersion is defined as a variable, so we can modify its value for testing.
 // please make sure this is the largest version
-var currentBootstrapVersion int64 = version247
+var currentBootstrapVersion int64 = version249
 
 // DDL owner key's expired time is ManagerSessionTTL seconds, we should wait the time and give more time to have a chance to finish it.
 var internalSQLTimeout = owner.ManagerSessionTTL + 15
@@ -1471,6 +1514,8 @@ var (
 		upgradeToVer245,
 		upgradeToVer246,
 		upgradeToVer247,
+		upgradeToVer248,
+		upgradeToVer249,
 	}
 )
 
@@ -3477,6 +3522,20 @@ func upgradeToVer247(s sessiontypes.Session, ver int64) {
 	doReentrantDDL(s, "ALTER TABLE mysql.stats_meta ADD COLUMN last_stats_histograms_version bigint unsigned DEFAULT NULL", infoschema.ErrColumnExists)
 }
 
+func upgradeToVer248(s sessiontypes.Session, ver int64) {
+	if ver >= version248 {
+		return
+	}
+	mustExecute(s, CreateRoutinesTable)
+}
+
+func upgradeToVer249(s sessiontypes.Session, ver int64) {
+	if ver >= version249 {
+		return
+	}
+	mustExecute(s, CreateEventHistoryTable)
+}
+
 // initGlobalVariableIfNotExists initialize a global variable with specific val if it does not exist.
 func initGlobalVariableIfNotExists(s sessiontypes.Session, name string, val any) {
 	ctx := kv.WithInternalSourceType(context.Background(), kv.InternalTxnBootstrap)
@@ -3633,6 +3692,10 @@ func doDDLWorks(s sessiontypes.Session) {
 	mustExecute(s, CreateKernelOptionsTable)
 	// create mysql.tidb_workload_values
 	mustExecute(s, CreateTiDBWorkloadValuesTable)
+	// create mysql.routines
+	mustExecute(s, CreateRoutinesTable)
+	// create mysql.tidb_event_history
+	mustExecute(s, CreateEventHistoryTable)
 }
 
 // doBootstrapSQLFile executes SQL commands in a file as the last stage of bootstrap.
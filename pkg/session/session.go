@@ -673,8 +673,8 @@ func (s *session) commitTxnWithTemporaryData(ctx context.Context, txn kv.Transac
 		return txn.Commit(ctx)
 	}
 
-	sessionData := sessVars.TemporaryTableData
 	var (
+		sessionData     variable.TemporaryTableData
 		stage           kv.StagingHandle
 		localTempTables *infoschema.SessionTables
 	)
@@ -697,14 +697,21 @@ func (s *session) commitTxnWithTemporaryData(ctx context.Context, txn kv.Transac
 			continue
 		}
 
-		if tbl.GetMeta().TempTableType != model.TempTableLocal {
+		// The rows of global temporary tables with ON COMMIT DELETE ROWS are discarded.
+		if !tbl.GetMeta().HasSessionTemporaryData() {
 			continue
 		}
-		if _, ok := localTempTables.TableByID(tblID); !ok {
-			continue
+		if tbl.GetMeta().TempTableType == model.TempTableLocal {
+			if _, ok := localTempTables.TableByID(tblID); !ok {
+				continue
+			}
 		}
 
 		if stage == kv.InvalidStagingHandle {
+			var err error
+			if sessionData, err = temptable.EnsureSessionData(s); err != nil {
+				return err
+			}
 			stage = sessionData.Staging()
 		}
 
@@ -3679,6 +3686,9 @@ func bootstrapSessionImpl(ctx context.Context, store kv.Storage, createSessionsI
 		return s
 	}
 	dom.StartTTLJobManager()
+	dom.StartEventScheduler(func() (types.Session, error) {
+		return CreateSession(store)
+	})
 
 	dom.LoadSigningCertLoop(cfg.Security.SessionTokenSigningCert, cfg.Security.SessionTokenSigningKey)
 
@@ -4022,9 +4032,27 @@ func (s *session) PrepareTxnCtx(ctx context.Context) error {
 		txnMode = ast.Pessimistic
 	}
 
-	return sessiontxn.GetTxnManager(s).EnterNewTxn(ctx, &sessiontxn.EnterNewTxnRequest{
+	if err := sessiontxn.GetTxnManager(s).EnterNewTxn(ctx, &sessiontxn.EnterNewTxnRequest{
 		Type:    sessiontxn.EnterNewTxnBeforeStmt,
 		TxnMode: txnMode,
+	}); err != nil {
+		return err
+	}
+	return s.releaseDroppedPreservedTemporaryTables(ctx)
+}
+
+// releaseDroppedPreservedTemporaryTables releases the rows kept by the session for the global temporary tables
+// with ON COMMIT PRESERVE ROWS which are dropped or truncated by other sessions. The latest schema is used
+// because the schema of the transaction may be a stale one.
+func (s *session) releaseDroppedPreservedTemporaryTables(ctx context.Context) error {
+	dom := domain.GetDomain(s)
+	if dom == nil {
+		return nil
+	}
+	is := dom.InfoSchema()
+	return s.sessionVars.ReleaseDroppedPreservedTemporaryTables(func(tblID int64) bool {
+		_, ok := is.TableByID(ctx, tblID)
+		return ok
 	})
 }
 
@@ -442,6 +442,8 @@ type StatementContext struct {
 
 	// InHandleForeignKeyTrigger indicates currently are handling foreign key trigger.
 	InHandleForeignKeyTrigger bool
+	// InHandleTrigger indicates currently are executing the statements of a row trigger.
+	InHandleTrigger bool
 
 	// ForeignKeyTriggerCtx is the contain information for foreign key cascade execution.
 	ForeignKeyTriggerCtx struct {
@@ -865,8 +867,8 @@ type TableEntry struct {
 
 // AddAffectedRows adds affected rows.
 func (sc *StatementContext) AddAffectedRows(rows uint64) {
-	if sc.InHandleForeignKeyTrigger {
-		// For compatibility with MySQL, not add the affected row cause by the foreign key trigger.
+	if sc.InHandleForeignKeyTrigger || sc.InHandleTrigger {
+		// For compatibility with MySQL, not add the affected row cause by the foreign key trigger or row trigger.
 		return
 	}
 	sc.affectedRows.Add(rows)
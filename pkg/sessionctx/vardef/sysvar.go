@@ -322,6 +322,8 @@ const (
 	RandSeed2 = "rand_seed2"
 	// SQLRequirePrimaryKey is the name of `sql_require_primary_key` system variable.
 	SQLRequirePrimaryKey = "sql_require_primary_key"
+	// EventScheduler is the name of 'event_scheduler' system variable, it controls whether the events are executed.
+	EventScheduler = "event_scheduler"
 	// ValidatePasswordEnable turns on/off the validation of password.
 	ValidatePasswordEnable = "validate_password.enable"
 	// ValidatePasswordPolicy specifies the password policy enforced by validate_password.
@@ -1000,6 +1000,18 @@ const (
 	// TiDBOptIndexJoinBuild indicates which way to build index join.
 	TiDBOptIndexJoinBuild = "tidb_opt_index_join_build_v2"
 
+	// TiDBEnableAdaptiveJoin indicates whether the join executors can switch their strategy at runtime
+	// according to the actual row counts.
+	TiDBEnableAdaptiveJoin = "tidb_enable_adaptive_join"
+
+	// TiDBAdaptiveJoinRowThreshold is the number of rows read from a join child before the adaptive join
+	// switches its strategy.
+	TiDBAdaptiveJoinRowThreshold = "tidb_adaptive_join_row_threshold"
+
+	// TiDBOptEnableCardinalityFeedback indicates whether the optimizer learns the cardinality corrections from the
+	// executed plans of the slow or frequently-run statements, and uses them in the estimation.
+	TiDBOptEnableCardinalityFeedback = "tidb_opt_enable_cardinality_feedback"
+
 	// TiDBOptObjective indicates whether the optimizer should be more stable, predictable or more aggressive.
 	// Please see comments of SessionVars.OptObjective for details.
 	TiDBOptObjective = "tidb_opt_objective"
@@ -1153,6 +1165,8 @@ const (
 	PasswordReuseHistory = "password_history"
 	// PasswordReuseTime limit how long passwords can be reused.
 	PasswordReuseTime = "password_reuse_interval"
+	// PartialRevokes indicates whether privileges can be revoked on a database from the global privileges.
+	PartialRevokes = "partial_revokes"
 	// TiDBHistoricalStatsDuration indicates the duration to remain tidb historical stats
 	TiDBHistoricalStatsDuration = "tidb_historical_stats_duration"
 	// TiDBEnableHistoricalStatsForCapture indicates whether use historical stats in plan replayer capture
@@ -1227,6 +1241,9 @@ const (
 	TiDBRuntimeFilterTypeName = "tidb_runtime_filter_type"
 	// TiDBRuntimeFilterModeName the mode of runtime filter, such as "OFF", "LOCAL"
 	TiDBRuntimeFilterModeName = "tidb_runtime_filter_mode"
+	// TiDBEnableCopRuntimeFilter indicates whether the root hash joins push the runtime filters built from their build
+	// side down into the coprocessor requests of the TiKV readers on the probe side.
+	TiDBEnableCopRuntimeFilter = "tidb_enable_cop_runtime_filter"
 	// TiDBSkipMissingPartitionStats controls how to handle missing partition stats when merging partition stats to global stats.
 	// When set to true, skip missing partition stats and continue to merge other partition stats to global stats.
 	// When set to false, give up merging partition stats to global stats.
@@ -1466,6 +1483,8 @@ const (
 	DefTiDBEnableIndexMergeJoin                       = false
 	DefTiDBTrackAggregateMemoryUsage                  = true
 	DefCTEMaxRecursionDepth                           = 1000
+	DefMaxSpRecursionDepth                            = 0
+	DefEventScheduler                                 = false
 	DefTiDBTmpTableMaxSize                            = 64 << 20 // 64MB.
 	DefTiDBEnableLocalTxn                             = false
 	DefTiDBTSOClientBatchMaxWaitTime                  = 0.0 // 0ms
@@ -1598,6 +1617,7 @@ const (
 	DefTiDBTTLRunningTasks                            = -1
 	DefPasswordReuseHistory                           = 0
 	DefPasswordReuseTime                              = 0
+	DefPartialRevokes                                 = false
 	DefMaxUserConnections                             = 0
 	DefTiDBStoreBatchSize                             = 4
 	DefTiDBHistoricalStatsDuration                    = 7 * 24 * time.Hour
@@ -1637,12 +1657,16 @@ const (
 	DefTiDBEnableFastCheckTable                       = true
 	DefRuntimeFilterType                              = "IN"
 	DefRuntimeFilterMode                              = "OFF"
+	DefTiDBEnableCopRuntimeFilter                     = false
 	DefTiDBLockUnchangedKeys                          = true
 	DefTiDBEnableCheckConstraint                      = false
 	DefTiDBSkipMissingPartitionStats                  = true
 	DefTiDBOptEnableHashJoin                          = true
 	DefTiDBHashJoinVersion                            = joinversion.HashJoinVersionOptimized
 	DefTiDBOptIndexJoinBuild                          = true
+	DefTiDBEnableAdaptiveJoin                         = false
+	DefTiDBAdaptiveJoinRowThreshold                   = 100000
+	DefTiDBOptEnableCardinalityFeedback               = false
 	DefTiDBOptObjective                               = OptObjectiveModerate
 	DefTiDBSchemaVersionCacheLimit                    = 16
 	DefTiDBIdleTransactionTimeout                     = 0
@@ -1747,6 +1771,7 @@ var (
 	PasswordValidtaionNumberCount      = atomic.NewInt32(1)
 	PasswordValidationSpecialCharCount = atomic.NewInt32(1)
 	EnableTTLJob                       = atomic.NewBool(DefTiDBTTLJobEnable)
+	EnableEventScheduler               = atomic.NewBool(DefEventScheduler)
 	TTLScanBatchSize                   = atomic.NewInt64(DefTiDBTTLScanBatchSize)
 	TTLDeleteBatchSize                 = atomic.NewInt64(DefTiDBTTLDeleteBatchSize)
 	TTLDeleteRateLimit                 = atomic.NewInt64(DefTiDBTTLDeleteRateLimit)
@@ -1766,6 +1791,7 @@ var (
 	TTLDeleteWorkerCount            = atomic.NewInt32(DefTiDBTTLDeleteWorkerCount)
 	PasswordHistory                 = atomic.NewInt64(DefPasswordReuseHistory)
 	PasswordReuseInterval           = atomic.NewInt64(DefPasswordReuseTime)
+	EnablePartialRevokes            = atomic.NewBool(DefPartialRevokes)
 	IsSandBoxModeEnabled            = atomic.NewBool(false)
 	MaxUserConnectionsValue         = atomic.NewUint32(DefMaxUserConnections)
 	MaxPreparedStmtCountValue       = atomic.NewInt64(DefMaxPreparedStmtCount)
@@ -337,7 +337,6 @@ var noopSysVars = []*SysVar{
 	{Scope: vardef.ScopeGlobal | vardef.ScopeSession, Name: "ndb_force_send", Value: ""},
 	{Scope: vardef.ScopeNone, Name: "skip_show_database", Value: "0"},
 	{Scope: vardef.ScopeGlobal, Name: "log_timestamps", Value: ""},
-	{Scope: vardef.ScopeGlobal, Name: "event_scheduler", Value: vardef.Off},
 	{Scope: vardef.ScopeGlobal | vardef.ScopeSession, Name: "ndb_deferred_constraints", Value: ""},
 	{Scope: vardef.ScopeGlobal, Name: "log_syslog_include_pid", Value: ""},
 	{Scope: vardef.ScopeNone, Name: "innodb_ft_cache_size", Value: "8000000"},
@@ -456,7 +455,6 @@ var noopSysVars = []*SysVar{
 		IsHintUpdatableVerified: true,
 	},
 	{Scope: vardef.ScopeNone, Name: "innodb_read_io_threads", Value: "4"},
-	{Scope: vardef.ScopeGlobal | vardef.ScopeSession, Name: vardef.MaxSpRecursionDepth, Value: "0", Type: vardef.TypeUnsigned, MinValue: 0, MaxValue: 255},
 	{Scope: vardef.ScopeNone, Name: "ignore_builtin_innodb", Value: "0"},
 	{Scope: vardef.ScopeGlobal, Name: "slow_query_log_file", Value: "/usr/local/mysql/data/localhost-slow.log"},
 	{Scope: vardef.ScopeGlobal, Name: "innodb_thread_sleep_delay", Value: "10000"},
@@ -49,6 +49,7 @@ import (
 	"github.com/pingcap/tidb/pkg/sessionctx/sessionstates"
 	"github.com/pingcap/tidb/pkg/sessionctx/stmtctx"
 	"github.com/pingcap/tidb/pkg/sessionctx/vardef"
+	"github.com/pingcap/tidb/pkg/tablecodec"
 	"github.com/pingcap/tidb/pkg/types"
 	"github.com/pingcap/tidb/pkg/util/chunk"
 	"github.com/pingcap/tidb/pkg/util/dbterror/plannererrors"
@@ -583,6 +584,8 @@ type TemporaryTableData interface {
 	DeleteTableKey(tblID int64, k kv.Key) error
 	// SetTableKey sets the entry for k from table
 	SetTableKey(tblID int64, k kv.Key, val []byte) error
+	// ClearTable removes all the entries of table
+	ClearTable(tblID int64) error
 }
 
 // temporaryTableData is used for store temporary table data in session
@@ -623,6 +626,38 @@ func (d *temporaryTableData) SetTableKey(tblID int64, k kv.Key, val []byte) erro
 	return d.MemBuffer.Set(k, val)
 }
 
+// ClearTable removes all the entries of table
+func (d *temporaryTableData) ClearTable(tblID int64) error {
+	tblPrefix := tablecodec.EncodeTablePrefix(tblID)
+	endKey := tablecodec.EncodeTablePrefix(tblID + 1)
+	iter, err := d.MemBuffer.Iter(tblPrefix, endKey)
+	if err != nil {
+		return err
+	}
+	keys := make([]kv.Key, 0, 16)
+	for iter.Valid() {
+		key := iter.Key()
+		if !bytes.HasPrefix(key, tblPrefix) {
+			break
+		}
+		keys = append(keys, key)
+
+		err = iter.Next()
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, key := range keys {
+		if err = d.DeleteTableKey(tblID, key); err != nil {
+			return err
+		}
+	}
+	// The deleted keys are kept as tombstones in the MemBuffer, so the size is reset explicitly.
+	delete(d.tblSize, tblID)
+	return nil
+}
+
 func (d *temporaryTableData) updateTblSize(tblID int64, beforeSize int) {
 	delta := int64(d.MemBuffer.Size() - beforeSize)
 	d.tblSize[tblID] = d.GetTableSize(tblID) + delta
@@ -750,6 +785,15 @@ type HookContext interface {
 	GetStore() kv.Storage
 }
 
+// ProcedureContext is the runtime context of the stored procedure being executed by CALL, or the
+// trigger being executed for a row. The planner uses it to resolve references to procedure
+// parameters, local variables and the NEW and OLD rows of triggers.
+type ProcedureContext interface {
+	// GetVariable returns the current value and type of the procedure variable with the given lower-case name.
+	// The columns of the trigger rows are named like "new.col_name" and "old.col_name".
+	GetVariable(name string) (types.Datum, *types.FieldType, bool)
+}
+
 // SessionVarsProvider provides the session variables.
 type SessionVarsProvider interface {
 	GetSessionVars() *SessionVars
@@ -924,6 +968,9 @@ type SessionVars struct {
 	// InMultiStmts indicates whether the statement is a multi-statement like `update t set a=1; update t set b=2;`.
 	InMultiStmts bool
 
+	// ProcedureCtx is the context of the stored procedure or trigger being executed, it's nil outside of them.
+	ProcedureCtx ProcedureContext
+
 	// AllowWriteRowID variable is currently not recommended to be turned on.
 	AllowWriteRowID bool
 
@@ -1358,6 +1405,17 @@ type SessionVars struct {
 	// UseHashJoinV2 indicates whether to use hash join v2.
 	UseHashJoinV2 bool
 
+	// EnableAdaptiveJoin indicates whether the join executors can switch their strategy at runtime.
+	EnableAdaptiveJoin bool
+
+	// AdaptiveJoinRowThreshold is the number of rows read from a join child before the adaptive join switches
+	// its strategy.
+	AdaptiveJoinRowThreshold int
+
+	// EnableCardinalityFeedback indicates whether to learn the cardinality corrections from the executed plans and
+	// use them in the estimation.
+	EnableCardinalityFeedback bool
+
 	// EnableHistoricalStats indicates whether to enable historical statistics.
 	EnableHistoricalStats bool
 
@@ -1378,6 +1436,9 @@ type SessionVars struct {
 	// see https://dev.mysql.com/doc/refman/8.0/en/server-system-variables.html#sysvar_cte_max_recursion_depth
 	CTEMaxRecursionDepth int
 
+	// MaxSpRecursionDepth indicates the maximum number of times a stored procedure may call itself recursively.
+	MaxSpRecursionDepth int
+
 	// The temporary table size threshold, which is different from MySQL. See https://github.com/pingcap/tidb/issues/28691.
 	TMPTableSize int64
 
@@ -1397,6 +1458,10 @@ type SessionVars struct {
 	// TemporaryTableData stores committed kv values for temporary table for current session.
 	TemporaryTableData TemporaryTableData
 
+	// preservedTemporaryTables stores the global temporary tables with ON COMMIT PRESERVE ROWS
+	// used by the session, their auto IDs are allocated across transactions.
+	preservedTemporaryTables map[int64]tableutil.TempTable
+
 	// MPPStoreFailTTL indicates the duration that protect TiDB from sending task to a new recovered TiFlash.
 	MPPStoreFailTTL string
 
@@ -1676,6 +1741,9 @@ type SessionVars struct {
 	runtimeFilterTypes []RuntimeFilterType
 	// Runtime filter mode: only support OFF, LOCAL now
 	runtimeFilterMode RuntimeFilterMode
+	// EnableCopRuntimeFilter indicates whether the root hash joins push the runtime filters down into the
+	// coprocessor requests of the TiKV readers on the probe side, the filter types are runtimeFilterTypes.
+	EnableCopRuntimeFilter bool
 
 	// Whether to lock duplicate keys in INSERT IGNORE and REPLACE statements,
 	// or unchanged unique keys in UPDATE statements, see PR #42210 and #42713
@@ -2262,6 +2330,7 @@ func NewSessionVars(hctx HookContext) *SessionVars {
 		EnableIndexMergeJoin:          vardef.DefTiDBEnableIndexMergeJoin,
 		AllowFallbackToTiKV:           make(map[kv.StoreType]struct{}),
 		CTEMaxRecursionDepth:          vardef.DefCTEMaxRecursionDepth,
+		MaxSpRecursionDepth:           vardef.DefMaxSpRecursionDepth,
 		TMPTableSize:                  vardef.DefTiDBTmpTableMaxSize,
 		MPPStoreFailTTL:               vardef.DefTiDBMPPStoreFailTTL,
 		Rng:                           mathutil.NewWithTime(),
@@ -2337,6 +2406,10 @@ func NewSessionVars(hctx HookContext) *SessionVars {
 	vars.MemTracker.Killer = &vars.SQLKiller
 	vars.StatsLoadSyncWait.Store(vardef.StatsLoadSyncWait.Load())
 	vars.UseHashJoinV2 = joinversion.IsOptimizedVersion(vardef.DefTiDBHashJoinVersion)
+	vars.EnableAdaptiveJoin = vardef.DefTiDBEnableAdaptiveJoin
+	vars.AdaptiveJoinRowThreshold = vardef.DefTiDBAdaptiveJoinRowThreshold
+	vars.EnableCopRuntimeFilter = vardef.DefTiDBEnableCopRuntimeFilter
+	vars.EnableCardinalityFeedback = vardef.DefTiDBOptEnableCardinalityFeedback
 
 	for _, engine := range config.GetGlobalConfig().IsolationRead.Engines {
 		switch engine {
@@ -2883,7 +2956,7 @@ func (s *SessionVars) GetTemporaryTable(tblInfo *model.TableInfo) tableutil.Temp
 		tempTables := s.TxnCtx.TemporaryTables
 		tempTable, ok := tempTables[tblInfo.ID]
 		if !ok {
-			tempTable = tableutil.TempTableFromMeta(tblInfo)
+			tempTable = s.newTemporaryTableInTxn(tblInfo)
 			tempTables[tblInfo.ID] = tempTable
 		}
 		return tempTable
@@ -2892,6 +2965,55 @@ func (s *SessionVars) GetTemporaryTable(tblInfo *model.TableInfo) tableutil.Temp
 	return nil
 }
 
+func (s *SessionVars) newTemporaryTableInTxn(tblInfo *model.TableInfo) tableutil.TempTable {
+	if tblInfo.TempTableType != model.TempTableGlobal || !tblInfo.OnCommitPreserveRows {
+		return tableutil.TempTableFromMeta(tblInfo)
+	}
+	// The rows of the former transactions are still in the session, so the
+	// auto ID allocator must be kept to avoid duplicated IDs.
+	if s.preservedTemporaryTables == nil {
+		s.preservedTemporaryTables = make(map[int64]tableutil.TempTable)
+	}
+	tempTable, ok := s.preservedTemporaryTables[tblInfo.ID]
+	if !ok {
+		tempTable = tableutil.TempTableFromMeta(tblInfo)
+		s.preservedTemporaryTables[tblInfo.ID] = tempTable
+	}
+	tempTable.SetModified(false)
+	// The size of the rows committed by the former transactions is carried over, so
+	// tidb_tmp_table_max_size limits all the rows of the table kept in the session.
+	var committedSize int64
+	if s.TemporaryTableData != nil {
+		committedSize = s.TemporaryTableData.GetTableSize(tblInfo.ID)
+	}
+	tempTable.SetSize(committedSize)
+	return tempTable
+}
+
+// ReleasePreservedTemporaryTable releases the rows and the auto ID allocator of the global temporary table
+// with ON COMMIT PRESERVE ROWS kept by the session, it's called after the table is dropped or truncated.
+func (s *SessionVars) ReleasePreservedTemporaryTable(tblID int64) error {
+	delete(s.preservedTemporaryTables, tblID)
+	if s.TemporaryTableData == nil {
+		return nil
+	}
+	return s.TemporaryTableData.ClearTable(tblID)
+}
+
+// ReleaseDroppedPreservedTemporaryTables releases the global temporary tables with ON COMMIT PRESERVE ROWS
+// kept by the session which don't exist anymore, i.e. dropped or truncated by other sessions.
+func (s *SessionVars) ReleaseDroppedPreservedTemporaryTables(exists func(tblID int64) bool) error {
+	for tblID := range s.preservedTemporaryTables {
+		if exists(tblID) {
+			continue
+		}
+		if err := s.ReleasePreservedTemporaryTable(tblID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // EncodeSessionStates saves session states into SessionStates.
 func (s *SessionVars) EncodeSessionStates(_ context.Context, sessionStates *sessionstates.SessionStates) (err error) {
 	// Encode user-defined variables.
@@ -1556,6 +1556,12 @@ var defaultSysVars = []*SysVar{
 		vardef.PasswordHistory.Store(TidbOptInt64(val, vardef.DefPasswordReuseHistory))
 		return nil
 	}},
+	{Scope: vardef.ScopeGlobal, Name: vardef.PartialRevokes, Value: BoolToOnOff(vardef.DefPartialRevokes), Type: vardef.TypeBool, GetGlobal: func(_ context.Context, s *SessionVars) (string, error) {
+		return BoolToOnOff(vardef.EnablePartialRevokes.Load()), nil
+	}, SetGlobal: func(_ context.Context, s *SessionVars, val string) error {
+		vardef.EnablePartialRevokes.Store(TiDBOptOn(val))
+		return nil
+	}},
 	{Scope: vardef.ScopeGlobal, Name: vardef.PasswordReuseTime, Value: strconv.Itoa(vardef.DefPasswordReuseTime), Type: vardef.TypeUnsigned, MinValue: 0, MaxValue: math.MaxUint32, GetGlobal: func(_ context.Context, s *SessionVars) (string, error) {
 		return strconv.FormatInt(vardef.PasswordReuseInterval.Load(), 10), nil
 	}, SetGlobal: func(_ context.Context, s *SessionVars, val string) error {
@@ -2480,6 +2486,10 @@ var defaultSysVars = []*SysVar{
 		s.CTEMaxRecursionDepth = TidbOptInt(val, vardef.DefCTEMaxRecursionDepth)
 		return nil
 	}},
+	{Scope: vardef.ScopeGlobal | vardef.ScopeSession, Name: vardef.MaxSpRecursionDepth, Value: strconv.Itoa(vardef.DefMaxSpRecursionDepth), Type: vardef.TypeUnsigned, MinValue: 0, MaxValue: 255, SetSession: func(s *SessionVars, val string) error {
+		s.MaxSpRecursionDepth = TidbOptInt(val, vardef.DefMaxSpRecursionDepth)
+		return nil
+	}},
 	{Scope: vardef.ScopeGlobal | vardef.ScopeSession, Name: vardef.TiDBAllowAutoRandExplicitInsert, Value: BoolToOnOff(vardef.DefTiDBAllowAutoRandExplicitInsert), Type: vardef.TypeBool, SetSession: func(s *SessionVars, val string) error {
 		s.AllowAutoRandExplicitInsert = TiDBOptOn(val)
 		return nil
@@ -2576,6 +2586,18 @@ var defaultSysVars = []*SysVar{
 		s.DisableHashJoin = !TiDBOptOn(val)
 		return nil
 	}},
+	{Scope: vardef.ScopeGlobal | vardef.ScopeSession, Name: vardef.TiDBEnableAdaptiveJoin, Value: BoolToOnOff(vardef.DefTiDBEnableAdaptiveJoin), Type: vardef.TypeBool, SetSession: func(s *SessionVars, val string) error {
+		s.EnableAdaptiveJoin = TiDBOptOn(val)
+		return nil
+	}},
+	{Scope: vardef.ScopeGlobal | vardef.ScopeSession, Name: vardef.TiDBAdaptiveJoinRowThreshold, Value: strconv.Itoa(vardef.DefTiDBAdaptiveJoinRowThreshold), Type: vardef.TypeUnsigned, MinValue: 1, MaxValue: math.MaxInt32, SetSession: func(s *SessionVars, val string) error {
+		s.AdaptiveJoinRowThreshold = tidbOptPositiveInt32(val, vardef.DefTiDBAdaptiveJoinRowThreshold)
+		return nil
+	}},
+	{Scope: vardef.ScopeGlobal | vardef.ScopeSession, Name: vardef.TiDBOptEnableCardinalityFeedback, Value: BoolToOnOff(vardef.DefTiDBOptEnableCardinalityFeedback), Type: vardef.TypeBool, SetSession: func(s *SessionVars, val string) error {
+		s.EnableCardinalityFeedback = TiDBOptOn(val)
+		return nil
+	}},
 	{Scope: vardef.ScopeGlobal | vardef.ScopeSession, Name: vardef.TiDBEnableIndexMergeJoin, Value: BoolToOnOff(vardef.DefTiDBEnableIndexMergeJoin), Hidden: true, Type: vardef.TypeBool, SetSession: func(s *SessionVars, val string) error {
 		s.EnableIndexMergeJoin = TiDBOptOn(val)
 		return nil
@@ -2910,6 +2932,12 @@ var defaultSysVars = []*SysVar{
 	}, GetGlobal: func(ctx context.Context, vars *SessionVars) (string, error) {
 		return BoolToOnOff(vardef.EnableTTLJob.Load()), nil
 	}},
+	{Scope: vardef.ScopeGlobal, Name: vardef.EventScheduler, Value: BoolToOnOff(vardef.DefEventScheduler), Type: vardef.TypeBool, SetGlobal: func(ctx context.Context, vars *SessionVars, s string) error {
+		vardef.EnableEventScheduler.Store(TiDBOptOn(s))
+		return nil
+	}, GetGlobal: func(ctx context.Context, vars *SessionVars) (string, error) {
+		return BoolToOnOff(vardef.EnableEventScheduler.Load()), nil
+	}},
 	{Scope: vardef.ScopeGlobal, Name: vardef.TiDBTTLScanBatchSize, Value: strconv.Itoa(vardef.DefTiDBTTLScanBatchSize), Type: vardef.TypeInt, MinValue: vardef.DefTiDBTTLScanBatchMinSize, MaxValue: vardef.DefTiDBTTLScanBatchMaxSize, SetGlobal: func(ctx context.Context, vars *SessionVars, s string) error {
 		val, err := strconv.ParseInt(s, 10, 64)
 		if err != nil {
@@ -3395,6 +3423,10 @@ var defaultSysVars = []*SysVar{
 			return nil
 		},
 	},
+	{Scope: vardef.ScopeGlobal | vardef.ScopeSession, Name: vardef.TiDBEnableCopRuntimeFilter, Value: BoolToOnOff(vardef.DefTiDBEnableCopRuntimeFilter), Type: vardef.TypeBool, SetSession: func(s *SessionVars, val string) error {
+		s.EnableCopRuntimeFilter = TiDBOptOn(val)
+		return nil
+	}},
 	{
 		Scope: vardef.ScopeGlobal | vardef.ScopeSession,
 		Name:  vardef.TiDBLockUnchangedKeys,
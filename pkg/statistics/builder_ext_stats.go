@@ -17,12 +17,15 @@ package statistics
 import (
 	"context"
 	"encoding/json"
+	"slices"
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/tidb/pkg/kv"
 	"github.com/pingcap/tidb/pkg/meta/model"
 	"github.com/pingcap/tidb/pkg/parser/ast"
 	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/codec"
 	"github.com/pingcap/tidb/pkg/util/logutil"
 	"go.uber.org/zap"
 )
@@ -63,16 +66,18 @@ func BuildExtendedStats(sctx sessionctx.Context,
 
 func fillExtendedStatsItemVals(sctx sessionctx.Context, item *ExtendedStatsItem, cols []*model.ColumnInfo, collectors []*SampleCollector) *ExtendedStatsItem {
 	switch item.Tp {
-	case ast.StatsTypeCardinality, ast.StatsTypeDependency:
-		return nil
+	case ast.StatsTypeCardinality:
+		return fillExtStatsCardinalityVals(sctx, item, cols, collectors)
+	case ast.StatsTypeDependency:
+		return fillExtStatsDependencyVals(sctx, item, cols, collectors)
 	case ast.StatsTypeCorrelation:
 		return fillExtStatsCorrVals(sctx, item, cols, collectors)
 	}
 	return nil
 }
 
-func fillExtStatsCorrVals(sctx sessionctx.Context, item *ExtendedStatsItem, cols []*model.ColumnInfo, collectors []*SampleCollector) *ExtendedStatsItem {
-	colOffsets := make([]int, 0, 2)
+func extStatsColOffsets(item *ExtendedStatsItem, cols []*model.ColumnInfo) []int {
+	colOffsets := make([]int, 0, len(item.ColIDs))
 	for _, id := range item.ColIDs {
 		for i, col := range cols {
 			if col.ID == id {
@@ -81,6 +86,133 @@ func fillExtStatsCorrVals(sctx sessionctx.Context, item *ExtendedStatsItem, cols
 			}
 		}
 	}
+	return colOffsets
+}
+
+// collectColGroupSamples collects the values of the columns in each sampled row. The samples of the columns are
+// matched by the Ordinal, and a missing sample of a column, which is NULL or too long, is regarded as NULL.
+// The rows are in the order of the Ordinal.
+func collectColGroupSamples(colOffsets []int, collectors []*SampleCollector) [][]types.Datum {
+	ordinal2Vals := make(map[int][]types.Datum)
+	for i, offset := range colOffsets {
+		for _, sample := range collectors[offset].Samples {
+			vals, ok := ordinal2Vals[sample.Ordinal]
+			if !ok {
+				vals = make([]types.Datum, len(colOffsets))
+				ordinal2Vals[sample.Ordinal] = vals
+			}
+			vals[i] = sample.Value
+		}
+	}
+	ordinals := make([]int, 0, len(ordinal2Vals))
+	for ordinal := range ordinal2Vals {
+		ordinals = append(ordinals, ordinal)
+	}
+	slices.Sort(ordinals)
+	rows := make([][]types.Datum, 0, len(ordinals))
+	for _, ordinal := range ordinals {
+		rows = append(rows, ordinal2Vals[ordinal])
+	}
+	return rows
+}
+
+// fillExtStatsCardinalityVals estimates the NDV of the column group by the samples.
+func fillExtStatsCardinalityVals(sctx sessionctx.Context, item *ExtendedStatsItem, cols []*model.ColumnInfo, collectors []*SampleCollector) *ExtendedStatsItem {
+	colOffsets := extStatsColOffsets(item, cols)
+	if len(colOffsets) != len(item.ColIDs) || len(colOffsets) < 2 {
+		return nil
+	}
+	rows := collectColGroupSamples(colOffsets, collectors)
+	if len(rows) == 0 {
+		item.ScalarVals = 0
+		return item
+	}
+	loc := sctx.GetSessionVars().StmtCtx.TimeZone()
+	counts := make(map[string]int, len(rows))
+	var key []byte
+	for _, row := range rows {
+		var err error
+		key, err = codec.EncodeKey(loc, key[:0], row...)
+		if err != nil {
+			return nil
+		}
+		counts[string(key)]++
+	}
+	onlyOnceItems := 0
+	for _, cnt := range counts {
+		if cnt == 1 {
+			onlyOnceItems++
+		}
+	}
+	first := collectors[colOffsets[0]]
+	rowCount := uint64(max(first.Count+first.NullCount, int64(len(rows))))
+	ndv := float64(estimateNDVBySample(uint64(len(rows)), uint64(len(counts)), uint64(onlyOnceItems), rowCount))
+	// The NDV of the column group is not less than the NDV of any column in it, and not more than the product of them.
+	lowerBound, upperBound := 1.0, 1.0
+	for _, offset := range colOffsets {
+		colNDV := 1.0
+		if fms := collectors[offset].FMSketch; fms != nil {
+			colNDV = max(float64(fms.NDV()), 1)
+		}
+		lowerBound = max(lowerBound, colNDV)
+		upperBound *= colNDV
+	}
+	item.ScalarVals = min(max(ndv, lowerBound), upperBound, float64(rowCount))
+	return item
+}
+
+// fillExtStatsDependencyVals calculates the degree of the functional dependency from the first column to the second
+// column by the samples, which is the fraction of the rows whose value of the first column determines the value of
+// the second column, i.e., all the rows with the same value of the first column have the same value of the second one.
+func fillExtStatsDependencyVals(sctx sessionctx.Context, item *ExtendedStatsItem, cols []*model.ColumnInfo, collectors []*SampleCollector) *ExtendedStatsItem {
+	colOffsets := extStatsColOffsets(item, cols)
+	if len(colOffsets) != 2 {
+		return nil
+	}
+	rows := collectColGroupSamples(colOffsets, collectors)
+	if len(rows) == 0 {
+		item.ScalarVals = 0
+		return item
+	}
+	type determinantGroup struct {
+		dependent  string
+		rows       int
+		consistent bool
+	}
+	loc := sctx.GetSessionVars().StmtCtx.TimeZone()
+	groups := make(map[string]*determinantGroup, len(rows))
+	for _, row := range rows {
+		determinant, err := codec.EncodeKey(loc, nil, row[0])
+		if err != nil {
+			return nil
+		}
+		dependentKey, err := codec.EncodeKey(loc, nil, row[1])
+		if err != nil {
+			return nil
+		}
+		key, dependent := string(determinant), string(dependentKey)
+		g, ok := groups[key]
+		if !ok {
+			groups[key] = &determinantGroup{dependent: dependent, rows: 1, consistent: true}
+			continue
+		}
+		g.rows++
+		if g.dependent != dependent {
+			g.consistent = false
+		}
+	}
+	supportingRows := 0
+	for _, g := range groups {
+		if g.consistent {
+			supportingRows += g.rows
+		}
+	}
+	item.ScalarVals = float64(supportingRows) / float64(len(rows))
+	return item
+}
+
+func fillExtStatsCorrVals(sctx sessionctx.Context, item *ExtendedStatsItem, cols []*model.ColumnInfo, collectors []*SampleCollector) *ExtendedStatsItem {
+	colOffsets := extStatsColOffsets(item, cols)
 	if len(colOffsets) != 2 {
 		return nil
 	}
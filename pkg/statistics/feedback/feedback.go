@@ -0,0 +1,199 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package feedback
+
+import (
+	"math"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pingcap/tidb/pkg/expression"
+)
+
+const (
+	// MinObservations is the number of observations a correction needs before it's used in the estimation, one
+	// misestimate may come from the data changes, only the repeated ones are corrected.
+	MinObservations = 2
+	// MinQError is the min q-error of the estimation to create a correction, which is max(est/act, act/est).
+	MinQError = 2.0
+	// FrequentExecCount is the execution count from which a digest is regarded as frequently-run.
+	FrequentExecCount = 10
+
+	maxCorrections    = 8192
+	maxDigests        = 65536
+	maxAnalyzedTables = 65536
+)
+
+// Corrections stores the cardinality corrections learned from the executed plans in the current instance.
+var Corrections = newStore()
+
+// Key identifies a correction by the physical table and the predicates on it.
+type Key struct {
+	PhysicalID int64
+	// Predicates is the predicates with their constants, see Predicates(). The constants are kept because a
+	// misestimate of one value, e.g. a skewed one, says nothing about the estimation of the other values.
+	Predicates string
+}
+
+// Correction is the correction of the estimated rows of the predicates on a table.
+type Correction struct {
+	Key
+	// Factor is multiplied to the selectivity of the predicates.
+	Factor float64
+	// EstRows and ActRows are the estimated and actual rows of the last observation.
+	EstRows float64
+	ActRows float64
+	// Observations is the number of the executions observed.
+	Observations int64
+	// StatsVersion is the version of the table stats used by the observed plans, the correction is dropped once the
+	// table is analyzed after this version.
+	StatsVersion uint64
+	LastUpdate   time.Time
+}
+
+// Valid returns whether the correction is observed enough to be used in the estimation.
+func (c *Correction) Valid() bool {
+	return c.Observations >= MinObservations
+}
+
+type store struct {
+	mu          sync.RWMutex
+	corrections map[Key]*Correction
+	// analyzed records the last analyze version of the tables whose corrections are invalidated, so that the late
+	// observations from the plans built with the old stats are not recorded again.
+	analyzed map[int64]uint64
+	// execCount is the execution count of the digests, used to find the frequently-run ones.
+	execCount map[string]int64
+}
+
+func newStore() *store {
+	return &store{
+		corrections: make(map[Key]*Correction),
+		analyzed:    make(map[int64]uint64),
+		execCount:   make(map[string]int64),
+	}
+}
+
+// Predicates returns the string of the predicates with their constants, which doesn't rely on the order of them.
+func Predicates(ctx expression.EvalContext, exprs []expression.Expression) string {
+	strs := make([]string, 0, len(exprs))
+	for _, expr := range exprs {
+		strs = append(strs, expr.ExplainInfo(ctx))
+	}
+	slices.Sort(strs)
+	return strings.Join(strs, ", ")
+}
+
+// ObserveExec records an execution of the digest, and returns the execution count of it.
+func (s *store) ObserveExec(digest string) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.execCount) >= maxDigests {
+		// the counts are only used to find the frequently-run digests, start over rather than tracking the least used.
+		clear(s.execCount)
+	}
+	s.execCount[digest]++
+	return s.execCount[digest]
+}
+
+// Record records an observation of the estimated and actual rows of the predicates on the table.
+func (s *store) Record(key Key, statsVersion uint64, estRows, actRows float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if statsVersion < s.analyzed[key.PhysicalID] {
+		return
+	}
+	c, ok := s.corrections[key]
+	if !ok {
+		if qError(estRows, actRows) < MinQError || len(s.corrections) >= maxCorrections {
+			return
+		}
+		c = &Correction{Key: key, Factor: 1}
+		s.corrections[key] = c
+	}
+	// the estimation of the observed plan may have been corrected already, recover the original one.
+	origEstRows := estRows
+	if c.Valid() {
+		origEstRows = estRows / c.Factor
+	}
+	observed := math.Max(actRows, 1) / math.Max(origEstRows, 1)
+	if c.Observations == 0 {
+		c.Factor = observed
+	} else {
+		// smooth the factor by the geometric mean, so a single outlier won't swing the estimation too much.
+		c.Factor = math.Sqrt(c.Factor * observed)
+	}
+	c.EstRows, c.ActRows = estRows, actRows
+	c.Observations++
+	c.StatsVersion = max(c.StatsVersion, statsVersion)
+	c.LastUpdate = time.Now()
+}
+
+// Factor returns the correction factor of the predicates on the table.
+func (s *store) Factor(key Key) (float64, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	c, ok := s.corrections[key]
+	if !ok || !c.Valid() {
+		return 1, false
+	}
+	return c.Factor, true
+}
+
+// Invalidate drops the corrections of the table observed before it's analyzed.
+func (s *store) Invalidate(physicalID int64, analyzeVersion uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if analyzeVersion <= s.analyzed[physicalID] {
+		return
+	}
+	if len(s.analyzed) >= maxAnalyzedTables {
+		// the late observations only come from the plans built shortly before the analyze, so forgetting the
+		// versions is harmless, start over rather than tracking the least recently analyzed tables.
+		clear(s.analyzed)
+	}
+	s.analyzed[physicalID] = analyzeVersion
+	for key, c := range s.corrections {
+		if key.PhysicalID == physicalID && c.StatsVersion < analyzeVersion {
+			delete(s.corrections, key)
+		}
+	}
+}
+
+// Clear drops all the corrections.
+func (s *store) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	clear(s.corrections)
+	clear(s.execCount)
+}
+
+// All returns the copies of all the corrections.
+func (s *store) All() []Correction {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	res := make([]Correction, 0, len(s.corrections))
+	for _, c := range s.corrections {
+		res = append(res, *c)
+	}
+	return res
+}
+
+func qError(estRows, actRows float64) float64 {
+	estRows, actRows = math.Max(estRows, 1), math.Max(actRows, 1)
+	return math.Max(estRows/actRows, actRows/estRows)
+}
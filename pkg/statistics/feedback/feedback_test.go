@@ -0,0 +1,61 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package feedback
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCorrections(t *testing.T) {
+	s := newStore()
+	key := Key{PhysicalID: 1, Predicates: "eq(test.t.a, 1)"}
+
+	// the estimation close to the actual rows doesn't create a correction.
+	s.Record(key, 10, 100, 150)
+	require.Empty(t, s.All())
+
+	// a single misestimate is not used in the estimation.
+	s.Record(key, 10, 100, 10000)
+	factor, ok := s.Factor(key)
+	require.False(t, ok)
+	require.Equal(t, 1.0, factor)
+
+	s.Record(key, 10, 100, 10000)
+	factor, ok = s.Factor(key)
+	require.True(t, ok)
+	require.InDelta(t, 100.0, factor, 1e-9)
+
+	// the corrected estimation is recovered before being compared with the actual rows.
+	s.Record(key, 10, 10000, 10000)
+	factor, ok = s.Factor(key)
+	require.True(t, ok)
+	require.InDelta(t, 100.0, factor, 1e-9)
+	require.Equal(t, int64(3), s.All()[0].Observations)
+
+	// analyzing the table drops the corrections observed with the old stats, and the late observations of them.
+	s.Invalidate(1, 20)
+	require.Empty(t, s.All())
+	s.Record(key, 10, 100, 10000)
+	require.Empty(t, s.All())
+	s.Record(key, 20, 100, 10000)
+	require.Len(t, s.All(), 1)
+
+	s.Clear()
+	require.Empty(t, s.All())
+	require.Equal(t, int64(1), s.ObserveExec("digest"))
+	require.Equal(t, int64(2), s.ObserveExec("digest"))
+}
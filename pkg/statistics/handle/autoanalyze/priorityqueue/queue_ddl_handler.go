@@ -79,6 +79,8 @@ func (pq *AnalysisPriorityQueue) HandleDDLEvent(_ context.Context, sctx sessionc
 		err = pq.handleAlterTablePartitioningEvent(sctx, event)
 	case model.ActionRemovePartitioning:
 		err = pq.handleRemovePartitioningEvent(sctx, event)
+	case model.ActionChangePrimaryKey:
+		err = pq.handleChangePrimaryKeyEvent(sctx, event)
 	case model.ActionDropSchema:
 		err = pq.handleDropSchemaEvent(sctx, event)
 	default:
@@ -417,6 +419,18 @@ func (pq *AnalysisPriorityQueue) handleRemovePartitioningEvent(sctx sessionctx.C
 	return pq.recreateAndPushJobForTable(sctx, newSingleTableInfo)
 }
 
+func (pq *AnalysisPriorityQueue) handleChangePrimaryKeyEvent(sctx sessionctx.Context, event *notifier.SchemaChangeEvent) error {
+	newTableInfo, oldTableInfo := event.GetChangePrimaryKeyInfo()
+
+	err := pq.getAndDeleteJob(oldTableInfo.ID)
+	if err != nil {
+		return err
+	}
+
+	// Recreate the job for the table with the new physical ID.
+	return pq.recreateAndPushJobForTable(sctx, newTableInfo)
+}
+
 func (pq *AnalysisPriorityQueue) handleDropSchemaEvent(_ sessionctx.Context, event *notifier.SchemaChangeEvent) error {
 	miniDBInfo := event.GetDropSchemaInfo()
 	for _, tbl := range miniDBInfo.Tables {
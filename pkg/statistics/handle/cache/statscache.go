@@ -28,6 +28,7 @@ import (
 	tidbmetrics "github.com/pingcap/tidb/pkg/metrics"
 	"github.com/pingcap/tidb/pkg/sessionctx"
 	"github.com/pingcap/tidb/pkg/statistics"
+	"github.com/pingcap/tidb/pkg/statistics/feedback"
 	"github.com/pingcap/tidb/pkg/statistics/handle/cache/internal/metrics"
 	statslogutil "github.com/pingcap/tidb/pkg/statistics/handle/logutil"
 	handle_metrics "github.com/pingcap/tidb/pkg/statistics/handle/metrics"
@@ -246,6 +247,10 @@ func (s *StatsCacheImpl) Update(ctx context.Context, is infoschema.InfoSchema, t
 		if tbl.LastAnalyzeVersion == 0 && snapshot != 0 {
 			tbl.LastAnalyzeVersion = snapshot
 		}
+		if !ok || tbl.LastAnalyzeVersion > oldTbl.LastAnalyzeVersion {
+			// The cardinality corrections learned with the old stats are out of date once the table is analyzed.
+			feedback.Corrections.Invalidate(physicalID, tbl.LastAnalyzeVersion)
+		}
 		tblToUpdateOrDelete.addToUpdate(tbl)
 	}
 	tblToUpdateOrDelete.flush()
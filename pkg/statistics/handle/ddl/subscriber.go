@@ -228,6 +228,11 @@ func (h subscriber) handle(
 		// Change id for global stats, since the data has not changed!
 		// Note: This operation will update all tables related to statistics with the new ID.
 		return errors.Trace(storage.ChangeGlobalStatsID(ctx, sctx, oldSingleTableID, globalTableInfo.ID))
+	case model.ActionChangePrimaryKey:
+		// Change id for table stats, since the data has not changed!
+		// Note: This operation will update all tables related to statistics with the new ID.
+		newTableInfo, oldTableInfo := change.GetChangePrimaryKeyInfo()
+		return errors.Trace(storage.ChangeGlobalStatsID(ctx, sctx, oldTableInfo.ID, newTableInfo.ID))
 	case model.ActionRemovePartitioning:
 		// Change id for global stats, since the data has not changed!
 		// Note: This operation will update all tables related to statistics with the new ID.
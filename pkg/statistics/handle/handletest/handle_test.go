@@ -484,6 +484,93 @@ func TestExtendedStatsOps(t *testing.T) {
 	require.Len(t, statsTbl.ExtendedStats.Stats, 0)
 }
 
+func TestColumnGroupExtendedStats(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("set session tidb_enable_extended_stats = on")
+	tk.MustExec("use test")
+	tk.MustExec("create table t(a int, b int, c int)")
+	tk.MustExec("insert into t values(1,1,1),(1,1,2),(2,2,3),(2,2,4),(3,3,5),(3,3,6)")
+	err := tk.ExecToErr("alter table t add stats_extended s1 cardinality(a)")
+	require.Equal(t, "Only support Cardinality statistics type on at least 2 columns", err.Error())
+	err = tk.ExecToErr("alter table t add stats_extended s1 dependency(a,b,c)")
+	require.Equal(t, "Only support Correlation and Dependency statistics types on 2 columns", err.Error())
+	tk.MustExec("alter table t add stats_extended s1 cardinality(b,a)")
+	tk.MustExec("alter table t add stats_extended s2 dependency(b,a)")
+	// The column order of dependency statistics is kept.
+	tk.MustQuery("select name, type, column_ids, stats, status from mysql.stats_extended where name in ('s1', 's2') order by name").Check(testkit.Rows(
+		"s1 0 [1,2] <nil> 0",
+		"s2 1 [2,1] <nil> 0",
+	))
+	tk.MustExec("analyze table t")
+	tk.MustQuery("select name, type, column_ids, stats, status from mysql.stats_extended where name in ('s1', 's2') order by name").Check(testkit.Rows(
+		"s1 0 [1,2] 3.000000 1",
+		"s2 1 [2,1] 1.000000 1",
+	))
+	result := tk.MustQuery("show stats_extended where db_name = 'test' and table_name = 't'").Sort().Rows()
+	require.Len(t, result, 2)
+	require.Equal(t, []any{"s1", "[a,b]", "cardinality", "3.000000"}, result[0][2:6])
+	require.Equal(t, []any{"s2", "[b,a]", "dependency", "1.000000"}, result[1][2:6])
+	is := dom.InfoSchema()
+	require.NoError(t, dom.StatsHandle().Update(context.Background(), is))
+
+	// a and b are estimated as independent without the column group statistics.
+	tk.MustExec("set session tidb_enable_extended_stats = off")
+	rows := tk.MustQuery("explain format = 'brief' select * from t where a = 1 and b = 1").Rows()
+	require.Equal(t, "0.67", rows[0][1])
+	tk.MustExec("set session tidb_enable_extended_stats = on")
+	rows = tk.MustQuery("explain format = 'brief' select * from t where a = 1 and b = 1").Rows()
+	require.Equal(t, "2.00", rows[0][1])
+	rows = tk.MustQuery("explain format = 'brief' select * from t where a in (1, 2) and b in (1, 2)").Rows()
+	require.Equal(t, "4.00", rows[0][1])
+
+	// Only the dependency statistics is used.
+	tk.MustExec("alter table t drop stats_extended s1")
+	require.NoError(t, dom.StatsHandle().Update(context.Background(), is))
+	rows = tk.MustQuery("explain format = 'brief' select * from t where a = 1 and b = 1").Rows()
+	require.Equal(t, "2.00", rows[0][1])
+
+	// b -> a with sel(b) < sel(a): the rows matching both predicates are bounded by the more selective one.
+	tk.MustExec("create table t2(a int, b int)")
+	tk.MustExec("insert into t2 values(1,1),(1,2),(2,3),(2,4),(3,5),(3,6)")
+	tk.MustExec("alter table t2 add stats_extended s3 dependency(b,a)")
+	tk.MustExec("analyze table t2")
+	tk.MustQuery("select stats from mysql.stats_extended where name = 's3'").Check(testkit.Rows("1.000000"))
+	require.NoError(t, dom.StatsHandle().Update(context.Background(), dom.InfoSchema()))
+	rows = tk.MustQuery("explain format = 'brief' select * from t2 where a = 1 and b = 1").Rows()
+	require.Equal(t, "1.00", rows[0][1])
+	rows = tk.MustQuery("explain format = 'brief' select * from t2 where b = 1 and a = 1").Rows()
+	require.Equal(t, "1.00", rows[0][1])
+}
+
+func TestRecordPredicateColumnGroups(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("set session tidb_enable_extended_stats = on")
+	tk.MustExec("use test")
+	tk.MustExec("create table t(a int, b int, c int, d int)")
+	tk.MustExec("create table pt(a int, b int) partition by hash(a) partitions 2")
+	tk.MustQuery("select * from t where a = 1 and b in (1, 2) and c > 1")
+	tk.MustQuery("select * from t where d = 1")
+	tk.MustQuery("select * from pt where a = 1 and b = 1")
+	require.NoError(t, dom.StatsHandle().DumpColStatsUsageToKV())
+	tbl, err := dom.InfoSchema().TableByName(context.Background(), ast.NewCIStr("test"), ast.NewCIStr("t"))
+	require.NoError(t, err)
+	tk.MustQuery("select type, column_ids, status from mysql.stats_extended where name like 'auto_cg_%'").Check(testkit.Rows(
+		"0 [1,2] 0",
+	))
+	tk.MustQuery(fmt.Sprintf("select count(*) from mysql.stats_extended where table_id = %d", tbl.Meta().ID)).Check(testkit.Rows("1"))
+
+	// The same column group is only registered once.
+	tk.MustQuery("select * from t where b = 1 and a = 2")
+	require.NoError(t, dom.StatsHandle().DumpColStatsUsageToKV())
+	tk.MustQuery("select count(*) from mysql.stats_extended").Check(testkit.Rows("1"))
+
+	tk.MustExec("insert into t values(1,1,1,1),(1,1,2,2),(2,2,3,3)")
+	tk.MustExec("analyze table t")
+	tk.MustQuery("select stats, status from mysql.stats_extended").Check(testkit.Rows("2.000000 1"))
+}
+
 func TestAdminReloadStatistics1(t *testing.T) {
 	store, dom := testkit.CreateMockStoreAndDomain(t)
 	tk := testkit.NewTestKit(t, store)
@@ -280,7 +280,7 @@ func ExtendedStatsFromStorage(sctx sessionctx.Context, table *statistics.Table,
 				return nil, err
 			}
 			statsStr := row.GetString(4)
-			if item.Tp == ast.StatsTypeCardinality || item.Tp == ast.StatsTypeCorrelation {
+			if item.Tp == ast.StatsTypeCardinality || item.Tp == ast.StatsTypeCorrelation || item.Tp == ast.StatsTypeDependency {
 				if statsStr != "" {
 					item.ScalarVals, err = strconv.ParseFloat(statsStr, 64)
 					if err != nil {
@@ -291,10 +291,8 @@ func SaveAnalyzeResultToStorage(sctx sessionctx.Context,
 		}
 		strColIDs := string(bytes)
 		switch item.Tp {
-		case ast.StatsTypeCardinality, ast.StatsTypeCorrelation:
+		case ast.StatsTypeCardinality, ast.StatsTypeCorrelation, ast.StatsTypeDependency:
 			statsStr = fmt.Sprintf("%f", item.ScalarVals)
-		case ast.StatsTypeDependency:
-			statsStr = item.StringVals
 		}
 		if _, err = util.Exec(sctx, "replace into mysql.stats_extended values (%?, %?, %?, %?, %?, %?, %?)", name, item.Tp, tableID, strColIDs, statsStr, version, statistics.ExtendedStatsAnalyzed); err != nil {
 			return 0, err
@@ -165,7 +165,11 @@ func UpdateStatsMeta(
 func InsertExtendedStats(sctx sessionctx.Context,
 	statsCache types.StatsCache,
 	statsName string, colIDs []int64, tp int, tableID int64, ifNotExists bool) (statsVer uint64, err error) {
-	slices.Sort(colIDs)
+	// The column order of dependency statistics is meaningful: the first column
+	// determines the second one, so keep it as specified.
+	if tp != int(ast.StatsTypeDependency) {
+		slices.Sort(colIDs)
+	}
 	bytes, err := json.Marshal(colIDs)
 	if err != nil {
 		return 0, errors.Trace(err)
@@ -236,10 +240,8 @@ func SaveExtendedStatsToStorage(sctx sessionctx.Context,
 		strColIDs := string(bytes)
 		var statsStr string
 		switch item.Tp {
-		case ast.StatsTypeCardinality, ast.StatsTypeCorrelation:
+		case ast.StatsTypeCardinality, ast.StatsTypeCorrelation, ast.StatsTypeDependency:
 			statsStr = fmt.Sprintf("%f", item.ScalarVals)
-		case ast.StatsTypeDependency:
-			statsStr = item.StringVals
 		}
 		// If isLoad is true, it's INSERT; otherwise, it's UPDATE.
 		if _, err := statsutil.Exec(sctx, "replace into mysql.stats_extended values (%?, %?, %?, %?, %?, %?, %?)", name, item.Tp, tableID, strColIDs, statsStr, version, statistics.ExtendedStatsAnalyzed); err != nil {
@@ -69,6 +69,10 @@ type StatsUsage interface {
 	// CollectColumnsInExtendedStats returns IDs of the columns involved in extended stats.
 	CollectColumnsInExtendedStats(tableID int64) ([]int64, error)
 
+	// RecordPredicateColumnGroup records a group of columns used together in the equal conditions of a table,
+	// whose cardinality extended stats would be registered when dumping column stats usage to KV.
+	RecordPredicateColumnGroup(tableID int64, colIDs []int64)
+
 	IndexUsage
 
 	// TODO: extract these function to a new interface only for delta/stats usage, like `IndexUsage`.
@@ -15,16 +15,28 @@
 package usage
 
 import (
+	"fmt"
+	"hash/fnv"
+	"slices"
+	"strconv"
+	"sync"
 	"time"
 
 	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/parser/ast"
 	"github.com/pingcap/tidb/pkg/sessionctx"
+	statslogutil "github.com/pingcap/tidb/pkg/statistics/handle/logutil"
 	statstypes "github.com/pingcap/tidb/pkg/statistics/handle/types"
 	"github.com/pingcap/tidb/pkg/statistics/handle/usage/indexusage"
 	"github.com/pingcap/tidb/pkg/statistics/handle/usage/predicatecolumn"
 	utilstats "github.com/pingcap/tidb/pkg/statistics/handle/util"
+	"go.uber.org/zap"
 )
 
+// maxPendingPredicateColumnGroups is the max number of the predicate column groups waiting to be registered,
+// which bounds the memory usage when the groups are not dumped in time.
+const maxPendingPredicateColumnGroups = 1024
+
 // statsUsageImpl implements statstypes.StatsUsage.
 type statsUsageImpl struct {
 	statsHandle statstypes.StatsHandle
@@ -34,6 +46,18 @@ type statsUsageImpl struct {
 
 	// SessionStatsList contains all the stats collector required by session.
 	*SessionStatsList
+
+	// colGroups contains the predicate column groups waiting to be registered as cardinality extended stats.
+	colGroups struct {
+		sync.Mutex
+		groups map[string]predicateColumnGroup
+	}
+}
+
+// predicateColumnGroup is a group of columns used together in the equal conditions of a table.
+type predicateColumnGroup struct {
+	tableID int64
+	colIDs  []int64
 }
 
 // NewStatsUsageImpl creates a statstypes.StatsUsage.
@@ -62,6 +86,61 @@ func (u *statsUsageImpl) GetPredicateColumns(tableID int64) (columnIDs []int64,
 	return
 }
 
+// RecordPredicateColumnGroup records a group of columns used together in the equal conditions of a table.
+// The group would be registered as cardinality extended stats when dumping column stats usage to KV.
+func (u *statsUsageImpl) RecordPredicateColumnGroup(tableID int64, colIDs []int64) {
+	if len(colIDs) < 2 {
+		return
+	}
+	colIDs = slices.Clone(colIDs)
+	slices.Sort(colIDs)
+	colIDs = slices.Compact(colIDs)
+	if len(colIDs) < 2 {
+		return
+	}
+	key := fmt.Sprintf("%d:%v", tableID, colIDs)
+	u.colGroups.Lock()
+	defer u.colGroups.Unlock()
+	if u.colGroups.groups == nil {
+		u.colGroups.groups = make(map[string]predicateColumnGroup)
+	}
+	if len(u.colGroups.groups) >= maxPendingPredicateColumnGroups {
+		return
+	}
+	u.colGroups.groups[key] = predicateColumnGroup{tableID: tableID, colIDs: colIDs}
+}
+
+// dumpPredicateColumnGroups registers the recorded predicate column groups as cardinality extended stats,
+// which would be collected in the next analyze of the table.
+func (u *statsUsageImpl) dumpPredicateColumnGroups() {
+	u.colGroups.Lock()
+	groups := u.colGroups.groups
+	u.colGroups.groups = nil
+	u.colGroups.Unlock()
+	for _, group := range groups {
+		name := predicateColumnGroupStatsName(group.colIDs)
+		err := u.statsHandle.InsertExtendedStats(name, group.colIDs, int(ast.StatsTypeCardinality), group.tableID, true)
+		if err != nil {
+			// It fails if there is already a cardinality extended stats on the same columns, just skip it.
+			statslogutil.StatsLogger().Info("skip registering the extended stats for predicate column group",
+				zap.Int64("tableID", group.tableID),
+				zap.Int64s("columnIDs", group.colIDs),
+				zap.Error(err))
+		}
+	}
+}
+
+// predicateColumnGroupStatsName generates the name of the extended stats for a predicate column group.
+// The name is derived from the column IDs, so that the same group is only registered once.
+func predicateColumnGroupStatsName(colIDs []int64) string {
+	h := fnv.New64a()
+	for _, id := range colIDs {
+		h.Write([]byte(strconv.FormatInt(id, 10)))
+		h.Write([]byte{','})
+	}
+	return fmt.Sprintf("auto_cg_%x", h.Sum64())
+}
+
 // CollectColumnsInExtendedStats returns IDs of the columns involved in extended stats.
 func (u *statsUsageImpl) CollectColumnsInExtendedStats(tableID int64) (columnIDs []int64, err error) {
 	err = utilstats.CallWithSCtx(u.statsHandle.SPool(), func(sctx sessionctx.Context) error {
@@ -317,6 +317,7 @@ func (s *statsUsageImpl) DumpColStatsUsageToKV() error {
 		metrics.StatsUsageUpdateHistogram.Observe(dur.Seconds())
 	}()
 	s.SweepSessionStatsList()
+	s.dumpPredicateColumnGroups()
 	colMap := s.SessionStatsUsage().GetUsageAndReset()
 	defer func() {
 		s.SessionStatsUsage().Merge(colMap)
@@ -266,6 +266,10 @@ type HistColl struct {
 	// For normal index, the column id is enough, as we already have in Idx2ColUniqueIDs. But currently, mv index needs more
 	// information to match the filter against the mv index columns, and we need this map to provide this information.
 	MVIdx2Columns map[int64][]*expression.Column
+	// ExtendedStats holds the extended statistics of the table. The column IDs in it are the IDs in the metadata,
+	// use UniqueID2colInfoID to match them with the columns in a query.
+	// It's used to calculate the selectivity and NDV of column groups in planner.
+	ExtendedStats *ExtendedStatsColl
 }
 
 // NewHistColl creates a new HistColl.
@@ -1017,6 +1021,27 @@ func (coll *HistColl) GenerateHistCollFromColumnInfo(tblInfo *model.TableInfo, c
 	return newColl
 }
 
+// ExtendedStatsUniqueIDs converts the column IDs of the extended statistics item to the column UniqueIDs
+// used in the query, keeping the column order of the item. It returns false if any of the columns is not
+// used in the query.
+func (coll *HistColl) ExtendedStatsUniqueIDs(item *ExtendedStatsItem) ([]int64, bool) {
+	uniqueIDs := make([]int64, 0, len(item.ColIDs))
+	for _, colID := range item.ColIDs {
+		found := false
+		for uniqueID, colInfoID := range coll.UniqueID2colInfoID {
+			if colInfoID == colID {
+				uniqueIDs = append(uniqueIDs, uniqueID)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, false
+		}
+	}
+	return uniqueIDs, true
+}
+
 // PseudoTable creates a pseudo table statistics.
 // Usually, we don't want to trigger stats loading for pseudo table.
 // But there are exceptional cases. In such cases, we should pass allowTriggerLoading as true.
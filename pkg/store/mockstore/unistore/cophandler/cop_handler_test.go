@@ -261,11 +261,11 @@ func (dagBuilder *dagBuilder) addTableScan(colInfos []*tipb.ColumnInfo, tableID
 	return dagBuilder
 }
 
-func (dagBuilder *dagBuilder) addSelection(expr *tipb.Expr) *dagBuilder {
+func (dagBuilder *dagBuilder) addSelection(exprs ...*tipb.Expr) *dagBuilder {
 	dagBuilder.executors = append(dagBuilder.executors, &tipb.Executor{
 		Tp: tipb.ExecType_TypeSelection,
 		Selection: &tipb.Selection{
-			Conditions: []*tipb.Expr{expr},
+			Conditions: exprs,
 		},
 	})
 	return dagBuilder
@@ -416,6 +416,70 @@ func TestMppExecutor(t *testing.T) {
 	require.NoError(t, err)
 }
 
+// TestRuntimeFilterSelection checks the predicates of the runtime filters pushed down by the hash join in TiDB, which
+// are the IN or the min-max conditions on the probe key, in the selection on the scan.
+func TestRuntimeFilterSelection(t *testing.T) {
+	data, err := prepareTestTableData(keyNumber, tableID)
+	require.NoError(t, err)
+	store, clean, err := newTestStore("cop_handler_test_db", "cop_handler_test_log")
+	require.NoError(t, err)
+	defer func() {
+		err := clean()
+		require.NoError(t, err)
+	}()
+
+	errs := initTestData(store, data.encodedTestKVDatas)
+	require.Nil(t, errs)
+
+	fullRange := kv.KeyRange{
+		StartKey: tablecodec.EncodeRowKeyWithHandle(tableID, kv.IntHandle(0)),
+		EndKey:   tablecodec.EncodeRowKeyWithHandle(tableID, kv.IntHandle(keyNumber)),
+	}
+	for _, c := range []struct {
+		conds    []*tipb.Expr
+		rowCount int
+	}{
+		{[]*tipb.Expr{buildIntFuncExpr(tipb.ScalarFuncSig_InInt, 0, 0, 2, 5)}, 2},
+		{[]*tipb.Expr{buildIntFuncExpr(tipb.ScalarFuncSig_InInt, 0, 5)}, 0},
+		{[]*tipb.Expr{buildIntFuncExpr(tipb.ScalarFuncSig_GEInt, 0, 1), buildIntFuncExpr(tipb.ScalarFuncSig_LEInt, 0, 1)}, 1},
+		{[]*tipb.Expr{buildIntFuncExpr(tipb.ScalarFuncSig_GEInt, 0, 1), buildIntFuncExpr(tipb.ScalarFuncSig_LEInt, 0, 10)}, 2},
+	} {
+		dagRequest := newDagBuilder().
+			setStartTs(dagRequestStartTs).
+			addTableScan(data.colInfos, tableID).
+			addSelection(c.conds...).
+			setOutputOffsets([]uint32{0, 1}).
+			build()
+		dagCtx := newDagContext(t, store, []kv.KeyRange{fullRange}, dagRequest, dagRequestStartTs)
+		_, rowCount, err := buildExecutorsAndExecute(dagCtx, dagRequest)
+		require.NoError(t, err)
+		require.Equal(t, c.rowCount, rowCount)
+	}
+}
+
+// buildIntFuncExpr builds the function on an int column and int constants.
+func buildIntFuncExpr(sig tipb.ScalarFuncSig, colIdx int64, vals ...int64) *tipb.Expr {
+	children := make([]*tipb.Expr, 0, len(vals)+1)
+	children = append(children, &tipb.Expr{
+		Tp:        tipb.ExprType_ColumnRef,
+		Val:       codec.EncodeInt(nil, colIdx),
+		FieldType: expression.ToPBFieldType(types.NewFieldType(mysql.TypeLonglong)),
+	})
+	for _, val := range vals {
+		children = append(children, &tipb.Expr{
+			Tp:        tipb.ExprType_Int64,
+			Val:       codec.EncodeInt(nil, val),
+			FieldType: expression.ToPBFieldType(types.NewFieldType(mysql.TypeLonglong)),
+		})
+	}
+	return &tipb.Expr{
+		Tp:        tipb.ExprType_ScalarFunc,
+		Sig:       sig,
+		FieldType: expression.ToPBFieldType(types.NewFieldType(mysql.TypeLonglong)),
+		Children:  children,
+	}
+}
+
 func buildNEIntExpr(colIdx, val int64) *tipb.Expr {
 	return &tipb.Expr{
 		Tp:        tipb.ExprType_ScalarFunc,
@@ -343,6 +343,12 @@ func castColumnValue(tc types.Context, ec errctx.Context, sqlMode mysql.SQLMode,
 	if returnErr && err != nil {
 		return casted, err
 	}
+	// The geometry must be in the spatial reference system of the column.
+	if err == nil && col.SRID != nil && !casted.IsNull() {
+		if srid := types.GeometrySRID(casted.GetBytes()); srid != *col.SRID {
+			return casted, types.ErrWrongSRIDForColumn.GenWithStackByArgs(col.Name.O, srid, *col.SRID)
+		}
+	}
 	if err != nil && types.ErrTruncated.Equal(err) && col.GetType() != mysql.TypeSet && col.GetType() != mysql.TypeEnum {
 		str, err1 := val.ToString()
 		if err1 != nil {
@@ -115,6 +115,8 @@ var (
 	ErrOptOnCacheTable = dbterror.ClassDDL.NewStd(mysql.ErrOptOnCacheTable)
 	// ErrCheckConstraintViolated return when check constraint is violated.
 	ErrCheckConstraintViolated = dbterror.ClassTable.NewStd(mysql.ErrCheckConstraintViolated)
+	// ErrRowPolicyViolated returns when the inserted row is not allowed by the row-level security policies.
+	ErrRowPolicyViolated = dbterror.ClassTable.NewStd(mysql.ErrRowPolicyViolated)
 )
 
 // RecordIterFunc is used for low-level record iteration.
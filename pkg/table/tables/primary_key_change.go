@@ -0,0 +1,262 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tables
+
+import (
+	"context"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/errctx"
+	"github.com/pingcap/tidb/pkg/kv"
+	"github.com/pingcap/tidb/pkg/meta/model"
+	"github.com/pingcap/tidb/pkg/table"
+	"github.com/pingcap/tidb/pkg/tablecodec"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/codec"
+	"github.com/pingcap/tidb/pkg/util/rowcodec"
+)
+
+// primaryKeyChange double writes the rows of a table whose primary key is being
+// changed to the table with the other handle layout, see model.PrimaryKeyChangeInfo.
+type primaryKeyChange struct {
+	state  model.SchemaState
+	shadow *TableCommon
+	// handleIdx is the unique index on the clustered primary key columns, it's used
+	// to locate the rows in shadow if shadow doesn't have a clustered index.
+	handleIdx table.Index
+}
+
+func initPrimaryKeyChange(t *TableCommon) error {
+	pkc := t.meta.PrimaryKeyChange
+	if pkc == nil {
+		return nil
+	}
+	// The allocators are shared, see autoid.NewAllocatorsFromTblInfo.
+	tbl, err := TableFromMeta(t.allocs, pkc.TableInfo)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	shadow, ok := tbl.(*TableCommon)
+	if !ok {
+		return errors.Errorf("unexpected table type %T when changing primary key of table %s", tbl, t.meta.Name)
+	}
+	// The rows may not be written to shadow yet.
+	shadow.skipAssert = true
+	c := &primaryKeyChange{state: pkc.DDLState, shadow: shadow}
+	if !shadow.meta.HasClusteredIndex() {
+		for _, idx := range shadow.indices {
+			if idx.Meta().ID == pkc.HandleIndexID {
+				c.handleIdx = idx
+				break
+			}
+		}
+		if c.handleIdx == nil {
+			return errors.Errorf("handle index %d not found when changing primary key of table %s", pkc.HandleIndexID, t.meta.Name)
+		}
+	}
+	t.pkChange = c
+	return nil
+}
+
+// shadowRow trims the row to the columns of shadow, the extra datum may be _tidb_rowid.
+func (c *primaryKeyChange) shadowRow(r []types.Datum) []types.Datum {
+	if n := len(c.shadow.Cols()); len(r) > n {
+		return r[:n:n]
+	}
+	return r
+}
+
+// findRecord returns the handle of the row in shadow, it returns nil if the row
+// hasn't been written to shadow.
+func (c *primaryKeyChange) findRecord(ctx table.MutateContext, txn kv.Transaction, r []types.Datum) (kv.Handle, error) {
+	evalCtx := ctx.GetExprCtx().GetEvalCtx()
+	tc, ec := evalCtx.TypeCtx(), evalCtx.ErrCtx()
+	if c.handleIdx != nil {
+		vals, err := c.handleIdx.FetchValues(r, nil)
+		if err != nil {
+			return nil, err
+		}
+		key, _, err := c.handleIdx.GenIndexKey(ec, tc.Location(), vals, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		return FetchDuplicatedHandle(context.Background(), key, txn)
+	}
+	h, err := buildClusteredHandle(tc.Location(), ec, c.shadow.meta, r)
+	if err != nil {
+		return nil, err
+	}
+	_, err = txn.Get(context.Background(), c.shadow.RecordKey(h))
+	if kv.ErrNotExist.Equal(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+// deleteMissingRecord deletes the record of r in shadow which isn't found by findRecord.
+// The rows may be copied to shadow by ingest at a timestamp before the current transaction,
+// see EncodeRecordForPrimaryKeyChange, the deletion prevents the copied row from being
+// visible. The record key is only known if shadow has a clustered index.
+func (c *primaryKeyChange) deleteMissingRecord(ctx table.MutateContext, txn kv.Transaction, r []types.Datum) error {
+	if c.handleIdx != nil {
+		return nil
+	}
+	evalCtx := ctx.GetExprCtx().GetEvalCtx()
+	h, err := buildClusteredHandle(evalCtx.Location(), evalCtx.ErrCtx(), c.shadow.meta, r)
+	if err != nil {
+		return err
+	}
+	return txn.Delete(c.shadow.RecordKey(h))
+}
+
+func (c *primaryKeyChange) addRecord(ctx table.MutateContext, txn kv.Transaction, r []types.Datum, opt *table.AddRecordOpt) error {
+	if c.state == model.StateDeleteOnly {
+		return nil
+	}
+	_, err := c.shadow.addRecord(ctx, txn, c.shadowRow(r), table.NewAddRecordOpt(table.WithCtx(opt.Ctx())))
+	return err
+}
+
+func (c *primaryKeyChange) updateRecord(ctx table.MutateContext, txn kv.Transaction, oldData, newData []types.Datum, opt *table.UpdateRecordOpt) error {
+	oldData, newData = c.shadowRow(oldData), c.shadowRow(newData)
+	h, err := c.findRecord(ctx, txn, oldData)
+	if err != nil {
+		return err
+	}
+	if h != nil {
+		if err = c.shadow.removeRecord(ctx, txn, h, oldData, table.NewRemoveRecordOpt()); err != nil {
+			return err
+		}
+		if !c.shadow.meta.HasClusteredIndex() {
+			// Keep the _tidb_rowid of the row in shadow.
+			newData = append(newData, types.NewIntDatum(h.IntValue()))
+		}
+	} else if err = c.deleteMissingRecord(ctx, txn, oldData); err != nil {
+		return err
+	}
+	if c.state == model.StateDeleteOnly {
+		return nil
+	}
+	_, err = c.shadow.addRecord(ctx, txn, newData, table.NewAddRecordOpt(table.WithCtx(opt.Ctx()), table.IsUpdate))
+	return err
+}
+
+func (c *primaryKeyChange) removeRecord(ctx table.MutateContext, txn kv.Transaction, r []types.Datum) error {
+	r = c.shadowRow(r)
+	h, err := c.findRecord(ctx, txn, r)
+	if err != nil {
+		return err
+	}
+	if h == nil {
+		return c.deleteMissingRecord(ctx, txn, r)
+	}
+	return c.shadow.removeRecord(ctx, txn, h, r, table.NewRemoveRecordOpt())
+}
+
+// CopyRecordForPrimaryKeyChange copies a row of t to the table with the new primary key,
+// it's used by the backfill worker of changing primary key. The row is skipped if it has
+// been double written, and a duplicate error is returned if another row has the same key.
+func CopyRecordForPrimaryKeyChange(ctx table.MutateContext, txn kv.Transaction, t table.Table, r []types.Datum) error {
+	tc, ok := t.(*TableCommon)
+	if !ok || tc.pkChange == nil {
+		return errors.Errorf("the primary key of table %s is not being changed", t.Meta().Name)
+	}
+	c := tc.pkChange
+	r = c.shadowRow(r)
+	h, err := c.findRecord(ctx, txn, r)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if h != nil {
+		val, err := txn.Get(context.Background(), c.shadow.RecordKey(h))
+		if err != nil {
+			return errors.Trace(err)
+		}
+		equal, err := dataEqRec(ctx.GetExprCtx().GetEvalCtx().Location(), c.shadow.meta, r, val)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if equal {
+			// Already copied or double written by concurrent DML.
+			return nil
+		}
+		return getDuplicateError(c.shadow.meta, h, r)
+	}
+	_, err = c.shadow.addRecord(ctx, txn, r, table.NewAddRecordOpt())
+	return err
+}
+
+// EncodeRecordForPrimaryKeyChange encodes a row of t as the record of the table with the new
+// primary key, it's used to copy the rows by ingest. The new table must have a clustered index,
+// so that the record key only depends on the row.
+func EncodeRecordForPrimaryKeyChange(ctx table.MutateContext, t table.Table, r []types.Datum) (kv.Key, []byte, kv.Handle, error) {
+	tc, ok := t.(*TableCommon)
+	if !ok || tc.pkChange == nil {
+		return nil, nil, nil, errors.Errorf("the primary key of table %s is not being changed", t.Meta().Name)
+	}
+	shadow := tc.pkChange.shadow
+	if !shadow.meta.HasClusteredIndex() {
+		return nil, nil, nil, errors.Errorf("table %s is changed to a primary key without clustered index", t.Meta().Name)
+	}
+	r = tc.pkChange.shadowRow(r)
+	evalCtx := ctx.GetExprCtx().GetEvalCtx()
+	loc, ec := evalCtx.Location(), evalCtx.ErrCtx()
+	h, err := buildClusteredHandle(loc, ec, shadow.meta, r)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	colIDs := make([]int64, 0, len(shadow.Columns))
+	row := make([]types.Datum, 0, len(shadow.Columns))
+	for _, col := range shadow.Columns {
+		if shadow.canSkip(col, &r[col.Offset]) {
+			continue
+		}
+		colIDs = append(colIDs, col.ID)
+		row = append(row, r[col.Offset])
+	}
+	cfg := ctx.GetRowEncodingConfig()
+	var checksum rowcodec.Checksum
+	if cfg.IsRowLevelChecksumEnabled {
+		checksum = rowcodec.RawChecksum{Handle: h}
+	}
+	val, err := tablecodec.EncodeRow(loc, row, colIDs, nil, nil, checksum, cfg.RowEncoder)
+	if err = ec.HandleError(err); err != nil {
+		return nil, nil, nil, err
+	}
+	return shadow.RecordKey(h), val, h, nil
+}
+
+// buildClusteredHandle builds the handle of a row for a table with clustered index.
+func buildClusteredHandle(loc *time.Location, ec errctx.Context, tblInfo *model.TableInfo, r []types.Datum) (kv.Handle, error) {
+	if tblInfo.PKIsHandle {
+		return kv.IntHandle(r[tblInfo.GetPkColInfo().Offset].GetInt64()), nil
+	}
+	pkIdx := FindPrimaryIndex(tblInfo)
+	pkDts := make([]types.Datum, 0, len(pkIdx.Columns))
+	for _, idxCol := range pkIdx.Columns {
+		pkDts = append(pkDts, r[idxCol.Offset])
+	}
+	tablecodec.TruncateIndexValues(tblInfo, pkIdx, pkDts)
+	handleBytes, err := codec.EncodeKey(loc, nil, pkDts...)
+	err = ec.HandleError(err)
+	if err != nil {
+		return nil, err
+	}
+	return kv.NewCommonHandle(handleBytes)
+}
@@ -83,8 +83,12 @@ type TableCommon struct {
 	recordPrefix kv.Key
 	indexPrefix  kv.Key
 
-	// skipAssert is used for partitions that are in WriteOnly/DeleteOnly state.
+	// skipAssert is used for partitions that are in WriteOnly/DeleteOnly state, and the
+	// table with the new primary key when the primary key is being changed.
 	skipAssert bool
+
+	// pkChange is not nil when the primary key of the table is being changed.
+	pkChange *primaryKeyChange
 }
 
 // ResetColumnsCache implements testingKnob interface.
@@ -220,6 +224,9 @@ func TableFromMeta(allocs autoid.Allocators, tblInfo *model.TableInfo) (table.Ta
 		if err := initTableIndices(&t); err != nil {
 			return nil, err
 		}
+		if err := initPrimaryKeyChange(&t); err != nil {
+			return nil, err
+		}
 		if tblInfo.TableCacheStatusType != model.TableCacheStatusDisable {
 			return newCachedTable(&t)
 		}
@@ -418,7 +425,10 @@ func (t *TableCommon) RecordKey(h kv.Handle) kv.Key {
 // Length of `oldData` and `newData` equals to length of `t.WritableCols()`.
 func (t *TableCommon) UpdateRecord(ctx table.MutateContext, txn kv.Transaction, h kv.Handle, oldData, newData []types.Datum, touched []bool, opts ...table.UpdateRecordOption) error {
 	opt := table.NewUpdateRecordOpt(opts...)
-	return t.updateRecord(ctx, txn, h, oldData, newData, touched, opt)
+	if err := t.updateRecord(ctx, txn, h, oldData, newData, touched, opt); err != nil || t.pkChange == nil {
+		return err
+	}
+	return t.pkChange.updateRecord(ctx, txn, oldData, newData, opt)
 }
 
 func (t *TableCommon) updateRecord(sctx table.MutateContext, txn kv.Transaction, h kv.Handle, oldData, newData []types.Datum, touched []bool, opt *table.UpdateRecordOpt) error {
@@ -679,7 +689,11 @@ func checkTempTableSize(tmpTable tblctx.TemporaryTableHandler, sizeLimit int64)
 func (t *TableCommon) AddRecord(sctx table.MutateContext, txn kv.Transaction, r []types.Datum, opts ...table.AddRecordOption) (recordID kv.Handle, err error) {
 	// TODO: optimize the allocation (and calculation) of opt.
 	opt := table.NewAddRecordOpt(opts...)
-	return t.addRecord(sctx, txn, r, opt)
+	recordID, err = t.addRecord(sctx, txn, r, opt)
+	if err != nil || t.pkChange == nil {
+		return recordID, err
+	}
+	return recordID, t.pkChange.addRecord(sctx, txn, r, opt)
 }
 
 func (t *TableCommon) addRecord(sctx table.MutateContext, txn kv.Transaction, r []types.Datum, opt *table.AddRecordOpt) (recordID kv.Handle, err error) {
@@ -718,23 +732,8 @@ func (t *TableCommon) addRecord(sctx table.MutateContext, txn kv.Transaction, r
 	} else {
 		tblInfo := t.Meta()
 		txn.CacheTableInfo(t.physicalTableID, tblInfo)
-		if tblInfo.PKIsHandle {
-			recordID = kv.IntHandle(r[tblInfo.GetPkColInfo().Offset].GetInt64())
-			hasRecordID = true
-		} else if tblInfo.IsCommonHandle {
-			pkIdx := FindPrimaryIndex(tblInfo)
-			pkDts := make([]types.Datum, 0, len(pkIdx.Columns))
-			for _, idxCol := range pkIdx.Columns {
-				pkDts = append(pkDts, r[idxCol.Offset])
-			}
-			tablecodec.TruncateIndexValues(tblInfo, pkIdx, pkDts)
-			var handleBytes []byte
-			handleBytes, err = codec.EncodeKey(tc.Location(), nil, pkDts...)
-			err = ec.HandleError(err)
-			if err != nil {
-				return
-			}
-			recordID, err = kv.NewCommonHandle(handleBytes)
+		if tblInfo.HasClusteredIndex() {
+			recordID, err = buildClusteredHandle(tc.Location(), ec, tblInfo, r)
 			if err != nil {
 				return
 			}
@@ -878,7 +877,7 @@ func (t *TableCommon) addRecord(sctx table.MutateContext, txn kv.Transaction, r
 			}
 		}
 	})
-	if setPresume && !txn.IsPessimistic() {
+	if (setPresume && !txn.IsPessimistic()) || t.skipAssert {
 		err = txn.SetAssertion(key, kv.SetAssertUnknown)
 	} else {
 		err = txn.SetAssertion(key, kv.SetAssertNotExist)
@@ -1104,7 +1103,10 @@ func GetChangingColVal(ctx exprctx.BuildContext, cols []*table.Column, col *tabl
 // RemoveRecord implements table.Table RemoveRecord interface.
 func (t *TableCommon) RemoveRecord(ctx table.MutateContext, txn kv.Transaction, h kv.Handle, r []types.Datum, opts ...table.RemoveRecordOption) error {
 	opt := table.NewRemoveRecordOpt(opts...)
-	return t.removeRecord(ctx, txn, h, r, opt)
+	if err := t.removeRecord(ctx, txn, h, r, opt); err != nil || t.pkChange == nil {
+		return err
+	}
+	return t.pkChange.removeRecord(ctx, txn, r)
 }
 
 func (t *TableCommon) removeRecord(ctx table.MutateContext, txn kv.Transaction, h kv.Handle, r []types.Datum, opt *table.RemoveRecordOpt) error {
@@ -68,14 +68,15 @@ func (h *TemporaryTableHandler) Meta() *model.TableInfo {
 	return h.tblInTxn.GetMeta()
 }
 
-// GetDirtySize returns the size of dirty data in txn of the temporary table
+// GetDirtySize returns the size of dirty data in txn of the temporary table. For a global temporary
+// table with ON COMMIT PRESERVE ROWS, the size of the committed data is carried over into it.
 func (h *TemporaryTableHandler) GetDirtySize() int64 {
 	return h.tblInTxn.GetSize()
 }
 
 // GetCommittedSize returns the committed data size of the temporary table
 func (h *TemporaryTableHandler) GetCommittedSize() int64 {
-	if h.data == nil {
+	if h.data == nil || h.tblInTxn.GetMeta().TempTableType == model.TempTableGlobal {
 		return 0
 	}
 	return h.data.GetTableSize(h.tblInTxn.GetMeta().ID)
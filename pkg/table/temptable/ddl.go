@@ -15,7 +15,6 @@
 package temptable
 
 import (
-	"bytes"
 	"context"
 
 	"github.com/pingcap/errors"
@@ -29,7 +28,6 @@ import (
 	"github.com/pingcap/tidb/pkg/sessionctx/variable"
 	"github.com/pingcap/tidb/pkg/table"
 	"github.com/pingcap/tidb/pkg/table/tables"
-	"github.com/pingcap/tidb/pkg/tablecodec"
 	"github.com/tikv/client-go/v2/tikv"
 )
 
@@ -46,7 +44,7 @@ type temporaryTableDDL struct {
 }
 
 func (d *temporaryTableDDL) CreateLocalTemporaryTable(db *model.DBInfo, info *model.TableInfo) error {
-	if _, err := ensureSessionData(d.sctx); err != nil {
+	if _, err := EnsureSessionData(d.sctx); err != nil {
 		return err
 	}
 	info.DBID = db.ID
@@ -96,35 +94,7 @@ func (d *temporaryTableDDL) clearTemporaryTableRecords(tblID int64) error {
 		return nil
 	}
 
-	tblPrefix := tablecodec.EncodeTablePrefix(tblID)
-	endKey := tablecodec.EncodeTablePrefix(tblID + 1)
-	iter, err := sessionData.Iter(tblPrefix, endKey)
-	if err != nil {
-		return err
-	}
-
-	keys := make([][]byte, 0, 16)
-	for iter.Valid() {
-		key := iter.Key()
-		if !bytes.HasPrefix(key, tblPrefix) {
-			break
-		}
-		keys = append(keys, key)
-
-		err = iter.Next()
-		if err != nil {
-			return err
-		}
-	}
-
-	for _, key := range keys {
-		err = sessionData.DeleteTableKey(tblID, key)
-		if err != nil {
-			return err
-		}
-	}
-
-	return nil
+	return sessionData.ClearTable(tblID)
 }
 
 func checkLocalTemporaryExistsAndReturn(sctx sessionctx.Context, schema ast.CIStr, tblName ast.CIStr) (table.Table, error) {
@@ -146,7 +116,9 @@ func getSessionData(sctx sessionctx.Context) variable.TemporaryTableData {
 	return sctx.GetSessionVars().TemporaryTableData
 }
 
-func ensureSessionData(sctx sessionctx.Context) (variable.TemporaryTableData, error) {
+// EnsureSessionData returns the committed data of the temporary tables in the session,
+// it's created if not exists.
+func EnsureSessionData(sctx sessionctx.Context) (variable.TemporaryTableData, error) {
 	sessVars := sctx.GetSessionVars()
 	if sessVars.TemporaryTableData == nil {
 		// Create this txn just for getting a MemBuffer. It's a little tricky
@@ -75,7 +75,7 @@ func getSessionKey(ctx context.Context, tblInfo *model.TableInfo, sessionData kv
 		return nil, errors.New("Cannot get normal table key from session")
 	}
 
-	if sessionData == nil || tblInfo.TempTableType == model.TempTableGlobal {
+	if sessionData == nil || !tblInfo.HasSessionTemporaryData() {
 		return nil, kv.ErrNotExist
 	}
 
@@ -174,7 +174,7 @@ func (i *TemporaryTableSnapshotInterceptor) iterTable(tblID int64, snap kv.Snaps
 		return snap.Iter(k, upperBound)
 	}
 
-	if tblInfo.TempTableType == model.TempTableGlobal || i.sessionData == nil {
+	if !tblInfo.HasSessionTemporaryData() || i.sessionData == nil {
 		return &kv.EmptyIterator{}, nil
 	}
 
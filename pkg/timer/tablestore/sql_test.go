@@ -499,6 +499,14 @@ func TestBuildUpdateCriteria(t *testing.T) {
 				"VERSION = VERSION + 1",
 			args: []any{"", "", "", []byte(nil), []byte(nil), json.RawMessage(`{"event":null,"manual":null,"tags":null}`)},
 		},
+		{
+			update: &api.TimerUpdate{
+				Enable: api.NewOptionalVal(true),
+				Data:   api.NewOptionalVal([]byte("data1")),
+			},
+			criteria: "ENABLE = %?, TIMER_DATA = %?, VERSION = VERSION + 1",
+			args:     []any{true, []byte("data1")},
+		},
 		{
 			update: &api.TimerUpdate{
 				CheckEventID: api.NewOptionalVal("ee"),
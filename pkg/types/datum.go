@@ -1060,6 +1060,8 @@ func (d *Datum) ConvertTo(ctx Context, target *FieldType) (Datum, error) {
 		return d.convertToMysqlJSON(target)
 	case mysql.TypeTiDBVectorFloat32:
 		return d.convertToVectorFloat32(ctx, target)
+	case mysql.TypeGeometry:
+		return d.convertToGeometry(target)
 	case mysql.TypeNull:
 		return Datum{}, nil
 	default:
@@ -1877,6 +1879,31 @@ func (d *Datum) convertToVectorFloat32(_ Context, target *FieldType) (ret Datum,
 	return ret, errors.Trace(err)
 }
 
+func (d *Datum) convertToGeometry(target *FieldType) (ret Datum, err error) {
+	switch d.k {
+	case KindString, KindBytes:
+		g, err := DecodeGeometry(d.GetBytes())
+		if err != nil || !geometryFitsType(g.Tp, target.GetGeometryType()) {
+			return ret, ErrCantCreateGeometryObject.GenWithStackByArgs()
+		}
+		ret.SetBytes(d.GetBytes())
+	default:
+		return ret, ErrCantCreateGeometryObject.GenWithStackByArgs()
+	}
+	return ret, nil
+}
+
+// geometryFitsType returns whether the geometry of tp can be stored in the column of colTp.
+func geometryFitsType(tp, colTp byte) bool {
+	switch colTp {
+	case mysql.GeometryTypeGeometry:
+		return true
+	case mysql.GeometryTypeGeometryCollection:
+		return tp >= mysql.GeometryTypeMultiPoint
+	}
+	return tp == colTp
+}
+
 // ToBool converts to a bool.
 // We will use 1 for true, and 0 for false.
 func (d *Datum) ToBool(ctx Context) (int64, error) {
@@ -98,4 +98,18 @@ var (
 	ErrJSONBadOneOrAllArg = dbterror.ClassTypes.NewStd(mysql.ErrJSONBadOneOrAllArg)
 	// ErrJSONVacuousPath is returned for path expressions that are not allowed in that context.
 	ErrJSONVacuousPath = dbterror.ClassTypes.NewStd(mysql.ErrJSONVacuousPath)
+	// ErrCantCreateGeometryObject is returned when the value can't be stored into a geometry column.
+	ErrCantCreateGeometryObject = dbterror.ClassTypes.NewStd(mysql.ErrCantCreateGeometryObject)
+	// ErrGISDifferentSRIDs is returned when the geometries of a binary geometry function have different SRIDs.
+	ErrGISDifferentSRIDs = dbterror.ClassTypes.NewStd(mysql.ErrGISDifferentSRIDs)
+	// ErrGISInvalidData is returned when the argument of a geometry function is not a valid geometry.
+	ErrGISInvalidData = dbterror.ClassTypes.NewStd(mysql.ErrGISInvalidData)
+	// ErrGISUnsupportedArgument is returned when a geometry function doesn't support the types of its arguments.
+	ErrGISUnsupportedArgument = dbterror.ClassTypes.NewStd(mysql.ErrGISUnsupportedArgument)
+	// ErrSRSNotFound is returned when the spatial reference system of the SRID is unknown.
+	ErrSRSNotFound = dbterror.ClassTypes.NewStd(mysql.ErrSRSNotFound)
+	// ErrNotImplementedForGeographicSRS is returned when a geometry function doesn't support geographic SRSs.
+	ErrNotImplementedForGeographicSRS = dbterror.ClassTypes.NewStd(mysql.ErrNotImplementedForGeographicSRS)
+	// ErrWrongSRIDForColumn is returned when the SRID of the geometry doesn't match the SRID of the column.
+	ErrWrongSRIDForColumn = dbterror.ClassTypes.NewStd(mysql.ErrWrongSRIDForColumn)
 )
@@ -0,0 +1,443 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+)
+
+// The spatial reference systems supported by TiDB.
+const (
+	// SRIDCartesian is the SRID of the Cartesian plane without units.
+	SRIDCartesian uint32 = 0
+	// SRIDWGS84 is the SRID of the WGS 84 geographic spatial reference system.
+	SRIDWGS84 uint32 = 4326
+)
+
+const (
+	wkbBigEndian    byte = 0
+	wkbLittleEndian byte = 1
+
+	geometrySRIDLen = 4
+	// wkbHeaderLen is the length of the byte order and the geometry type.
+	wkbHeaderLen = 5
+	wkbPointLen  = 16
+	// maxGeometryDepth limits the nesting depth of the geometry collections.
+	maxGeometryDepth = 64
+)
+
+// errInvalidGeometry is returned when the data is not a valid geometry. The callers are
+// expected to convert it to the error of MySQL, which depends on the context.
+var errInvalidGeometry = errors.New("invalid geometry data")
+
+// IsGeographicSRID returns whether the SRID refers to a geographic spatial reference system.
+func IsGeographicSRID(srid uint32) bool {
+	return srid == SRIDWGS84
+}
+
+// CheckSRID checks whether the spatial reference system of the SRID is supported.
+func CheckSRID(srid uint32) error {
+	if srid != SRIDCartesian && srid != SRIDWGS84 {
+		return ErrSRSNotFound.GenWithStackByArgs(srid)
+	}
+	return nil
+}
+
+// GeoPoint is a point of the geometry.
+// For geographic spatial reference systems, X is the longitude and Y is the latitude.
+type GeoPoint struct {
+	X float64
+	Y float64
+}
+
+// Geometry is a geometry value of the spatial types.
+//
+// The geometry is stored in the same format as MySQL: a 4-byte little-endian SRID
+// followed by the WKB of the geometry in little-endian.
+type Geometry struct {
+	SRID uint32
+	// Tp is the type of the geometry, such as mysql.GeometryTypePoint.
+	Tp byte
+	// Points holds the point of a Point, or the points of a LineString.
+	Points []GeoPoint
+	// Rings holds the rings of a Polygon, and the first one is the exterior ring.
+	Rings [][]GeoPoint
+	// Geoms holds the elements of a MultiPoint, MultiLineString, MultiPolygon or GeometryCollection.
+	Geoms []Geometry
+}
+
+// NewPointGeometry creates a Point.
+func NewPointGeometry(srid uint32, x, y float64) Geometry {
+	return Geometry{SRID: srid, Tp: mysql.GeometryTypePoint, Points: []GeoPoint{{X: x, Y: y}}}
+}
+
+// TypeName returns the name of the geometry type, which is the result of ST_GeometryType.
+func (g *Geometry) TypeName() string {
+	switch g.Tp {
+	case mysql.GeometryTypePoint:
+		return "POINT"
+	case mysql.GeometryTypeLineString:
+		return "LINESTRING"
+	case mysql.GeometryTypePolygon:
+		return "POLYGON"
+	case mysql.GeometryTypeMultiPoint:
+		return "MULTIPOINT"
+	case mysql.GeometryTypeMultiLineString:
+		return "MULTILINESTRING"
+	case mysql.GeometryTypeMultiPolygon:
+		return "MULTIPOLYGON"
+	default:
+		return "GEOMCOLLECTION"
+	}
+}
+
+// IsEmpty returns whether the geometry is an empty geometry collection.
+func (g *Geometry) IsEmpty() bool {
+	if g.Tp != mysql.GeometryTypeGeometryCollection {
+		return false
+	}
+	for i := range g.Geoms {
+		if !g.Geoms[i].IsEmpty() {
+			return false
+		}
+	}
+	return true
+}
+
+// Valid checks the geometry has enough points, the rings are closed and the coordinates are finite.
+func (g *Geometry) Valid() bool {
+	return g.valid(0)
+}
+
+func (g *Geometry) valid(depth int) bool {
+	if depth > maxGeometryDepth {
+		return false
+	}
+	switch g.Tp {
+	case mysql.GeometryTypePoint:
+		return len(g.Points) == 1 && validPoints(g.Points)
+	case mysql.GeometryTypeLineString:
+		return len(g.Points) >= 2 && validPoints(g.Points)
+	case mysql.GeometryTypePolygon:
+		if len(g.Rings) == 0 {
+			return false
+		}
+		for _, ring := range g.Rings {
+			if len(ring) < 4 || ring[0] != ring[len(ring)-1] || !validPoints(ring) {
+				return false
+			}
+		}
+		return true
+	case mysql.GeometryTypeMultiPoint, mysql.GeometryTypeMultiLineString, mysql.GeometryTypeMultiPolygon:
+		if len(g.Geoms) == 0 {
+			return false
+		}
+		elemTp := g.Tp - (mysql.GeometryTypeMultiPoint - mysql.GeometryTypePoint)
+		for i := range g.Geoms {
+			if g.Geoms[i].Tp != elemTp || !g.Geoms[i].valid(depth+1) {
+				return false
+			}
+		}
+		return true
+	case mysql.GeometryTypeGeometryCollection:
+		for i := range g.Geoms {
+			if !g.Geoms[i].valid(depth + 1) {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
+
+func validPoints(points []GeoPoint) bool {
+	for _, p := range points {
+		if math.IsNaN(p.X) || math.IsInf(p.X, 0) || math.IsNaN(p.Y) || math.IsInf(p.Y, 0) {
+			return false
+		}
+	}
+	return true
+}
+
+// setSRID sets the SRID of the geometry and its elements.
+func (g *Geometry) setSRID(srid uint32) {
+	g.SRID = srid
+	for i := range g.Geoms {
+		g.Geoms[i].setSRID(srid)
+	}
+}
+
+// swapAxes swaps the coordinates of all the points. The geographic spatial reference
+// systems use the latitude-longitude axis order in WKT and WKB by default, while the
+// points are stored in the longitude-latitude order.
+func (g *Geometry) swapAxes() {
+	swap := func(points []GeoPoint) {
+		for i := range points {
+			points[i].X, points[i].Y = points[i].Y, points[i].X
+		}
+	}
+	swap(g.Points)
+	for _, ring := range g.Rings {
+		swap(ring)
+	}
+	for i := range g.Geoms {
+		g.Geoms[i].swapAxes()
+	}
+}
+
+// DecodeGeometry decodes the geometry stored in the internal format.
+func DecodeGeometry(b []byte) (Geometry, error) {
+	if len(b) < geometrySRIDLen+wkbHeaderLen {
+		return Geometry{}, errInvalidGeometry
+	}
+	srid := binary.LittleEndian.Uint32(b)
+	r := wkbReader{data: b[geometrySRIDLen:]}
+	g, err := r.readGeometry(0)
+	if err != nil || r.pos != len(r.data) {
+		return Geometry{}, errInvalidGeometry
+	}
+	g.setSRID(srid)
+	return g, nil
+}
+
+// GeometrySRID returns the SRID of the geometry stored in the internal format, the data
+// is expected to be validated.
+func GeometrySRID(b []byte) uint32 {
+	return binary.LittleEndian.Uint32(b)
+}
+
+// ParseGeometryWKB parses the geometry from WKB. The coordinates are expected to be in
+// the axis order of the spatial reference system.
+func ParseGeometryWKB(wkb []byte, srid uint32) (Geometry, error) {
+	r := wkbReader{data: wkb}
+	g, err := r.readGeometry(0)
+	if err != nil || r.pos != len(r.data) {
+		return Geometry{}, errInvalidGeometry
+	}
+	g.setSRID(srid)
+	if IsGeographicSRID(srid) {
+		g.swapAxes()
+	}
+	return g, nil
+}
+
+// Encode encodes the geometry into the internal format.
+func (g *Geometry) Encode() []byte {
+	buf := make([]byte, geometrySRIDLen, geometrySRIDLen+g.wkbLen())
+	binary.LittleEndian.PutUint32(buf, g.SRID)
+	return g.appendWKB(buf)
+}
+
+// WKB returns the WKB of the geometry, the coordinates are in the axis order of the
+// spatial reference system.
+func (g *Geometry) WKB() []byte {
+	if IsGeographicSRID(g.SRID) {
+		swapped := g.Clone()
+		swapped.swapAxes()
+		return swapped.appendWKB(make([]byte, 0, g.wkbLen()))
+	}
+	return g.appendWKB(make([]byte, 0, g.wkbLen()))
+}
+
+// Clone returns a deep copy of the geometry.
+func (g *Geometry) Clone() Geometry {
+	ret := Geometry{SRID: g.SRID, Tp: g.Tp}
+	if g.Points != nil {
+		ret.Points = append([]GeoPoint(nil), g.Points...)
+	}
+	if g.Rings != nil {
+		ret.Rings = make([][]GeoPoint, 0, len(g.Rings))
+		for _, ring := range g.Rings {
+			ret.Rings = append(ret.Rings, append([]GeoPoint(nil), ring...))
+		}
+	}
+	if g.Geoms != nil {
+		ret.Geoms = make([]Geometry, 0, len(g.Geoms))
+		for i := range g.Geoms {
+			ret.Geoms = append(ret.Geoms, g.Geoms[i].Clone())
+		}
+	}
+	return ret
+}
+
+func (g *Geometry) wkbLen() int {
+	switch g.Tp {
+	case mysql.GeometryTypePoint:
+		return wkbHeaderLen + wkbPointLen
+	case mysql.GeometryTypeLineString:
+		return wkbHeaderLen + 4 + len(g.Points)*wkbPointLen
+	case mysql.GeometryTypePolygon:
+		l := wkbHeaderLen + 4
+		for _, ring := range g.Rings {
+			l += 4 + len(ring)*wkbPointLen
+		}
+		return l
+	default:
+		l := wkbHeaderLen + 4
+		for i := range g.Geoms {
+			l += g.Geoms[i].wkbLen()
+		}
+		return l
+	}
+}
+
+func (g *Geometry) appendWKB(buf []byte) []byte {
+	buf = append(buf, wkbLittleEndian)
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(g.Tp))
+	switch g.Tp {
+	case mysql.GeometryTypePoint:
+		buf = appendWKBPoints(buf, g.Points)
+	case mysql.GeometryTypeLineString:
+		buf = binary.LittleEndian.AppendUint32(buf, uint32(len(g.Points)))
+		buf = appendWKBPoints(buf, g.Points)
+	case mysql.GeometryTypePolygon:
+		buf = binary.LittleEndian.AppendUint32(buf, uint32(len(g.Rings)))
+		for _, ring := range g.Rings {
+			buf = binary.LittleEndian.AppendUint32(buf, uint32(len(ring)))
+			buf = appendWKBPoints(buf, ring)
+		}
+	default:
+		buf = binary.LittleEndian.AppendUint32(buf, uint32(len(g.Geoms)))
+		for i := range g.Geoms {
+			buf = g.Geoms[i].appendWKB(buf)
+		}
+	}
+	return buf
+}
+
+func appendWKBPoints(buf []byte, points []GeoPoint) []byte {
+	for _, p := range points {
+		buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(p.X))
+		buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(p.Y))
+	}
+	return buf
+}
+
+// wkbReader reads the geometries from WKB.
+type wkbReader struct {
+	data  []byte
+	pos   int
+	order binary.ByteOrder
+}
+
+func (r *wkbReader) readUint32() (uint32, error) {
+	if len(r.data)-r.pos < 4 {
+		return 0, errInvalidGeometry
+	}
+	v := r.order.Uint32(r.data[r.pos:])
+	r.pos += 4
+	return v, nil
+}
+
+// readCount reads the number of the elements, each of which takes at least minLen bytes.
+func (r *wkbReader) readCount(minLen int) (int, error) {
+	n, err := r.readUint32()
+	if err != nil {
+		return 0, err
+	}
+	if uint64(n)*uint64(minLen) > uint64(len(r.data)-r.pos) {
+		return 0, errInvalidGeometry
+	}
+	return int(n), nil
+}
+
+func (r *wkbReader) readPoints(n int) ([]GeoPoint, error) {
+	if len(r.data)-r.pos < n*wkbPointLen {
+		return nil, errInvalidGeometry
+	}
+	points := make([]GeoPoint, n)
+	for i := range points {
+		points[i].X = math.Float64frombits(r.order.Uint64(r.data[r.pos:]))
+		points[i].Y = math.Float64frombits(r.order.Uint64(r.data[r.pos+8:]))
+		r.pos += wkbPointLen
+	}
+	if !validPoints(points) {
+		return nil, errInvalidGeometry
+	}
+	return points, nil
+}
+
+func (r *wkbReader) readGeometry(depth int) (g Geometry, err error) {
+	if depth > maxGeometryDepth || len(r.data)-r.pos < wkbHeaderLen {
+		return g, errInvalidGeometry
+	}
+	switch r.data[r.pos] {
+	case wkbBigEndian:
+		r.order = binary.BigEndian
+	case wkbLittleEndian:
+		r.order = binary.LittleEndian
+	default:
+		return g, errInvalidGeometry
+	}
+	r.pos++
+	tp, err := r.readUint32()
+	if err != nil {
+		return g, err
+	}
+	if tp < uint32(mysql.GeometryTypePoint) || tp > uint32(mysql.GeometryTypeGeometryCollection) {
+		return g, errInvalidGeometry
+	}
+	g.Tp = byte(tp)
+	switch g.Tp {
+	case mysql.GeometryTypePoint:
+		g.Points, err = r.readPoints(1)
+	case mysql.GeometryTypeLineString:
+		var n int
+		if n, err = r.readCount(wkbPointLen); err == nil {
+			g.Points, err = r.readPoints(n)
+		}
+	case mysql.GeometryTypePolygon:
+		var numRings, numPoints int
+		if numRings, err = r.readCount(4); err != nil {
+			return g, err
+		}
+		g.Rings = make([][]GeoPoint, 0, numRings)
+		for range numRings {
+			var ring []GeoPoint
+			if numPoints, err = r.readCount(wkbPointLen); err != nil {
+				return g, err
+			}
+			if ring, err = r.readPoints(numPoints); err != nil {
+				return g, err
+			}
+			g.Rings = append(g.Rings, ring)
+		}
+	default:
+		var n int
+		if n, err = r.readCount(wkbHeaderLen); err != nil {
+			return g, err
+		}
+		g.Geoms = make([]Geometry, 0, n)
+		for range n {
+			elem, err := r.readGeometry(depth + 1)
+			if err != nil {
+				return g, err
+			}
+			g.Geoms = append(g.Geoms, elem)
+		}
+	}
+	if err != nil {
+		return g, err
+	}
+	if depth == 0 && !g.Valid() {
+		return g, errInvalidGeometry
+	}
+	return g, nil
+}
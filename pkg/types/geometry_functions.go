@@ -0,0 +1,456 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"math"
+	"slices"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+)
+
+// The functions in this file compute on the Cartesian plane. For geographic spatial
+// reference systems, the longitude and latitude are used as the coordinates directly.
+
+// ErrGeometryTypeUnsupported is returned when the function doesn't support the type of the geometry.
+var ErrGeometryTypeUnsupported = errors.New("unsupported geometry type")
+
+// geoEpsilon is the relative tolerance to check whether a point is on a segment.
+const geoEpsilon = 1e-9
+
+// geoComponents is the decomposition of a geometry into the basic components.
+type geoComponents struct {
+	points   []GeoPoint
+	lines    [][]GeoPoint
+	polygons [][][]GeoPoint
+}
+
+func (g *Geometry) components() *geoComponents {
+	c := &geoComponents{}
+	c.add(g)
+	return c
+}
+
+func (c *geoComponents) add(g *Geometry) {
+	switch g.Tp {
+	case mysql.GeometryTypePoint:
+		c.points = append(c.points, g.Points[0])
+	case mysql.GeometryTypeLineString:
+		c.lines = append(c.lines, g.Points)
+	case mysql.GeometryTypePolygon:
+		c.polygons = append(c.polygons, g.Rings)
+	default:
+		for i := range g.Geoms {
+			c.add(&g.Geoms[i])
+		}
+	}
+}
+
+// forEachSegment calls fn for all the segments of the lines and the polygon rings. The
+// points are passed as degenerate segments if withPoints is true. It stops if fn returns true.
+func (c *geoComponents) forEachSegment(withPoints bool, fn func(a, b GeoPoint) bool) bool {
+	if withPoints {
+		for _, p := range c.points {
+			if fn(p, p) {
+				return true
+			}
+		}
+	}
+	for _, line := range c.lines {
+		for i := 1; i < len(line); i++ {
+			if fn(line[i-1], line[i]) {
+				return true
+			}
+		}
+	}
+	for _, polygon := range c.polygons {
+		for _, ring := range polygon {
+			for i := 1; i < len(ring); i++ {
+				if fn(ring[i-1], ring[i]) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func (c *geoComponents) isEmpty() bool {
+	return len(c.points) == 0 && len(c.lines) == 0 && len(c.polygons) == 0
+}
+
+func cross(o, a, b GeoPoint) float64 {
+	return (a.X-o.X)*(b.Y-o.Y) - (a.Y-o.Y)*(b.X-o.X)
+}
+
+func geoDist(a, b GeoPoint) float64 {
+	return math.Hypot(a.X-b.X, a.Y-b.Y)
+}
+
+func geoTolerance(a, b GeoPoint) float64 {
+	return geoEpsilon * (1 + max(math.Abs(a.X), math.Abs(a.Y), math.Abs(b.X), math.Abs(b.Y)))
+}
+
+// pointSegmentDistance returns the distance from p to the segment ab.
+func pointSegmentDistance(p, a, b GeoPoint) float64 {
+	dx, dy := b.X-a.X, b.Y-a.Y
+	l2 := dx*dx + dy*dy
+	if l2 == 0 {
+		return geoDist(p, a)
+	}
+	t := ((p.X-a.X)*dx + (p.Y-a.Y)*dy) / l2
+	t = math.Max(0, math.Min(1, t))
+	return geoDist(p, GeoPoint{X: a.X + t*dx, Y: a.Y + t*dy})
+}
+
+func onSegment(p, a, b GeoPoint) bool {
+	return pointSegmentDistance(p, a, b) <= geoTolerance(a, b)
+}
+
+// segmentsIntersect returns whether the segments ab and cd have common points.
+func segmentsIntersect(a, b, c, d GeoPoint) bool {
+	d1, d2 := cross(c, d, a), cross(c, d, b)
+	d3, d4 := cross(a, b, c), cross(a, b, d)
+	if ((d1 > 0 && d2 < 0) || (d1 < 0 && d2 > 0)) && ((d3 > 0 && d4 < 0) || (d3 < 0 && d4 > 0)) {
+		return true
+	}
+	return onSegment(a, c, d) || onSegment(b, c, d) || onSegment(c, a, b) || onSegment(d, a, b)
+}
+
+func segmentsDistance(a, b, c, d GeoPoint) float64 {
+	if segmentsIntersect(a, b, c, d) {
+		return 0
+	}
+	return min(pointSegmentDistance(a, c, d), pointSegmentDistance(b, c, d),
+		pointSegmentDistance(c, a, b), pointSegmentDistance(d, a, b))
+}
+
+// pointInRing returns 1 if p is inside the ring, 0 if p is on the ring, and -1 otherwise.
+func pointInRing(p GeoPoint, ring []GeoPoint) int {
+	inside := false
+	for i := 1; i < len(ring); i++ {
+		a, b := ring[i-1], ring[i]
+		if onSegment(p, a, b) {
+			return 0
+		}
+		if (a.Y > p.Y) != (b.Y > p.Y) && p.X < (b.X-a.X)*(p.Y-a.Y)/(b.Y-a.Y)+a.X {
+			inside = !inside
+		}
+	}
+	if inside {
+		return 1
+	}
+	return -1
+}
+
+// pointInPolygon returns 1 if p is in the interior of the polygon, 0 if p is on the
+// boundary, and -1 otherwise.
+func pointInPolygon(p GeoPoint, rings [][]GeoPoint) int {
+	ret := pointInRing(p, rings[0])
+	if ret <= 0 {
+		return ret
+	}
+	for _, hole := range rings[1:] {
+		switch pointInRing(p, hole) {
+		case 0:
+			return 0
+		case 1:
+			return -1
+		}
+	}
+	return 1
+}
+
+// locatePoint returns 1 if p is in the interior of the geometry, 0 if p is on the
+// boundary, and -1 otherwise.
+func (c *geoComponents) locatePoint(p GeoPoint) int {
+	ret := -1
+	if slices.Contains(c.points, p) {
+		return 1
+	}
+	for _, line := range c.lines {
+		for i := 1; i < len(line); i++ {
+			if !onSegment(p, line[i-1], line[i]) {
+				continue
+			}
+			// The endpoints of a linestring that is not closed are its boundary.
+			isEndpoint := line[0] != line[len(line)-1] && (geoDist(p, line[0]) <= geoTolerance(p, line[0]) ||
+				geoDist(p, line[len(line)-1]) <= geoTolerance(p, line[len(line)-1]))
+			if !isEndpoint {
+				return 1
+			}
+			ret = 0
+		}
+	}
+	for _, polygon := range c.polygons {
+		ret = max(ret, pointInPolygon(p, polygon))
+		if ret == 1 {
+			return ret
+		}
+	}
+	return ret
+}
+
+// segmentPieces splits the segment ab by all the components of c, and returns the
+// midpoints of the pieces. Each piece is either entirely covered by c or not.
+func (c *geoComponents) segmentPieces(a, b GeoPoint) []GeoPoint {
+	dx, dy := b.X-a.X, b.Y-a.Y
+	l2 := dx*dx + dy*dy
+	if l2 == 0 {
+		return []GeoPoint{a}
+	}
+	param := func(p GeoPoint) float64 {
+		return ((p.X-a.X)*dx + (p.Y-a.Y)*dy) / l2
+	}
+	ts := []float64{0, 1}
+	c.forEachSegment(true, func(p, q GeoPoint) bool {
+		if onSegment(p, a, b) {
+			ts = append(ts, param(p))
+		}
+		if onSegment(q, a, b) {
+			ts = append(ts, param(q))
+		}
+		if denom := dx*(q.Y-p.Y) - dy*(q.X-p.X); denom != 0 && segmentsIntersect(a, b, p, q) {
+			ts = append(ts, ((p.X-a.X)*(q.Y-p.Y)-(p.Y-a.Y)*(q.X-p.X))/denom)
+		}
+		return false
+	})
+	slices.Sort(ts)
+	mids := make([]GeoPoint, 0, len(ts))
+	for i := 1; i < len(ts); i++ {
+		t0, t1 := math.Max(0, ts[i-1]), math.Min(1, ts[i])
+		if t1 <= t0 {
+			continue
+		}
+		t := (t0 + t1) / 2
+		mids = append(mids, GeoPoint{X: a.X + t*dx, Y: a.Y + t*dy})
+	}
+	return mids
+}
+
+// covers returns whether every point of o is a point of c. If interior is not nil, it's
+// set to whether the interior of o intersects the interior of c.
+func (c *geoComponents) covers(o *geoComponents, interior *bool) bool {
+	checkPoint := func(p GeoPoint) bool {
+		loc := c.locatePoint(p)
+		if loc == 1 && interior != nil {
+			*interior = true
+		}
+		return loc >= 0
+	}
+	for _, p := range o.points {
+		if !checkPoint(p) {
+			return false
+		}
+	}
+	notCovered := o.forEachSegment(false, func(a, b GeoPoint) bool {
+		if !checkPoint(a) || !checkPoint(b) {
+			return true
+		}
+		for _, mid := range c.segmentPieces(a, b) {
+			if !checkPoint(mid) {
+				return true
+			}
+		}
+		return false
+	})
+	if notCovered {
+		return false
+	}
+	// The boundary of the polygon is covered, but the holes of c may be inside the polygon.
+	for _, polygon := range o.polygons {
+		for _, other := range c.polygons {
+			for _, hole := range other[1:] {
+				for i := 1; i < len(hole); i++ {
+					mid := GeoPoint{X: (hole[i-1].X + hole[i].X) / 2, Y: (hole[i-1].Y + hole[i].Y) / 2}
+					if pointInPolygon(hole[i], polygon) == 1 || pointInPolygon(mid, polygon) == 1 {
+						return false
+					}
+				}
+			}
+		}
+		if interior != nil {
+			*interior = true
+		}
+	}
+	return true
+}
+
+// GeoIntersects returns whether the geometries have common points.
+func GeoIntersects(g1, g2 *Geometry) bool {
+	c1, c2 := g1.components(), g2.components()
+	for _, p := range c2.points {
+		if c1.locatePoint(p) >= 0 {
+			return true
+		}
+	}
+	for _, p := range c1.points {
+		if c2.locatePoint(p) >= 0 {
+			return true
+		}
+	}
+	intersects := c1.forEachSegment(false, func(a, b GeoPoint) bool {
+		return c2.forEachSegment(false, func(c, d GeoPoint) bool {
+			return segmentsIntersect(a, b, c, d)
+		})
+	})
+	if intersects {
+		return true
+	}
+	// One of the geometries may be entirely inside a polygon of the other one.
+	for _, line := range c2.lines {
+		if c1.locatePoint(line[0]) >= 0 {
+			return true
+		}
+	}
+	for _, polygon := range c2.polygons {
+		if c1.locatePoint(polygon[0][0]) >= 0 {
+			return true
+		}
+	}
+	for _, line := range c1.lines {
+		if c2.locatePoint(line[0]) >= 0 {
+			return true
+		}
+	}
+	for _, polygon := range c1.polygons {
+		if c2.locatePoint(polygon[0][0]) >= 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// GeoContains returns whether g1 contains g2, which means no points of g2 lie in the
+// exterior of g1, and at least one point of the interior of g2 lies in the interior of g1.
+func GeoContains(g1, g2 *Geometry) bool {
+	c1, c2 := g1.components(), g2.components()
+	if c1.isEmpty() || c2.isEmpty() {
+		return false
+	}
+	interior := false
+	return c1.covers(c2, &interior) && interior
+}
+
+// GeoEquals returns whether the geometries are spatially equal.
+func GeoEquals(g1, g2 *Geometry) bool {
+	c1, c2 := g1.components(), g2.components()
+	if c1.isEmpty() || c2.isEmpty() {
+		return c1.isEmpty() && c2.isEmpty()
+	}
+	return c1.covers(c2, nil) && c2.covers(c1, nil)
+}
+
+// GeoDistance returns the minimum distance between the geometries.
+// It returns false if either of the geometries is empty.
+func GeoDistance(g1, g2 *Geometry) (float64, bool) {
+	c1, c2 := g1.components(), g2.components()
+	if c1.isEmpty() || c2.isEmpty() {
+		return 0, false
+	}
+	if GeoIntersects(g1, g2) {
+		return 0, true
+	}
+	dist := math.Inf(1)
+	c1.forEachSegment(true, func(a, b GeoPoint) bool {
+		c2.forEachSegment(true, func(c, d GeoPoint) bool {
+			dist = math.Min(dist, segmentsDistance(a, b, c, d))
+			return false
+		})
+		return false
+	})
+	return dist, true
+}
+
+// GeoArea returns the area of the Polygon or MultiPolygon.
+func GeoArea(g *Geometry) (float64, error) {
+	switch g.Tp {
+	case mysql.GeometryTypePolygon:
+		area := math.Abs(ringArea(g.Rings[0]))
+		for _, hole := range g.Rings[1:] {
+			area -= math.Abs(ringArea(hole))
+		}
+		return area, nil
+	case mysql.GeometryTypeMultiPolygon:
+		area := 0.0
+		for i := range g.Geoms {
+			a, err := GeoArea(&g.Geoms[i])
+			if err != nil {
+				return 0, err
+			}
+			area += a
+		}
+		return area, nil
+	}
+	return 0, ErrGeometryTypeUnsupported
+}
+
+func ringArea(ring []GeoPoint) float64 {
+	area := 0.0
+	for i := 1; i < len(ring); i++ {
+		area += ring[i-1].X*ring[i].Y - ring[i].X*ring[i-1].Y
+	}
+	return area / 2
+}
+
+// GeoLength returns the length of the LineString or MultiLineString.
+func GeoLength(g *Geometry) (float64, error) {
+	switch g.Tp {
+	case mysql.GeometryTypeLineString:
+		length := 0.0
+		for i := 1; i < len(g.Points); i++ {
+			length += geoDist(g.Points[i-1], g.Points[i])
+		}
+		return length, nil
+	case mysql.GeometryTypeMultiLineString:
+		length := 0.0
+		for i := range g.Geoms {
+			l, err := GeoLength(&g.Geoms[i])
+			if err != nil {
+				return 0, err
+			}
+			length += l
+		}
+		return length, nil
+	}
+	return 0, ErrGeometryTypeUnsupported
+}
+
+// GeoDistanceSphere returns the minimum spherical distance between the Points or MultiPoints
+// on a sphere with the radius. X is the longitude and Y is the latitude in degrees.
+func GeoDistanceSphere(g1, g2 *Geometry, radius float64) (float64, error) {
+	for _, g := range []*Geometry{g1, g2} {
+		if g.Tp != mysql.GeometryTypePoint && g.Tp != mysql.GeometryTypeMultiPoint {
+			return 0, ErrGeometryTypeUnsupported
+		}
+	}
+	dist := math.Inf(1)
+	c1, c2 := g1.components(), g2.components()
+	for _, p := range c1.points {
+		for _, q := range c2.points {
+			dist = math.Min(dist, haversine(p, q, radius))
+		}
+	}
+	return dist, nil
+}
+
+func haversine(p, q GeoPoint, radius float64) float64 {
+	const rad = math.Pi / 180
+	lat1, lat2 := p.Y*rad, q.Y*rad
+	dLat, dLon := (q.Y-p.Y)*rad, (q.X-p.X)*rad
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * radius * math.Asin(math.Min(1, math.Sqrt(h)))
+}
@@ -0,0 +1,331 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+)
+
+// The options of ST_AsGeoJSON.
+const (
+	// GeoJSONOptionBoundingBox adds the bounding box to the output.
+	GeoJSONOptionBoundingBox = 1
+	// GeoJSONOptionShortCRS adds the short format CRS URN like "EPSG:4326" to the output.
+	GeoJSONOptionShortCRS = 2
+	// GeoJSONOptionLongCRS adds the long format CRS URN like "urn:ogc:def:crs:EPSG::4326" to the output.
+	// It overrides GeoJSONOptionShortCRS.
+	GeoJSONOptionLongCRS = 4
+)
+
+// GeoJSONOptionRejectHigherDimensions is the option of ST_GeomFromGeoJSON, which rejects
+// the documents with coordinates of higher dimensions instead of stripping them.
+const GeoJSONOptionRejectHigherDimensions = 1
+
+var geoJSONTypes = map[byte]string{
+	mysql.GeometryTypePoint:              "Point",
+	mysql.GeometryTypeLineString:         "LineString",
+	mysql.GeometryTypePolygon:            "Polygon",
+	mysql.GeometryTypeMultiPoint:         "MultiPoint",
+	mysql.GeometryTypeMultiLineString:    "MultiLineString",
+	mysql.GeometryTypeMultiPolygon:       "MultiPolygon",
+	mysql.GeometryTypeGeometryCollection: "GeometryCollection",
+}
+
+// GeoJSON returns the GeoJSON of the geometry. The coordinates are rounded to maxDecimals
+// digits after the decimal point, and options are the flags like GeoJSONOptionBoundingBox.
+// The coordinates of GeoJSON are always in the longitude-latitude order.
+func (g *Geometry) GeoJSON(maxDecimals int, options int) (BinaryJSON, error) {
+	obj := g.geoJSONObject(maxDecimals)
+	if options&GeoJSONOptionBoundingBox != 0 && !g.IsEmpty() {
+		minPt, maxPt := g.boundingBox()
+		obj["bbox"] = []any{
+			roundGeoJSONCoordinate(minPt.X, maxDecimals), roundGeoJSONCoordinate(minPt.Y, maxDecimals),
+			roundGeoJSONCoordinate(maxPt.X, maxDecimals), roundGeoJSONCoordinate(maxPt.Y, maxDecimals),
+		}
+	}
+	if options&(GeoJSONOptionShortCRS|GeoJSONOptionLongCRS) != 0 && g.SRID != SRIDCartesian {
+		name := fmt.Sprintf("EPSG:%d", g.SRID)
+		if options&GeoJSONOptionLongCRS != 0 {
+			name = fmt.Sprintf("urn:ogc:def:crs:EPSG::%d", g.SRID)
+		}
+		obj["crs"] = map[string]any{
+			"type":       "name",
+			"properties": map[string]any{"name": name},
+		}
+	}
+	return CreateBinaryJSONWithCheck(obj)
+}
+
+func (g *Geometry) geoJSONObject(maxDecimals int) map[string]any {
+	obj := map[string]any{"type": geoJSONTypes[g.Tp]}
+	switch g.Tp {
+	case mysql.GeometryTypePoint:
+		obj["coordinates"] = geoJSONPoint(g.Points[0], maxDecimals)
+	case mysql.GeometryTypeLineString:
+		obj["coordinates"] = geoJSONPoints(g.Points, maxDecimals)
+	case mysql.GeometryTypePolygon:
+		obj["coordinates"] = geoJSONRings(g.Rings, maxDecimals)
+	case mysql.GeometryTypeGeometryCollection:
+		geoms := make([]any, 0, len(g.Geoms))
+		for i := range g.Geoms {
+			geoms = append(geoms, g.Geoms[i].geoJSONObject(maxDecimals))
+		}
+		obj["geometries"] = geoms
+	default:
+		coords := make([]any, 0, len(g.Geoms))
+		for i := range g.Geoms {
+			coords = append(coords, g.Geoms[i].geoJSONObject(maxDecimals)["coordinates"])
+		}
+		obj["coordinates"] = coords
+	}
+	return obj
+}
+
+func geoJSONPoint(pt GeoPoint, maxDecimals int) []any {
+	return []any{roundGeoJSONCoordinate(pt.X, maxDecimals), roundGeoJSONCoordinate(pt.Y, maxDecimals)}
+}
+
+func geoJSONPoints(points []GeoPoint, maxDecimals int) []any {
+	ret := make([]any, 0, len(points))
+	for _, pt := range points {
+		ret = append(ret, geoJSONPoint(pt, maxDecimals))
+	}
+	return ret
+}
+
+func geoJSONRings(rings [][]GeoPoint, maxDecimals int) []any {
+	ret := make([]any, 0, len(rings))
+	for _, ring := range rings {
+		ret = append(ret, geoJSONPoints(ring, maxDecimals))
+	}
+	return ret
+}
+
+func roundGeoJSONCoordinate(f float64, maxDecimals int) float64 {
+	// float64 has no more than 17 significant digits, so there is no need to round.
+	if maxDecimals > 17 {
+		return f
+	}
+	return Round(f, maxDecimals)
+}
+
+// ParseGeometryGeoJSON parses the geometry from GeoJSON. Both the Feature and the
+// FeatureCollection objects are accepted, and the latter is parsed as a GeometryCollection.
+func ParseGeometryGeoJSON(bj BinaryJSON, options int, srid uint32) (Geometry, error) {
+	p := geoJSONParser{rejectHigherDims: options == GeoJSONOptionRejectHigherDimensions}
+	g, err := p.parseGeometry(bj, 0)
+	if err != nil {
+		return Geometry{}, err
+	}
+	if !g.Valid() {
+		return Geometry{}, errInvalidGeometry
+	}
+	g.setSRID(srid)
+	return g, nil
+}
+
+type geoJSONParser struct {
+	rejectHigherDims bool
+}
+
+func geoJSONMember(bj BinaryJSON, key string) (BinaryJSON, bool) {
+	if bj.TypeCode != JSONTypeCodeObject {
+		return BinaryJSON{}, false
+	}
+	return bj.objectSearchKey([]byte(key))
+}
+
+func geoJSONArray(bj BinaryJSON, key string) ([]BinaryJSON, error) {
+	arr, ok := geoJSONMember(bj, key)
+	if !ok || arr.TypeCode != JSONTypeCodeArray {
+		return nil, errInvalidGeometry
+	}
+	elems := make([]BinaryJSON, 0, arr.GetElemCount())
+	for i := range arr.GetElemCount() {
+		elems = append(elems, arr.ArrayGetElem(i))
+	}
+	return elems, nil
+}
+
+func (p *geoJSONParser) parseGeometry(bj BinaryJSON, depth int) (g Geometry, err error) {
+	if depth > maxGeometryDepth {
+		return g, errInvalidGeometry
+	}
+	tpJSON, ok := geoJSONMember(bj, "type")
+	if !ok || tpJSON.TypeCode != JSONTypeCodeString {
+		return g, errInvalidGeometry
+	}
+	switch tp := string(tpJSON.GetString()); tp {
+	case "Feature":
+		geom, ok := geoJSONMember(bj, "geometry")
+		if !ok {
+			return g, errInvalidGeometry
+		}
+		return p.parseGeometry(geom, depth+1)
+	case "FeatureCollection":
+		features, err := geoJSONArray(bj, "features")
+		if err != nil {
+			return g, err
+		}
+		g.Tp = mysql.GeometryTypeGeometryCollection
+		g.Geoms = make([]Geometry, 0, len(features))
+		for _, feature := range features {
+			elem, err := p.parseGeometry(feature, depth+1)
+			if err != nil {
+				return g, err
+			}
+			g.Geoms = append(g.Geoms, elem)
+		}
+		return g, nil
+	case "GeometryCollection":
+		geoms, err := geoJSONArray(bj, "geometries")
+		if err != nil {
+			return g, err
+		}
+		g.Tp = mysql.GeometryTypeGeometryCollection
+		g.Geoms = make([]Geometry, 0, len(geoms))
+		for _, geom := range geoms {
+			elem, err := p.parseGeometry(geom, depth+1)
+			if err != nil {
+				return g, err
+			}
+			g.Geoms = append(g.Geoms, elem)
+		}
+		return g, nil
+	default:
+		for geomTp, name := range geoJSONTypes {
+			if name == tp {
+				g.Tp = geomTp
+			}
+		}
+		if g.Tp == mysql.GeometryTypeGeometry {
+			return g, errInvalidGeometry
+		}
+		coords, ok := geoJSONMember(bj, "coordinates")
+		if !ok {
+			return g, errInvalidGeometry
+		}
+		err = p.parseCoordinates(&g, coords)
+		return g, err
+	}
+}
+
+// parseCoordinates parses the coordinates of the geometry whose type is not GeometryCollection.
+func (p *geoJSONParser) parseCoordinates(g *Geometry, coords BinaryJSON) (err error) {
+	switch g.Tp {
+	case mysql.GeometryTypePoint:
+		var pt GeoPoint
+		pt, err = p.parsePoint(coords)
+		g.Points = []GeoPoint{pt}
+	case mysql.GeometryTypeLineString:
+		g.Points, err = p.parsePoints(coords)
+	case mysql.GeometryTypePolygon:
+		if coords.TypeCode != JSONTypeCodeArray {
+			return errInvalidGeometry
+		}
+		g.Rings = make([][]GeoPoint, 0, coords.GetElemCount())
+		for i := range coords.GetElemCount() {
+			ring, err := p.parsePoints(coords.ArrayGetElem(i))
+			if err != nil {
+				return err
+			}
+			g.Rings = append(g.Rings, ring)
+		}
+	default:
+		if coords.TypeCode != JSONTypeCodeArray {
+			return errInvalidGeometry
+		}
+		elemTp := g.Tp - (mysql.GeometryTypeMultiPoint - mysql.GeometryTypePoint)
+		g.Geoms = make([]Geometry, 0, coords.GetElemCount())
+		for i := range coords.GetElemCount() {
+			elem := Geometry{Tp: elemTp}
+			if err := p.parseCoordinates(&elem, coords.ArrayGetElem(i)); err != nil {
+				return err
+			}
+			g.Geoms = append(g.Geoms, elem)
+		}
+	}
+	return err
+}
+
+func (p *geoJSONParser) parsePoints(coords BinaryJSON) ([]GeoPoint, error) {
+	if coords.TypeCode != JSONTypeCodeArray {
+		return nil, errInvalidGeometry
+	}
+	points := make([]GeoPoint, 0, coords.GetElemCount())
+	for i := range coords.GetElemCount() {
+		pt, err := p.parsePoint(coords.ArrayGetElem(i))
+		if err != nil {
+			return nil, err
+		}
+		points = append(points, pt)
+	}
+	return points, nil
+}
+
+func (p *geoJSONParser) parsePoint(coords BinaryJSON) (pt GeoPoint, err error) {
+	if coords.TypeCode != JSONTypeCodeArray || coords.GetElemCount() < 2 {
+		return pt, errInvalidGeometry
+	}
+	if coords.GetElemCount() > 2 && p.rejectHigherDims {
+		return pt, errInvalidGeometry
+	}
+	if pt.X, err = geoJSONNumber(coords.ArrayGetElem(0)); err != nil {
+		return pt, err
+	}
+	pt.Y, err = geoJSONNumber(coords.ArrayGetElem(1))
+	return pt, err
+}
+
+func geoJSONNumber(bj BinaryJSON) (float64, error) {
+	switch bj.TypeCode {
+	case JSONTypeCodeFloat64:
+		return bj.GetFloat64(), nil
+	case JSONTypeCodeInt64:
+		return float64(bj.GetInt64()), nil
+	case JSONTypeCodeUint64:
+		return float64(bj.GetUint64()), nil
+	}
+	return 0, errInvalidGeometry
+}
+
+// boundingBox returns the minimum and maximum coordinates of the geometry.
+func (g *Geometry) boundingBox() (minPt, maxPt GeoPoint) {
+	minPt = GeoPoint{X: math.Inf(1), Y: math.Inf(1)}
+	maxPt = GeoPoint{X: math.Inf(-1), Y: math.Inf(-1)}
+	g.ForEachPoint(func(pt GeoPoint) {
+		minPt.X, minPt.Y = math.Min(minPt.X, pt.X), math.Min(minPt.Y, pt.Y)
+		maxPt.X, maxPt.Y = math.Max(maxPt.X, pt.X), math.Max(maxPt.Y, pt.Y)
+	})
+	return minPt, maxPt
+}
+
+// ForEachPoint calls fn for all the points of the geometry.
+func (g *Geometry) ForEachPoint(fn func(GeoPoint)) {
+	for _, pt := range g.Points {
+		fn(pt)
+	}
+	for _, ring := range g.Rings {
+		for _, pt := range ring {
+			fn(pt)
+		}
+	}
+	for i := range g.Geoms {
+		g.Geoms[i].ForEachPoint(fn)
+	}
+}
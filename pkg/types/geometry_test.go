@@ -0,0 +1,300 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/hex"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeometryWKT(t *testing.T) {
+	tests := []struct {
+		input  string
+		output string
+		tp     byte
+	}{
+		{"POINT(1 2)", "POINT(1 2)", mysql.GeometryTypePoint},
+		{" point ( -1.5   2e3 ) ", "POINT(-1.5 2000)", mysql.GeometryTypePoint},
+		{"POINT(1e30 0.000001)", "POINT(1e30 0.000001)", mysql.GeometryTypePoint},
+		{"POINT(-1.5e-16 123456789012345678)", "POINT(-1.5e-16 1.2345678901234568e17)", mysql.GeometryTypePoint},
+		{"LINESTRING(0 0, 1 1, 2 0)", "LINESTRING(0 0,1 1,2 0)", mysql.GeometryTypeLineString},
+		{"POLYGON((0 0,4 0,4 4,0 4,0 0),(1 1,2 1,2 2,1 1))", "POLYGON((0 0,4 0,4 4,0 4,0 0),(1 1,2 1,2 2,1 1))", mysql.GeometryTypePolygon},
+		{"MULTIPOINT(0 0, 1 1)", "MULTIPOINT((0 0),(1 1))", mysql.GeometryTypeMultiPoint},
+		{"MULTIPOINT((0 0), (1 1))", "MULTIPOINT((0 0),(1 1))", mysql.GeometryTypeMultiPoint},
+		{"MULTILINESTRING((0 0,1 1),(2 2,3 3))", "MULTILINESTRING((0 0,1 1),(2 2,3 3))", mysql.GeometryTypeMultiLineString},
+		{"MULTIPOLYGON(((0 0,1 0,1 1,0 0)),((2 2,3 2,3 3,2 2)))", "MULTIPOLYGON(((0 0,1 0,1 1,0 0)),((2 2,3 2,3 3,2 2)))", mysql.GeometryTypeMultiPolygon},
+		{"GEOMETRYCOLLECTION(POINT(1 2),LINESTRING(0 0,1 1))", "GEOMETRYCOLLECTION(POINT(1 2),LINESTRING(0 0,1 1))", mysql.GeometryTypeGeometryCollection},
+		{"GEOMCOLLECTION(GEOMCOLLECTION(POINT(1 2)))", "GEOMETRYCOLLECTION(GEOMETRYCOLLECTION(POINT(1 2)))", mysql.GeometryTypeGeometryCollection},
+		{"GEOMETRYCOLLECTION EMPTY", "GEOMETRYCOLLECTION EMPTY", mysql.GeometryTypeGeometryCollection},
+		{"GEOMCOLLECTION()", "GEOMETRYCOLLECTION EMPTY", mysql.GeometryTypeGeometryCollection},
+	}
+	for _, tt := range tests {
+		g, err := ParseGeometryWKT(tt.input, 0)
+		require.NoError(t, err, tt.input)
+		require.Equal(t, tt.tp, g.Tp, tt.input)
+		require.Equal(t, tt.output, g.WKT(), tt.input)
+
+		// The geometry is not changed after encoding and decoding.
+		decoded, err := DecodeGeometry(g.Encode())
+		require.NoError(t, err, tt.input)
+		require.Equal(t, tt.output, decoded.WKT(), tt.input)
+		fromWKB, err := ParseGeometryWKB(g.WKB(), 0)
+		require.NoError(t, err, tt.input)
+		require.Equal(t, tt.output, fromWKB.WKT(), tt.input)
+	}
+
+	invalids := []string{
+		"",
+		"POINT",
+		"POINT()",
+		"POINT(1)",
+		"POINT(1 2 3)",
+		"POINT(1 2",
+		"POINT(1 2) x",
+		"POINT(a b)",
+		"LINESTRING(0 0)",
+		"POLYGON((0 0,1 0,1 1))",
+		"POLYGON((0 0,1 0,1 1,0 1))",
+		"MULTIPOINT()",
+		"MULTIPOINT EMPTY",
+		"MULTIPOLYGON((0 0,1 0,1 1,0 0))",
+		"GEOMETRYCOLLECTION(POINT(1 2),)",
+		"CIRCLE(0 0, 1)",
+	}
+	for _, input := range invalids {
+		_, err := ParseGeometryWKT(input, 0)
+		require.Error(t, err, input)
+	}
+}
+
+func TestGeometryWKB(t *testing.T) {
+	// POINT(1 2) in big endian.
+	wkb, err := hex.DecodeString("00000000013FF00000000000004000000000000000")
+	require.NoError(t, err)
+	g, err := ParseGeometryWKB(wkb, 0)
+	require.NoError(t, err)
+	require.Equal(t, "POINT(1 2)", g.WKT())
+	require.Equal(t, "0101000000000000000000F03F0000000000000040", strings.ToUpper(hex.EncodeToString(g.WKB())))
+	require.Equal(t, "000000000101000000000000000000F03F0000000000000040", strings.ToUpper(hex.EncodeToString(g.Encode())))
+
+	g.SRID = 4326
+	require.Equal(t, "E61000000101000000000000000000F03F0000000000000040", strings.ToUpper(hex.EncodeToString(g.Encode())))
+
+	invalids := []string{
+		"",
+		"01",
+		// Unknown byte order.
+		"0201000000000000000000F03F0000000000000040",
+		// Unknown type.
+		"0108000000000000000000F03F0000000000000040",
+		// Truncated.
+		"0101000000000000000000F03F00000000000000",
+		// Trailing bytes.
+		"0101000000000000000000F03F000000000000004000",
+		// Too many points.
+		"0102000000FFFFFFFF",
+		// A MultiPoint containing a LineString.
+		"0104000000010000000102000000020000000000000000000000000000000000000000000000000000000000F03F000000000000F03F",
+	}
+	for _, input := range invalids {
+		wkb, err := hex.DecodeString(input)
+		require.NoError(t, err)
+		_, err = ParseGeometryWKB(wkb, 0)
+		require.Error(t, err, input)
+	}
+	_, err = DecodeGeometry([]byte{0, 0, 0})
+	require.Error(t, err)
+}
+
+func TestGeometryGeographicAxisOrder(t *testing.T) {
+	// The latitude comes first in WKT and WKB for SRID 4326, but the points are stored
+	// in the longitude-latitude order.
+	g, err := ParseGeometryWKT("POINT(10 20)", SRIDWGS84)
+	require.NoError(t, err)
+	require.Equal(t, GeoPoint{X: 20, Y: 10}, g.Points[0])
+	require.Equal(t, "POINT(10 20)", g.WKT())
+	fromWKB, err := ParseGeometryWKB(g.WKB(), SRIDWGS84)
+	require.NoError(t, err)
+	require.Equal(t, g, fromWKB)
+
+	require.NoError(t, CheckSRID(SRIDCartesian))
+	require.NoError(t, CheckSRID(SRIDWGS84))
+	require.True(t, ErrSRSNotFound.Equal(CheckSRID(3857)))
+}
+
+func TestGeometryGeoJSON(t *testing.T) {
+	g, err := ParseGeometryWKT("POLYGON((0 0,4 0,4 4,0 0))", SRIDCartesian)
+	require.NoError(t, err)
+	bj, err := g.GeoJSON(math.MaxInt32, 0)
+	require.NoError(t, err)
+	require.Equal(t, `{"coordinates": [[[0.0, 0.0], [4.0, 0.0], [4.0, 4.0], [0.0, 0.0]]], "type": "Polygon"}`, bj.String())
+
+	g, err = ParseGeometryWKT("POINT(11.11111 12.22222)", SRIDWGS84)
+	require.NoError(t, err)
+	bj, err = g.GeoJSON(2, GeoJSONOptionBoundingBox|GeoJSONOptionShortCRS)
+	require.NoError(t, err)
+	require.Equal(t, `{"bbox": [12.22, 11.11, 12.22, 11.11], "coordinates": [12.22, 11.11], "crs": {"properties": {"name": "EPSG:4326"}, "type": "name"}, "type": "Point"}`, bj.String())
+
+	tests := []struct {
+		input string
+		wkt   string
+	}{
+		{`{"type": "Point", "coordinates": [1, 2.5]}`, "POINT(1 2.5)"},
+		{`{"type": "LineString", "coordinates": [[0, 0], [1, 1]]}`, "LINESTRING(0 0,1 1)"},
+		{`{"type": "MultiPoint", "coordinates": [[0, 0], [1, 1]]}`, "MULTIPOINT((0 0),(1 1))"},
+		{`{"type": "MultiPolygon", "coordinates": [[[[0, 0], [1, 0], [1, 1], [0, 0]]]]}`, "MULTIPOLYGON(((0 0,1 0,1 1,0 0)))"},
+		{`{"type": "Feature", "geometry": {"type": "Point", "coordinates": [1, 2, 3]}, "properties": {}}`, "POINT(1 2)"},
+		{`{"type": "FeatureCollection", "features": [{"type": "Feature", "geometry": {"type": "Point", "coordinates": [1, 2]}}]}`, "GEOMETRYCOLLECTION(POINT(1 2))"},
+		{`{"type": "GeometryCollection", "geometries": []}`, "GEOMETRYCOLLECTION EMPTY"},
+	}
+	for _, tt := range tests {
+		bj, err := ParseBinaryJSONFromString(tt.input)
+		require.NoError(t, err)
+		g, err := ParseGeometryGeoJSON(bj, GeoJSONOptionRejectHigherDimensions+1, SRIDCartesian)
+		require.NoError(t, err, tt.input)
+		require.Equal(t, tt.wkt, g.WKT(), tt.input)
+	}
+
+	invalids := []string{
+		`[1, 2]`,
+		`{"type": "Point"}`,
+		`{"type": "Point", "coordinates": [1]}`,
+		`{"type": "Point", "coordinates": ["1", 2]}`,
+		`{"type": "Circle", "coordinates": [1, 2]}`,
+		`{"type": "LineString", "coordinates": [[0, 0]]}`,
+		`{"type": "MultiPoint", "coordinates": []}`,
+	}
+	for _, input := range invalids {
+		bj, err := ParseBinaryJSONFromString(input)
+		require.NoError(t, err)
+		_, err = ParseGeometryGeoJSON(bj, GeoJSONOptionRejectHigherDimensions, SRIDCartesian)
+		require.Error(t, err, input)
+	}
+	bj, err = ParseBinaryJSONFromString(`{"type": "Point", "coordinates": [1, 2, 3]}`)
+	require.NoError(t, err)
+	_, err = ParseGeometryGeoJSON(bj, GeoJSONOptionRejectHigherDimensions, SRIDCartesian)
+	require.Error(t, err)
+}
+
+func TestGeometryPredicates(t *testing.T) {
+	mustParse := func(wkt string) *Geometry {
+		g, err := ParseGeometryWKT(wkt, 0)
+		require.NoError(t, err, wkt)
+		return &g
+	}
+	square := "POLYGON((0 0,4 0,4 4,0 4,0 0))"
+	tests := []struct {
+		g1, g2     string
+		intersects bool
+		contains   bool
+		equals     bool
+	}{
+		{"POINT(1 1)", "POINT(1 1)", true, true, true},
+		{"POINT(1 1)", "POINT(1 2)", false, false, false},
+		{square, "POINT(2 2)", true, true, false},
+		{square, "POINT(4 2)", true, false, false},
+		{square, "POINT(5 2)", false, false, false},
+		{square, "LINESTRING(1 1,3 3)", true, true, false},
+		{square, "LINESTRING(0 0,4 0)", true, false, false},
+		{square, "LINESTRING(1 1,5 5)", true, false, false},
+		{square, "LINESTRING(5 5,6 6)", false, false, false},
+		{square, "POLYGON((1 1,2 1,2 2,1 1))", true, true, false},
+		{square, "POLYGON((0 0,4 0,4 4,0 0))", true, true, false},
+		{square, "POLYGON((4 0,0 0,0 4,4 4,4 0))", true, true, true},
+		{square, "POLYGON((3 3,5 3,5 5,3 3))", true, false, false},
+		{square, "POLYGON((5 5,6 5,6 6,5 5))", false, false, false},
+		{"POLYGON((0 0,4 0,4 4,0 4,0 0),(1 1,3 1,3 3,1 3,1 1))", "POINT(2 2)", false, false, false},
+		{"POLYGON((0 0,4 0,4 4,0 4,0 0),(1 1,3 1,3 3,1 3,1 1))", "POLYGON((0.5 0.5,3.5 0.5,3.5 3.5,0.5 3.5,0.5 0.5))", true, false, false},
+		{"POLYGON((0 0,10 0,10 10,0 0))", "POLYGON((1 0.5,2 0.5,2 1,1 0.5))", true, true, false},
+		{"LINESTRING(0 0,4 4)", "POINT(2 2)", true, true, false},
+		{"LINESTRING(0 0,4 4)", "POINT(0 0)", true, false, false},
+		{"LINESTRING(0 0,4 4)", "LINESTRING(1 1,2 2)", true, true, false},
+		{"LINESTRING(0 0,4 4)", "LINESTRING(0 0,2 2,4 4)", true, true, true},
+		{"LINESTRING(0 0,4 4)", "LINESTRING(0 4,4 0)", true, false, false},
+		{"LINESTRING(0 0,1 1)", "LINESTRING(2 2,3 3)", false, false, false},
+		{"MULTIPOINT((0 0),(1 1))", "POINT(1 1)", true, true, false},
+		{"GEOMETRYCOLLECTION(POINT(9 9),POLYGON((0 0,4 0,4 4,0 0)))", "POINT(3 1)", true, true, false},
+		{"GEOMETRYCOLLECTION EMPTY", "POINT(1 1)", false, false, false},
+	}
+	for _, tt := range tests {
+		g1, g2 := mustParse(tt.g1), mustParse(tt.g2)
+		require.Equal(t, tt.intersects, GeoIntersects(g1, g2), "%s intersects %s", tt.g1, tt.g2)
+		require.Equal(t, tt.intersects, GeoIntersects(g2, g1), "%s intersects %s", tt.g2, tt.g1)
+		require.Equal(t, tt.contains, GeoContains(g1, g2), "%s contains %s", tt.g1, tt.g2)
+		require.Equal(t, tt.equals, GeoEquals(g1, g2), "%s equals %s", tt.g1, tt.g2)
+		require.Equal(t, tt.equals, GeoEquals(g2, g1), "%s equals %s", tt.g2, tt.g1)
+	}
+}
+
+func TestGeometryMeasurements(t *testing.T) {
+	mustParse := func(wkt string) *Geometry {
+		g, err := ParseGeometryWKT(wkt, 0)
+		require.NoError(t, err, wkt)
+		return &g
+	}
+
+	distances := []struct {
+		g1, g2 string
+		dist   float64
+	}{
+		{"POINT(0 0)", "POINT(3 4)", 5},
+		{"POINT(0 2)", "LINESTRING(-1 0,1 0)", 2},
+		{"POINT(2 2)", "POLYGON((0 0,4 0,4 4,0 4,0 0))", 0},
+		{"POINT(6 2)", "POLYGON((0 0,4 0,4 4,0 4,0 0))", 2},
+		{"LINESTRING(0 5,4 5)", "POLYGON((0 0,4 0,4 4,0 4,0 0))", 1},
+		{"LINESTRING(0 0,1 1)", "LINESTRING(0 1,1 0)", 0},
+		{"MULTIPOINT((10 10),(0 1))", "POINT(0 0)", 1},
+	}
+	for _, tt := range distances {
+		dist, ok := GeoDistance(mustParse(tt.g1), mustParse(tt.g2))
+		require.True(t, ok)
+		require.InDelta(t, tt.dist, dist, 1e-9, "%s, %s", tt.g1, tt.g2)
+	}
+	_, ok := GeoDistance(mustParse("GEOMETRYCOLLECTION EMPTY"), mustParse("POINT(0 0)"))
+	require.False(t, ok)
+
+	area, err := GeoArea(mustParse("POLYGON((0 0,4 0,4 4,0 4,0 0),(1 1,2 1,2 2,1 2,1 1))"))
+	require.NoError(t, err)
+	require.Equal(t, 15.0, area)
+	area, err = GeoArea(mustParse("MULTIPOLYGON(((0 0,1 0,1 1,0 0)),((0 0,0 2,2 2,0 0)))"))
+	require.NoError(t, err)
+	require.Equal(t, 2.5, area)
+	_, err = GeoArea(mustParse("POINT(0 0)"))
+	require.ErrorIs(t, err, ErrGeometryTypeUnsupported)
+
+	length, err := GeoLength(mustParse("LINESTRING(0 0,3 4,3 5)"))
+	require.NoError(t, err)
+	require.Equal(t, 6.0, length)
+	length, err = GeoLength(mustParse("MULTILINESTRING((0 0,0 1),(0 0,1 0))"))
+	require.NoError(t, err)
+	require.Equal(t, 2.0, length)
+	_, err = GeoLength(mustParse("POLYGON((0 0,4 0,4 4,0 0))"))
+	require.ErrorIs(t, err, ErrGeometryTypeUnsupported)
+
+	// The distance between (0, 0) and (0, 90) is a quarter of the great circle.
+	dist, err := GeoDistanceSphere(mustParse("POINT(0 0)"), mustParse("POINT(0 90)"), 6370986)
+	require.NoError(t, err)
+	require.InDelta(t, math.Pi*6370986/2, dist, 1e-6)
+	dist, err = GeoDistanceSphere(mustParse("POINT(-73.9949 40.7501)"), mustParse("POINT(-73.9961 40.7542)"), 6370986)
+	require.NoError(t, err)
+	require.InDelta(t, 466.97, dist, 0.01)
+	_, err = GeoDistanceSphere(mustParse("LINESTRING(0 0,1 1)"), mustParse("POINT(0 0)"), 6370986)
+	require.ErrorIs(t, err, ErrGeometryTypeUnsupported)
+}
@@ -0,0 +1,305 @@
+// Copyright 2026 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+)
+
+var wktGeometryTypes = map[string]byte{
+	"POINT":              mysql.GeometryTypePoint,
+	"LINESTRING":         mysql.GeometryTypeLineString,
+	"POLYGON":            mysql.GeometryTypePolygon,
+	"MULTIPOINT":         mysql.GeometryTypeMultiPoint,
+	"MULTILINESTRING":    mysql.GeometryTypeMultiLineString,
+	"MULTIPOLYGON":       mysql.GeometryTypeMultiPolygon,
+	"GEOMETRYCOLLECTION": mysql.GeometryTypeGeometryCollection,
+	"GEOMCOLLECTION":     mysql.GeometryTypeGeometryCollection,
+}
+
+// ParseGeometryWKT parses the geometry from WKT. The coordinates are expected to be in
+// the axis order of the spatial reference system.
+func ParseGeometryWKT(wkt string, srid uint32) (Geometry, error) {
+	p := wktParser{s: wkt}
+	g, err := p.parseGeometry(0)
+	if err != nil {
+		return Geometry{}, err
+	}
+	p.skipSpaces()
+	if p.pos != len(p.s) || !g.Valid() {
+		return Geometry{}, errInvalidGeometry
+	}
+	g.setSRID(srid)
+	if IsGeographicSRID(srid) {
+		g.swapAxes()
+	}
+	return g, nil
+}
+
+// wktParser parses the WKT of the geometries.
+type wktParser struct {
+	s   string
+	pos int
+}
+
+func (p *wktParser) skipSpaces() {
+	for p.pos < len(p.s) && (p.s[p.pos] == ' ' || p.s[p.pos] == '\t' || p.s[p.pos] == '\n' || p.s[p.pos] == '\r') {
+		p.pos++
+	}
+}
+
+// consume skips the next character if it's c.
+func (p *wktParser) consume(c byte) bool {
+	p.skipSpaces()
+	if p.pos < len(p.s) && p.s[p.pos] == c {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *wktParser) expect(c byte) error {
+	if !p.consume(c) {
+		return errInvalidGeometry
+	}
+	return nil
+}
+
+func (p *wktParser) parseWord() string {
+	p.skipSpaces()
+	start := p.pos
+	for p.pos < len(p.s) && (p.s[p.pos] >= 'a' && p.s[p.pos] <= 'z' || p.s[p.pos] >= 'A' && p.s[p.pos] <= 'Z') {
+		p.pos++
+	}
+	return strings.ToUpper(p.s[start:p.pos])
+}
+
+func (p *wktParser) parseNumber() (float64, error) {
+	p.skipSpaces()
+	start := p.pos
+	for p.pos < len(p.s) && strings.IndexByte("+-.0123456789eE", p.s[p.pos]) >= 0 {
+		p.pos++
+	}
+	f, err := strconv.ParseFloat(p.s[start:p.pos], 64)
+	if err != nil {
+		return 0, errInvalidGeometry
+	}
+	return f, nil
+}
+
+func (p *wktParser) parsePoint() (pt GeoPoint, err error) {
+	if pt.X, err = p.parseNumber(); err != nil {
+		return pt, err
+	}
+	pt.Y, err = p.parseNumber()
+	return pt, err
+}
+
+// parsePoints parses the points like "(0 0, 1 1)".
+func (p *wktParser) parsePoints() ([]GeoPoint, error) {
+	if err := p.expect('('); err != nil {
+		return nil, err
+	}
+	var points []GeoPoint
+	for {
+		pt, err := p.parsePoint()
+		if err != nil {
+			return nil, err
+		}
+		points = append(points, pt)
+		if !p.consume(',') {
+			break
+		}
+	}
+	return points, p.expect(')')
+}
+
+// parseList parses the list like "(elem, elem)", the elements are parsed by fn.
+func (p *wktParser) parseList(fn func() error) error {
+	if err := p.expect('('); err != nil {
+		return err
+	}
+	for {
+		if err := fn(); err != nil {
+			return err
+		}
+		if !p.consume(',') {
+			break
+		}
+	}
+	return p.expect(')')
+}
+
+func (p *wktParser) parseGeometry(depth int) (g Geometry, err error) {
+	if depth > maxGeometryDepth {
+		return g, errInvalidGeometry
+	}
+	tp, ok := wktGeometryTypes[p.parseWord()]
+	if !ok {
+		return g, errInvalidGeometry
+	}
+	g.Tp = tp
+	switch tp {
+	case mysql.GeometryTypePoint:
+		var pt GeoPoint
+		if err = p.expect('('); err != nil {
+			return g, err
+		}
+		if pt, err = p.parsePoint(); err != nil {
+			return g, err
+		}
+		g.Points = []GeoPoint{pt}
+		err = p.expect(')')
+	case mysql.GeometryTypeLineString:
+		g.Points, err = p.parsePoints()
+	case mysql.GeometryTypePolygon:
+		g.Rings, err = p.parseRings()
+	case mysql.GeometryTypeMultiPoint:
+		err = p.parseList(func() error {
+			// Both "MULTIPOINT(0 0, 1 1)" and "MULTIPOINT((0 0), (1 1))" are allowed.
+			withParen := p.consume('(')
+			pt, err := p.parsePoint()
+			if err != nil {
+				return err
+			}
+			g.Geoms = append(g.Geoms, Geometry{Tp: mysql.GeometryTypePoint, Points: []GeoPoint{pt}})
+			if withParen {
+				return p.expect(')')
+			}
+			return nil
+		})
+	case mysql.GeometryTypeMultiLineString:
+		err = p.parseList(func() error {
+			points, err := p.parsePoints()
+			g.Geoms = append(g.Geoms, Geometry{Tp: mysql.GeometryTypeLineString, Points: points})
+			return err
+		})
+	case mysql.GeometryTypeMultiPolygon:
+		err = p.parseList(func() error {
+			rings, err := p.parseRings()
+			g.Geoms = append(g.Geoms, Geometry{Tp: mysql.GeometryTypePolygon, Rings: rings})
+			return err
+		})
+	case mysql.GeometryTypeGeometryCollection:
+		start := p.pos
+		if p.parseWord() == "EMPTY" {
+			g.Geoms = []Geometry{}
+			return g, nil
+		}
+		p.pos = start
+		if p.consume('(') && p.consume(')') {
+			g.Geoms = []Geometry{}
+			return g, nil
+		}
+		p.pos = start
+		err = p.parseList(func() error {
+			elem, err := p.parseGeometry(depth + 1)
+			g.Geoms = append(g.Geoms, elem)
+			return err
+		})
+	}
+	return g, err
+}
+
+func (p *wktParser) parseRings() (rings [][]GeoPoint, err error) {
+	err = p.parseList(func() error {
+		points, err := p.parsePoints()
+		rings = append(rings, points)
+		return err
+	})
+	return rings, err
+}
+
+// WKT returns the WKT of the geometry, the coordinates are in the axis order of the
+// spatial reference system.
+func (g *Geometry) WKT() string {
+	var sb strings.Builder
+	if IsGeographicSRID(g.SRID) {
+		swapped := g.Clone()
+		swapped.swapAxes()
+		swapped.writeWKT(&sb, true)
+	} else {
+		g.writeWKT(&sb, true)
+	}
+	return sb.String()
+}
+
+func (g *Geometry) writeWKT(sb *strings.Builder, withType bool) {
+	if withType {
+		if g.Tp == mysql.GeometryTypeGeometryCollection {
+			sb.WriteString("GEOMETRYCOLLECTION")
+		} else {
+			sb.WriteString(g.TypeName())
+		}
+	}
+	switch g.Tp {
+	case mysql.GeometryTypePoint, mysql.GeometryTypeLineString:
+		writeWKTPoints(sb, g.Points)
+	case mysql.GeometryTypePolygon:
+		sb.WriteByte('(')
+		for i, ring := range g.Rings {
+			if i > 0 {
+				sb.WriteByte(',')
+			}
+			writeWKTPoints(sb, ring)
+		}
+		sb.WriteByte(')')
+	default:
+		if len(g.Geoms) == 0 {
+			sb.WriteString(" EMPTY")
+			return
+		}
+		sb.WriteByte('(')
+		for i := range g.Geoms {
+			if i > 0 {
+				sb.WriteByte(',')
+			}
+			g.Geoms[i].writeWKT(sb, g.Tp == mysql.GeometryTypeGeometryCollection)
+		}
+		sb.WriteByte(')')
+	}
+}
+
+func writeWKTPoints(sb *strings.Builder, points []GeoPoint) {
+	sb.WriteByte('(')
+	for i, pt := range points {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteString(formatGeometryCoordinate(pt.X))
+		sb.WriteByte(' ')
+		sb.WriteString(formatGeometryCoordinate(pt.Y))
+	}
+	sb.WriteByte(')')
+}
+
+// formatGeometryCoordinate formats the coordinate in the shortest representation like MySQL,
+// the scientific notation is only used for the very large or small numbers like JSON.
+func formatGeometryCoordinate(f float64) string {
+	if abs := math.Abs(f); abs == 0 || (abs >= 1e-15 && abs < 1e15) {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	// Go prints the exponent with a sign and at least two digits, like "1e+30" and "1e-07".
+	mantissa, exp, _ := strings.Cut(strconv.FormatFloat(f, 'g', -1, 64), "e")
+	sign := ""
+	if exp[0] == '-' {
+		sign = "-"
+	}
+	return mantissa + "e" + sign + strings.TrimLeft(exp[1:], "0")
+}
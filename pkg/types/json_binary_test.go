@@ -542,6 +542,36 @@ func TestFunctions(t *testing.T) {
 	require.EqualError(t, err, "Cant peek from empty bytes")
 }
 
+func TestBinaryJSONExtractAll(t *testing.T) {
+	bj1 := mustParseBinaryFromString(t, `{"a": [1, "2", {"aa": "bb"}, 4.0, {"aa": "cc"}], "b": true}`)
+	bj2 := mustParseBinaryFromString(t, `[{"a": 1}, {"a": [2, 3]}, {"b": 4}]`)
+
+	var tests = []struct {
+		bj       BinaryJSON
+		pathExpr string
+		expected []string
+	}{
+		{bj1, "$", []string{`{"a": [1, "2", {"aa": "bb"}, 4, {"aa": "cc"}], "b": true}`}},
+		{bj1, "$.a", []string{`[1, "2", {"aa": "bb"}, 4, {"aa": "cc"}]`}},
+		{bj1, "$.a[*]", []string{`1`, `"2"`, `{"aa": "bb"}`, `4`, `{"aa": "cc"}`}},
+		{bj1, "$.a[*].aa", []string{`"bb"`, `"cc"`}},
+		{bj1, "$.c", []string{}},
+		{bj1, "$[0]", []string{`{"a": [1, "2", {"aa": "bb"}, 4, {"aa": "cc"}], "b": true}`}},
+		{bj2, "$[*].a", []string{`1`, `[2, 3]`}},
+		{bj2, "$[1].a[*]", []string{`2`, `3`}},
+	}
+
+	for _, test := range tests {
+		pe, err := ParseJSONPathExpr(test.pathExpr)
+		require.NoError(t, err)
+		result := test.bj.ExtractAll(pe)
+		require.Len(t, result, len(test.expected), test.pathExpr)
+		for i, bj := range result {
+			require.Equal(t, mustParseBinaryFromString(t, test.expected[i]).String(), bj.String(), test.pathExpr)
+		}
+	}
+}
+
 func TestBinaryJSONExtractCallback(t *testing.T) {
 	bj1 := mustParseBinaryFromString(t, `{"\"hello\"": "world", "a": [1, "2", {"aa": "bb"}, 4.0, {"aa": "cc"}], "b": true, "c": ["d"]}`)
 	bj2 := mustParseBinaryFromString(t, `[{"a": 1, "b": true}, 3, 3.5, "hello, world", null, true]`)
@@ -17,6 +17,7 @@ package admin
 import (
 	"context"
 	"math"
+	"slices"
 	"strings"
 
 	"github.com/pingcap/errors"
@@ -68,7 +69,8 @@ const (
 // It returns the count greater type, the index offset and an error.
 // It returns nil if the count from the index is equal to the count from the table columns,
 // otherwise it returns an error and the corresponding index's offset.
-func CheckIndicesCount(ctx sessionctx.Context, dbName, tableName string, indices []string) (byte, int, error) {
+// Only the given partitions are counted if partitions isn't empty.
+func CheckIndicesCount(ctx sessionctx.Context, dbName, tableName string, partitions []string, indices []string) (byte, int, error) {
 	// Here we need check all indexes, includes invisible index
 	originOptUseInvisibleIdx := ctx.GetSessionVars().OptimizerUseInvisibleIndexes
 	ctx.GetSessionVars().OptimizerUseInvisibleIndexes = true
@@ -89,13 +91,21 @@ func CheckIndicesCount(ctx sessionctx.Context, dbName, tableName string, indices
 	}
 
 	// Add `` for some names like `table name`.
+	source, args := "SELECT COUNT(*) FROM %n.%n", []any{dbName, tableName}
+	if len(partitions) > 0 {
+		source += " PARTITION(%n" + strings.Repeat(", %n", len(partitions)-1) + ")"
+		for _, p := range partitions {
+			args = append(args, p)
+		}
+	}
 	exec := ctx.GetRestrictedSQLExecutor()
-	tblCnt, err := getCount(exec, snapshot, "SELECT COUNT(*) FROM %n.%n USE INDEX()", dbName, tableName)
+	tblCnt, err := getCount(exec, snapshot, source+" USE INDEX()", args...)
 	if err != nil {
 		return 0, 0, errors.Trace(err)
 	}
 	for i, idx := range indices {
-		idxCnt, err := getCount(exec, snapshot, "SELECT COUNT(*) FROM %n.%n USE INDEX(%n)", dbName, tableName, idx)
+		idxArgs := append(slices.Clip(args), idx)
+		idxCnt, err := getCount(exec, snapshot, source+" USE INDEX(%n)", idxArgs...)
 		if err != nil {
 			return 0, i, errors.Trace(err)
 		}
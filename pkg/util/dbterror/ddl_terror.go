@@ -371,13 +371,13 @@ var (
 	ErrOptOnTemporaryTable = ClassDDL.NewStd(mysql.ErrOptOnTemporaryTable)
 	// ErrOptOnCacheTable returns when exec unsupported opt at cache mode
 	ErrOptOnCacheTable = ClassDDL.NewStd(mysql.ErrOptOnCacheTable)
-	// ErrUnsupportedOnCommitPreserve returns when exec unsupported opt on commit preserve
-	ErrUnsupportedOnCommitPreserve = ClassDDL.NewStdErr(mysql.ErrUnsupportedDDLOperation, parser_mysql.Message("TiDB doesn't support ON COMMIT PRESERVE ROWS for now", nil))
 	// ErrUnsupportedClusteredSecondaryKey returns when exec unsupported clustered secondary key
 	ErrUnsupportedClusteredSecondaryKey = ClassDDL.NewStdErr(mysql.ErrUnsupportedDDLOperation, parser_mysql.Message("CLUSTERED/NONCLUSTERED keyword is only supported for primary key", nil))
 
 	// ErrUnsupportedLocalTempTableDDL returns when ddl operation unsupported for local temporary table
 	ErrUnsupportedLocalTempTableDDL = ClassDDL.NewStdErr(mysql.ErrUnsupportedDDLOperation, parser_mysql.Message("TiDB doesn't support %s for local temporary table", nil))
+	// ErrUnsupportedPreservedTempTableDDL returns when ddl operation unsupported for global temporary table with ON COMMIT PRESERVE ROWS
+	ErrUnsupportedPreservedTempTableDDL = ClassDDL.NewStdErr(mysql.ErrUnsupportedDDLOperation, parser_mysql.Message("TiDB doesn't support %s for global temporary table with ON COMMIT PRESERVE ROWS", nil))
 	// ErrInvalidAttributesSpec is returned when meeting invalid attributes.
 	ErrInvalidAttributesSpec = ClassDDL.NewStd(mysql.ErrInvalidAttributesSpec)
 	// ErrFunctionalIndexOnJSONOrGeometryFunction returns when creating expression index and the type of the expression is JSON.
@@ -507,6 +507,24 @@ var (
 	// ErrWarnGlobalIndexNeedManuallyAnalyze is used for global indexes,
 	// which cannot trigger automatic analysis when it contains prefix columns or virtual generated columns.
 	ErrWarnGlobalIndexNeedManuallyAnalyze = ClassDDL.NewStd(mysql.ErrWarnGlobalIndexNeedManuallyAnalyze)
+	// ErrMaskingPolicyExists is returned when the masking policy already exists on the table.
+	ErrMaskingPolicyExists = ClassDDL.NewStd(mysql.ErrMaskingPolicyExists)
+	// ErrMaskingPolicyNotExists is returned when the masking policy doesn't exist on the table.
+	ErrMaskingPolicyNotExists = ClassDDL.NewStd(mysql.ErrMaskingPolicyNotExists)
+	// ErrRowPolicyExists is returned when the row-level security policy already exists on the table.
+	ErrRowPolicyExists = ClassDDL.NewStd(mysql.ErrRowPolicyExists)
+	// ErrRowPolicyNotExists is returned when the row-level security policy doesn't exist on the table.
+	ErrRowPolicyNotExists = ClassDDL.NewStd(mysql.ErrRowPolicyNotExists)
+	// ErrTrgAlreadyExists is returned when the trigger already exists in the schema.
+	ErrTrgAlreadyExists = ClassDDL.NewStd(mysql.ErrTrgAlreadyExists)
+	// ErrTrgDoesNotExist is returned when the trigger doesn't exist.
+	ErrTrgDoesNotExist = ClassDDL.NewStd(mysql.ErrTrgDoesNotExist)
+	// ErrTrgOnViewOrTempTable is returned when creating a trigger on a view or a temporary table.
+	ErrTrgOnViewOrTempTable = ClassDDL.NewStd(mysql.ErrTrgOnViewOrTempTable)
+	// ErrTrgInWrongSchema is returned when the schema of the trigger isn't the one of the table.
+	ErrTrgInWrongSchema = ClassDDL.NewStd(mysql.ErrTrgInWrongSchema)
+	// ErrNoTriggersOnSystemSchema is returned when creating a trigger on a table of the system schemas.
+	ErrNoTriggersOnSystemSchema = ClassDDL.NewStd(mysql.ErrNoTriggersOnSystemSchema)
 
 	// ErrEngineAttributeInvalidFormat is returned when meeting invalid format of engine attribute.
 	ErrEngineAttributeInvalidFormat = ClassDDL.NewStd(mysql.ErrEngineAttributeInvalidFormat)
@@ -502,3 +502,48 @@ func TestAddIndexDistCleanUpBlock(t *testing.T) {
 	wg.Wait()
 	close(ch)
 }
+
+func TestChangePrimaryKeyDistCopyRows(t *testing.T) {
+	store := realtikvtest.CreateMockStoreAndSetup(t)
+	if store.Name() != "TiKV" {
+		t.Skip("TiKV store only")
+	}
+
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("drop database if exists test;")
+	tk.MustExec("create database test;")
+	tk.MustExec("use test;")
+	tk.MustExec(`set global tidb_enable_dist_task=1;`)
+	tk.MustExec("create table t (a int, b int, index idx_b(b));")
+	for i := range 10 {
+		tk.MustExec(fmt.Sprintf("insert into t values (%d, %d);", i, i))
+	}
+	tk.MustExec("split table t between (0) and (10) regions 5;")
+	tblID := tk.MustQuery("select tidb_table_id from information_schema.tables where table_schema = 'test' and table_name = 't';").Rows()[0][0].(string)
+
+	// The rows are read before the DML, and ingested after it.
+	tk1 := testkit.NewTestKit(t, store)
+	tk1.MustExec("use test;")
+	var once sync.Once
+	testfailpoint.EnableCall(t, "github.com/pingcap/tidb/pkg/ddl/writeRecordsExec", func() {
+		once.Do(func() {
+			tk1.MustExec("delete from t where a = 1;")
+			tk1.MustExec("update t set b = 100 where a = 2;")
+			tk1.MustExec("update t set a = 1000 where a = 3;")
+			tk1.MustExec("insert into t values (20, 20);")
+		})
+	})
+	tk.MustExec("alter table t add primary key(a) clustered;")
+	tk.MustQuery("select tidb_pk_type from information_schema.tables where table_schema = 'test' and table_name = 't';").Check(testkit.Rows("CLUSTERED"))
+	tk.MustExec("admin check table t;")
+	tk.MustQuery("select a, b from t order by a;").Check(testkit.Rows(
+		"0 0", "2 100", "4 4", "5 5", "6 6", "7 7", "8 8", "9 9", "20 20", "1000 3"))
+
+	jobID := tk.MustQuery("admin show ddl jobs 1;").Rows()[0][0].(string)
+	taskMgr, err := storage.GetTaskManager()
+	require.NoError(t, err)
+	ctx := util.WithInternalSourceType(context.Background(), "scheduler")
+	task, err := taskMgr.GetTaskByKeyWithHistory(ctx, fmt.Sprintf("ddl/%s/%s/%s/rows", proto.Backfill, jobID, tblID))
+	require.NoError(t, err)
+	require.Equal(t, proto.TaskStateSucceed, task.State)
+}